@@ -0,0 +1,112 @@
+// Package auth handles logging in to the uploader server: local accounts with
+// bcrypt-hashed passwords, OpenID Connect, signed session cookies and roles.
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Role is what a user is allowed to do. Each role can do everything the
+// roles before it can.
+type Role string
+
+const (
+	// Reporter can see stories and validate them.
+	Reporter Role = "reporter"
+	// CopyEditor can also fix and edit stories.
+	CopyEditor Role = "copy-editor"
+	// WebEditor can also publish to WordPress.
+	WebEditor Role = "web-editor"
+)
+
+var roleRank = map[Role]int{
+	Reporter:   1,
+	CopyEditor: 2,
+	WebEditor:  3,
+}
+
+// Valid reports whether r is one of the known roles.
+func (r Role) Valid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// Allows reports whether someone with role r may do what requires role min.
+func (r Role) Allows(min Role) bool {
+	return roleRank[r] >= roleRank[min]
+}
+
+// User is someone who is logged in.
+type User struct {
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Role     Role   `json:"role"`
+}
+
+// ErrBadLogin is returned for an unknown user or wrong password.
+var ErrBadLogin = errors.New("incorrect username or password")
+
+// Account is a local account as stored in the accounts file.
+type Account struct {
+	Username     string `json:"username"`
+	Name         string `json:"name"`
+	PasswordHash string `json:"passwordHash"`
+	Role         Role   `json:"role"`
+}
+
+// Accounts are the local accounts allowed to log in.
+type Accounts struct {
+	accounts map[string]Account
+}
+
+// LoadAccounts reads a JSON list of accounts from path.
+func LoadAccounts(path string) (*Accounts, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read accounts file: %v", err)
+	}
+	list := []Account{}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, fmt.Errorf("unable to parse accounts file %s: %v", path, err)
+	}
+	a := &Accounts{accounts: make(map[string]Account)}
+	for _, account := range list {
+		if !account.Role.Valid() {
+			return nil, fmt.Errorf("account %q has unknown role %q", account.Username, account.Role)
+		}
+		a.accounts[account.Username] = account
+	}
+	return a, nil
+}
+
+// Authenticate checks a username and password.
+func (a *Accounts) Authenticate(username, password string) (*User, error) {
+	account, ok := a.accounts[username]
+	if !ok {
+		// compare anyway so unknown users take as long as wrong passwords
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, ErrBadLogin
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(account.PasswordHash), []byte(password))
+	if err != nil {
+		return nil, ErrBadLogin
+	}
+	return &User{
+		Username: account.Username,
+		Name:     account.Name,
+		Role:     account.Role,
+	}, nil
+}
+
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("uploader"), bcrypt.DefaultCost)
+
+// HashPassword hashes a password for the accounts file.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
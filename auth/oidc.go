@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+const stateCookie = "uploader_oidc_state"
+
+// OIDCConfig describes an OpenID Connect provider to log in with.
+type OIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Roles gives the role of each user by email address.
+	Roles map[string]Role
+	// DefaultRole is given to anyone not in Roles. If it's empty, only
+	// people in Roles can log in.
+	DefaultRole Role
+}
+
+// OIDC logs users in through an OpenID Connect provider.
+type OIDC struct {
+	config      oauth2.Config
+	userInfoURL string
+	roles       map[string]Role
+	defaultRole Role
+}
+
+// NewOIDC looks up the provider's endpoints from its discovery document.
+func NewOIDC(c OIDCConfig) (*OIDC, error) {
+	if c.DefaultRole != "" && !c.DefaultRole.Valid() {
+		return nil, fmt.Errorf("unknown default role %q", c.DefaultRole)
+	}
+	roles := make(map[string]Role)
+	for email, role := range c.Roles {
+		if !role.Valid() {
+			return nil, fmt.Errorf("%s has unknown role %q", email, role)
+		}
+		roles[strings.ToLower(email)] = role
+	}
+
+	wellKnown := strings.TrimSuffix(c.Issuer, "/") + "/.well-known/openid-configuration"
+	resp, err := http.Get(wellKnown)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch OpenID configuration: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch OpenID configuration: %s returned %s", wellKnown, resp.Status)
+	}
+	discovery := struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return nil, fmt.Errorf("unable to parse OpenID configuration: %v", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(c.Issuer, "/") {
+		return nil, fmt.Errorf("OpenID configuration is for issuer %q, not %q", discovery.Issuer, c.Issuer)
+	}
+	if discovery.UserInfoEndpoint == "" {
+		return nil, errors.New("OpenID provider has no userinfo endpoint")
+	}
+
+	return &OIDC{
+		config: oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			RedirectURL:  c.RedirectURL,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  discovery.AuthorizationEndpoint,
+				TokenURL: discovery.TokenEndpoint,
+			},
+			Scopes: []string{"openid", "profile", "email"},
+		},
+		userInfoURL: discovery.UserInfoEndpoint,
+		roles:       roles,
+		defaultRole: c.DefaultRole,
+	}, nil
+}
+
+// Begin sends the browser to the provider to log in.
+func (o *OIDC) Begin(w http.ResponseWriter, req *http.Request) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		http.Error(w, "Unable to start login", 500)
+		return
+	}
+	state := base64.RawURLEncoding.EncodeToString(b)
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   600,
+		HttpOnly: true,
+	})
+	http.Redirect(w, req, o.config.AuthCodeURL(state), http.StatusFound)
+}
+
+// Finish handles the provider redirecting back after login and returns the
+// user who logged in.
+func (o *OIDC) Finish(w http.ResponseWriter, req *http.Request) (*User, error) {
+	cookie, err := req.Cookie(stateCookie)
+	if err != nil || cookie.Value == "" || cookie.Value != req.FormValue("state") {
+		return nil, errors.New("login state doesn't match; try logging in again")
+	}
+	http.SetCookie(w, &http.Cookie{Name: stateCookie, Path: "/", MaxAge: -1})
+	if msg := req.FormValue("error"); msg != "" {
+		return nil, fmt.Errorf("login failed: %s", msg)
+	}
+
+	ctx := context.Background()
+	tok, err := o.config.Exchange(ctx, req.FormValue("code"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to exchange login code: %v", err)
+	}
+
+	// The userinfo response comes straight from the provider over TLS with
+	// the token it just gave us, so it doesn't need verifying like an ID token.
+	resp, err := o.config.Client(ctx, tok).Get(o.userInfoURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get user info: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get user info: %s", resp.Status)
+	}
+	info := struct {
+		Subject       string `json:"sub"`
+		Name          string `json:"name"`
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("unable to parse user info: %v", err)
+	}
+	if info.Email == "" || (info.EmailVerified != nil && !*info.EmailVerified) {
+		return nil, errors.New("your account has no verified email address")
+	}
+
+	role, ok := o.roles[strings.ToLower(info.Email)]
+	if !ok {
+		role = o.defaultRole
+	}
+	if role == "" {
+		return nil, fmt.Errorf("%s isn't allowed to use the uploader", info.Email)
+	}
+	return &User{
+		Username: info.Email,
+		Name:     info.Name,
+		Role:     role,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const sessionCookie = "uploader_session"
+
+// ErrNoSession is returned when a request has no valid session cookie.
+var ErrNoSession = errors.New("not logged in")
+
+// Sessions keeps users logged in with a cookie holding the user and an
+// expiry time, signed with a secret key. Nothing is stored on the server,
+// so sessions survive restarts as long as the key doesn't change.
+type Sessions struct {
+	key    []byte
+	maxAge time.Duration
+	// Secure marks cookies as HTTPS-only.
+	Secure bool
+}
+
+type session struct {
+	User    User      `json:"user"`
+	Expires time.Time `json:"expires"`
+}
+
+func NewSessions(key []byte, maxAge time.Duration) *Sessions {
+	return &Sessions{
+		key:    key,
+		maxAge: maxAge,
+	}
+}
+
+// Start logs user in by setting a session cookie.
+func (s *Sessions) Start(w http.ResponseWriter, user *User) error {
+	expires := time.Now().Add(s.maxAge)
+	payload, err := json.Marshal(session{User: *user, Expires: expires})
+	if err != nil {
+		return err
+	}
+	value := base64.RawURLEncoding.EncodeToString(payload)
+	value += "." + base64.RawURLEncoding.EncodeToString(s.sign(value))
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   s.Secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// End logs the user out by clearing the session cookie.
+func (s *Sessions) End(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   s.Secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// User returns the user logged in on req.
+func (s *Sessions) User(req *http.Request) (*User, error) {
+	cookie, err := req.Cookie(sessionCookie)
+	if err != nil {
+		return nil, ErrNoSession
+	}
+	parts := strings.SplitN(cookie.Value, ".", 2)
+	if len(parts) != 2 {
+		return nil, ErrNoSession
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, s.sign(parts[0])) {
+		return nil, ErrNoSession
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrNoSession
+	}
+	sess := session{}
+	if err := json.Unmarshal(payload, &sess); err != nil {
+		return nil, ErrNoSession
+	}
+	if time.Now().After(sess.Expires) {
+		return nil, ErrNoSession
+	}
+	return &sess.User, nil
+}
+
+func (s *Sessions) sign(value string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,277 @@
+// Package blocks turns post HTML into the block markup of the WordPress
+// block editor, so posts open there as paragraphs, quotes, lists, images
+// and tables rather than one Classic block.
+package blocks
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Formats of post content, for the contentFormat setting.
+const (
+	// Classic is plain HTML, which the block editor shows as one Classic
+	// block.
+	Classic = "classic"
+	// Gutenberg is block markup.
+	Gutenberg = "blocks"
+)
+
+// Formats are the content formats there are.
+var Formats = []string{Classic, Gutenberg}
+
+// CheckFormat returns an error if format isn't one of Formats. An empty
+// format is Classic.
+func CheckFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	for _, f := range Formats {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown content format %q; use %s", format, strings.Join(Formats, " or "))
+}
+
+// Convert returns html in format.
+func Convert(html, format string) string {
+	if format == Gutenberg {
+		return Serialize(html)
+	}
+	return html
+}
+
+// IsSerialized reports whether html is already block markup.
+func IsSerialized(html string) bool {
+	return strings.HasPrefix(strings.TrimSpace(html), "<!-- wp:")
+}
+
+var (
+	tagPattern     = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	imgPattern     = regexp.MustCompile(`(?i)<img\b(?:[^>"']|"[^"]*"|'[^']*')*>`)
+	captionPattern = regexp.MustCompile(`(?is)<figcaption[^>]*>(.*?)</figcaption>`)
+	// paragraphs in text outside any element are separated by blank lines,
+	// as WordPress's wpautop reads them
+	blankLine = regexp.MustCompile(`\n\s*\n`)
+)
+
+// elements that make blocks of their own; anything else is part of a
+// paragraph
+var blockTags = map[string]bool{
+	"p": true, "blockquote": true, "ul": true, "ol": true,
+	"table": true, "figure": true, "img": true,
+}
+
+// element is a top-level element of the HTML: its tag, the whole of it,
+// and what's inside it. Text outside any block element has an empty tag.
+type element struct {
+	tag, outer, inner string
+}
+
+// split divides html into its top-level elements.
+func split(html string) []element {
+	elements := []element{}
+	last := 0
+	loose := func(text string) {
+		for _, p := range blankLine.Split(text, -1) {
+			if p = strings.TrimSpace(p); p != "" {
+				elements = append(elements, element{"", p, p})
+			}
+		}
+	}
+	for last < len(html) {
+		m := tagPattern.FindStringSubmatchIndex(html[last:])
+		if m == nil {
+			break
+		}
+		start, end := last+m[0], last+m[1]
+		name := strings.ToLower(html[last+m[4] : last+m[5]])
+		if m[3] > m[2] || !blockTags[name] {
+			// closing tags and inline elements stay in the running text
+			// until a block element turns up
+			if blockStart := nextBlock(html, end); blockStart >= 0 {
+				loose(html[last:blockStart])
+				last = blockStart
+			} else {
+				loose(html[last:])
+				last = len(html)
+			}
+			continue
+		}
+		loose(html[last:start])
+		if name == "img" {
+			elements = append(elements, element{name, html[start:end], ""})
+			last = end
+			continue
+		}
+		closeStart, closeEnd := matchingClose(html, name, end)
+		elements = append(elements, element{name, html[start:closeEnd], html[end:closeStart]})
+		last = closeEnd
+	}
+	loose(html[last:])
+	return elements
+}
+
+// nextBlock returns where the next block element after from starts, or -1.
+func nextBlock(html string, from int) int {
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(html[from:], -1) {
+		if m[3] == m[2] && blockTags[strings.ToLower(html[from+m[4]:from+m[5]])] {
+			return from + m[0]
+		}
+	}
+	return -1
+}
+
+// matchingClose returns where the tag closing a name element opened just
+// before from starts and ends, counting nested elements of the same name. An
+// element that's never closed runs to the end.
+func matchingClose(html, name string, from int) (int, int) {
+	depth := 1
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(html[from:], -1) {
+		if strings.ToLower(html[from+m[4]:from+m[5]]) != name {
+			continue
+		}
+		if m[3] == m[2] {
+			depth++
+			continue
+		}
+		if depth--; depth == 0 {
+			return from + m[0], from + m[1]
+		}
+	}
+	return len(html), len(html)
+}
+
+// Serialize returns html as block markup. Paragraphs, quotes, lists and
+// tables each become a block, and images an image block, or a gallery when
+// several come one after another.
+func Serialize(html string) string {
+	out := &bytes.Buffer{}
+	elements := split(html)
+	for i := 0; i < len(elements); i++ {
+		e := elements[i]
+		if isImage(e) {
+			images := []element{e}
+			for i+1 < len(elements) && isImage(elements[i+1]) {
+				i++
+				images = append(images, elements[i])
+			}
+			if len(images) == 1 {
+				writeImage(out, e)
+			} else {
+				writeGallery(out, images)
+			}
+			continue
+		}
+		switch e.tag {
+		case "", "p":
+			writeBlock(out, "paragraph", "", "<p>"+strings.TrimSpace(e.inner)+"</p>")
+		case "blockquote":
+			writeBlock(out, "quote", "", `<blockquote class="wp-block-quote">`+paragraphs(e.inner)+"</blockquote>")
+		case "ul":
+			writeBlock(out, "list", "", e.outer)
+		case "ol":
+			writeBlock(out, "list", `{"ordered":true}`, e.outer)
+		case "table":
+			writeTable(out, e)
+		case "figure":
+			// a figure that isn't an image may be a table with a caption,
+			// which the table block has no place for
+			written := false
+			for _, inner := range split(e.inner) {
+				if inner.tag == "table" {
+					writeTable(out, inner)
+					written = true
+				}
+			}
+			if !written {
+				writeBlock(out, "html", "", e.outer)
+			}
+		}
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// writeBlock writes a block of the given type, with its attributes as JSON
+// if there are any.
+func writeBlock(out *bytes.Buffer, name, attributes, html string) {
+	if attributes != "" {
+		attributes = " " + attributes
+	}
+	fmt.Fprintf(out, "<!-- wp:%s%s -->\n%s\n<!-- /wp:%s -->\n\n", name, attributes, html, name)
+}
+
+// paragraphs returns html with any text outside an element wrapped in
+// paragraphs, as the quote block expects.
+func paragraphs(html string) string {
+	out := &bytes.Buffer{}
+	for _, e := range split(html) {
+		if e.tag == "" {
+			out.WriteString("<p>" + e.inner + "</p>")
+		} else {
+			out.WriteString(e.outer)
+		}
+	}
+	return out.String()
+}
+
+// isImage reports whether e is an image: an <img>, or a <figure> with one
+// in it and no table.
+func isImage(e element) bool {
+	inner := strings.ToLower(e.inner)
+	return e.tag == "img" ||
+		e.tag == "figure" && imgPattern.MatchString(e.inner) && !strings.Contains(inner, "<table")
+}
+
+// image returns the <img> tag and caption of an image element.
+func image(e element) (img, caption string) {
+	img = imgPattern.FindString(e.outer)
+	if e.tag == "figure" {
+		if m := captionPattern.FindStringSubmatch(e.inner); m != nil {
+			caption = strings.TrimSpace(m[1])
+		}
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(img, ">"), "/") + "/>", caption
+}
+
+// writeTable writes a table block. The block editor expects rows to be in a
+// <thead> or <tbody>, so they're put in a <tbody> if they aren't.
+func writeTable(out *bytes.Buffer, e element) {
+	rows := strings.TrimSpace(e.inner)
+	lower := strings.ToLower(rows)
+	if !strings.Contains(lower, "<tbody") && !strings.Contains(lower, "<thead") {
+		rows = "<tbody>" + rows + "</tbody>"
+	}
+	writeBlock(out, "table", "", `<figure class="wp-block-table"><table>`+rows+"</table></figure>")
+}
+
+func writeImage(out *bytes.Buffer, e element) {
+	img, caption := image(e)
+	html := `<figure class="wp-block-image">` + img
+	if caption != "" {
+		html += "<figcaption>" + caption + "</figcaption>"
+	}
+	writeBlock(out, "image", "", html+"</figure>")
+}
+
+func writeGallery(out *bytes.Buffer, images []element) {
+	columns := len(images)
+	if columns > 3 {
+		columns = 3
+	}
+	html := &bytes.Buffer{}
+	fmt.Fprintf(html, `<figure class="wp-block-gallery columns-%d is-cropped"><ul class="blocks-gallery-grid">`, columns)
+	for _, e := range images {
+		img, caption := image(e)
+		html.WriteString(`<li class="blocks-gallery-item"><figure>` + img)
+		if caption != "" {
+			html.WriteString(`<figcaption class="blocks-gallery-item__caption">` + caption + "</figcaption>")
+		}
+		html.WriteString("</figure></li>")
+	}
+	html.WriteString("</ul></figure>")
+	writeBlock(out, "gallery", "", html.String())
+}
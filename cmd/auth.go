@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var AuthCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "authorize the uploader to read from Google Drive",
+	Long: `Runs the interactive OAuth flow once and saves the token, so that later
+commands (including the server) can use Drive without prompting.
+This isn't needed when using a service account key.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := newDriveClient().Authorize(os.Stdin, os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to authorize:", err)
+			os.Exit(1)
+		}
+	},
+	Args: cobra.NoArgs,
+}
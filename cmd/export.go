@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/export"
+	"github.com/thepoly/uploader/upload"
+	"github.com/thepoly/uploader/validate"
+)
+
+var (
+	exportFormat string
+	exportDir    string
+)
+
+var ExportCmd = &cobra.Command{
+	Use:   "export [IDML files...]",
+	Short: "write IDML files out as Markdown, text, HTML or JSON",
+	Long: `Writes each story to a file of its own in --out, named after its slug, for
+places other than WordPress like a partner site or the newsletter. Files are
+given the same way as to upload. Nothing is validated or posted.
+
+--format is one of markdown, text, html or json. Each file starts with the
+story's kicker, headline, byline, date and photo credits: as YAML front
+matter in Markdown, <meta> tags in HTML, and "Name: value" lines in text.
+The date is the issue date from the config or --issue-date.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, ok := export.Formats[exportFormat]
+		if !ok {
+			return fmt.Errorf("unknown format %q; use %s", exportFormat, strings.Join(export.FormatNames(), ", "))
+		}
+		date, err := cfg.Publishing.Date(time.Now())
+		if err != nil {
+			return err
+		}
+		paths, err := upload.Files(args)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(exportDir, 0755); err != nil {
+			return err
+		}
+
+		written := map[string]bool{}
+		for _, path := range paths {
+			stories, err := upload.OpenStories(path)
+			if err != nil {
+				return err
+			}
+			for _, story := range stories {
+				clean, _ := validate.Sanitize(story.ValidationStory())
+				s := export.New(clean, date)
+
+				name := s.Slug
+				if name == "" {
+					name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+				}
+				out := filepath.Join(exportDir, name+format.Ext)
+				for i := 2; written[out]; i++ {
+					out = filepath.Join(exportDir, fmt.Sprintf("%s-%d%s", name, i, format.Ext))
+				}
+				written[out] = true
+
+				f, err := os.Create(out)
+				if err != nil {
+					return err
+				}
+				err = format.Write(f, s)
+				if closeErr := f.Close(); err == nil {
+					err = closeErr
+				}
+				if err != nil {
+					return fmt.Errorf("unable to write %s: %v", out, err)
+				}
+				fmt.Printf("%s → %s\n", upload.Label(path, story), out)
+			}
+		}
+		return nil
+	},
+	Args: cobra.MinimumNArgs(1),
+}
+
+func init() {
+	ExportCmd.Flags().StringVar(&exportFormat, "format", "markdown", "markdown, text, html or json")
+	ExportCmd.Flags().StringVarP(&exportDir, "out", "o", ".", "directory to write the files to")
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/upload"
+	"github.com/thepoly/uploader/validate"
+)
+
+var FixCmd = &cobra.Command{
+	Use:   "fix [IDML file]",
+	Short: "show the automatic fixes for an IDML file",
+	Long: `Applies the fixes for mechanical validation problems (doubled spaces,
+straight quotes, non-breaking spaces, hyphens used as dashes...) and shows
+what they change. Snippets aren't rewritten; use "upload --fix" to post the
+fixed text.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		validator, err := validate.New(cfg.Validation)
+		if err != nil {
+			return err
+		}
+		story, err := upload.OpenStory(args[0])
+		if err != nil {
+			return err
+		}
+		_, changes := validator.Fix(story.ValidationStory())
+		upload.PrintChanges(changes)
+		if len(changes) > 0 {
+			fmt.Printf("%d fields would be changed.\n", len(changes))
+		}
+		return nil
+	},
+	Args: cobra.ExactArgs(1),
+}
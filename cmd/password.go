@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/auth"
+)
+
+var HashPasswordCmd = &cobra.Command{
+	Use:   "hash-password",
+	Short: "hash a password for the server's accounts file",
+	Long: `Reads a password from standard input and prints its bcrypt hash, for the
+passwordHash of an account in the server's accounts file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Fprint(os.Stderr, "Password: ")
+		password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && password == "" {
+			fmt.Fprintln(os.Stderr, "Unable to read password:", err)
+			os.Exit(1)
+		}
+		hash, err := auth.HashPassword(strings.TrimRight(password, "\r\n"))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to hash password:", err)
+			os.Exit(1)
+		}
+		fmt.Println(hash)
+	},
+	Args: cobra.NoArgs,
+}
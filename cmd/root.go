@@ -1,13 +1,40 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/thepoly/uploader/config"
+	"github.com/thepoly/uploader/gdrive"
+)
 
 var RootCmd = &cobra.Command{
 	Use:   "uploader [command]",
 	Short: "Uploader parses IDML files and turns stories into WordPress posts",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		cfg, err = config.Load(cmd.Flags())
+		return err
+	},
+}
+
+// cfg is loaded before any command runs.
+var cfg *config.Config
+
+// newDriveClient returns the Drive client shared by everything a command runs.
+func newDriveClient() *gdrive.Client {
+	return gdrive.New(cfg.DriveCredentials, cfg.DriveToken)
 }
 
 func init() {
+	config.AddFlags(RootCmd.PersistentFlags())
+
 	RootCmd.AddCommand(UploadCmd)
 	RootCmd.AddCommand(ServerCmd)
+	RootCmd.AddCommand(AuthCmd)
+	RootCmd.AddCommand(HashPasswordCmd)
+	RootCmd.AddCommand(FixCmd)
+	RootCmd.AddCommand(StaffCmd)
+	RootCmd.AddCommand(ValidateCmd)
+	RootCmd.AddCommand(UpdateCmd)
+	RootCmd.AddCommand(ExportCmd)
 }
@@ -2,23 +2,27 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/thepoly/uploader/server"
 )
 
 var ServerCmd = &cobra.Command{
-	Use:   "server [API password] [IDML file]",
+	Use:   "server",
 	Short: "run the server",
-	Run: func(cmd *cobra.Command, args []string) {
-		apiPassword := args[0]
-		server, err := server.New(apiPassword)
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// failing to start isn't a usage mistake
+		cmd.SilenceUsage = true
+		driveClient := newDriveClient()
+		if err := driveClient.Check(); err != nil {
+			return fmt.Errorf("unable to connect to Google Drive: %v", err)
+		}
+		server, err := server.New(cfg, driveClient)
 		if err != nil {
-			fmt.Fprint(os.Stderr, "Unable to create server:", err.Error())
-			return
+			return fmt.Errorf("unable to create server: %v", err)
 		}
 		server.Run()
+		return nil
 	},
-	Args: cobra.ExactArgs(1),
+	Args: cobra.NoArgs,
 }
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/staff"
+)
+
+var StaffCmd = &cobra.Command{
+	Use:   "staff",
+	Short: "manage the staff directory bylines are checked against",
+}
+
+var StaffSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "add WordPress users to the staff directory",
+	Long: `Matches the staff directory to the WordPress site's users, so posts are
+attached to the right author, and adds users who aren't in it yet. Titles
+aren't in WordPress; fill them in for the new members afterwards.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := cfg.Validation.Staff
+		if path == "" {
+			return errors.New("no staff directory; set validation.staff or --staff")
+		}
+		if err := cfg.RequireWPPassword(); err != nil {
+			return err
+		}
+		directory := staff.New(nil)
+		if _, err := os.Stat(path); err == nil {
+			if directory, err = staff.Load(path); err != nil {
+				return err
+			}
+		}
+		client, err := cfg.WordPress()
+		if err != nil {
+			return err
+		}
+		added, err := directory.Sync(client)
+		if err != nil {
+			return err
+		}
+		if err := directory.Save(path); err != nil {
+			return err
+		}
+		for _, name := range added {
+			fmt.Println("Added", name)
+		}
+		fmt.Printf("%d members, %d new.\n", len(directory.Members), len(added))
+		return nil
+	},
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	StaffCmd.AddCommand(StaffSyncCmd)
+}
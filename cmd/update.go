@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/upload"
+)
+
+var UpdateCmd = &cobra.Command{
+	Use:   "update [IDML file]",
+	Short: "update the post made from an IDML file",
+	Long: `Finds the post made from a snippet, shows how each of its fields differs
+from the snippet now, and updates it after asking. The post's status, date
+and slug aren't changed.
+
+--correction adds a note to the end of the post, made with the
+correctionTemplate in the config.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cfg.RequireWPPassword(); err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+		return upload.UpdatePost(cfg, args[0], updateOptions)
+	},
+	Args: cobra.ExactArgs(1),
+}
+
+var updateOptions upload.UpdateOptions
+
+func init() {
+	UpdateCmd.Flags().StringVar(&updateOptions.Correction, "correction", "", `note saying what was corrected, like "An earlier version misspelled the president's name."`)
+	UpdateCmd.Flags().BoolVarP(&updateOptions.Yes, "yes", "y", false, "update without asking")
+}
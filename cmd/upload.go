@@ -1,17 +1,84 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/schedule"
 	"github.com/thepoly/uploader/upload"
 )
 
 var UploadCmd = &cobra.Command{
-	Use:   "upload [API password] [IDML file]",
-	Short: "upload an IDML file",
-	Run: func(cmd *cobra.Command, args []string) {
-		apiPassword := args[0]
-		snippetPath := args[1]
-		upload.ParseAndUpload(apiPassword, snippetPath)
+	Use:   "upload [IDML files...]",
+	Short: "upload IDML files",
+	Long: `Uploads snippets, InCopy files and IDML packages. Arguments can be files,
+globs like "snippets/*.idms", or directories, which are searched for story
+files.
+
+Every story is validated first, including each one in an IDML package; the
+ones without errors are then posted, a few at a time, and the ones that were
+posted, skipped as already posted, or failed are listed at the end.
+
+--dry-run prints the request each post would be created with, and the media
+that would go with it, and --preview writes each post as an HTML page next
+to its file; neither posts anything.
+
+Stories that were already posted are found by their words and file name.
+--on-duplicate says whether to skip them or update the post they were posted
+as; by default you're asked.
+
+Posts are scheduled for the issue date set in the config or by --issue-date,
+unless --status or --publish-at say otherwise.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// trying an upload out doesn't talk to WordPress
+		if !uploadOptions.DryRun && !uploadOptions.Preview {
+			if err := cfg.RequireWPPassword(); err != nil {
+				return err
+			}
+		}
+		paths, err := upload.Files(args)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return errors.New("no story files found")
+		}
+		if len(paths) > 1 && (uploadOptions.Slug != "" || uploadOptions.Excerpt != "") {
+			return errors.New("--slug and --excerpt can only be used when uploading one file")
+		}
+		switch uploadOptions.OnDuplicate {
+		case upload.Ask, upload.Skip, upload.Update:
+		default:
+			return fmt.Errorf("--on-duplicate must be %s, %s or %s", upload.Ask, upload.Skip, upload.Update)
+		}
+		uploadOptions.Schedule, err = schedule.New(cfg.Publishing, uploadStatus, uploadPublishAt, time.Now())
+		if err != nil {
+			return err
+		}
+		uploadOptions.Format = cfg.ContentFormat
+		// files that weren't posted aren't a usage mistake
+		cmd.SilenceUsage = true
+		return upload.Upload(cfg, newDriveClient(), paths, uploadOptions)
 	},
-	Args: cobra.ExactArgs(2),
+	Args: cobra.MinimumNArgs(1),
+}
+
+var (
+	uploadOptions   upload.Options
+	uploadStatus    string
+	uploadPublishAt string
+)
+
+func init() {
+	UploadCmd.Flags().BoolVar(&uploadOptions.Fix, "fix", false, "apply automatic fixes before validating")
+	UploadCmd.Flags().StringVar(&uploadOptions.Slug, "slug", "", "URL slug for the post (default made from the headline)")
+	UploadCmd.Flags().StringVar(&uploadOptions.Excerpt, "excerpt", "", "excerpt for the post (default the start of the story)")
+	UploadCmd.Flags().StringVar(&uploadStatus, "status", "", "post status: draft, pending, publish or future (default from the config)")
+	UploadCmd.Flags().StringVar(&uploadPublishAt, "publish-at", "", `publish time, like "2018-03-14 09:00" in the configured time zone, or RFC 3339 (default the issue date)`)
+	UploadCmd.Flags().BoolVar(&uploadOptions.DryRun, "dry-run", false, "print the WordPress requests instead of posting")
+	UploadCmd.Flags().BoolVar(&uploadOptions.Preview, "preview", false, "write an HTML preview of each post instead of posting")
+	UploadCmd.Flags().StringVar(&uploadOptions.OnDuplicate, "on-duplicate", upload.Ask, "what to do with stories already posted: ask, skip, or update the post")
+	UploadCmd.Flags().IntVar(&uploadOptions.Concurrency, "concurrency", 4, "number of stories to post at once")
 }
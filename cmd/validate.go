@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/thepoly/uploader/upload"
+	"github.com/thepoly/uploader/validate"
+)
+
+// exit codes of the validate command, by the worst finding
+var validateExitCodes = map[validate.Severity]int{
+	validate.Info:    0,
+	validate.Warning: 2,
+	validate.Error:   3,
+}
+
+var validateFormat string
+
+var ValidateCmd = &cobra.Command{
+	Use:   "validate [IDML files...]",
+	Short: "check IDML files without uploading them",
+	Long: `Runs the validation rules on each file and prints what they find. Nothing
+is sent to WordPress or Google Drive, so no credentials are needed, but
+photos aren't checked. Files are given the same way as to upload.
+
+--format is one of human, json, junit or checkstyle. The exit code is 0 if
+nothing worse than info was found, 2 for warnings and 3 for errors,
+including files that couldn't be read; 1 means validation couldn't run.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		write, ok := map[string]func(io.Writer, []validate.Report) error{
+			"json":       validate.WriteJSON,
+			"junit":      validate.WriteJUnit,
+			"checkstyle": validate.WriteCheckstyle,
+		}[validateFormat]
+		if !ok && validateFormat != "human" {
+			return fmt.Errorf("unknown format %q", validateFormat)
+		}
+		validator, err := validate.New(cfg.Validation)
+		if err != nil {
+			return err
+		}
+
+		paths, err := upload.Files(args)
+		if err != nil {
+			return err
+		}
+
+		reports := []validate.Report{}
+		stories := []*validate.Story{}
+		all := []validate.Finding{}
+		for _, path := range paths {
+			// files that can't be read are reported like any other
+			// error, so the rest are still checked
+			read, err := upload.OpenStories(path)
+			if err != nil {
+				findings := []validate.Finding{{
+					Rule:     "file-unreadable",
+					Severity: validate.Error,
+					Message:  err.Error(),
+				}}
+				reports = append(reports, validate.Report{File: path, Findings: findings})
+				stories = append(stories, &validate.Story{})
+				all = append(all, findings...)
+				continue
+			}
+			for _, story := range read {
+				vs := story.ValidationStory()
+				findings := validator.Validate(vs)
+				reports = append(reports, validate.Report{File: upload.Label(path, story), Findings: findings})
+				stories = append(stories, vs)
+				all = append(all, findings...)
+			}
+		}
+		if write == nil {
+			printReports(reports, stories)
+		} else if err := write(os.Stdout, reports); err != nil {
+			return err
+		}
+		if worst, ok := validate.Worst(all); ok {
+			os.Exit(validateExitCodes[worst])
+		}
+		return nil
+	},
+	Args: cobra.MinimumNArgs(1),
+}
+
+// printReports prints reports the way upload does, with a heading for each
+// story.
+func printReports(reports []validate.Report, stories []*validate.Story) {
+	for i, r := range reports {
+		if i > 0 {
+			fmt.Println()
+		}
+		color.Cyan(r.File)
+		upload.PrintFindings(stories[i], r.Findings)
+	}
+}
+
+func init() {
+	ValidateCmd.Flags().StringVar(&validateFormat, "format", "human", "output format: human, json, junit or checkstyle")
+}
@@ -0,0 +1,295 @@
+// Package config loads the uploader's settings. Each setting has a default,
+// which can be overridden by the JSON config file, then by an environment
+// variable, then by a command line flag.
+//
+// Secrets are never taken from flags, since those show up in ps. Each comes
+// from an environment variable or from a file named in the config, e.g. the
+// WordPress password comes from $UPLOADER_WP_PASSWORD or wpPasswordFile.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/thepoly/uploader/blocks"
+	"github.com/thepoly/uploader/gdrive"
+	"github.com/thepoly/uploader/schedule"
+	"github.com/thepoly/uploader/validate"
+	"github.com/thepoly/uploader/wordpress"
+)
+
+type Config struct {
+	// WordPress
+	APIRoot        string `json:"apiRoot"`
+	WPUsername     string `json:"wpUsername"`
+	WPPasswordFile string `json:"wpPasswordFile"`
+	WPPassword     string `json:"-"`
+	// WPRequests says how WordPress requests are timed out, retried and
+	// rate limited.
+	WPRequests wordpress.Config `json:"wpRequests"`
+	// ContentFormat is how post content is written: blocks.Classic HTML or
+	// blocks.Gutenberg block markup.
+	ContentFormat string `json:"contentFormat"`
+	// CorrectionTemplate is the HTML template of the note added to
+	// corrected posts; see upload.Correction. Empty uses the default.
+	CorrectionTemplate string `json:"correctionTemplate"`
+
+	// server
+	ListenAddr string `json:"listenAddr"`
+	// PublicURL is where people reach the server, if it's behind a proxy.
+	PublicURL      string   `json:"publicURL"`
+	AllowedOrigins []string `json:"allowedOrigins"`
+	SessionKeyFile string   `json:"sessionKeyFile"`
+	SessionKey     string   `json:"-"`
+
+	// server login, with local accounts and/or OpenID Connect
+	AccountsFile         string            `json:"accountsFile"`
+	OIDCIssuer           string            `json:"oidcIssuer"`
+	OIDCClientID         string            `json:"oidcClientID"`
+	OIDCClientSecretFile string            `json:"oidcClientSecretFile"`
+	OIDCClientSecret     string            `json:"-"`
+	OIDCRoles            map[string]string `json:"oidcRoles"`
+	OIDCDefaultRole      string            `json:"oidcDefaultRole"`
+
+	// Google Drive
+	TeamDriveID      string `json:"teamDriveID"`
+	DriveCredentials string `json:"driveCredentials"`
+	DriveToken       string `json:"driveToken"`
+	// LinkRoots defaults to linking the drive's own paths to TeamDriveID,
+	// whichever drive that ends up being.
+	LinkRoots []string `json:"linkRoots"`
+
+	// Publishing is when posts go up by default.
+	Publishing schedule.Config `json:"publishing"`
+
+	// Validation says which validation rules apply to which sections.
+	Validation validate.Config `json:"validation"`
+}
+
+// Default returns the settings used when nothing overrides them.
+func Default() *Config {
+	return &Config{
+		APIRoot:          "https://poly.rpi.edu/wp-json",
+		WPUsername:       "uploader",
+		WPRequests:       wordpress.Default,
+		ContentFormat:    blocks.Classic,
+		ListenAddr:       "127.0.0.1:8000",
+		PublicURL:        "http://127.0.0.1:8000",
+		AllowedOrigins:   []string{"http://localhost:8080"},
+		TeamDriveID:      "0ACukZyn2MrvEUk9PVA",
+		DriveCredentials: "client_secret.json",
+		DriveToken:       gdrive.DefaultTokenPath(),
+		Publishing:       schedule.Default,
+		Validation: validate.Config{
+			Dictionary: filepath.Join(filepath.Dir(DefaultPath()), "dictionary.dic"),
+		},
+	}
+}
+
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	value func(c *Config) *string
+}
+
+var settings = []setting{
+	{"api-root", "UPLOADER_API_ROOT", "WordPress REST API root",
+		func(c *Config) *string { return &c.APIRoot }},
+	{"wp-username", "UPLOADER_WP_USERNAME", "WordPress user to post as",
+		func(c *Config) *string { return &c.WPUsername }},
+	{"wp-password-file", "UPLOADER_WP_PASSWORD_FILE", "file containing the WordPress application password",
+		func(c *Config) *string { return &c.WPPasswordFile }},
+	{"content-format", "UPLOADER_CONTENT_FORMAT", `format of post content, "classic" HTML or Gutenberg "blocks"`,
+		func(c *Config) *string { return &c.ContentFormat }},
+	{"listen", "UPLOADER_LISTEN", "address for the server to listen on",
+		func(c *Config) *string { return &c.ListenAddr }},
+	{"public-url", "UPLOADER_PUBLIC_URL", "URL people reach the server at",
+		func(c *Config) *string { return &c.PublicURL }},
+	{"accounts-file", "UPLOADER_ACCOUNTS_FILE", "JSON file of local accounts allowed to log in to the server",
+		func(c *Config) *string { return &c.AccountsFile }},
+	{"oidc-issuer", "UPLOADER_OIDC_ISSUER", "OpenID Connect issuer to log in to the server with",
+		func(c *Config) *string { return &c.OIDCIssuer }},
+	{"oidc-client-id", "UPLOADER_OIDC_CLIENT_ID", "OpenID Connect client ID",
+		func(c *Config) *string { return &c.OIDCClientID }},
+	{"team-drive", "UPLOADER_TEAM_DRIVE", "ID of the team drive snippets are read from",
+		func(c *Config) *string { return &c.TeamDriveID }},
+	{"drive-credentials", "UPLOADER_DRIVE_CREDENTIALS", "Google OAuth client secret or service account key",
+		func(c *Config) *string { return &c.DriveCredentials }},
+	{"drive-token", "UPLOADER_DRIVE_TOKEN", "where the OAuth token from \"uploader auth\" is kept",
+		func(c *Config) *string { return &c.DriveToken }},
+	{"issue-date", "UPLOADER_ISSUE_DATE", "date of the issue being uploaded (YYYY-MM-DD), which scheduled posts go up on",
+		func(c *Config) *string { return &c.Publishing.IssueDate }},
+	{"time-zone", "UPLOADER_TIME_ZONE", "time zone of publish times",
+		func(c *Config) *string { return &c.Publishing.TimeZone }},
+	{"house-style", "UPLOADER_HOUSE_STYLE", "JSON file of house style rules to validate stories with",
+		func(c *Config) *string { return &c.Validation.HouseStyle }},
+	{"dictionary", "UPLOADER_DICTIONARY", "file of extra words for the spell checker, which editors can add to",
+		func(c *Config) *string { return &c.Validation.Dictionary }},
+	{"staff", "UPLOADER_STAFF", "JSON staff directory that bylines are checked against",
+		func(c *Config) *string { return &c.Validation.Staff }},
+}
+
+const (
+	configFlag    = "config"
+	configEnv     = "UPLOADER_CONFIG"
+	linkRootsFlag = "link-root"
+	// link roots in the environment are separated by semicolons
+	linkRootsEnv = "UPLOADER_LINK_ROOTS"
+	passwordEnv  = "UPLOADER_WP_PASSWORD"
+)
+
+type secret struct {
+	env   string
+	file  func(c *Config) string
+	value func(c *Config) *string
+}
+
+var secrets = []secret{
+	{passwordEnv,
+		func(c *Config) string { return c.WPPasswordFile },
+		func(c *Config) *string { return &c.WPPassword }},
+	{"UPLOADER_SESSION_KEY",
+		func(c *Config) string { return c.SessionKeyFile },
+		func(c *Config) *string { return &c.SessionKey }},
+	{"UPLOADER_OIDC_CLIENT_SECRET",
+		func(c *Config) string { return c.OIDCClientSecretFile },
+		func(c *Config) *string { return &c.OIDCClientSecret }},
+}
+
+// AddFlags registers a flag for every setting on fs.
+func AddFlags(fs *pflag.FlagSet) {
+	def := Default()
+	fs.String(configFlag, "", fmt.Sprintf("config file (default %s, or $%s)", DefaultPath(), configEnv))
+	for _, s := range settings {
+		fs.String(s.flag, *s.value(def), fmt.Sprintf("%s (or $%s)", s.usage, s.env))
+	}
+	fs.StringArray(linkRootsFlag, nil,
+		"map a linked image path prefix to a local directory or drive:<team drive ID>[/<folder ID>], as prefix=root (or $"+linkRootsEnv+")")
+}
+
+// DefaultPath is the config file read when no other is given.
+func DefaultPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		if usr, err := user.Current(); err == nil {
+			dir = filepath.Join(usr.HomeDir, ".config")
+		}
+	}
+	return filepath.Join(dir, "uploader", "config.json")
+}
+
+// Load builds the configuration from the defaults, the config file, the
+// environment and any flags set on fs, in that order. fs may be nil.
+func Load(fs *pflag.FlagSet) (*Config, error) {
+	c := Default()
+
+	path, explicit := os.Getenv(configEnv), true
+	if fs != nil && fs.Changed(configFlag) {
+		path, _ = fs.GetString(configFlag)
+	}
+	if path == "" {
+		path, explicit = DefaultPath(), false
+	}
+	if err := c.readFile(path); err != nil {
+		if explicit || !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		if val, ok := os.LookupEnv(s.env); ok {
+			*s.value(c) = val
+		}
+		if fs != nil && fs.Changed(s.flag) {
+			*s.value(c), _ = fs.GetString(s.flag)
+		}
+	}
+	if val, ok := os.LookupEnv(linkRootsEnv); ok {
+		c.LinkRoots = splitList(val)
+	}
+	if fs != nil && fs.Changed(linkRootsFlag) {
+		c.LinkRoots, _ = fs.GetStringArray(linkRootsFlag)
+	}
+	if c.LinkRoots == nil {
+		c.LinkRoots = defaultLinkRoots(c.TeamDriveID)
+	}
+
+	if err := blocks.CheckFormat(c.ContentFormat); err != nil {
+		return nil, err
+	}
+	if err := c.loadSecrets(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// defaultLinkRoots maps the paths the team drive is synced to on editors'
+// computers to the drive itself.
+func defaultLinkRoots(teamDriveID string) []string {
+	return []string{
+		"Team Drives/The Polytechnic/=drive:" + teamDriveID,
+		"Shared drives/The Polytechnic/=drive:" + teamDriveID,
+	}
+}
+
+func (c *Config) readFile(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, c); err != nil {
+		return fmt.Errorf("unable to parse config file %s: %v", path, err)
+	}
+	return nil
+}
+
+func (c *Config) loadSecrets() error {
+	for _, s := range secrets {
+		if val, ok := os.LookupEnv(s.env); ok {
+			*s.value(c) = val
+			continue
+		}
+		if s.file(c) == "" {
+			continue
+		}
+		b, err := ioutil.ReadFile(s.file(c))
+		if err != nil {
+			return fmt.Errorf("unable to read secret: %v", err)
+		}
+		*s.value(c) = strings.TrimSpace(string(b))
+	}
+	return nil
+}
+
+// RequireWPPassword returns an error explaining how to set the WordPress
+// password if it hasn't been.
+func (c *Config) RequireWPPassword() error {
+	if c.WPPassword == "" {
+		return fmt.Errorf("no WordPress password; set $%s or wpPasswordFile", passwordEnv)
+	}
+	return nil
+}
+
+// WordPress returns a client for the WordPress API that logs in with the
+// configured user and password.
+func (c *Config) WordPress() (*wordpress.Client, error) {
+	return wordpress.New(c.APIRoot, c.WPUsername, c.WPPassword, c.WPRequests)
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,119 @@
+// Package export writes stories out for places other than WordPress, like a
+// partner site, the newsletter, or a reporter who wants a plain copy: as
+// Markdown or HTML with front matter for static site tools, plain text, or
+// JSON.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thepoly/uploader/validate"
+)
+
+// Story is a story as it's exported. Headline, kicker and byline fields may
+// have italics, and Body is the story's sanitized HTML.
+type Story struct {
+	Kicker       string `json:"kicker"`
+	Headline     string `json:"headline"`
+	Subdeck      string `json:"subdeck,omitempty"`
+	Byline       string `json:"byline"`
+	AuthorTitle  string `json:"authorTitle,omitempty"`
+	Date         string `json:"date"`
+	PhotoCredit  string `json:"photoCredit,omitempty"`
+	PhotoCaption string `json:"photoCaption,omitempty"`
+	Slug         string `json:"slug"`
+	Excerpt      string `json:"excerpt,omitempty"`
+	Body         string `json:"body"`
+}
+
+// New returns s for export, dated date. Its text should already have been
+// sanitized; see validate.Sanitize.
+func New(s *validate.Story, date time.Time) *Story {
+	return &Story{
+		Kicker:       s.Kicker,
+		Headline:     s.Headline,
+		Subdeck:      s.Subdeck,
+		Byline:       s.AuthorName,
+		AuthorTitle:  s.AuthorTitle,
+		Date:         date.Format("2006-01-02"),
+		PhotoCredit:  s.PhotoByline,
+		PhotoCaption: s.PhotoCaption,
+		Slug:         s.Slug,
+		Excerpt:      s.Excerpt,
+		Body:         s.BodyText,
+	}
+}
+
+// Format is a way of writing stories out.
+type Format struct {
+	// Ext is the extension of the files written, like ".md".
+	Ext   string
+	Write func(w io.Writer, s *Story) error
+}
+
+// Formats are the export formats by name.
+var Formats = map[string]Format{
+	"markdown": {".md", WriteMarkdown},
+	"text":     {".txt", WriteText},
+	"html":     {".html", WriteHTML},
+	"json":     {".json", WriteJSON},
+}
+
+// FormatNames lists the names of Formats in order.
+func FormatNames() []string {
+	names := []string{}
+	for name := range Formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// field is a line of front matter.
+type field struct {
+	name, value string
+}
+
+// frontMatter returns the fields of s's front matter that have values, as
+// plain text.
+func (s *Story) frontMatter() []field {
+	fields := []field{
+		{"kicker", s.Kicker},
+		{"headline", s.Headline},
+		{"subdeck", s.Subdeck},
+		{"byline", s.Byline},
+		{"authorTitle", s.AuthorTitle},
+		{"date", s.Date},
+		{"photoCredit", s.PhotoCredit},
+		{"photoCaption", s.PhotoCaption},
+		{"slug", s.Slug},
+		{"excerpt", s.Excerpt},
+	}
+	present := []field{}
+	for _, f := range fields {
+		if f.value = plain(f.value); f.value != "" {
+			present = append(present, f)
+		}
+	}
+	return present
+}
+
+// plain returns inline HTML as plain text.
+func plain(s string) string {
+	return strings.TrimSpace(text(parse(s)))
+}
+
+// WriteJSON writes s as a JSON object.
+func WriteJSON(w io.Writer, s *Story) error {
+	b, err := json.MarshalIndent(s, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+var htmlTemplate = template.Must(template.New("story").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+{{range .FrontMatter}}<meta name="{{.Name}}" content="{{.Value}}">
+{{end}}</head>
+<body>
+<article>
+<header>
+{{with .Story.Kicker}}<p class="kicker">{{.}}</p>
+{{end}}<h1>{{.Story.Headline}}</h1>
+{{with .Story.Subdeck}}<p class="subdeck">{{.}}</p>
+{{end}}{{with .Story.Byline}}<p class="byline">By {{.}}{{with $.Story.AuthorTitle}}, {{.}}{{end}}</p>
+{{end}}<time datetime="{{.Date}}">{{.Date}}</time>
+</header>
+{{.Story.Body}}
+{{if or .Story.PhotoCaption .Story.PhotoCredit}}<footer>
+{{with .Story.PhotoCaption}}<p class="photo-caption">{{.}}</p>
+{{end}}{{with .Story.PhotoCredit}}<p class="photo-credit">{{.}}</p>
+{{end}}</footer>
+{{end}}</article>
+</body>
+</html>
+`))
+
+// WriteHTML writes s as an HTML page, with its front matter in <meta> tags.
+func WriteHTML(w io.Writer, s *Story) error {
+	type metaField struct{ Name, Value string }
+	meta := []metaField{}
+	for _, f := range s.frontMatter() {
+		meta = append(meta, metaField{f.name, f.value})
+	}
+	// body text straight from a snippet separates paragraphs with blank
+	// lines, so each block is written out again as an element
+	body := []string{}
+	for _, b := range parse(s.Body).blocks() {
+		body = append(body, b.html())
+	}
+	// the story's HTML has been sanitized already
+	return htmlTemplate.Execute(w, struct {
+		Title       string
+		Date        string
+		FrontMatter []metaField
+		Story       map[string]template.HTML
+	}{
+		Title:       plain(s.Headline),
+		Date:        s.Date,
+		FrontMatter: meta,
+		Story: map[string]template.HTML{
+			"Kicker":       template.HTML(s.Kicker),
+			"Headline":     template.HTML(s.Headline),
+			"Subdeck":      template.HTML(s.Subdeck),
+			"Byline":       template.HTML(s.Byline),
+			"AuthorTitle":  template.HTML(s.AuthorTitle),
+			"PhotoCaption": template.HTML(s.PhotoCaption),
+			"PhotoCredit":  template.HTML(s.PhotoCredit),
+			"Body":         template.HTML(strings.Join(body, "\n")),
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// WriteMarkdown writes s as Markdown with YAML front matter, the way static
+// site generators like Jekyll and Hugo read posts.
+func WriteMarkdown(w io.Writer, s *Story) error {
+	out := &bytes.Buffer{}
+	out.WriteString("---\n")
+	for _, f := range s.frontMatter() {
+		// JSON strings are YAML strings too, and quoting every value
+		// saves working out which ones YAML would misread
+		value, _ := json.Marshal(f.value)
+		fmt.Fprintf(out, "%s: %s\n", f.name, value)
+	}
+	out.WriteString("---\n\n")
+	out.WriteString(markdown(parse(s.Body)))
+	out.WriteString("\n")
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// markdown returns the blocks in n as Markdown, separated by blank lines.
+func markdown(n *node) string {
+	blocks := []string{}
+	for _, b := range n.blocks() {
+		if md := markdownBlock(b); md != "" {
+			blocks = append(blocks, md)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func markdownBlock(b *node) string {
+	switch b.tag {
+	case "blockquote":
+		lines := strings.Split(markdown(b), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	case "ul", "ol":
+		items := []string{}
+		for _, li := range b.children {
+			if li.tag != "li" {
+				continue
+			}
+			marker := "-"
+			if b.tag == "ol" {
+				marker = fmt.Sprintf("%d.", len(items)+1)
+			}
+			items = append(items, marker+" "+strings.TrimSpace(markdownInline(li)))
+		}
+		return strings.Join(items, "\n")
+	case "table":
+		return markdownTable(b)
+	case "figure":
+		if table := b.find("table"); table != nil {
+			return markdownTable(table)
+		}
+		md := ""
+		if img := b.find("img"); img != nil {
+			md = markdownImage(img)
+		}
+		if caption := b.find("figcaption"); caption != nil {
+			md += "\n*" + strings.TrimSpace(markdownInline(caption)) + "*"
+		}
+		return strings.TrimSpace(md)
+	case "img":
+		return markdownImage(b)
+	}
+	return strings.TrimSpace(markdownInline(b))
+}
+
+func markdownImage(img *node) string {
+	return fmt.Sprintf("![%s](%s)", escapeMarkdown(img.attrs["alt"]), img.attrs["src"])
+}
+
+// markdownTable returns a table as a GitHub-style pipe table, with the first
+// row as the header, since Markdown tables have to have one.
+func markdownTable(table *node) string {
+	lines := []string{}
+	for i, row := range table.rows() {
+		cells := []string{}
+		for _, cell := range row.cells() {
+			md := strings.TrimSpace(markdownInline(cell))
+			cells = append(cells, strings.Replace(md, "|", `\|`, -1))
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			rule := strings.Repeat("| --- ", len(cells)) + "|"
+			lines = append(lines, rule)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownInline returns the text and inline elements under n as Markdown.
+func markdownInline(n *node) string {
+	out := &bytes.Buffer{}
+	for _, c := range n.children {
+		switch c.tag {
+		case "":
+			out.WriteString(escapeMarkdown(collapseSpace(c.text)))
+		case "em", "i":
+			out.WriteString("*" + markdownInline(c) + "*")
+		case "strong", "b":
+			out.WriteString("**" + markdownInline(c) + "**")
+		case "a":
+			fmt.Fprintf(out, "[%s](%s)", markdownInline(c), c.attrs["href"])
+		case "img":
+			out.WriteString(markdownImage(c))
+		case "br":
+			out.WriteString("  \n")
+		default:
+			out.WriteString(markdownInline(c))
+		}
+	}
+	return out.String()
+}
+
+var markdownSpecial = regexp.MustCompile("[\\\\`*_\\[\\]<>]")
+
+// escapeMarkdown escapes the characters in text that Markdown would read as
+// formatting.
+func escapeMarkdown(text string) string {
+	return markdownSpecial.ReplaceAllString(text, `\$0`)
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+// collapseSpace replaces runs of whitespace with single spaces, as HTML
+// shows them.
+func collapseSpace(text string) string {
+	return spaces.ReplaceAllString(text, " ")
+}
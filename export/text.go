@@ -0,0 +1,123 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteText writes s as plain text, with its front matter as "Name: value"
+// lines before the story.
+func WriteText(w io.Writer, s *Story) error {
+	out := &bytes.Buffer{}
+	for _, f := range s.frontMatter() {
+		fmt.Fprintf(out, "%s: %s\n", textLabels[f.name], f.value)
+	}
+	out.WriteString("\n")
+	out.WriteString(plainBlocks(parse(s.Body)))
+	out.WriteString("\n")
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+var textLabels = map[string]string{
+	"kicker":       "Kicker",
+	"headline":     "Headline",
+	"subdeck":      "Subdeck",
+	"byline":       "Byline",
+	"authorTitle":  "Author title",
+	"date":         "Date",
+	"photoCredit":  "Photo credit",
+	"photoCaption": "Photo caption",
+	"slug":         "Slug",
+	"excerpt":      "Excerpt",
+}
+
+// plainBlocks returns the blocks in n as plain text, separated by blank
+// lines.
+func plainBlocks(n *node) string {
+	blocks := []string{}
+	for _, b := range n.blocks() {
+		if t := plainBlock(b); t != "" {
+			blocks = append(blocks, t)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func plainBlock(b *node) string {
+	switch b.tag {
+	case "blockquote":
+		lines := strings.Split(plainBlocks(b), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("    "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	case "ul", "ol":
+		items := []string{}
+		for _, li := range b.children {
+			if li.tag != "li" {
+				continue
+			}
+			marker := "-"
+			if b.tag == "ol" {
+				marker = fmt.Sprintf("%d.", len(items)+1)
+			}
+			items = append(items, marker+" "+strings.TrimSpace(text(li)))
+		}
+		return strings.Join(items, "\n")
+	case "table":
+		return plainTable(b)
+	case "figure":
+		if table := b.find("table"); table != nil {
+			return plainTable(table)
+		}
+		if caption := b.find("figcaption"); caption != nil {
+			return "[Photo: " + strings.TrimSpace(text(caption)) + "]"
+		}
+		return "[Photo]"
+	case "img":
+		if alt := b.attrs["alt"]; alt != "" {
+			return "[Photo: " + alt + "]"
+		}
+		return "[Photo]"
+	}
+	return strings.TrimSpace(text(b))
+}
+
+// plainTable returns a table with its cells separated by tabs, which pastes
+// into a spreadsheet.
+func plainTable(table *node) string {
+	lines := []string{}
+	for _, row := range table.rows() {
+		cells := []string{}
+		for _, cell := range row.cells() {
+			cells = append(cells, strings.TrimSpace(text(cell)))
+		}
+		lines = append(lines, strings.Join(cells, "\t"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// text returns the text under n, with links followed by where they go.
+func text(n *node) string {
+	out := &bytes.Buffer{}
+	for _, c := range n.children {
+		switch c.tag {
+		case "":
+			out.WriteString(collapseSpace(c.text))
+		case "a":
+			t := text(c)
+			out.WriteString(t)
+			if href := c.attrs["href"]; href != "" && href != t {
+				out.WriteString(" (" + href + ")")
+			}
+		case "br":
+			out.WriteString("\n")
+		default:
+			out.WriteString(text(c))
+		}
+	}
+	return out.String()
+}
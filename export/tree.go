@@ -0,0 +1,184 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// node is an element of story HTML, or text if tag is empty.
+type node struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+var (
+	tagPattern       = regexp.MustCompile(`(?s)<!--.*?-->|<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	attributePattern = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+))?`)
+)
+
+// elements that never have children
+var voidTags = map[string]bool{"img": true, "br": true, "hr": true}
+
+// parse reads story HTML into a tree. It's only meant for the sanitized HTML
+// of body text, so it doesn't try hard with anything else: a closing tag
+// that doesn't match an open element is ignored, and elements left open are
+// closed at the end.
+func parse(s string) *node {
+	root := &node{}
+	stack := []*node{root}
+	top := func() *node { return stack[len(stack)-1] }
+	text := func(t string) {
+		if t != "" {
+			top().children = append(top().children, &node{text: html.UnescapeString(t)})
+		}
+	}
+	last := 0
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(s, -1) {
+		text(s[last:m[0]])
+		last = m[1]
+		if m[4] < 0 {
+			// a comment
+			continue
+		}
+		name := strings.ToLower(s[m[4]:m[5]])
+		if m[3] > m[2] {
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == name {
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		}
+		n := &node{tag: name, attrs: map[string]string{}}
+		attributes := s[m[6]:m[7]]
+		for _, a := range attributePattern.FindAllStringSubmatch(attributes, -1) {
+			n.attrs[strings.ToLower(a[1])] = html.UnescapeString(strings.Trim(a[2], `"'`))
+		}
+		top().children = append(top().children, n)
+		if !voidTags[name] {
+			stack = append(stack, n)
+		}
+	}
+	text(s[last:])
+	return root
+}
+
+// blankLine separates paragraphs of text outside any element, as in body
+// text straight from a snippet.
+var blankLine = regexp.MustCompile(`\n\s*\n`)
+
+// blocks returns the children of n with text outside any element split into
+// paragraphs, so that each is a block: a paragraph, quote, list, table or
+// image. Runs of text and inline elements become paragraphs.
+func (n *node) blocks() []*node {
+	blocks := []*node{}
+	var para *node
+	for _, c := range n.children {
+		if c.tag != "" && isBlock(c.tag) {
+			blocks = append(blocks, c)
+			para = nil
+			continue
+		}
+		if c.tag != "" {
+			if para == nil {
+				para = &node{tag: "p"}
+				blocks = append(blocks, para)
+			}
+			para.children = append(para.children, c)
+			continue
+		}
+		for i, t := range blankLine.Split(c.text, -1) {
+			if i > 0 {
+				para = nil
+			}
+			if strings.TrimSpace(t) == "" {
+				continue
+			}
+			if para == nil {
+				para = &node{tag: "p"}
+				blocks = append(blocks, para)
+			}
+			para.children = append(para.children, &node{text: t})
+		}
+	}
+	return blocks
+}
+
+func isBlock(tag string) bool {
+	switch tag {
+	case "p", "blockquote", "ul", "ol", "table", "figure", "img":
+		return true
+	}
+	return false
+}
+
+// find returns the first element under n with the given tag, or nil.
+func (n *node) find(tag string) *node {
+	for _, c := range n.children {
+		if c.tag == tag {
+			return c
+		}
+		if found := c.find(tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// rows returns the rows of a table, wherever they are in it.
+func (n *node) rows() []*node {
+	rows := []*node{}
+	for _, c := range n.children {
+		if c.tag == "tr" {
+			rows = append(rows, c)
+		} else if c.tag != "" {
+			rows = append(rows, c.rows()...)
+		}
+	}
+	return rows
+}
+
+// cells returns the cells of a row.
+func (n *node) cells() []*node {
+	cells := []*node{}
+	for _, c := range n.children {
+		if c.tag == "td" || c.tag == "th" {
+			cells = append(cells, c)
+		}
+	}
+	return cells
+}
+
+// html returns n as HTML.
+func (n *node) html() string {
+	if n.tag == "" && n.text != "" {
+		return html.EscapeString(n.text)
+	}
+	out := &bytes.Buffer{}
+	if n.tag != "" {
+		out.WriteString("<" + n.tag)
+		names := []string{}
+		for name := range n.attrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(out, ` %s="%s"`, name, html.EscapeString(n.attrs[name]))
+		}
+		out.WriteString(">")
+	}
+	for _, c := range n.children {
+		out.WriteString(c.html())
+	}
+	if n.tag != "" && !voidTags[n.tag] {
+		out.WriteString("</" + n.tag + ">")
+	}
+	return out.String()
+}
@@ -0,0 +1,35 @@
+// Package fingerprint identifies stories by their words, so a story posted
+// twice is recognized even if it was fixed up or retyped in between.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// words returns the words in s, lowercased and without markup or
+// punctuation.
+func words(s string) []string {
+	s = html.UnescapeString(tagPattern.ReplaceAllString(s, " "))
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Of returns the fingerprint of the parts of a story, such as its headline,
+// byline and body text. Only their words count, so quotes, dashes, spacing,
+// case and markup can change without changing the fingerprint.
+func Of(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(strings.Join(words(part), " ")))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
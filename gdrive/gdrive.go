@@ -0,0 +1,271 @@
+// Package gdrive wraps the Google Drive API client shared by the rest of the
+// uploader. One Client is created per process and passed to whatever needs
+// it, so credentials are read once and folder lookups are remembered.
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/drive/v3"
+)
+
+const folderMimeType = "application/vnd.google-apps.folder"
+
+type Client struct {
+	credentialsPath string
+	tokenPath       string
+
+	connect sync.Once
+	srv     *drive.Service
+	err     error
+
+	// folder IDs by team drive, parent and path
+	m       sync.Mutex
+	folders map[string]string
+}
+
+// New returns a client using the credentials in credentialsPath, which may be
+// either an OAuth client secret or a service account key. OAuth clients also
+// need a token, cached in tokenPath by Authorize; if tokenPath is empty,
+// DefaultTokenPath is used. Nothing is read until the client is first used.
+func New(credentialsPath, tokenPath string) *Client {
+	if tokenPath == "" {
+		tokenPath = DefaultTokenPath()
+	}
+	return &Client{
+		credentialsPath: credentialsPath,
+		tokenPath:       tokenPath,
+		folders:         make(map[string]string),
+	}
+}
+
+// DefaultTokenPath is where OAuth tokens are cached unless told otherwise.
+func DefaultTokenPath() string {
+	dir := ".credentials"
+	if usr, err := user.Current(); err == nil {
+		dir = filepath.Join(usr.HomeDir, dir)
+	}
+	return filepath.Join(dir, "drive-go-quickstart.json")
+}
+
+// Service returns the underlying Drive service, connecting on the first call.
+// It never prompts for anything, so it's safe to use from the server; if
+// there's no way to authenticate it returns an error saying what's missing.
+func (c *Client) Service() (*drive.Service, error) {
+	c.connect.Do(func() {
+		c.srv, c.err = c.newService()
+	})
+	return c.srv, c.err
+}
+
+// Check connects to Drive, for callers that want to fail early if
+// credentials are missing rather than on first use.
+func (c *Client) Check() error {
+	_, err := c.Service()
+	return err
+}
+
+func (c *Client) newService() (*drive.Service, error) {
+	ctx := context.Background()
+	b, err := c.readCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	var client *http.Client
+	if isServiceAccount(b) {
+		config, err := google.JWTConfigFromJSON(b, drive.DriveReadonlyScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse service account key %s: %v", c.credentialsPath, err)
+		}
+		client = config.Client(ctx)
+	} else {
+		config, err := google.ConfigFromJSON(b, drive.DriveReadonlyScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client secret file %s: %v", c.credentialsPath, err)
+		}
+		tok, err := tokenFromFile(c.tokenPath)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no Drive token at %s; run \"uploader auth\" first or use a service account key", c.tokenPath)
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to read Drive token %s: %v", c.tokenPath, err)
+		}
+		client = config.Client(ctx, tok)
+	}
+
+	srv, err := drive.New(client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve drive client: %v", err)
+	}
+	return srv, nil
+}
+
+func (c *Client) readCredentials() ([]byte, error) {
+	b, err := ioutil.ReadFile(c.credentialsPath)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no Drive credentials at %s; download an OAuth client secret or service account key from the Google API console", c.credentialsPath)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read Drive credentials: %v", err)
+	}
+	return b, nil
+}
+
+func isServiceAccount(credentials []byte) bool {
+	var f struct {
+		Type string `json:"type"`
+	}
+	return json.Unmarshal(credentials, &f) == nil && f.Type == "service_account"
+}
+
+// Authorize runs the interactive OAuth flow: it writes a link to out, reads
+// the authorization code pasted from it on in, and saves the resulting token
+// for later runs. It isn't needed with a service account key.
+func (c *Client) Authorize(in io.Reader, out io.Writer) error {
+	b, err := c.readCredentials()
+	if err != nil {
+		return err
+	}
+	if isServiceAccount(b) {
+		return fmt.Errorf("%s is a service account key, which doesn't need authorizing", c.credentialsPath)
+	}
+	config, err := google.ConfigFromJSON(b, drive.DriveReadonlyScope)
+	if err != nil {
+		return fmt.Errorf("unable to parse client secret file %s: %v", c.credentialsPath, err)
+	}
+
+	authURL := config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
+	fmt.Fprintf(out, "Go to the following link in your browser then type the "+
+		"authorization code: \n%v\n", authURL)
+
+	var code string
+	if _, err := fmt.Fscan(in, &code); err != nil {
+		return fmt.Errorf("unable to read authorization code: %v", err)
+	}
+
+	tok, err := config.Exchange(oauth2.NoContext, code)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve token from web: %v", err)
+	}
+
+	fmt.Fprintf(out, "Saving credential file to: %s\n", c.tokenPath)
+	return saveToken(c.tokenPath, tok)
+}
+
+// Find returns the ID of the file called name in the folder parent, or an
+// empty ID if there isn't one. If folder is set, only folders are considered.
+// teamDriveID may be empty to search the user's own drive.
+func (c *Client) Find(teamDriveID, parent, name string, folder bool) (string, error) {
+	srv, err := c.Service()
+	if err != nil {
+		return "", err
+	}
+	q := fmt.Sprintf("name = '%s' and '%s' in parents and trashed = false", escapeQuery(name), parent)
+	if folder {
+		q += fmt.Sprintf(" and mimeType = '%s'", folderMimeType)
+	}
+	call := srv.Files.List().PageSize(10).Q(q).
+		Fields("files(id, name)").
+		SupportsTeamDrives(true).IncludeTeamDriveItems(true)
+	if teamDriveID != "" {
+		call = call.TeamDriveId(teamDriveID).Corpora("teamDrive")
+	}
+	r, err := call.Do()
+	if err != nil {
+		return "", fmt.Errorf("unable to list files in %s: %v", parent, err)
+	}
+	for _, f := range r.Files {
+		if f.Name == name {
+			return f.Id, nil
+		}
+	}
+	return "", nil
+}
+
+// FolderID returns the ID of the folder at the slash-separated path under
+// root, or an empty ID if it doesn't exist. Every folder found along the way
+// is remembered, so later lookups under the same folders skip those calls.
+func (c *Client) FolderID(teamDriveID, root, path string) (string, error) {
+	parent := root
+	walked := ""
+	for _, name := range strings.Split(path, "/") {
+		if name == "" {
+			continue
+		}
+		walked += "/" + name
+		key := teamDriveID + ":" + root + walked
+
+		c.m.Lock()
+		id, ok := c.folders[key]
+		c.m.Unlock()
+		if !ok {
+			var err error
+			id, err = c.Find(teamDriveID, parent, name, true)
+			if err != nil || id == "" {
+				return "", err
+			}
+			c.m.Lock()
+			c.folders[key] = id
+			c.m.Unlock()
+		}
+		parent = id
+	}
+	return parent, nil
+}
+
+// Download reads the contents of the file with the given ID.
+func (c *Client) Download(id string) ([]byte, error) {
+	srv, err := c.Service()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := srv.Files.Get(id).SupportsTeamDrives(true).Download()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func escapeQuery(s string) string {
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	return strings.Replace(s, "'", "\\'", -1)
+}
+
+// tokenFromFile retrieves a Token from a given file path.
+// It returns the retrieved Token and any read error encountered.
+func tokenFromFile(file string) (*oauth2.Token, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	t := &oauth2.Token{}
+	err = json.NewDecoder(f).Decode(t)
+	defer f.Close()
+	return t, err
+}
+
+// saveToken uses a file path to create a file and store the
+// token in it.
+func saveToken(file string, token *oauth2.Token) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to cache oauth token: %v", err)
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(token)
+}
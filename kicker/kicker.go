@@ -0,0 +1,104 @@
+// Package kicker is the list of kickers stories may have. Kickers double as
+// section labels, so each maps to the WordPress categories and tags of its
+// section.
+package kicker
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/thepoly/uploader/spell"
+)
+
+// Kicker is one allowed kicker, as configured.
+type Kicker struct {
+	Name string `json:"name"`
+	// Aliases are other ways the kicker is written, e.g. "Ed Notebook".
+	Aliases []string `json:"aliases,omitempty"`
+	// Categories and Tags are the IDs of the WordPress categories and tags
+	// posts with the kicker go in.
+	Categories []int `json:"categories,omitempty"`
+	Tags       []int `json:"tags,omitempty"`
+}
+
+// Taxonomy is the set of allowed kickers.
+type Taxonomy struct {
+	kickers []Kicker
+	byName  map[string]int
+}
+
+// New returns a taxonomy of kickers. It's an error for two kickers to share
+// a name or alias.
+func New(kickers []Kicker) (*Taxonomy, error) {
+	t := &Taxonomy{kickers: kickers, byName: map[string]int{}}
+	for i, k := range kickers {
+		for _, name := range append([]string{k.Name}, k.Aliases...) {
+			key := normalize(name)
+			if j, ok := t.byName[key]; ok && j != i {
+				return nil, fmt.Errorf("kicker %q is used by both %q and %q", name, kickers[j].Name, k.Name)
+			}
+			t.byName[key] = i
+		}
+	}
+	return t, nil
+}
+
+var spacePattern = regexp.MustCompile(`\s+`)
+
+// normalize ignores case and spacing, since kickers are usually set in
+// capitals.
+func normalize(name string) string {
+	name = strings.Replace(name, "\u00a0", " ", -1)
+	return strings.ToLower(spacePattern.ReplaceAllString(strings.TrimSpace(name), " "))
+}
+
+// Empty reports whether no kickers are configured, in which case any kicker
+// is allowed.
+func (t *Taxonomy) Empty() bool {
+	return t == nil || len(t.kickers) == 0
+}
+
+// Find returns the kicker with the given name or alias, or nil if there
+// isn't one.
+func (t *Taxonomy) Find(name string) *Kicker {
+	if t == nil {
+		return nil
+	}
+	i, ok := t.byName[normalize(name)]
+	if !ok {
+		return nil
+	}
+	return &t.kickers[i]
+}
+
+// Similar returns up to n kicker names that look like name, most alike first.
+func (t *Taxonomy) Similar(name string, n int) []string {
+	if t == nil {
+		return nil
+	}
+	name = normalize(name)
+	type match struct {
+		name     string
+		distance int
+	}
+	matches := []match{}
+	for _, k := range t.kickers {
+		best := -1
+		for _, alias := range append([]string{k.Name}, k.Aliases...) {
+			if d := spell.Distance(name, normalize(alias)); best == -1 || d < best {
+				best = d
+			}
+		}
+		if best <= len(name)/3+1 {
+			matches = append(matches, match{k.Name, best})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].distance < matches[j].distance })
+	names := []string{}
+	for i := 0; i < len(matches) && i < n; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
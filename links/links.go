@@ -0,0 +1,231 @@
+// Package links resolves the paths InDesign records for placed images
+// (the LinkResourceURI of a Link element) to the image files themselves.
+//
+// A snippet made on one designer's machine might refer to a photo as
+// file:/Volumes/GoogleDrive/Team%20Drives/The%20Polytechnic/Photos/x.jpg
+// while the same photo lives somewhere else entirely on the machine doing
+// the uploading. A Resolver maps the prefixes we see in those URIs to roots
+// we can actually read from, either a local directory or a Drive folder.
+package links
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thepoly/uploader/gdrive"
+)
+
+// NotFoundError is returned when a link maps to a root but the file isn't there.
+type NotFoundError struct {
+	URI  string
+	Path string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("photo not found at %s", e.Path)
+}
+
+// UnmappedError is returned when no configured prefix matches a link.
+type UnmappedError struct {
+	URI string
+}
+
+func (e *UnmappedError) Error() string {
+	return fmt.Sprintf("no link root configured for %s", e.URI)
+}
+
+// Root is somewhere linked files can be read from.
+type Root interface {
+	// Open reads the file at path, a slash-separated path relative to the root.
+	Open(path string) ([]byte, error)
+	// Location describes where path would be found, for error messages.
+	Location(path string) string
+}
+
+// Mapping ties a LinkResourceURI prefix to the root its files live under.
+// A prefix starting with "/" must match the beginning of the link's path.
+// Any other prefix, e.g. "Team Drives/The Polytechnic/", may appear anywhere
+// in the path so that it matches no matter where the drive is mounted.
+type Mapping struct {
+	Prefix string
+	Root   Root
+}
+
+type Resolver struct {
+	mappings []Mapping
+}
+
+func NewResolver(mappings ...Mapping) *Resolver {
+	r := &Resolver{}
+	for _, m := range mappings {
+		r.Add(m.Prefix, m.Root)
+	}
+	return r
+}
+
+// Add maps prefix to root. When several prefixes match a link, the longest wins.
+func (r *Resolver) Add(prefix string, root Root) {
+	prefix = strings.Replace(prefix, "\\", "/", -1)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	r.mappings = append(r.mappings, Mapping{Prefix: prefix, Root: root})
+}
+
+// Resolve finds the root a link belongs to and the link's path relative to it.
+func (r *Resolver) Resolve(uri string) (Root, string, error) {
+	path, err := Normalize(uri)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var best *Mapping
+	rel := ""
+	for i, m := range r.mappings {
+		if best != nil && len(m.Prefix) <= len(best.Prefix) {
+			continue
+		}
+		if strings.HasPrefix(m.Prefix, "/") {
+			if strings.HasPrefix(path, m.Prefix) {
+				best = &r.mappings[i]
+				rel = path[len(m.Prefix):]
+			}
+			continue
+		}
+		if idx := strings.Index(path, "/"+m.Prefix); idx != -1 {
+			best = &r.mappings[i]
+			rel = path[idx+1+len(m.Prefix):]
+		}
+	}
+	if best == nil {
+		return nil, "", &UnmappedError{URI: path}
+	}
+	return best.Root, rel, nil
+}
+
+// Open reads the file a link refers to.
+func (r *Resolver) Open(uri string) ([]byte, error) {
+	root, rel, err := r.Resolve(uri)
+	if err != nil {
+		return nil, err
+	}
+	data, err := root.Open(rel)
+	if nfe, ok := err.(*NotFoundError); ok && nfe.URI == "" {
+		nfe.URI = uri
+	}
+	return data, err
+}
+
+// Normalize turns a LinkResourceURI into a plain slash-separated path.
+// It unescapes the URI and strips the file: scheme in any of the forms
+// InDesign writes it (file:/x, file:///x, file://localhost/x).
+func Normalize(uri string) (string, error) {
+	path, err := url.PathUnescape(uri)
+	if err != nil {
+		return "", err
+	}
+	path = strings.Replace(path, "\\", "/", -1)
+	if strings.HasPrefix(path, "file:") {
+		path = strings.TrimPrefix(path, "file:")
+		if strings.HasPrefix(path, "//") {
+			path = strings.TrimPrefix(path, "//")
+			// what's left is either "/path" or "host/path"
+			if idx := strings.Index(path, "/"); idx > 0 {
+				path = path[idx:]
+			}
+		}
+	}
+	return path, nil
+}
+
+// ParseMapping parses a mapping written as "prefix=root". See ParseRoot for
+// the forms root can take.
+func ParseMapping(spec string, client *gdrive.Client) (Mapping, error) {
+	idx := strings.Index(spec, "=")
+	if idx <= 0 {
+		return Mapping{}, fmt.Errorf("link root %q isn't of the form prefix=root", spec)
+	}
+	root, err := ParseRoot(spec[idx+1:], client)
+	if err != nil {
+		return Mapping{}, err
+	}
+	return Mapping{Prefix: spec[:idx], Root: root}, nil
+}
+
+// ParseRoot parses a root written as either a local directory or
+// "drive:<team drive ID>" or "drive:<team drive ID>/<folder ID>".
+func ParseRoot(spec string, client *gdrive.Client) (Root, error) {
+	if !strings.HasPrefix(spec, "drive:") {
+		return Dir(spec), nil
+	}
+	if client == nil {
+		return nil, fmt.Errorf("link root %q needs a Drive client", spec)
+	}
+	ids := strings.SplitN(strings.TrimPrefix(spec, "drive:"), "/", 2)
+	root := &DriveFolder{Client: client, TeamDriveID: ids[0]}
+	if len(ids) == 2 {
+		root.FolderID = ids[1]
+	}
+	return root, nil
+}
+
+// Dir is a root on the local filesystem, e.g. a synced copy of the drive.
+type Dir string
+
+func (d Dir) Open(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(d.Location(path))
+	if os.IsNotExist(err) {
+		return nil, &NotFoundError{Path: d.Location(path)}
+	}
+	return data, err
+}
+
+func (d Dir) Location(path string) string {
+	return filepath.Join(string(d), filepath.FromSlash(path))
+}
+
+// DriveFolder is a root on Google Drive. FolderID may be left empty to use
+// the top level of the team drive.
+type DriveFolder struct {
+	Client      *gdrive.Client
+	TeamDriveID string
+	FolderID    string
+}
+
+func (d *DriveFolder) Open(path string) ([]byte, error) {
+	dir, name := "", path
+	if idx := strings.LastIndex(path, "/"); idx != -1 {
+		dir, name = path[:idx], path[idx+1:]
+	}
+
+	folder, err := d.Client.FolderID(d.TeamDriveID, d.root(), dir)
+	if err != nil {
+		return nil, err
+	}
+	if folder == "" {
+		return nil, &NotFoundError{Path: d.Location(path)}
+	}
+	id, err := d.Client.Find(d.TeamDriveID, folder, name, false)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, &NotFoundError{Path: d.Location(path)}
+	}
+	return d.Client.Download(id)
+}
+
+func (d *DriveFolder) Location(path string) string {
+	return fmt.Sprintf("drive:%s/%s", d.root(), path)
+}
+
+func (d *DriveFolder) root() string {
+	if d.FolderID != "" {
+		return d.FolderID
+	}
+	return d.TeamDriveID
+}
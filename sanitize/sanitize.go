@@ -0,0 +1,233 @@
+// Package sanitize strips story HTML down to the tags each field may have,
+// whatever the web editor or a pasted snippet put there.
+package sanitize
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Policy says which tags, and which of their attributes, are allowed.
+type Policy struct {
+	// Tags maps each allowed tag to its allowed attributes.
+	Tags map[string][]string
+	// Rename maps tags to allowed ones that mean the same thing, like <b>
+	// to <strong>.
+	Rename map[string]string
+}
+
+var (
+	// Inline is for one-line fields like headlines: plain text and italics.
+	Inline = &Policy{
+		Tags:   map[string][]string{"i": nil},
+		Rename: map[string]string{"em": "i"},
+	}
+	// Body is for body text: paragraphs, emphasis, links, quotes, lists,
+	// images and tables.
+	Body = &Policy{
+		Tags: map[string][]string{
+			"p":          nil,
+			"em":         nil,
+			"strong":     nil,
+			"a":          {"href", "title"},
+			"blockquote": nil,
+			"ul":         nil,
+			"ol":         nil,
+			"li":         nil,
+			"img":        {"src", "alt"},
+			"figure":     nil,
+			"figcaption": nil,
+			"table":      nil,
+			"thead":      nil,
+			"tbody":      nil,
+			"tr":         nil,
+			"th":         nil,
+			"td":         nil,
+		},
+		Rename: map[string]string{"i": "em", "b": "strong"},
+	}
+)
+
+// Removal is something taken out of the HTML.
+type Removal struct {
+	// Start and End are the byte offsets of what was removed in the
+	// original HTML.
+	Start, End int
+	// What describes it, like "<span> tag" or "style attribute of <p>".
+	What string
+}
+
+var (
+	tagPattern       = regexp.MustCompile(`(?s)<!--.*?-->|<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	attributePattern = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+))?`)
+	// a URL is safe if it's one of these schemes, or relative: without a
+	// colon before its path, query or fragment
+	safeURLPattern = regexp.MustCompile(`(?i)^(?:https?:|mailto:|[^:/?#]*(?:[/?#]|$))`)
+)
+
+// escaper escapes attribute values, which are always written in double
+// quotes.
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// elements removed along with everything in them
+var dropContent = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+}
+
+// Sanitize returns html with everything p doesn't allow removed, and what
+// was removed. The text inside removed tags is kept, except for scripts and
+// the like.
+func (p *Policy) Sanitize(html string) (string, []Removal) {
+	out := &bytes.Buffer{}
+	removals := []Removal{}
+	last := 0
+	dropping := ""
+	// closing tags are only reported when their opening tag wasn't
+	open := map[string]int{}
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(html, -1) {
+		start, end := m[0], m[1]
+		if dropping == "" {
+			out.WriteString(html[last:start])
+		}
+		last = end
+		tag := html[start:end]
+
+		if strings.HasPrefix(tag, "<!--") {
+			if dropping == "" {
+				removals = append(removals, Removal{start, end, "comment"})
+			}
+			continue
+		}
+		closing := m[3] > m[2]
+		name := strings.ToLower(html[m[4]:m[5]])
+		attributes := html[m[6]:m[7]]
+
+		if dropping != "" {
+			if closing && name == dropping {
+				removals[len(removals)-1].End = end
+				dropping = ""
+			}
+			continue
+		}
+		if dropContent[name] && !closing {
+			removals = append(removals, Removal{start, end, fmt.Sprintf("<%s> element", name)})
+			dropping = name
+			continue
+		}
+
+		allowedName := name
+		if renamed, ok := p.Rename[name]; ok {
+			allowedName = renamed
+		}
+		allowed, ok := p.Tags[allowedName]
+		if !ok {
+			switch {
+			case !closing:
+				open[name]++
+				removals = append(removals, Removal{start, end, fmt.Sprintf("<%s> tag", name)})
+			case open[name] > 0:
+				open[name]--
+			default:
+				removals = append(removals, Removal{start, end, fmt.Sprintf("</%s> tag", name)})
+			}
+			if name == "br" {
+				// keep the words on either side apart
+				out.WriteString(" ")
+			}
+			continue
+		}
+		if closing {
+			out.WriteString("</" + allowedName + ">")
+			continue
+		}
+
+		out.WriteString("<" + allowedName)
+		for _, a := range attributePattern.FindAllStringSubmatchIndex(attributes, -1) {
+			attr := strings.ToLower(attributes[a[2]:a[3]])
+			value := ""
+			if a[4] >= 0 {
+				value = unquote(attributes[a[4]:a[5]])
+			}
+			switch {
+			case !contains(allowed, attr):
+				removals = append(removals, Removal{start, end, fmt.Sprintf("%s attribute of <%s>", attr, name)})
+			case (attr == "href" || attr == "src") && !safeURL(value):
+				removals = append(removals, Removal{start, end, fmt.Sprintf("unsafe link %q", value)})
+			default:
+				out.WriteString(fmt.Sprintf(` %s="%s"`, attr, escaper.Replace(unescape(value))))
+			}
+		}
+		out.WriteString(">")
+	}
+	if dropping == "" {
+		out.WriteString(html[last:])
+	} else {
+		removals[len(removals)-1].End = len(html)
+	}
+	return out.String(), removals
+}
+
+// Clean returns html with everything p doesn't allow removed.
+func (p *Policy) Clean(html string) string {
+	clean, _ := p.Sanitize(html)
+	return clean
+}
+
+// Summary describes removals, like "2 <span> tags, style attribute of <p>".
+func Summary(removals []Removal) string {
+	counts := map[string]int{}
+	for _, r := range removals {
+		counts[r.What]++
+	}
+	parts := []string{}
+	for what, n := range counts {
+		if n > 1 {
+			what = fmt.Sprintf("%d %ss", n, what)
+		}
+		parts = append(parts, what)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
+
+// unescape decodes the character references in an attribute value, the way
+// a browser does before using it.
+func unescape(value string) string {
+	return html.UnescapeString(value)
+}
+
+// safeURL reports whether the link in an href or src attribute is safe to
+// follow. It's checked as the browser reads it: after character references
+// are decoded, and without the spaces and control characters browsers skip,
+// so "java&#09;script&colon;" is caught along with "javascript:".
+func safeURL(value string) bool {
+	url := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, unescape(value))
+	return safeURLPattern.MatchString(url)
+}
+
+// unquote removes the quotes around an attribute value, if it has them,
+// leaving any of the other kind inside it.
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package sanitize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *Policy
+		in       string
+		want     string
+		removals []string
+	}{
+		{"plain text", Inline, "Council votes", "Council votes", nil},
+		{"italics kept", Inline, "<i>The Poly</i>", "<i>The Poly</i>", nil},
+		{"em renamed to i", Inline, "<em>The Poly</em>", "<i>The Poly</i>", nil},
+		{"inline paragraph", Inline, "<p>Title</p>", "Title", []string{"<p> tag"}},
+		{"inline line break", Inline, "one<br>two", "one two", []string{"<br> tag"}},
+
+		{"body paragraphs", Body, "<p>One</p><p>Two</p>", "<p>One</p><p>Two</p>", nil},
+		{"i renamed to em", Body, "<i>a</i> <b>b</b>", "<em>a</em> <strong>b</strong>", nil},
+		{"upper case tags", Body, "<P>a</P>", "<p>a</p>", nil},
+		{"span unwrapped", Body, "<p><span>a</span></p>", "<p>a</p>", []string{"<span> tag"}},
+		{"stray closing tag", Body, "a</span>", "a", []string{"</span> tag"}},
+		{"style attribute", Body, `<p style="color: red">a</p>`, "<p>a</p>", []string{"style attribute of <p>"}},
+		{"link kept", Body, `<a href="https://poly.rpi.edu" title='The Poly'>x</a>`,
+			`<a href="https://poly.rpi.edu" title="The Poly">x</a>`, nil},
+		{"relative link", Body, `<a href="/news">x</a>`, `<a href="/news">x</a>`, nil},
+		{"javascript link", Body, `<a href="javascript:alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "javascript:alert(1)"`}},
+		{"entity in javascript link", Body, `<a href="javascript&#58;alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "javascript&#58;alert(1)"`}},
+		{"named entity in javascript link", Body, `<a href="javascript&colon;alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "javascript&colon;alert(1)"`}},
+		{"encoded javascript link", Body, `<a href="&#106;&#x61;vascript:alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "&#106;&#x61;vascript:alert(1)"`}},
+		{"tab in javascript link", Body, "<a href=\"java\tscript:alert(1)\">x</a>", "<a>x</a>", []string{"unsafe link \"java\\tscript:alert(1)\""}},
+		{"encoded tab in javascript link", Body, `<a href="java&#9;script:alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "java&#9;script:alert(1)"`}},
+		{"control character in javascript link", Body, "<a href=\"\x01javascript:alert(1)\">x</a>", "<a>x</a>", []string{"unsafe link \"\\x01javascript:alert(1)\""}},
+		{"mixed case javascript link", Body, `<a href="JaVaScRiPt:alert(1)">x</a>`, "<a>x</a>", []string{`unsafe link "JaVaScRiPt:alert(1)"`}},
+		{"mixed case data link", Body, `<a href="DaTa:text/html,x">x</a>`, "<a>x</a>", []string{`unsafe link "DaTa:text/html,x"`}},
+		{"other scheme", Body, `<a href="vbscript:x">x</a>`, "<a>x</a>", []string{`unsafe link "vbscript:x"`}},
+		{"mailto link", Body, `<a href="MAILTO:news@poly.rpi.edu">x</a>`, `<a href="MAILTO:news@poly.rpi.edu">x</a>`, nil},
+		{"relative link with colon", Body, `<a href="news/a:b?c=d:e#f:g">x</a>`, `<a href="news/a:b?c=d:e#f:g">x</a>`, nil},
+		{"fragment link", Body, `<a href="#note:1">x</a>`, `<a href="#note:1">x</a>`, nil},
+		{"escaped link", Body, `<a href="/search?q=a&amp;page=2" title="Tom &amp; Jerry">x</a>`,
+			`<a href="/search?q=a&amp;page=2" title="Tom &amp; Jerry">x</a>`, nil},
+		{"unescaped link", Body, `<a href='/search?q="a"&page=<2>'>x</a>`,
+			`<a href="/search?q=&quot;a&quot;&amp;page=&lt;2&gt;">x</a>`, nil},
+		{"data link", Body, `<a href=" data:text/html,x" title="a">x</a>`, `<a title="a">x</a>`, []string{`unsafe link " data:text/html,x"`}},
+		{"event handler", Body, `<a href="/news" onclick="x()">x</a>`, `<a href="/news">x</a>`, []string{"onclick attribute of <a>"}},
+		{"quote in attribute", Body, `<a title='say "hi"'>x</a>`, `<a title="say &quot;hi&quot;">x</a>`, nil},
+		{"image", Body, `<figure><img src="/a.jpg" alt="a"><figcaption>A</figcaption></figure>`,
+			`<figure><img src="/a.jpg" alt="a"><figcaption>A</figcaption></figure>`, nil},
+		{"encoded data image", Body, `<img src="&#100;ata:image/svg+xml,x">`, "<img>", []string{`unsafe link "&#100;ata:image/svg+xml,x"`}},
+		{"unsafe image", Body, `<img src=" data:image/png;base64,x" alt="a">`, `<img alt="a">`, []string{`unsafe link " data:image/png;base64,x"`}},
+		{"image event handler", Body, `<img src="/a.jpg" onerror="x()">`, `<img src="/a.jpg">`, []string{"onerror attribute of <img>"}},
+		{"script dropped", Body, "a<script>alert(1)</script>b", "ab", []string{"<script> element"}},
+		{"unclosed script", Body, "a<style>p {}", "a", []string{"<style> element"}},
+		{"comment", Body, "a<!-- <p>note</p> -->b", "ab", []string{"comment"}},
+		{"table", Body, "<table><tr><td>1</td></tr></table>", "<table><tr><td>1</td></tr></table>", nil},
+		{"text with angle bracket", Body, "1 < 2", "1 < 2", nil},
+	}
+	for _, test := range tests {
+		got, removals := test.policy.Sanitize(test.in)
+		if got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+		what := []string(nil)
+		for _, r := range removals {
+			what = append(what, r.What)
+		}
+		if !reflect.DeepEqual(what, test.removals) {
+			t.Errorf("%s: removed %q, want %q", test.name, what, test.removals)
+		}
+	}
+}
+
+func TestRemovalOffsets(t *testing.T) {
+	in := `<p>a<span class="x">b</span><script>c</script></p>`
+	_, removals := Body.Sanitize(in)
+	want := []string{`<span class="x">`, "<script>c</script>"}
+	got := []string{}
+	for _, r := range removals {
+		got = append(got, in[r.Start:r.End])
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("removed %q, want %q", got, want)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	removals := []Removal{{What: "<span> tag"}, {What: "style attribute of <p>"}, {What: "<span> tag"}}
+	if got, want := Summary(removals), "2 <span> tags, style attribute of <p>"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,199 @@
+// Package schedule decides when posts go up: their WordPress status, and
+// for scheduled posts the time they're published, which defaults to the
+// morning the issue comes out.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The statuses a post can be created with.
+const (
+	Draft   = "draft"
+	Pending = "pending"
+	Publish = "publish"
+	Future  = "future"
+)
+
+// Statuses are the statuses a post can be created with.
+var Statuses = []string{Draft, Pending, Publish, Future}
+
+// Config is the default schedule for posts.
+type Config struct {
+	// Status is the status posts are created with.
+	Status string `json:"status"`
+	// IssueDate is the date of the issue being uploaded, as YYYY-MM-DD.
+	// Scheduled posts go up on it at Time. If it isn't set, they go up the
+	// next time it's Time.
+	IssueDate string `json:"issueDate"`
+	// Time is the time of day, as HH:MM, scheduled posts go up.
+	Time string `json:"time"`
+	// TimeZone is the IANA time zone IssueDate and Time are in.
+	TimeZone string `json:"timeZone"`
+}
+
+// Default is the schedule used when nothing is configured, which is the
+// one posts always had: scheduled for noon.
+var Default = Config{
+	Status:   Future,
+	Time:     "12:00",
+	TimeZone: "America/New_York",
+}
+
+// Schedule is when a post goes up.
+type Schedule struct {
+	Status string
+	// Date is when the post is published. It's zero for drafts and pending
+	// posts without a date, and for posts published now.
+	Date time.Time
+}
+
+// layouts publish times can be written in, besides RFC 3339
+var layouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 3:04pm",
+	"2006-01-02 3:04PM",
+}
+
+// ParseTime parses a publish time: either RFC 3339, with its own offset,
+// or a date and time like "2018-03-14 09:00" in loc.
+func ParseTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("can't understand publish time %q; use e.g. \"2018-03-14 09:00\" or RFC 3339", s)
+}
+
+// Location returns the time zone times are in.
+func (c Config) Location() (*time.Location, error) {
+	name := c.TimeZone
+	if name == "" {
+		name = Default.TimeZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %v", name, err)
+	}
+	return loc, nil
+}
+
+// Date returns when scheduled posts go up: on the issue date, or the next
+// time it's the configured time of day if there isn't one.
+func (c Config) Date(now time.Time) (time.Time, error) {
+	loc, err := c.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return c.defaultDate(now, loc)
+}
+
+// defaultDate returns when scheduled posts go up: on the issue date, or
+// after now if there isn't one.
+func (c Config) defaultDate(now time.Time, loc *time.Location) (time.Time, error) {
+	clock := c.Time
+	if clock == "" {
+		clock = Default.Time
+	}
+	hm, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't understand publish time of day %q; use HH:MM", clock)
+	}
+	now = now.In(loc)
+	if c.IssueDate != "" {
+		day, err := time.ParseInLocation("2006-01-02", c.IssueDate, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("can't understand issue date %q; use YYYY-MM-DD", c.IssueDate)
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), hm.Hour(), hm.Minute(), 0, 0, loc), nil
+	}
+	date := time.Date(now.Year(), now.Month(), now.Day(), hm.Hour(), hm.Minute(), 0, 0, loc)
+	if !date.After(now) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date, nil
+}
+
+// New returns the schedule for a post. status and at override the
+// configured status and publish time if they aren't empty. It's an error to
+// schedule a post for the past, since WordPress would publish it right away.
+func New(c Config, status, at string, now time.Time) (Schedule, error) {
+	if status == "" {
+		status = c.Status
+	}
+	if status == "" {
+		status = Default.Status
+	}
+	if !valid(status) {
+		return Schedule{}, fmt.Errorf("unknown post status %q; use one of %s", status, strings.Join(Statuses, ", "))
+	}
+	loc, err := c.Location()
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	s := Schedule{Status: status}
+	switch {
+	case at != "":
+		if s.Date, err = ParseTime(at, loc); err != nil {
+			return Schedule{}, err
+		}
+		s.Date = s.Date.In(loc)
+	case status == Future:
+		if s.Date, err = c.defaultDate(now, loc); err != nil {
+			return Schedule{}, err
+		}
+	}
+	if status == Future && !s.Date.After(now) {
+		return Schedule{}, fmt.Errorf("publish time %s is in the past; publish now or pick a later time",
+			s.Date.Format("Jan 2 3:04 PM MST"))
+	}
+	return s, nil
+}
+
+func valid(status string) bool {
+	for _, s := range Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// GMT returns the date in the form WordPress's date_gmt field takes, or ""
+// if the schedule has no date.
+func (s Schedule) GMT() string {
+	if s.Date.IsZero() {
+		return ""
+	}
+	return s.Date.UTC().Format("2006-01-02T15:04:05")
+}
+
+// String describes the schedule, like "scheduled for Mar 14 9:00 AM EDT".
+func (s Schedule) String() string {
+	when := ""
+	if !s.Date.IsZero() {
+		when = s.Date.Format("Jan 2, 2006 3:04 PM MST")
+	}
+	switch {
+	case s.Status == Future:
+		return "scheduled for " + when
+	case s.Status == Publish && when != "":
+		return "published, dated " + when
+	case s.Status == Publish:
+		return "published now"
+	case when != "":
+		return s.Status + ", dated " + when
+	}
+	return s.Status
+}
@@ -0,0 +1,95 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	// 9 a.m. in New York
+	now := time.Date(2018, 3, 14, 13, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		config     Config
+		status, at string
+		now        time.Time
+		wantStatus string
+		wantGMT    string
+		wantErr    bool
+	}{
+		{name: "default noon today", config: Default, now: now,
+			wantStatus: Future, wantGMT: "2018-03-14T16:00:00"},
+		{name: "default noon tomorrow", config: Default, now: now.Add(4 * time.Hour),
+			wantStatus: Future, wantGMT: "2018-03-15T16:00:00"},
+		{name: "empty config", config: Config{}, now: now,
+			wantStatus: Future, wantGMT: "2018-03-14T16:00:00"},
+		{name: "issue date", config: Config{IssueDate: "2018-03-16", Time: "07:00"}, now: now,
+			wantStatus: Future, wantGMT: "2018-03-16T11:00:00"},
+		{name: "before daylight saving", config: Config{IssueDate: "2018-03-10", Time: "09:00"}, now: now.AddDate(0, 0, -13),
+			wantStatus: Future, wantGMT: "2018-03-10T14:00:00"},
+		{name: "after daylight saving", config: Config{IssueDate: "2018-03-11", Time: "09:00"}, now: now.AddDate(0, 0, -13),
+			wantStatus: Future, wantGMT: "2018-03-11T13:00:00"},
+		{name: "other time zone", config: Config{IssueDate: "2018-03-16", Time: "07:00", TimeZone: "Asia/Tokyo"}, now: now,
+			wantStatus: Future, wantGMT: "2018-03-15T22:00:00"},
+		{name: "UTC", config: Config{Time: "23:30", TimeZone: "UTC"}, now: now,
+			wantStatus: Future, wantGMT: "2018-03-14T23:30:00"},
+
+		{name: "draft", config: Config{Status: Draft}, now: now, wantStatus: Draft},
+		{name: "publish now", config: Default, status: Publish, now: now, wantStatus: Publish},
+		{name: "pending with date", config: Default, status: Pending, at: "2018-03-20 09:00", now: now,
+			wantStatus: Pending, wantGMT: "2018-03-20T13:00:00"},
+		{name: "publish backdated", config: Default, status: Publish, at: "2018-03-01 09:00", now: now,
+			wantStatus: Publish, wantGMT: "2018-03-01T14:00:00"},
+		{name: "at overrides issue date", config: Config{IssueDate: "2018-03-16"}, at: "2018-03-20 3:30pm", now: now,
+			wantStatus: Future, wantGMT: "2018-03-20T19:30:00"},
+		{name: "at with offset", config: Default, at: "2018-03-20T09:00:00+09:00", now: now,
+			wantStatus: Future, wantGMT: "2018-03-20T00:00:00"},
+
+		{name: "at in the past", config: Default, at: "2018-03-14 08:00", now: now, wantErr: true},
+		{name: "issue date in the past", config: Config{IssueDate: "2018-03-13"}, now: now, wantErr: true},
+		{name: "unknown status", config: Default, status: "published", now: now, wantErr: true},
+		{name: "unknown time zone", config: Config{TimeZone: "Troy/Campus"}, now: now, wantErr: true},
+		{name: "bad issue date", config: Config{IssueDate: "3/16/2018"}, now: now, wantErr: true},
+		{name: "bad time of day", config: Config{Time: "noon"}, now: now, wantErr: true},
+		{name: "bad at", config: Default, at: "tomorrow", now: now, wantErr: true},
+	}
+	for _, test := range tests {
+		s, err := New(test.config, test.status, test.at, test.now)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: got %s, want an error", test.name, s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if s.Status != test.wantStatus || s.GMT() != test.wantGMT {
+			t.Errorf("%s: got %s at %q, want %s at %q", test.name, s.Status, s.GMT(), test.wantStatus, test.wantGMT)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Date(2018, 3, 14, 9, 0, 0, 0, loc)
+	tests := []struct {
+		s    Schedule
+		want string
+	}{
+		{Schedule{Status: Future, Date: date}, "scheduled for Mar 14, 2018 9:00 AM EDT"},
+		{Schedule{Status: Publish}, "published now"},
+		{Schedule{Status: Publish, Date: date}, "published, dated Mar 14, 2018 9:00 AM EDT"},
+		{Schedule{Status: Pending, Date: date}, "pending, dated Mar 14, 2018 9:00 AM EDT"},
+		{Schedule{Status: Draft}, "draft"},
+	}
+	for _, test := range tests {
+		if got := test.s.String(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Package seo makes the parts of a post that search engines and social
+// cards show, which print stories don't have: the URL slug and the excerpt.
+package seo
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Lengths, in characters, after which search results and social cards cut
+// text off.
+const (
+	TitleLength   = 60
+	ExcerptLength = 155
+	SlugLength    = 60
+)
+
+var (
+	tagPattern       = regexp.MustCompile(`<[^>]*>`)
+	paragraphPattern = regexp.MustCompile(`(?i)</p>|<br\s*/?>|\n\s*\n`)
+	spacePattern     = regexp.MustCompile(`\s+`)
+	slugSeparator    = regexp.MustCompile(`[^a-z0-9]+`)
+	slugPattern      = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+)
+
+// PlainText returns s without HTML tags or entities, with its spacing
+// collapsed.
+func PlainText(s string) string {
+	s = html.UnescapeString(tagPattern.ReplaceAllString(s, ""))
+	return strings.TrimSpace(spacePattern.ReplaceAllString(s, " "))
+}
+
+// accented letters people use in names, and what slugs use instead
+var transliterations = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c", "ß", "ss", "æ", "ae", "œ", "oe",
+	// apostrophes join words rather than splitting them
+	"'", "", "’", "", "&", " and ",
+)
+
+// Slug makes a URL slug from a headline, like "rpi-raises-tuition-again".
+// Long headlines are cut at a word.
+func Slug(headline string) string {
+	s := transliterations.Replace(strings.ToLower(PlainText(headline)))
+	s = strings.Trim(slugSeparator.ReplaceAllString(s, "-"), "-")
+	if len(s) > SlugLength {
+		cut := s[:SlugLength]
+		if i := strings.LastIndex(cut, "-"); i > 0 && s[SlugLength] != '-' {
+			cut = cut[:i]
+		}
+		s = cut
+	}
+	return s
+}
+
+// ValidSlug reports whether slug is only lowercase letters, digits and
+// single hyphens between them.
+func ValidSlug(slug string) bool {
+	return slugPattern.MatchString(slug)
+}
+
+// Excerpt makes an excerpt from the subdeck, or the first paragraph of the
+// body if there's no subdeck, cut at a word if it's too long. Paragraphs end
+// at </p>, <br> or a blank line, since stories read from files separate
+// their paragraphs with blank lines.
+func Excerpt(subdeck, bodyText string) string {
+	text := PlainText(subdeck)
+	if text == "" {
+		paragraphs := paragraphPattern.Split(bodyText, -1)
+		for _, p := range paragraphs {
+			if text = PlainText(p); text != "" {
+				break
+			}
+		}
+	}
+	return Truncate(text, ExcerptLength)
+}
+
+// Truncate cuts text to at most n characters, at a word, adding an ellipsis
+// if anything was cut.
+func Truncate(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	runes := []rune(text)[:n-1]
+	cut := string(runes)
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,;:—-") + "…"
+}
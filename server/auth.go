@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thepoly/uploader/auth"
+	"github.com/thepoly/uploader/config"
+)
+
+type contextKey string
+
+const userKey contextKey = "user"
+
+// setupAuth configures whichever login methods cfg has settings for.
+func (s *Server) setupAuth(cfg *config.Config) error {
+	if cfg.SessionKey == "" {
+		return errors.New("no session key; set $UPLOADER_SESSION_KEY or sessionKeyFile")
+	}
+	s.sessions = auth.NewSessions([]byte(cfg.SessionKey), 12*time.Hour)
+	s.sessions.Secure = strings.HasPrefix(cfg.PublicURL, "https://")
+
+	if cfg.AccountsFile != "" {
+		accounts, err := auth.LoadAccounts(cfg.AccountsFile)
+		if err != nil {
+			return err
+		}
+		s.accounts = accounts
+	}
+
+	if cfg.OIDCIssuer != "" {
+		roles := make(map[string]auth.Role)
+		for email, role := range cfg.OIDCRoles {
+			roles[email] = auth.Role(role)
+		}
+		oidc, err := auth.NewOIDC(auth.OIDCConfig{
+			Issuer:       cfg.OIDCIssuer,
+			ClientID:     cfg.OIDCClientID,
+			ClientSecret: cfg.OIDCClientSecret,
+			RedirectURL:  strings.TrimSuffix(cfg.PublicURL, "/") + "/auth/oidc/callback",
+			Roles:        roles,
+			DefaultRole:  auth.Role(cfg.OIDCDefaultRole),
+		})
+		if err != nil {
+			return err
+		}
+		s.oidc = oidc
+	}
+
+	if s.accounts == nil && s.oidc == nil {
+		return errors.New("no way to log in; set accountsFile or oidcIssuer")
+	}
+	return nil
+}
+
+// requireRole only lets through requests from users logged in with at least
+// the given role. The user is available to the handler through userFrom.
+func (s *Server) requireRole(min auth.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			user, err := s.sessions.User(req)
+			if err != nil {
+				http.Error(w, "Not logged in", http.StatusUnauthorized)
+				return
+			}
+			if !user.Role.Allows(min) {
+				http.Error(w, "Only a "+string(min)+" can do that", http.StatusForbidden)
+				return
+			}
+			ctx := context.WithValue(req.Context(), userKey, user)
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}
+}
+
+// requireJSON turns away POST requests that aren't JSON. Forms on other
+// sites can send the session cookie along with a POST, but not with a JSON
+// content type, so this keeps them from acting as whoever is logged in.
+func requireJSON(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "POST" {
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				http.Error(w, "Requests must be JSON", http.StatusUnsupportedMediaType)
+				return
+			}
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func userFrom(req *http.Request) *auth.User {
+	user, _ := req.Context().Value(userKey).(*auth.User)
+	return user
+}
+
+func (s *Server) LoginHandler(w http.ResponseWriter, req *http.Request) {
+	if s.accounts == nil {
+		http.Error(w, "Local accounts aren't enabled", http.StatusNotFound)
+		return
+	}
+	login := struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{}
+	decoder := json.NewDecoder(req.Body)
+	err := decoder.Decode(&login)
+	if err != nil {
+		http.Error(w, "Unable to decode login", 400)
+		return
+	}
+	user, err := s.accounts.Authenticate(login.Username, login.Password)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	s.startSession(w, user)
+}
+
+func (s *Server) LogoutHandler(w http.ResponseWriter, req *http.Request) {
+	s.sessions.End(w)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) MeHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err := encoder.Encode(userFrom(req))
+	if err != nil {
+		http.Error(w, "Unable to encode user", 500)
+		return
+	}
+}
+
+func (s *Server) OIDCLoginHandler(w http.ResponseWriter, req *http.Request) {
+	if s.oidc == nil {
+		http.Error(w, "OpenID Connect isn't enabled", http.StatusNotFound)
+		return
+	}
+	s.oidc.Begin(w, req)
+}
+
+func (s *Server) OIDCCallbackHandler(w http.ResponseWriter, req *http.Request) {
+	if s.oidc == nil {
+		http.Error(w, "OpenID Connect isn't enabled", http.StatusNotFound)
+		return
+	}
+	user, err := s.oidc.Finish(w, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err := s.sessions.Start(w, user); err != nil {
+		http.Error(w, "Unable to start session", 500)
+		return
+	}
+	log.Printf("%s logged in as %s", user.Username, user.Role)
+	// the web app is served separately, so send people back there
+	http.Redirect(w, req, s.webURL, http.StatusFound)
+}
+
+func (s *Server) startSession(w http.ResponseWriter, user *auth.User) {
+	if err := s.sessions.Start(w, user); err != nil {
+		http.Error(w, "Unable to start session", 500)
+		return
+	}
+	log.Printf("%s logged in as %s", user.Username, user.Role)
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err := encoder.Encode(user)
+	if err != nil {
+		http.Error(w, "Unable to encode user", 500)
+		return
+	}
+}
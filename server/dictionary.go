@@ -0,0 +1,40 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// DictionaryHandler lists the words editors have added to the spell
+// checker's dictionary.
+func (s *Server) DictionaryHandler(w http.ResponseWriter, req *http.Request) {
+	words := s.validator.Dictionary().Words()
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err := encoder.Encode(&words)
+	if err != nil {
+		http.Error(w, "Unable to encode dictionary", 500)
+		return
+	}
+}
+
+// AddWordHandler adds a word to the spell checker's dictionary, so it's no
+// longer reported as misspelled.
+func (s *Server) AddWordHandler(w http.ResponseWriter, req *http.Request) {
+	body := struct {
+		Word string `json:"word"`
+	}{}
+	decoder := json.NewDecoder(req.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		http.Error(w, "Unable to decode word", 400)
+		return
+	}
+	if err := s.validator.Dictionary().Add(body.Word); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	log.Printf("%s added %q to the dictionary", userFrom(req).Username, body.Word)
+	w.WriteHeader(http.StatusNoContent)
+}
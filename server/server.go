@@ -2,41 +2,107 @@ package server
 
 import (
 	"encoding/json"
+	"io"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/cors"
 
+	"github.com/thepoly/uploader/auth"
+	"github.com/thepoly/uploader/config"
+	"github.com/thepoly/uploader/gdrive"
+	"github.com/thepoly/uploader/schedule"
 	"github.com/thepoly/uploader/story"
+	"github.com/thepoly/uploader/validate"
+	"github.com/thepoly/uploader/wordpress"
 )
 
 type Server struct {
-	listenAddr    string
-	handler       http.Handler
-	wpAPIPassword string
-	storyManager  *story.Manager
+	listenAddr string
+	webURL     string
+	handler    http.Handler
+	// wp is the site posts are updated on, format the format of their
+	// content, and corrections the template of the notes added to them.
+	wp           *wordpress.Client
+	format       string
+	corrections  string
+	storyManager *story.Manager
+	validator    *validate.Validator
+	publishing   schedule.Config
+	sessions     *auth.Sessions
+	accounts     *auth.Accounts
+	oidc         *auth.OIDC
 }
 
-func New(apiPassword string) (*Server, error) {
-	sm, err := story.NewManager()
+func New(cfg *config.Config, driveClient *gdrive.Client) (*Server, error) {
+	sm, err := story.NewManager(driveClient, cfg.TeamDriveID)
 	if err != nil {
 		return nil, err
 	}
 
+	validator, err := validate.New(cfg.Validation)
+	if err != nil {
+		return nil, err
+	}
+
+	wp, err := cfg.WordPress()
+	if err != nil {
+		return nil, err
+	}
+	wp.Retrying = func(err error, wait time.Duration) {
+		log.Printf("%v; trying again in %v", err, wait)
+	}
+
 	server := &Server{
-		listenAddr:    "127.0.0.1:8000",
-		wpAPIPassword: apiPassword,
-		storyManager:  sm,
+		listenAddr:   cfg.ListenAddr,
+		webURL:       "/",
+		format:       cfg.ContentFormat,
+		corrections:  cfg.CorrectionTemplate,
+		storyManager: sm,
+		validator:    validator,
+		publishing:   cfg.Publishing,
+		wp:           wp,
+	}
+	if len(cfg.AllowedOrigins) > 0 {
+		server.webURL = cfg.AllowedOrigins[0]
+	}
+	if err := server.setupAuth(cfg); err != nil {
+		return nil, err
 	}
 
 	router := chi.NewRouter()
 	cors := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Content-Type"},
+		AllowCredentials: true,
 	})
 	router.Use(cors.Handler)
-	router.Post("/validate-story", server.ValidateStoryHandler)
-	router.Get("/available-stories", server.GetAvailableStories)
+	router.Use(requireJSON)
+	router.Post("/login", server.LoginHandler)
+	router.Post("/logout", server.LogoutHandler)
+	router.Get("/auth/oidc/login", server.OIDCLoginHandler)
+	router.Get("/auth/oidc/callback", server.OIDCCallbackHandler)
+	router.Group(func(r chi.Router) {
+		r.Use(server.requireRole(auth.Reporter))
+		r.Get("/me", server.MeHandler)
+		r.Post("/validate-story", server.ValidateStoryHandler)
+		r.Get("/available-stories", server.GetAvailableStories)
+		r.Get("/dictionary", server.DictionaryHandler)
+		r.Get("/schedule", server.ScheduleHandler)
+	})
+	router.Group(func(r chi.Router) {
+		r.Use(server.requireRole(auth.CopyEditor))
+		r.Post("/stories/{id}/autofix", server.AutofixHandler)
+		r.Post("/dictionary", server.AddWordHandler)
+	})
+	// only web editors publish, which includes changing posts already up
+	router.Group(func(r chi.Router) {
+		r.Use(server.requireRole(auth.WebEditor))
+		r.Post("/stories/{id}/update", server.UpdatePostHandler)
+	})
 	server.handler = router
 
 	return server, nil
@@ -78,12 +144,84 @@ func (s *Server) ValidateStoryHandler(w http.ResponseWriter, req *http.Request)
 		http.Error(w, "Unable to decode story", 500)
 		return
 	}
-	validationErrors := story.ValidationErrors()
+	findings := s.validator.Validate(story.ValidationStory())
+	if _, err := story.PostSchedule(s.publishing, time.Now()); err != nil {
+		findings = append(findings, validate.Finding{
+			Rule:     "schedule-invalid",
+			Severity: validate.Error,
+			Field:    "publishAt",
+			Message:  err.Error(),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(&findings)
+	if err != nil {
+		http.Error(w, "Unable to encode validation findings", 500)
+		return
+	}
+}
+
+// ScheduleHandler says when posts go up unless a story says otherwise, and
+// what statuses they can have.
+func (s *Server) ScheduleHandler(w http.ResponseWriter, req *http.Request) {
+	response := struct {
+		Status    string   `json:"status"`
+		PublishAt string   `json:"publishAt"`
+		TimeZone  string   `json:"timeZone"`
+		Statuses  []string `json:"statuses"`
+		Error     string   `json:"error,omitempty"`
+	}{TimeZone: s.publishing.TimeZone, Statuses: schedule.Statuses}
+	sched, err := schedule.New(s.publishing, "", "", time.Now())
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Status = sched.Status
+		if !sched.Date.IsZero() {
+			response.PublishAt = sched.Date.Format("2006-01-02 15:04")
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(&response)
+	if err != nil {
+		http.Error(w, "Unable to encode schedule", 500)
+		return
+	}
+}
+
+// AutofixHandler fixes the mechanical problems in a story. The story is
+// taken from the request body if there is one, so that edits made in the
+// browser are kept; otherwise it's the available story with the given ID.
+// Nothing is saved; the fixed story is sent back along with what changed.
+func (s *Server) AutofixHandler(w http.ResponseWriter, req *http.Request) {
+	st := &story.Story{}
+	decoder := json.NewDecoder(req.Body)
+	err := decoder.Decode(st)
+	if err == io.EOF {
+		st = s.storyManager.GetStory(chi.URLParam(req, "id"))
+		if st == nil {
+			http.Error(w, "No such story", http.StatusNotFound)
+			return
+		}
+	} else if err != nil {
+		http.Error(w, "Unable to decode story", 400)
+		return
+	}
+
+	fixed, changes := s.validator.Fix(st.ValidationStory())
+	result := *st
+	result.ApplyFixes(fixed)
+	response := struct {
+		Story   *story.Story      `json:"story"`
+		Changes []validate.Change `json:"changes"`
+	}{&result, changes}
+
 	w.Header().Set("Content-Type", "application/json")
 	encoder := json.NewEncoder(w)
-	err = encoder.Encode(&validationErrors)
+	err = encoder.Encode(&response)
 	if err != nil {
-		http.Error(w, "Unable to encode validation errors", 500)
+		http.Error(w, "Unable to encode fixed story", 500)
 		return
 	}
 }
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+
+	"github.com/thepoly/uploader/story"
+	"github.com/thepoly/uploader/upload"
+	"github.com/thepoly/uploader/validate"
+)
+
+// UpdatePostHandler compares the post made from a story with the story now,
+// and updates the post if asked to. The story is taken from the request body
+// if it has one, so that edits made in the browser are kept; otherwise it's
+// the available story with the given ID. Either way, the post is found by
+// the Drive ID of the story's snippet or its fingerprint.
+//
+// A correction note is added to the end of the post if one is given, made
+// with the configured correction template. The post is only changed if
+// apply is true, so the changes can be shown first.
+func (s *Server) UpdatePostHandler(w http.ResponseWriter, req *http.Request) {
+	body := struct {
+		Story      *story.Story `json:"story"`
+		Correction string       `json:"correction"`
+		Apply      bool         `json:"apply"`
+	}{}
+	decoder := json.NewDecoder(req.Body)
+	err := decoder.Decode(&body)
+	if err != nil {
+		http.Error(w, "Unable to decode update", 400)
+		return
+	}
+	available := s.storyManager.GetStory(chi.URLParam(req, "id"))
+	st := body.Story
+	if st == nil {
+		st = available
+	}
+	if st == nil {
+		http.Error(w, "No such story", http.StatusNotFound)
+		return
+	}
+	if st.Snippet == nil && available != nil {
+		st.Snippet = available.Snippet
+	}
+	if validate.Blocking(s.validator.Validate(st.ValidationStory())) {
+		http.Error(w, "Story has validation errors", 400)
+		return
+	}
+
+	post := st.WPPost(s.validator, s.format)
+	live, err := upload.FindPost(s.wp, post.Meta.SourceID, post.Meta.Fingerprint)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if live == nil {
+		http.Error(w, "No post was made from this story", http.StatusNotFound)
+		return
+	}
+	correction := ""
+	if body.Correction != "" {
+		correction, err = upload.Correction(s.corrections, body.Correction, time.Now())
+		if err != nil {
+			http.Error(w, "Bad correction template: "+err.Error(), 500)
+			return
+		}
+	}
+	post = upload.UpdatedPost(live, post, correction)
+
+	response := struct {
+		Link    string            `json:"link"`
+		Changes []validate.Change `json:"changes"`
+		Applied bool              `json:"applied"`
+	}{Link: live.Link, Changes: upload.PostChanges(live, post)}
+	if body.Apply && len(response.Changes) > 0 {
+		if response.Link, err = upload.SendPost(s.wp, live.ID, post); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		response.Applied = true
+		log.Printf("%s updated post %d", userFrom(req).Username, live.ID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(&response)
+	if err != nil {
+		http.Error(w, "Unable to encode update", 500)
+		return
+	}
+}
@@ -0,0 +1,206 @@
+package spell
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// how flags are written in a dictionary, set by FLAG in the affix file
+const (
+	flagChar = iota
+	flagLong
+	flagNum
+)
+
+type affix struct {
+	strip, add string
+	// condition matches the end of words a suffix applies to, or the start
+	// of words a prefix applies to
+	condition *regexp.Regexp
+}
+
+type affixClass struct {
+	prefix bool
+	// cross is set if the class combines with affixes of the other kind
+	cross   bool
+	affixes []affix
+}
+
+type affixFile struct {
+	flag    int
+	try     string
+	classes map[string]*affixClass
+}
+
+// parseAffixes reads the parts of a Hunspell affix file that say how to
+// expand dictionary words.
+func parseAffixes(r io.Reader) (*affixFile, error) {
+	f := &affixFile{classes: map[string]*affixClass{}}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		switch fields[0] {
+		case "FLAG":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: FLAG without a type", n)
+			}
+			switch fields[1] {
+			case "long":
+				f.flag = flagLong
+			case "num":
+				f.flag = flagNum
+			}
+		case "TRY":
+			if len(fields) > 1 {
+				f.try = fields[1]
+			}
+		case "PFX", "SFX":
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("line %d: short %s line", n, fields[0])
+			}
+			class, ok := f.classes[fields[1]]
+			if !ok {
+				// the first line for a flag is its header: PFX flag cross count
+				f.classes[fields[1]] = &affixClass{
+					prefix: fields[0] == "PFX",
+					cross:  fields[2] == "Y",
+				}
+				continue
+			}
+			a, err := parseAffix(fields, class.prefix)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n, err)
+			}
+			class.affixes = append(class.affixes, a)
+		}
+	}
+	return f, scanner.Err()
+}
+
+// parseAffix parses a rule like "SFX B y ied [^aeiou]y".
+func parseAffix(fields []string, prefix bool) (affix, error) {
+	a := affix{strip: fields[2], add: fields[3]}
+	if a.strip == "0" {
+		a.strip = ""
+	}
+	// affixes can have affixes of their own, which aren't supported
+	if i := strings.Index(a.add, "/"); i != -1 {
+		a.add = a.add[:i]
+	}
+	if a.add == "0" {
+		a.add = ""
+	}
+	condition := "."
+	if len(fields) > 4 {
+		condition = fields[4]
+	}
+	if condition == "." {
+		return a, nil
+	}
+	// conditions are already regular expressions, made of letters, "." and
+	// character classes
+	pattern := condition + "$"
+	if prefix {
+		pattern = "^" + condition
+	}
+	var err error
+	a.condition, err = regexp.Compile(pattern)
+	if err != nil {
+		return a, fmt.Errorf("bad condition %q: %v", condition, err)
+	}
+	return a, nil
+}
+
+// splitFlags splits the flags after a dictionary word.
+func (f *affixFile) splitFlags(flags string) []string {
+	switch f.flag {
+	case flagLong:
+		out := []string{}
+		runes := []rune(flags)
+		for i := 0; i+1 < len(runes); i += 2 {
+			out = append(out, string(runes[i:i+2]))
+		}
+		return out
+	case flagNum:
+		out := []string{}
+		for _, n := range strings.Split(flags, ",") {
+			if _, err := strconv.Atoi(n); err == nil {
+				out = append(out, n)
+			}
+		}
+		return out
+	}
+	out := []string{}
+	for _, r := range flags {
+		out = append(out, string(r))
+	}
+	return out
+}
+
+func (a affix) apply(word string, prefix bool) (string, bool) {
+	if prefix {
+		if !strings.HasPrefix(word, a.strip) || a.condition != nil && !a.condition.MatchString(word) {
+			return "", false
+		}
+		return a.add + word[len(a.strip):], true
+	}
+	if !strings.HasSuffix(word, a.strip) || a.condition != nil && !a.condition.MatchString(word) {
+		return "", false
+	}
+	return word[:len(word)-len(a.strip)] + a.add, true
+}
+
+// expand returns word along with every form its flags allow.
+func (f *affixFile) expand(word, flags string) []string {
+	words := []string{word}
+	if flags == "" {
+		return words
+	}
+	var prefixes, suffixes []*affixClass
+	for _, flag := range f.splitFlags(flags) {
+		class, ok := f.classes[flag]
+		if !ok {
+			continue
+		}
+		if class.prefix {
+			prefixes = append(prefixes, class)
+		} else {
+			suffixes = append(suffixes, class)
+		}
+	}
+
+	suffixed := []string{}
+	for _, class := range suffixes {
+		for _, a := range class.affixes {
+			if w, ok := a.apply(word, false); ok {
+				words = append(words, w)
+				if class.cross {
+					suffixed = append(suffixed, w)
+				}
+			}
+		}
+	}
+	for _, class := range prefixes {
+		for _, a := range class.affixes {
+			if w, ok := a.apply(word, true); ok {
+				words = append(words, w)
+			}
+			if !class.cross {
+				continue
+			}
+			for _, s := range suffixed {
+				if w, ok := a.apply(s, true); ok {
+					words = append(words, w)
+				}
+			}
+		}
+	}
+	return words
+}
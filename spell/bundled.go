@@ -0,0 +1,67 @@
+package spell
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var (
+	english, newsroom         *Dictionary
+	englishErr, newsroomErr   error
+	englishOnce, newsroomOnce sync.Once
+)
+
+// English returns the bundled American English dictionary.
+func English() (*Dictionary, error) {
+	englishOnce.Do(func() {
+		english, englishErr = loadBundled("en_US")
+	})
+	return english, englishErr
+}
+
+// Newsroom returns the bundled dictionary of RPI buildings, people and local
+// terms that aren't in the English one.
+func Newsroom() (*Dictionary, error) {
+	newsroomOnce.Do(func() {
+		newsroom, newsroomErr = loadBundled("newsroom")
+	})
+	return newsroom, newsroomErr
+}
+
+func loadBundled(name string) (*Dictionary, error) {
+	dic, err := openBundled(name + ".dic")
+	if err != nil {
+		return nil, err
+	}
+	var aff io.Reader
+	if _, ok := bundledFiles[name+".aff"]; ok {
+		if aff, err = openBundled(name + ".aff"); err != nil {
+			return nil, err
+		}
+	}
+	d := New()
+	if err := d.Load(dic, aff); err != nil {
+		return nil, fmt.Errorf("unable to load bundled dictionary %s: %v", name, err)
+	}
+	return d, nil
+}
+
+func openBundled(name string) (io.Reader, error) {
+	encoded, ok := bundledFiles[name]
+	if !ok {
+		return nil, fmt.Errorf("no bundled dictionary file %s", name)
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode bundled dictionary file %s: %v", name, err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress bundled dictionary file %s: %v", name, err)
+	}
+	return r, nil
+}
@@ -0,0 +1,4127 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package spell
+
+// bundledFiles holds the gzipped, base64-encoded files in dictionaries.
+var bundledFiles = map[string]string{
+	"en_US.aff": "" +
+		"H4sIAAAAAAAC/wp2DVEIDXHTteAKCYpUSC3OTMwrKsnPSU4pTc8tyEiqTCvLLq9yDfZ09AsK8fdx" +
+		"dgl19w3wcIp0C/MOj1LnAgwA03i0NT4AAAA=",
+	"en_US.dic": "" +
+		"H4sIAAAAAAAC/1z9SXfjPrI9AO7xRWrfiz699WxneirLmfXe24XIsIgUCOiHQU7q0/e5N0DZ9T9H" +
+		"insxzyNB8P/3//v//n/+P+7CXeB34S4u3cUl4Ar/4i4un/AH2biLK3dx9fjLXVy943/vLq7dxfWV" +
+		"u7gGvrqLW3dxe/Hw013cXrqL2yt3cQvT22d3cfvuLh7cxcP1xl08FHfx0108uosnd/F04S6ert3F" +
+		"8+aBoriL5//7tXEXL4/u4tVdvF66i9crd/H64C5eX9zF65u7wO/KXWxgvrl6eDBZ3MXm0V1sXq8u" +
+		"3MX7k7t4f3UX77/dBX4P7uI/F1cbd/Ef+Pt/7uL/3t2FDJNGdyHBLzK5C8kpuovtVopJPwJTrYDF" +
+		"XWzHFtzFVvEnCZJHYqFDzaOqkSh1ggs//hHo+J14OPFBI9xHze5im7Lf+ahfDAFnmWQmdgnNIiFB" +
+		"2f6Iu9gupfjov7HoLgZ3McgIzUEOLQzJXQyDxtqygmUY6FFH6E+idDIpEjNMiG8mYUKGyYeAuAwp" +
+		"DrJrcJpKBeR0SMHDKLe/7mKoonRRTcKgfXy4i1HwnykKZKSgboaAZjcbNQddQLxSMhKjBjHpRyUx" +
+		"yfwalTaiNOTjOAmSN3rz1ucURxn23yi09z4CAl0gZrPPEioCTuHDZCIcJkD0pQOyYswaJfj4xajJ" +
+		"rCeIYfUDiUbG86ixdvDlGyudElEzdMci0fCR4YNGRVo0KiqhptCgyukjpOoutAzTYlplaEEO3ng6" +
+		"uIuP3SSxg1+x1LNecRcfGX8/SIe4Is38Xr4Y6+nKqJkoULMY0TMvMxXFXezcxU5mnSOKccec2Ukp" +
+		"vsMJWCfo7lDguyCotTvW/l3UT0hYRpnush9SMHI4rOjpZ5MySPAaK102H1C1ds1HCajmu+YzKv+u" +
+		"lYqCm2TrLqZZRpOyXZmP+se4QmY/I0s86rDfo6b5wHbtZ1V34WNzFz7rKEHPpLgLX5CmP/LXXey3" +
+		"iMl+mqWmo7iLvZ98TcBKFfX2CHLPLie4C6QxyFbmM8YzKZ35s57vmuPoqTUnk7uUR1LqRvqVKVBW" +
+		"Qeio7KVDXBH6W6GI/ozxTMw8LgDkQdhq9smwdpAVYyc09zuNpXvlk0FWGrV/muZ/GrwbpGY5gWip" +
+		"aHdh8FsvoxqNY0IqhlmZpCGZRMcchsYsGJaEBhfY04RRt5I7M5llpj1mz5jJFXmifph0Bvkjccxy" +
+		"ZrCoc0+gHg5Ut2rSUmSkGCP8pZA4av5iZaVZzsx/p/Trr3oCk/+RYkmGJ0OMNOEj6wrSEaY7jX5L" +
+		"zCa9rBjPBN7uvLKYd95itUuBMv7TutVOy5l/6VJzknnLZExWFoizH5SSofrd5DVTmwXiU50AhYZs" +
+		"I8F6+OBL16ziEfG9oLMLQSaT1kKD1eagu0mj13LmizHGJqjZiaMa1vRJnUlCSJ905NWkKSwKoVSp" +
+		"1F9Mg+1vlmEiYHQIs99NRtLECM0py5FRjT5gkAvRV9m7i9BL62CV5jBxfhAOkw6DGMlST2QpFl0J" +
+		"3Vh2sZodEMUizNYivbIZoQn6+VA45ga2uyre5GCQCRjgQCYl+EOQCO+rdS41+bEQoWpWO48msrBi" +
+		"HSXryZBK1oaF8er5tVgZnib1M2rf7C5mGRXj0Uw/ZgmoF7PEkZB9CNTODbJqlmLMQ55S7OBlZfBr" +
+		"S8/V/EJhzxqnVPUARqMs1s/MakOb4VkjfmlxrPri5UtxkspO6r/V3y3odz5+V3y35ePurDrrdxLH" +
+		"Fc/RJT0bkxTW+nmynnueNJfqLmbU4dnvkBZfJncxsyjnhOnenODywKKaD5phNftaBNlTqmZOyOaa" +
+		"UVXnFsfCfGww/pTFXcyLu0A2RdnKweYrUTjVjTKg6KMMmfO9KChvYFDO+qJEL8QiJ0+sKA+wmoJ2" +
+		"PGsw6VH+yi5luhumlGUHe6OEZi47i6SzoXbIRVeSjMCXMeUsK8YzoVFWk2LgDbpRrV392cH0vUSZ" +
+		"D8lHz6Ixp8mPhugmtFN2yGCHdARBVu44LY075uCO81kAw9xZVSYOZ5I6iatO1E7+aSur3azbTY3I" +
+		"phk5oYq7fcoAZvuuh9eDC9YmOmFL+OLlrFjZl4Vvxudm0lUWTDJ5mHwPNx2mtCVNlvQUJK5Iv5Ll" +
+		"T68Cu1JzYnDNB3OBbiR62Q8T6mz0W0FWWhbsLXfhI7NrrapERiGivscofckC1rLZpbHWSmSmRXRN" +
+		"JC0Ofk3glwIepObD5C5i0TB3QKoLZ9yxCru9WCVKlOyPiYo8cE2wMjHKQqlTwigW64R6M3BpGKsf" +
+		"pmyNb6W067UyPdXvUrfIpVmsPgRrFrWv22L1CSNYrP4gpj4kayE1fchOuJiLNVn9IlpecCyA7Aov" +
+		"HaMlLUWfOq4aTMCnhf+p+eAuYtsyv08yuIuDNRJCAQYZpj6pP0hNRVqGVweN0VbAhymn0SM6BwR/" +
+		"SIOE5VCUNC+HiZoBrcRnNZ462KhBCp96aPKNxu+820mpyBejZlCTRXU0xqw5HNIstaa/7uKQ8ffB" +
+		"JBy1oMyVf5p8ZEX3/E+TvGqBKKlH2jGhElPGRKjC4sjuAhU7Y7WSZeulQ1yxGBkMSl3R9E0ukIMG" +
+		"D/wQ2tklerFrstDPADGLeTSj78lykOmMWlZGks2XNvSlQZZP2XegeusrVzO570MYUmPSjmjBozE1" +
+		"mJUVs7eSPFSrDnmULfI2cwKf9Z/msfxju8k7jZV9ZGf6xSSeuf9vToeJIkqrZ2L60TxhUtntZC8j" +
+		"9XzvJrPXQFlMGqZS4acvaN6TOSzVW5p8qekwSTwrqtrIZ4pA783NiSJFWTGeSenMn/V6av40Wkch" +
+		"YA0n8UzKykgmwUBRaO8zYzbrLrJtROXgV9ixgudZdjqO/0WL8YjmnDGR65AMUcdmZVdhGM+ELr31" +
+		"FXlOcU9oqOIzentMk3JU1PTIVVhGW8gxsZLExh0Zq1R50u6PqZGGKn81/2XuVp09cWq5g0WksqLV" +
+		"luFTw+onLzRYGD38uOgug3LQKYPGws6/7Dh9R+9ePXDI6aOCKMROtkKV3wsmZGXaa5QTpkVl2vfF" +
+		"S5mCqsECmJN11WVqGUvrA1ohZzvFC2qnzXeK5V3p+0mG1JgxvSgzx76SsJIvBw2BECkzV4Hl4Edf" +
+		"9ggbXcvkLkphhIrMJrXAsOi8DYha8QWJLAsXj4bxTBB2te62VM6lUeErlcyrmrJJusYck4MiCvm9" +
+		"5Q8wlAj8wZBKNxw0qruoMrAIqkxNwoEsSKwk2UPWhmpekatlkJWx3znTQh6lsJpUW6AC1MBLPJNu" +
+		"AbD3Z0Cs6hoyUEsnfliJ6XRJc85Ma+bgX5n+iuEcKvzNrY38tbLvr5W1olY4rI37k/UzpdFdNHfR" +
+		"thmVpQ37wLbW2AW20VMkchR/G83e2LbU3OHP2tV2Zdsy1a3UDrJi7IQtvzP/Tdeyh4oVkbSWJe5O" +
+		"aKst2+SVmDqaFU3zwqVCy1yltMw5XivD9Omx8m2leO0AF6UycaVqDt0CN+sIZiFLsKXEF4+r4kv7" +
+		"vzS/XHKy3unB10mH1s1Wp2eHX85S1B5IbXN0F0d3gXXxUQIy+qj493HnqJlTo6PmzBHzqHmBZIYf" +
+		"/aCcnR79jtuhR5b/0aO1HFGXjmkn3Gs6wpibP3/b7C4WgcWF2zSLNfcll4ktcGn5yPXGsrQt0ndi" +
+		"izzZ3t1JThrcxUnzVjyfPpyp/86Luzgljqcn9CmnqoNJOqmK/uaEOu8u3eWFu7zYPFyZLO7y0l3y" +
+		"f+UuL//tLi83+Gtx0Li9dZcPF+7y4WXjLh/en2/e3eUj/8VdPrnLp/+4yxd3+eouX19u3OWbg+vN" +
+		"hbvcXONf3OX7L3f5/h93+b8vl+5S3KVIoCiQdTLpS3WXst3KTom+Uq3BJOxuFxRYRy9f7JtmPFsE" +
+		"GSQEQh49cECHEuh0wLTVsNEq4jHsjTOYgZXqUtBeL2VE64WhjrrXfCbUOrlL+fjwsIddcOjtplFG" +
+		"YPPJXcok//IGjTtioLN06EkALYZnjZ6iiTGZsjAMv2cOeoxCl+Izwtlr8FVJssny4TXQqFFEug0S" +
+		"h8lHJa1MaNgm+B5Gugzjp48rIuyg3LW4lPCRGm14iqiFvuwldqDt/SQF6WPOBz5Tu5RQJzkJHVfz" +
+		"q/o5Zbpvw2RPNC4lYI1xKbPskWfzFgFFK5pow/WlxLHFHXAnwXzACnlPDDJqmb5R/50XKlImNBr9" +
+		"kTxLYXrjn4YYxz2txV7IMfpSjVVv4dZmEvZSTVDZ9kpHXQksZOEDSJABccxbm0WfWek0dZKlI+cv" +
+		"RlMpK12IjFDeeiVYqeRtd9pG4qAhRWNBlhUZCp9rXkrmE6tLyZbUvLf6lEP6BGDfqHSyGLIscxTm" +
+		"VI5qxsqmmqO5jm0GJIvFP80XP2tFeWaGkgW7zz1xWXNH8yNbkjLrZbbU5qXXlEwr7DBynSzwOqUw" +
+		"+pWlWRn1Grp/lRUjWz3PjTUpL2WKfp+O7lIK+5cyIXqFYRdPHWzQGCCYwlgW0qJVM+NTuEAHaTVx" +
+		"cL+UKizcSmd1KpNu4RTVgTibcaU71qTGh6L0sI2psS62Mfu16TQWTpuEFY6ZexTrmYiw/9eq6CIz" +
+		"E7qYinFYbOwDaX8BgQ1g4RLpUpasjTm6fEpF9qi7VLY4lTFQESHyaNKKAqywtiiqgy/UW3VqUPZp" +
+		"nRdi9oN28rfjiVjpplF8JJSxSvuTgng6bDNWYmdFimYh667HqR2Tz+5Sh6miMBXNTAdWaUDtYIiw" +
+		"BjyootURMep5bohQdAttxXN04IfkFD1YOOm2bcHyNk0zSJ3SEW1JuZ9wqTt6NTHPpswOQ/2oeYs4" +
+		"gP/perkhQnstiBHaggbJKGENzIug8D98CPoVDTsKFqRhMdJoa5eFSQk+jvQKm3iXGgKFmJwXw14A" +
+		"IajuiZ6pC2z1GnoOhzkdLLSUWyn+vzlDL5Oc2KdrqBIZYGOPywyJMmjO8gE6TFYz+BwNwBhEHf1Q" +
+		"z4Rj0hcvVIRmNs1atUxGz9/Bd6Tt3YTN6kvlQKexD1DKDUZCAvzho8NLjdZvKTcMAYxhsRDqJDOx" +
+		"R7wHfKLQMVtk02cLSKB1yAREJGu0yo5eDUHnHQXzhes5A4x1mnfsEjTvLGi2bO1VJ+/VIpD3Nlk8" +
+		"M4bD9pmDjx00n0nprGM6GVbizCHCMJ5JWdlK/NmwF3mmOsrI7UWjlhM5Stx3kscVk5HF3G6tW8m9" +
+		"WHLE87RK1rPLSiJHq5E5phaCsVLVUpkZ8cw8oVvL4KqhzBJppU6mZZ6hj7aMrVnmjpFRXFCL8slW" +
+		"O5daTLBnwEKaucn1zaWyFVYNO22MPLpMxfJ0IZqWFmZrnYJObL51asydylZueVYtXtbt1bpA0Tgj" +
+		"1KOdnDECZD8+pQOq+9Q4RSIwA6dWUaG9u/QSlsKRzkscxF36Lf5BTRZ3iSmXH9mfe05nvWZkud99" +
+		"pFSBO7OHeZcPWzGgDBReDeKukCzu0s8sJ5/oCKXu81gUKfJ5r7HUhL7Y59mjac6gsFkGWTogdzx2" +
+		"WvbEdACgw993QvynedMo6Bp9fU85K3opf0LH8Efznv3FnwT7e3cZZNhvbcwibTka+1CtnaXU2SGl" +
+		"YAwNq2sW7pmTfrIfDdzNBWTIPTkzGzD1sOIwmQGSP/kDeV0yNdH2ghylFgxDQSMful0GPOwp7hKP" +
+		"2t1lSIPJ/bbZxDMknT9StAYQUhw1GKJIQkqzSbOa5j7pB/Vxx1MtVGALAcE2/G1sC03XXGpaU0Kl" +
+		"DkcoF1bpJKMfVECKu0xb/LfepBqgB004TXeZhkGGwSd3mcbJjxMm1saL1HokD8ouJSl7t6SpelmR" +
+		"+pkCgWmd2CrTjtO+tEto6GnS2Z8xngksTnDMmUj6I/ac7DIFXWAr+KNkQy8rxjOhzbBwy+YyhbSL" +
+		"tFQmPfr9FytnWuYvWo0mZAwfvgNO1hmleYu6nqIcuL0Gduyn+C4TeqEUp6QfH0x59B8y0IAjVqIP" +
+		"MZpZ1KPNNpMNWCkiqxPnOSnRwDYjLxOrbkqcg1qppjyqtL9GIoERzcqSxfiU8k6NM4fyLmBukfKO" +
+		"mp4SeZGDNFqn+6iIRU7bNrZMNnoaNHeZCqpyYokUTgcJMC6HxMlOKpZhpabVpHLlnoo1u4RnM5ep" +
+		"YnMRHmDjTTkopGbr1dQClt8JG7B7byTFFQtJ1DlxlpuOXEGlz9Fzspt6KJ/MlE+rep9qdpjLnxyd" +
+		"04KiYnecFuR1dpcZy/sso7WrLOPI7i7LyNM9IOzNgQZlkk8jNbMjyjLSZLeDRJPLMs3SYdcOdeVx" +
+		"RX8mZf5Gy8pXcmg1r467HsHqkKFp0Ov5wILIFlBE3mCUVF86iStyJ5Lcd1DD1KHbrAZMX6SCS6os" +
+		"xXMDJkv1Bd0hae3xoPWTDx28xC/GqJySwUl6c8gq+3JQNO+sUicJy0mpGKZK2GvMftzR7syEKKfJ" +
+		"BFPGFc0l49snfZljf1YTplOp+DTLn9ZNZz1NUY/u0pZkkGJgiIad/SjhqEweYnTgcoc0dywdV30e" +
+		"fOr0rFfNL5s5csnM51iX2e9kTIyj3+1MgwNv5lMig27sR4PuWQgoPx9tDyD7suWUnk+2GF0678Xk" +
+		"q0Q26M6GL2aadtLgzIrRMq2o+YuZYbRge+ysE8i+mrSwq8SeSlCzXtXsd/cRiUgyfspyJnCRtqOP" +
+		"uyi779zKyRpt2rNpJpv/Zwy3BusDbSj+Qqa9diiGYYlGqKZgkaVPZmD6jD2gT/bbht0ax0GQPvUg" +
+		"XSt223ItmxurThv2Vjub7hQl0ubZMKrvIPFMijG08mEy88mHkT4xl1osn978L4X7q1yS5saNLT5U" +
+		"o4TewhgsvXkszKKTnnws6HFrc5eNK6DG/dVoxBoOphrxUzAlacO0kj3FOi3EoxEU35rEBjujHMyD" +
+		"cZykQzFk3erkrFXPxLTg3/ipvtDHjw9BBW8fH9C3DrrtpFYPPNl2lE0z2582b1uGej/JGVHxW5BP" +
+		"WeBP+PDsJFvYSTSjneQOfkU5k/jFEL0QWO1aCJ9scqDVpgxtxtnUy2ZTyRZHRVPcd1pRhW0nt0WO" +
+		"/S0WpaqhNrTIgmu5u8GSFMNTy4ytLT4BpRATp+At75qdX1uplpXS8Z6W9tQMFP0B82XjNK9lPn+8" +
+		"bH0zsGUmM8fiR2ozVM4iWs6p7SayQqe03z1rHFZa/oqOMVjndKIVEx7jTStT5ADeamDCatX84eOO" +
+		"vUr7W3VqCH/ZBjRMPn65XFD2C3cYIdF5LSex5z9nVlbaZnflri7c1cW1u7p4cFcXT+7q4tVdXbzd" +
+		"uCu8j3J16a4ur9zV5cZdXUF99ctdXbur6yt3df3urm5e3NWtu7q9cle3L+7q7sFdPVy4q4drd/X8" +
+		"7K6eN+7qxV29XL48mizu6uXaXVH5eOGuXvF/cFevL+7q9c1dvf5yV2/v+Bd3tXl3V+/u6re7+r1x" +
+		"V//nrsRdyVZRDCCJMksgaKZp9tG7q76Iv5IRlX8gObkr0XFOEZhx9CssoEVyh+KuZAdXO3S4VzKl" +
+		"vYenXg5onlfiIwVpTpA1UO9P6xJGiA83+K4kDK1WeMHpnAEjEEZOzq4k6JZy5EOfK0E7QthhSvQz" +
+		"eIqtmOLDZMrddqfxO2fAftcCbQThSxZkZ5IMaDN4NUiHTkqleUqD2T/2KB01V6KPHdBZnVk50/rF" +
+		"/PDFYQHnEOH7vE2jlzOJX4y2tnnVy9+0RpbOPCqNNJhMB8mjBF+6sq5oGnYs/sxM00oB+I14+S9e" +
+		"zgoydqOGjEVS0z9I1G502FqhzgcfxQwTgfk3N3AEEeUMvuoXo7mMJsXASzwTy27jZjdoZWFF4SQJ" +
+		"5KjWJOKWG0pXEgfNHWhjYMXCu0YMevQrjoaIa4zmW2T2rPJgKahoZrsesaqZiwRQs1YT93DIGHDN" +
+		"li2xNsjGJB9ka3sMV3LQPaXl4oGt+6DVAjjYLPFKDhOELzVLTKQ1hRV91JUiiodk6mRBMRsO2Zsc" +
+		"Uo5fjNYZIh7V0aAFRoGuMh+zrqwQj3gOiijkbQppMRL9h+bEDMrbhJ4dvatgSWrORv/xYRgJ6UQv" +
+		"2NNkvzW5VYlfrBg1iIyEL4UYKIwqE5utK8ohmaSrULasTzmUZKa142KSdTnPal7NGs4kGdL27IdJ" +
+		"2N7ybFmdoxwMePoUTHfeTCxJkQ0xs4xysnBQULKSs06vSuRnuhAPUicv3ymTdVDUOzATEk/0NlsM" +
+		"su+GnPmDJFbA3DOhu6329Ahsso6f5ByJs4Jh1u5p7cfSriS3Qt+PZoBUl69aXYRVsdi7NCTp+EVo" +
+		"NvC1oSthcynMt4JqXli9yoFDUzlYfMqXV8aK0VqJng2XW7qEdFBvlj1rZakSlb0KH+0xgBo0q+wY" +
+		"pDXWUntHUipHOJ5uWrEYsTKsEvqYVeVzS9R84JM98EkWguYOVuB1Cso6VacU/HAm1rWdeTkrjOXF" +
+		"3NBP3ysPe75a9j6EM6F1ZEhtITDdbZBezJ2tmsmPnXV79P2ocfQshGNqiPtfazGLRga6zPRraTvp" +
+		"UIgowq27Gt2VuiuVVrQMzV3p1gT7LB00IMd08MGkGqyai7vSMSNbNGhB59UdWJ7351lXGqxMNVQK" +
+		"c8Bjd0DImE6J2rHaGg/M27M0UI4RepjUj4a0ghm1EY14hgxC5QfE0U6ZXdmUCZK9lZbCyFW65DEW" +
+		"A44CehJm3Ie7mtzV9C8f3dUkWw7XE/qmqY9x0zqsTTJy8XY1yU5QtJN4rInFmHkQRpUVTSMopsak" +
+		"M0c6vP6lOYiPKzfNw1mLJ7MTuYlBO4SQcl+rQmP0O8mT0WCBcDPoaupN0tA2tbqiKFZWVGkwWAg7" +
+		"k8PUzINdmcxRtSnpJIdgETzMFq/DQf5pfhx7rhxaqHrQATxvs9BNHlOMnfpqGBRHYbXzMykr1hS/" +
+		"0VW7u/Xdeqr1G7PBmBvgvns4W2SzPdUmM43KRg2Se5jLdjQrhU5Lj2hVaZzosTrUKlNKw6TaVTGl" +
+		"nRjXNX6gPfptsBJpcTCzVqX90+jiqCfAoh94LnE1wUvlm/REq1Q6jiwo1S59KiS149EM9lM6rmhV" +
+		"VkNhNdSwyBY7BuA0sMLWuBsbMYoHpgN9zN4ko5MjGpuxtF1CZ/NiU4VJc9qrnol5kOl9NttFDip7" +
+		"WrEnqSBV84r22KQrLNf0KMGbjaPHSD3pMaegKzM7DKT3fJ0geMTeo76uSE2MAZPfDqzNfpBdWpF5" +
+		"Ze+1GZrJXop8fjF6MgStJFOTqckXoyabow/W5InUnrcJm6ekXAcamhnyn/noLfgovQ7bI3RgSvsV" +
+		"6eagn4sFcTho5GMm459yJrTINyFAMJcElimFmcRHYci1yi6xzXsO+lNI8C0NVT5XhHaarYamuPvH" +
+		"0346MNqJ09cpwbes1bN+ZWY5X70xkI7DxN0QU2gc0/ydl7OirN5Uv6LEL+YrZwFfyuWb4qRfirOl" +
+		"s16UM1v1gt+vdJbyxfQbr1x+fFf9l+Gs/61aDdNB85lOZ07jFFGdSJdi3XZeSirVMmUpVdB8GnvV" +
+		"th9Yr9vMKdjUWAY9MwGcZUwtZ79rVsnakVa9u/KD5uSuMJb6WbZN3ZWPg49RkL0+jmon3r5oIV8g" +
+		"dZbNwMW3j5plhrUDvcsYj3xB1fLVc9cLZJdTO4DlhFIM7iqI36aM/OYzXMquSDSOwwI4KPeGwKoh" +
+		"EhLELGeNw0rGbuy7jq07guS9Seq2cYVaO/PSMa4aqWMrJF0qj1iTdhNGcLFoqfBRCthMgZhJ67R0" +
+		"rCtaYKRmlrqdYr4lioNUplaPyvNsV8Hz4flV4MoMMmVqf1gszFvPcHzsevDLH2GQMruqkHL6CyiN" +
+		"2ZjqBDupFYtxOqKthoV5tYxarD8JS9U5amGcZnc1j9ldJXeVtpydpO3WXaUB7wvM6FiTLU4BRYlZ" +
+		"qN5zpElDZWhpxB8aHx/U55swV4kTnMSxKfl5KzUhrsnHms0dCpTb/leoMMkuSwDCE8Q32bw98fgb" +
+		"se/HpGCln8KH/AXsxJSMb1id2WgD7MrIsBIFJykppHnr5UziFyudmm0zyDLKN1ZWms6kG5eiWIqn" +
+		"wATVLBZYWwMDOWuhLqJ7SLOdd1gJtbeULKjZx6qoPGm22kb8VAl1oqJFzixXVlZa5jOrX4zGicLK" +
+		"dU45UfMg/xCq+XZoG81Hxou5HGWPqUCKdBUHPQwWbhx4PguEtdpQVwK/47o7muKY8oCxP6FGcraV" +
+		"4oeOmmVYvnjV75xefLTBSqozS9+X4pulM2Ueo3tNcZdMBg7FKe6y7rijIOH/UVp2/T86pWuVL6Jf" +
+		"9Ls3zLLIyWOKjG9UvMbXqnG+tkCWO5hNT3cxcWYOkul/ltHAM4CCxVH1Fkipsg0ri8OZWTd15umw" +
+		"WvK11V5opXHjJdFyrF/MElKzTWtSxEPAK85bkrXdZGlLobdKGxgJxSY8yeJ+kEG2nIqlQ+8I00Ej" +
+		"dkAiaY5873qlLK3DatFCORzOk0pyHigCTYH+ckWamKN5tCVsyqhtPfp5TOzUuPuV8gd6INvhSrZP" +
+		"AFixTitaTVspPfIpSGwr9STIlBzU4pRnnE2/SjmqLefAeoyinY4zFoicegHLtKKajU8J4UwsoBSt" +
+		"u8kJJZUPEFltczDlNSXFD7Ii429NB9BJz9x8PPt87H1qPlr8kE2FfXEplp5SMS1IpSr3clKpVnXp" +
+		"rKaYGvEgfz1I+UwsrWr9SENvSuQyIbWDZnbMeKhWidUGllb6yHLk1UlaVgrDT2HXYs8y0qdVjk+r" +
+		"eRgLoDw17tQfgrvK7gqvDCjgo0L6HSTfgriyjjlLnJUWq8Gnjcpc5mZZWMOy9m1HkARrin4qq1KM" +
+		"lHuThUDZTwhcZeWQRqCBaZbiR3qOriZrlUG5oQsaO5gSjv3QPeOqPPtZpQM1E4sl2+ztPDHOSapJ" +
+		"LyvGMynGCOg38PxeKx0oN6lymi2vO+mOozfY82lCTtHs2niereLkVKj4pGBJ5YSVR26D//B/LTvP" +
+		"nAZ+XyZOOnPjrCO3kgh/IU7uKi+H2jea1ul0cVclxb24q+quqhZ/mOBznVqYmrvCD02/8YkWZHFX" +
+		"bZiabco0LpxydVeNUxBO4FoICrDtHDSVNs88rHnVeCL3qsXYn7dftQNm4S3LIAnolbJyedr61nDj" +
+		"+rkxnMzlq51HvGpHMz+h1JatoposQ7Bt2mUI6XAmwB1zepm3dv3F1cKeyV0tB3t+BUx1xUJCF/YM" +
+		"Y8k+mORW6EKzkw6TyVRCOsr+vxRe/h9l/H/V3QtCjou7dtcX7vrizV1fvLvry6eNu75y19fu+nrj" +
+		"rq/f8S/u+sZd31y465sr/HWELO763l3fb9z1g7v+4a6fri7c9dO1u376P3f9fOGuX/C/dNcv1+76" +
+		"5cZdv2zc9cu7u35116+A4q437+76/dVd/3pw17+v3fXvN/yLu/7Pg7uWYZIGyCl2KO5aRqHwZXbX" +
+		"orxYxV0LFl1ZQXiS8VqmNOvirsXPQTOwQLUX8H2q0iF2hB9BPKRSwHag2tMSF2XXEma2zZXEL0bT" +
+		"yqjOUgbGaoZfs1mbvRqYlcS15zXfWrmmhSgUcBJNBPkkwl7crWPqtUQvgaArBO2EVn2ZANFTF4Us" +
+		"MZmf1aQF2rZqYDE8TLSTt3CQB8pR7CANOA2ZiPzRkI/ZU+ETJVOc/ygq+w40iLvuO4vXkldd+AfM" +
+		"ukJH+tW9REcEWGhWJ8o5pdxJM60j05E//QpezqzMX6ycaQWjr1VmGyWupe0mzRXpbbNnbTkK0nRU" +
+		"isizate8KIuydKDjo1dTU8KTT2p88m3sa/mEpU+zC9OFUeRK9lrvNHIP/VplnFRGkEghlCMyXSWa" +
+		"XtdEfirPIQMWSKZbsc/VYSI2H4JWUDNAQNtW0BR0wF9C2jUlqyhS3u5FCf8GPhM/E7jmy0XXeP3T" +
+		"R8QWbpXxUkb1I0Hu0FbUW+z9nKjKXYmM1j8csa5RBzSgbfu/nbUTSdTFMBE+Wf06kfiNljMn48r0" +
+		"WoMyfwMOBQInD4mWq8FvzaoffLJI+CDTiqZh+oxdoKNZcoejqdXUKfqBIaQegZQ98WBBHiY/dIzm" +
+		"KfoenfHn0yQQzSkQK32dtVpz0pmNRuc0ZKln4oczLZ35avZT4TUB5IfCPGTpRHZmGnVrMjFV9Dwy" +
+		"vtEXZHDkiwfXGrtupA9HRos5eji4a2UXoVlhkxOca81zQgTzqsweHbWWgQ9SwUadE+NSfGa9KXNi" +
+		"8mtOHm5b1Zyws+2u9ShReJzsWo8mEPgxdWn1/ZNl8sl3Ka71k6n99BV+/e0vKl0r3w+8nmQvkC3I" +
+		"zsNb/PiUP7lr/2SP7K+97CYf9AgWIBBfz+7YsxF6a4TeGqFHNfdSDmx3nUALWeBx+LasyAiD8iEz" +
+		"mI8r61gFcyH9RuF61IyM9ajGfsy+29YPjVu+73ztdQczVCmPijNM7tr/2XPr6Nr/ofXeLAzhrb1h" +
+		"eW2vtdCXEGiTyYtoDh5Nz2OcsoHOp53VLHaaPlkWpLj0xIE1Gh+mvpy99uixsSMO4F8GSD9M7Jg8" +
+		"Khtqmi9s8ARO4q59yaKotP5oXn2yCqOf8H+9mrRmAdYddQYf/yKaf/w2terd9ewrij3qIacDqtyx" +
+		"7N119PYk5BovdESA5llIcnLXaagpd8CIlLB4hmQhpDHNAjAVTCaosSt2nTAkc1+LQB3rItKsZcRQ" +
+		"wLdUUsemJ2N+WFHOJH6xstJ9J2k1xBlzMCstaqMwU5SJJlHC2KF0U1sdXqeoFbmRsHlfkVsp0oK5" +
+		"j2oyBMNM8KaLdODAAu0jctxpAHi+6XudUJF5Bug64fIpuEbNTHlAW0lZMfKnbLHOTD670JR9TcTE" +
+		"ex+NwJtctBpQ1TUrLgJ016nUpEc8LblGw0nVEtsEPV5qzPi2DWpF0HYUnNwZkrDw2eux2D+Zsk8m" +
+		"dkGisrvOMiST1htlGRqCyLKHBZmFZ2HOrJBum6depNujH82tndW9zuglqf6EWD7QnrLdEHCd02Gb" +
+		"/rrr3FgL80KrZZjadivuurnr9ppiddeNHVbbiocc0Eu37UStgEretjkdo4f2gMfc7rrxdY1rHhG+" +
+		"bt6uXLluSEiz2V8LnHLh8Qck8i9hRG7Ydb1u88GaUYvCGMStUMWq2+KI/r5FHRl83LPZtxgEYUfq" +
+		"Mcq86CuQRJO1A023psuAsv6FxKLOAPHKe75Xdt1yGkxOtIyhrZVJ4pakaBhT/gCt/VWza7usBYAs" +
+		"qMNkkp0BiXbymUyvPyG9PrLLPKYse3fdx6JPHgG6Xtz1Aivo0dw1rxm9Pmk+TXgWvLjrU4t2VPrG" +
+		"3Vy5m6s7d3P1dLEZsj9Ud3P96m6u390NjG7u3M3NC/5X7ub20d3cvrubn3fu5vHW3Ty5m6d3d/N8" +
+		"425ebtzN64W7eXM3bxfuZnPjbjaP7mbziv+zu9m8uZvNu7vB78LdvF+7m1/u5tfjBUVxN8JZ643k" +
+		"SXIFBgo1GQ29YdRSV1y17L3WG8kHdyM0ZvYaxBXPOoWIDAWaigu4G6kpuputmlA9wQ1HsRtOXm62" +
+		"mHkV4uJu0F3fDFhTeXhc3M0AjVKlLO5maDKmvKLEL1ZWql+6/r9ocTejuxllpoBqFAivlHYW/AaH" +
+		"7m/QICFgaSfZ5Jjczej57tAEVmjdVyg4ASKYJ+zBbsaIAIoGdzO27gG30gwYvfHzmwHCwxqIUtyN" +
+		"Limru/n4QBw/ssDoIyNyu11yN7vlUE3Sq06KsRTSbnE3U9a41bwDC5hS3Hg2huhu/McHomY3id/4" +
+		"/n7ySoq78QjcF40T3iczuloqLHt7o/QmIFODxEQtPKINrDNhKxBKkamRW3E3YUQuBe0ulNeq3AQd" +
+		"aoYDRdr5LsJN8PgL3Pg/MgHMjU/wzhcxudVKw6ImE2Gi6anL1Y4xid85AkBl5BYUZJkVqee4fxPW" +
+		"VwVuQg8YYFhM0nCGDzMzZkYMojDEmJisNHlkU7JIpsXdhEzJRBQGVJA8kCoR+Rws1BbcTTjC2tGb" +
+		"jJTZFIgDnhTcBGtzYSmqBFYMYJtXLO5mloGS71vczDaXBS7uZvaBQim9GCQCjaPOBnGAHaR5Pns1" +
+		"K7JlnmEzwbuIdYO4mzgu3DG5iTv8NRQAJ3tAX6YVNZ9ZWRk7lk71TK0H/1LQxMND/wHxqTXt3U3c" +
+		"+7G5m5hQ+2OCpzzHAcBc6yZmRgznGjMLx+49vTkMKOvDZOdHV1KMNWIWlOnBD5Xn4sCadUFkpjX3" +
+		"F4FvDh4zaq9nuoCUgW/Hn5kF9qWg7VJRIoeSZkrY6E3xn9aCwvfsbrKUmVRqMrkuI28yNgwAnArf" +
+		"ZP2c4AvGh9HdZD9QCOVEuTdpWlyu3GAxGOkNamv2tGI2aEqTwuB8zSorxjOBUdA4816Am4zakyMF" +
+		"B6GvMYgMiYgMKtIUhZeTBZBTcDcZxZWPDwiAPWdxN0WauymcMRh43rd6U4aE0+/J3ZS9n1eAg1mz" +
+		"BIwG5aBZYk0rO1HPx0TyD/6NvaIh3BaNJpXwT/PbRPYXEnHng0KruRUqxfQHGMTgjLTMaFfNk5wW" +
+		"MoRSLTV2/W3HeCa0kQUpsI6tThpMZh1J+DbNTZ18Ong5k/jFirupKAh2ODU3TmdqbmWgLbTh5m74" +
+		"Upwv9Yv54YsX0IAW2HbMEICXjqs6uZuGJLeANLVZI08B3TReoXHT0KqY6gbjLMXLivFMaOwP3WVO" +
+		"YwpB8jdq2gcx0A4Sz4QWltHCLLXfxHvT0A2ou0Fvi9UQhN2IHc48yhfVM0WuHOkvpJ0qvTnyHxZ4" +
+		"pHHvAflNBdOMo/IZFLGekQ6y3fdFChNG7ehRCp8eXejfQXiuBYxbJCQhaBzYv6yKBXRSXBfkbv5q" +
+		"HpYB0fqbBtSHv2lEa/6LJfbNgha/oHYvRSMUJ917VKRTFnfrbi8u3O3Fv/Ev7vbywd1eXbnba3d7" +
+		"feFurx/A39zt/YW7fbiCuH1xt4/u9sndPhV3+/x04W5foPHy9v528exuX1/c7ebW3W4e4fb9yt3+" +
+		"usa/uNv/XLvb/zz8x93+r7v93wd3K5jCKBAZYVDcrQy6TWnvbkVzgvFH9Nndys7D0jRCZN5RUd2t" +
+		"eL5QCGe+SOjAO1FvxdfJ3UqQMgnQrrlcCZyEYO3lVkKp8vEBwmd0t7Y/f8v9+VtBKyTuEmRQKmZF" +
+		"tHKWXatGeBfpbd82v7WN8lvJxbtbKWXLA2TuVqogXmgNt3bI3oBKP4uBR1Rb4IunK7G4tliD5oQ4" +
+		"tFJNdhN0s8lIg3efewYCq4u6W/y2+Odm7xx1urhbHfG/+QupWcKKaACdFiMYZW8V+adBBy9AtDfC" +
+		"qvR1ITmYPry18++3dj/qrV1NdKt59JHForwO2SDRAuZnE3c8bjXPUglU0BjO9LQyxClnyb6jqVkO" +
+		"mrOHz0ucGfLJ3Xr45rdskQUsRRkG727xYFjdrR81QCZ36zVgHWEEdnf0nAcJk7hb/8dTSOwAO8Ef" +
+		"WBCdUCvwhrJbHxLOWt3yNd1bH61Seh5vufUxUmwTtsZrRcX3MepOTN8XumEYKSL0rB/pL9Fuhrn1" +
+		"Nkje2qTr1pe9u+WdEre+nnYcE0kPvPmMhidWJn9Sae42CP5RGGTo35W5DaihaFRBaoVsnPvfBl3D" +
+		"C7YdTkQsg9Zu4GM1yRjCs4Q/up+Q7NyiEc4RSGmJm9QdYyf+O+u2uqPC3QWwBZLD9G2wuzNuQ1oQ" +
+		"+QX5O7tbzBpv036P+HFL7DYFvpt7m0JJsDBLmATtOkXEIqWyFVSmlLeIXcojBM/TAUvtQB/xzpWs" +
+		"SNtzKrIiXaxOVhdVgp5gpRSFNO2aWO9TG6QFEj4Dv+XVITwOCopuDscCYPAXf8Qwu1texS6IaUag" +
+		"mQeaDMqKgxhjLpKUFc9GnC180S8LydjeoKvS6gPXRMbsYw3guyDd3FztNXTsq1AqeN2esfadaTbu" +
+		"tWOZjITVpfmNXhszPchijhqF0loLPaQTczOj8+OnjiBHeq7YM7jN1uGdyf7MKvI+2ws/HWloNYJY" +
+		"0jdm3e1ZtXxx0+Yxr9us3mLBy+5u0TimDuY82qZXZ7oyWzSduelb8CUyh7WgP8raRpPe7DMeC4L0" +
+		"+HOYIyA0b1kCiIZzJ3HsQGu7Ha3t/CgeXVz2ZauGrBS+WGhEuqjUriiftO09FeZ4kL4UYZmneYaM" +
+		"oxKqRkFep1JNbhVNIrd4ggVEHGNcG6bibpt9re227Xbwu6GTbn98LY34Keh92h+/CMbbtm9pb9g1" +
+		"gmCsamFr7wvetsDmRWDRt2AQ5aNhhOKFBLfNirllfKhiZ07K1GDxNCWEfTr1Pc07d3fh7i5e3N3F" +
+		"+7u7u3R3V1fu7vrV3d24u5trd3f/b3d3f3J3D+7u4dbdPdy9uLsnd/d08e7unt7d3fOru3v+5e5e" +
+		"Xt0dfhfu7vXF3b1u3N3rL3e3uXB37zfu7pe7+/Xg7sTd8WTjnWxTNKnFlDlFkuw1rCgroRN+hPFO" +
+		"Ru5/34nClHYx1BOKu+MTQRjv4MTDjocdfuXuTrjJDigIse0mdydBJhlXLCQH2SVjlcEEnujoGM3k" +
+		"7wLYZk7w7oRRDIxZ8FtPCCrxTEpn2jEBA4XsUPfAtIdrKQr9tcYzMzNzmbAtA9IOgORpWD7tYccd" +
+		"XrqPjMaRg7K7Q52CgCFPLhvEFQsJ0zGnT3cncZTwQZx8B9qOWia4jjtmJvav9sCa4XVcZh3hCaKV" +
+		"t4hsHhhaHiOTmZU51s+qgDT2wHeSdxJro11+OwPB2oGtO8ndMRc0QPR1xMLUZYaezeujUkFxgn9Y" +
+		"oUNVCn2pjHnlgZM7qYlvNd5JxUG3O6kNHrZdY01qgcKXiQiHrXRpGdKq5W3jG753cqS7TyHAw4W5" +
+		"yoicvMSqB3c3urtRYtm7O3V3uOMsujvle17A7HfuTj36jTsN26PXCSRQzZsFDYq7o0O6ipITQCmK" +
+		"GWqlPIqB1yONd5MZJzFJRU10z4JEBdX08YHu+U5T3mmH0pGDRae1G3pZMZ5Jt+8ZRUSfsz8Dz7hm" +
+		"yWMHBMpJ+Z1mGvEd/Tt75Grgh05KR8Qvp+hnOmbcc5mwZ3OnueaGotVS5ZBWhMs6FQyTMKt1MWlP" +
+		"te70s+VT5UcUEZNJophksiapBfKEBja6u0ljdXeT33Jxoe7Oy5BmLhnuvMTIwvJ4oRBmW/Z8frst" +
+		"kFlClfzFqMn67BFrPyq5hl0bgVEmd4cOzaNz7M/sOyaQ/jWlOx9GWlB0bT6g0HzYyayFHtCHoOXg" +
+		"1RhL0IfgZe4YDXdGuGy4YxnaaQAA9/PufEQJ+IjwY+ETAZLWSXN3HuXPDt6nyojicTDzJfNa57/u" +
+		"zhfr59nOfL1vW3fnGyICa63o4QBTNKK9uwsy2lLjzArpQmAjD1J26Mf4wPaODw8iHzKDf6rV0TOl" +
+		"lb/J3QXlnOmOfXngXWgGiFzQiHd3KpmZI0tCyp7QBpt93wU+Gr6LidtYHdmXx/Z65+7Q7BKGiTTi" +
+		"zwaQxhXRGSY77XWXRo+2m8ZUIctBdTyTAlYnkS1Ii+kItAub7pJu+WmAu2QX5t4lrZOYRp3U3aWP" +
+		"D3eXYLBDI0vIa7gLyJkUBq467lLo0oo28SujAK8GuK2pGI1mYTYPxjJzcExh/ORhbLKFZjtPmepE" +
+		"z73Q4qwnyJSzUHlQNIk0t7CHtTh+ShQbEFI8Ceu1kVMnyd0l5mkaObym1GOTRquuKY2fvpuwj0hp" +
+		"XBSNMKUdyjilYC9c3tn7gHcp425eRdbaozXibEZjolopjC6QO/SoKe/MeJfiKbFM8h7G5aChA6xV" +
+		"3SbmLTOBtysD/NCBdibIxrLgLcR3qaEfTS0yvUfNMSFKi7i77O6yzbRy/4QDiCntHAyY1w6IGW9b" +
+		"vMv4UIPQOt9hu7MPdBvQOnqfLHgOA7D93bts983c8XH8XWaLy3JUAmwqX/K5y6qMhereZCEEpR01" +
+		"G9FsxB7Pzvxg/KDSvYilA+ZynQa8a73qH3qQ8ZMlnxVFlnVHYdIe5XaWOmFkeoK036BzZmWl0cLh" +
+		"jbPAZPmtxTJMK51XvisOYtbqaU/vITyj4vlpDEOUf+bLqQB4wx2au4zBOqd52SOOiZ/AvsupmDxB" +
+		"1q7HfienEzpl3ILHuHNhAFDeMXeXabctHGQNi7trMkqQP5Kl8wGtrfN/mj/63BUNfXGTUUPqNASp" +
+		"PnvQmcI+23HX8B2dKTWyKlEwSjfJ7Nab5OjVXFWdJcgXi98o47bgCgPEBntkdw3nJQE5Fl3OpJAx" +
+		"AZqzol40PVqKdrt+qfBd8xzSm8d8gydgM6Plo0qHuGIxcmRWNR+jFmotgi6i+RP65YZMOyOi9ycL" +
+		"3vOBdyGgM2sBIwcyEIf93V2L3HW9a+lD6dMBFQbnUwAIolQ5dkgdqV/7c/e7VqeMNsx5Z0YX2JAT" +
+		"iyVusZuw79qJdQBn49A/fKI6fmoc7QadTtEps2deDsWjLiyHsrh7d3/54u6vr9399dODu79+/+3u" +
+		"b939/cbdP7j7h9/u/sndP724+6eNu3/57e5f3f3rb3f/b3f/5u7f7h1sbi6v3P3m3d3j9/To7t/f" +
+		"X939r2t3L+5epLh72cp+3/Ygmt29jEKpMLJPRt/Lhz+5eyzvIHfiAT7tshwmeLNr6u5lgk0/ikk4" +
+		"9x9U4LDkDqR6kxJXhK39Hrb2oS3V3UvAf5QIH4MJ2aMs73nq/16Cx+7pvQTa7Hp8aFJTNE5ngUdl" +
+		"77laU41frJCWKpXhmSNqFno2488ozpxHdYSNmS+W38vsw8DMmD2jMftggRuxBM4e0497mYNWgqfu" +
+		"LLns/6QwUqHZNr3IGaGZp06ALcsWOTYf7F6I+34X7r3MpQH4H9KAZLIrvOcFYPc8nX0vcdeoZX3A" +
+		"vUTai2JyImCpB5I85VHzipYI5lYs5ndR02IcYmO5xbbfy3QmsH4oPd+yMNZ5ywTm8Qw0ZEzzLivH" +
+		"qnsuNgk6G/Cb4GS0GdInYGbo2TIwHxjhfPDmxYE2uesP9FpX7MQOfJCWDmtkbTELkkYzo2d0WJmO" +
+		"ypfZQNh8Qayi5KOYwZFRLducAH70SEqpfN3pXqqtuu+lTritE6RMemgIovL71CSsv+1QeZ7nXloR" +
+		"SpyJ+3D3grPXHQoxs10dWUbH1KOGvPoU7ztIPBM4wtsPu46mpqxTynSNuPEAI4BS95SwtkQDik9L" +
+		"ud1QTswV1eHE6JyCR86ru1cZIXKBUupkEqWneDnDj7CzxZ9Eke+KHZ6hY5nPpJDpZwdT8pnxvQ6C" +
+		"srLjEPc6tK24e9WDu9ePyNB2GkwyT3TnM2z4UblRSBbYxYN6yA+tJ+DMk1b3ygyG3COD1EduJoPk" +
+		"1QLtlz5WgLKeaNAuxQD+hB0Vfkg088k+IkxKWO0BVw0/rKTMX+xsWuoX+7LaP6a8qrqn2aAcUjRX" +
+		"ParzhMviwbiNf6+h+LhHhoSjHTnvrCFYPPphleaG9b2u38sG427RSkpnf4Fs2cphnSR7k3z5nYwO" +
+		"Mw1Lt23eHnj7w70eJlE+67xXHF/+nDzLn4WahW9xGfFmKcu+623ZtrnjQRxakKht7lwlrozWR+YU" +
+		"nrbl8Uxoomjtmr1tBNzbfgmhf2DYAp7Nn9lHL0aq7LQzw1R8CPSiP9u815xGk6l7w1jknsCetezY" +
+		"gHgUF0iYV7msysnULNBcT+WQm7k46S7xjCw47JbpyOgXz5DLQTMDLiaU0oqfy+172+K8t8+H3Nsb" +
+		"Hvf6ye9c3euieZQJHvcuQk/bxFnZveIgAsiHu9+5ey9BofLyiQ7S3SNDo/8i0d37YT93THtCcfde" +
+		"c4rLjFj6nZTJpyIYm/xux97N7yZ7jPmNfmkb48zy3gfJPhkuAKsb3NCBRP+DwdmHD24P3nP492G1" +
+		"HpRK5ofH7HmRM/nSQkAz33u891E4Y7z3cVQu2MnsKntQT9lMsrF3UoytUCV+Mf+N0oJSWrQOh/79" +
+		"YFC+pKTfOHoLnzEH8jlt2+xJJo+K7XMqk58RWyzv/HAmZWUp0BRqHr0BDpNNUXy1JANoobLaGxZ3" +
+		"P3OWmNx9spaZtltoJ2zX3ferUu75igdlIezR82IvBUIoWUHSR6kysn6mHTV23J++T7tPyRWOJ40h" +
+		"TWqsVGnYBqbilELQTMWUEJMp7ZElab8XPyKG+1YwC05hy64/hQFv5N/b/gwBAQc+TrvnkIw8Qb2P" +
+		"44qav9jZ0IhmVoVk01t7TYVgxnz8cM/XVu7PXwe5T9Zzp8COJoU08HQIGYeQFEzZZ5yd0C70Z0Zo" +
+		"5uPOe1QVbkFB8rz/SsrKSOyNQ5CF52burRCjl0y3KbTQSGxP5D7FMkGDMU57NQkveOfFfUrFfyHC" +
+		"SLXj0fSPpjwoRQ/14CloYHMaXm3h7hP3Xgho2Ql1nDijraY8txMgUmx5WoCUbhLvObq3xzoAZgv7" +
+		"w1QUCWSOFtanekgeQ2iqVWNN3xhM2+jRLlNDD5qaTQhSs74ztWWKU0QVO/Ir3nBhM6v0qRQhdKBR" +
+		"RkfJd13uc6oTV2YIBit+d483TuC2bZnlvAGfsrp73Jd138bx/BHO+8b3je6bZgy6DTWuoUG13pRa" +
+		"70bxxA5CyRNE05jqmUDf419PPqRDqsMUoEbU+fo/OhG8kRJGOJoRtZm1rs2HKetyJvBpPiosIAJM" +
+		"U+y31Z9Z6RTOTGEfqL5vKIUW2fgbv43JTG72yTRgP8Z332xt0TLNOTdF2bZS2EKAORDZVfXSwmsm" +
+		"9nrgfatdp7n79tc8W3ZcYy62hl4wsV1GzTySdr+MaBfLjG5iOWjmhGxpcUR3cnIP7uFfo3v4Vwju" +
+		"4V+ze/jXUd3DhXu4fHIPV10U93B15R6ufrmHa/dwfeMerot7uHEPN9fu4ebmxj38vLlwD4/u4enW" +
+		"PTzdv7iHp+cN4cU9PLuH5zv38Pz24B6eN+7h5Zd7eHUPrxfu4fXFPfzbPbxd4F/cw9uVe3jbuIfN" +
+		"5bN72Ly4h82re9hs3MO7e/h17R5+u4fft+7hd3EP4h5kGNIA3CX3INE9yIGHpx+2vOrpYavZSwco" +
+		"8TThgTeJPmyTe9gWje5hkIkSTexhUPzZZXbU/MXKSv3gHkbBf0omJa5YjGjH4h50kdLcwy6yPwBy" +
+		"BvuwS9k97JoUmP4pRcOsCG6v7mE/Rb7v8hDEPXA6/hC8jCaLe0BpheBj8uVMJH6j1G6zR2DuISyt" +
+		"TD66h1kDIj5PCU8lH+Y0+jYDecz2ATOah+georiHOOBPItAdJsQnDikf0KXp6B4i/4o7D3mk6iGO" +
+		"XkzGDqvySyOWldmywxRfLkxHDTLd8zKzL6aFQdmXrL9Y/EbpOmXaMz+YsnFxD5Fe68k9xB3Md0E5" +
+		"mj3EXZaZQBu77KEZMZRVkLLFd0fcQ0yxuYfIj7lyefMQS6WQ7kOxqwSQgVWDySKVpN+ALUG7UuuZ" +
+		"FGOHBDfNV5PQbXve+uQe4lGye0juAQkdiEw5oRAHk1B8CkU0aTrFPRwmb+epHw6Fm+UP/0hgeP9s" +
+		"JbgHXFOJmpvdAzIvw4Pcy8gQhvIPhTcJnd5yMutSppYvk0nNhrPETrQTO0i0UuruWy1795BnhJ7+" +
+		"acmC7qysFCTLp+Dc1EOuFhYex1Oqwc498ET/QxEZILcaOgRZiYJ4mdxDGST7VN1D+ZAJoSLqVkEw" +
+		"FYWchT5MFUVRzJUfcwIU91CCzCbZAZP5oWOZV1KNpMOUtl6+K7pdeNWDmsUDMMtzDzbmPPBt6A5+" +
+		"xbKSqp1BpzDhRYaJMa4Stw1O26m5h+oeqvgDGMq+2nsjHaXqSgvJAlnQLdRJzgD7yT0cBf9IMSV1" +
+		"D9hmezjaUxfg4h6Oi3tYEI2TyB4yys4bzsAJr+q6h9PsYQfZfsKRFi/uh/txtXE/rt2P25/ux6v7" +
+		"8Xpz5378dj9k2FN4JYQlEks6o5cztXEYigWyW05bkypd6TusyqpGSgfz+58meTRiR/g7Xbqp0no7" +
+		"neAdd3t+yM5rCHS+awKNSeLOg/gZHjBIu67kB8oFJnu++/RD9rAwiwSCH2TFeCaFzCzAKf2clRqM" +
+		"zqx2v9sP5DcELEAZhQKvB/8QpiVqCB1MWU0yM/iSBcCkOfDFZJGM7I1/5FMVORTN9267aOyAdvBD" +
+		"Yj8bbowuG1QHRuxgjzZWQoOW4V+m3znYOYQfkrsGTw394L4q4MholdkiaWWHXQb3QyrW7T/kaKK/" +
+		"aQvagzwKnPKWpx/y166u+CGI4bJGYpFPfrYB1pdBV6A902SIrFq6Zp9axmnPObvExWA1Xy10GyP+" +
+		"3v1QPUCgaf3Qjw8Kzbp0YmF15mVVmPFqjcoplQmPUyr5USb3Q/cLSlz5cQogFzY/lKVnX4T7oT1i" +
+		"8WwpcrP5B+8G+WFXBf/Qw1QnesnzPz806+xlOpNiDA6ypximBLR7wn9oTtskM4m5TzO1zTYLVvNq" +
+		"xvRwB8qAvrciQeFDKRRKyUYDpBKDngHtoNJpxVr3h37ir8GkQSkdmPefvkwdYjdiPD5BT7rVsGJx" +
+		"P/w4ivvhA1q3h3f91M0PP+OvmJD88DNbo8fj9h8+0pjPTX7w1M0Pf5Sc3A/8YJYkdikGCuAzjB/o" +
+		"0NhbpUEK+o40WLeEjREI5FVCfUojwkwj1CCIdqLp7s/a8aTJgpp6WEAtZ8K9ox9popUofHWss2SM" +
+		"QUzxjAuhmOx2uNT5kTjb/ZGCafMvk8lCMNkDOYdh8YlIUPeRt+L/SHmUFfx3But5h6CKiQ+TYuCj" +
+		"kcPUgdWSrKyYYmeNWrQ5NbpjXU+sZak0yIY+NB0pLCLIhGVQk6bDFnaI7kd2Pxq0GrvlJtFXIp77" +
+		"/miYpf1oo2giTJR+6NANOYIYFpIulThCKAU85vbWj8bX0H/Y+vxHQ53gaeYorbofDf4G2gteTMYO" +
+		"qxKZ1ILv0qxq7VBNO1E2GgbPEbQFBAXP6EFUaQTYiTuKj2x6Ows0+pQ70NJecwcqEcbBV2pmQbMH" +
+		"Qdk0PKBQw9hhVZrXdr/mj3bUKMH9dD8v3c8r9/P2yv28u3Q/Hy7cz58/3c8X9/PV/dy4n//rfops" +
+		"xf0UTKt+ysdeTGrBKcCfsuu7mD9lCg3geQjgp/iiuUMB1uCj+8mzydmTzHJKiaxM0eMbGz8lbCH0" +
+		"KAF+hV1KGTn+UwLdzMve/RQGNw+TVMZm1ol/0IM5nPEFIwVDmHGYNP7B9gYUI2JAYjdT/JSIACKP" +
+		"yfyUiChFzE1+SiwU5kk5KwmVwtMsTcU3pvqQCiKahWKYTLFDqGT7lNtMhtQjxVmVGhpN+kibdMgc" +
+		"y10nUBjFHu1PyYmCBplZmRe4KALXGDJ+Splmn1dExMtBMgOuShHopKJUmA/r92mMdUM8RcjGzCSo" +
+		"mskscWxkZ1erIwRfzb4loc6MWE2fqKU/pfakVdhsNGvcJfspLTKXm+Xbp2ylGimyhz24WODHwhw5" +
+		"yX7qUFas9Oi0ynqSvS/u59b9VO4/AIr7qcPe/VSNsKf6ibi6n+pDSBlYUK8UXcdPe75HWAjepBLS" +
+		"bkekUTEbR/o1a07HBHKgQCyQezyKGYhZDDwjE3lG9ad9rhag42LIaMToO1K3UjBBseJrm/KdlpXT" +
+		"7iImY4diWKvp05O0g+cJp1h+6sGSnNWaiqJe8hEbALZzajIAac2MM2zWamc1f+qRibW8OAbUIz2m" +
+		"3OP5eWD+wQWfhBvQbJKt2NVVPyes72rLXT/4EUCe2XFMkmtCy5pkWYR4QkjTjPhPye+n5EnYmqc0" +
+		"q4/UyII6OOVWJh6N/Dm1jwYZqP8p2Z9m2Fy28Aov+x/Q1vw4up9eA0Te607Q2f/0LCIPf/yOnZbf" +
+		"t6W5nz5IQ4fkg5+xdkDb9bzb4qef8d/a91w6A0HmcfpJyRI28kkWaTuWDpMUeJ8+u4SzA/7BPMpb" +
+		"2MvDNLHzAItMUN5NdumS0dOKHh5lv5XqSeIiO2rtKTiQgKxvPv707Fd8LhUFjozeCfLYlwnHzkCC" +
+		"QbGT1z9RjXyVPbbwkEc8YmgG6BP8J1ddPwMiFyRym/9nkAY9VYqof1dUaqOaBT9XSHRISD6yjl/S" +
+		"3+uZFPdzllzdzyiHg/sZtRSFCk+0sqJvibzCbosJZYZKjyjtyDeYfsZ0+IAsJRXgZ1DiXwpbif+M" +
+		"bSzIi9jqZLK4n2mr7mcaJgrZup9plGHiGgB0T4kKkEZPlfLbZO5n4mdZSIL7mfypoV6mP7QUFoyK" +
+		"dof+iRSlzlvlf9od7T9TivXkfuJRDtxniSb9YIi4ZRWTsQM17eTFz4SGnfJp2RZ0wqkMvpU9AiiF" +
+		"KUxl2SH/U8Opsp/pMyRkfXY/ecn1zyx7qUlI0ieAzTNLiWmUlS2S0eazbgskj3p1TCHtfKnflQsU" +
+		"ZafuJ7qbQCwUU5QV55ZRi+3rMASNhl3Xa8dVu7vlBOrn1ydVSBFkGiBYi/P64O5nxnOaPf1oZgTg" +
+		"U+6fuaGa9auhfxb3s0xoOOiR2zZg8Gtba0dtiu5n89ulsD9qoU5tnt3PFvlK8M/GLy4IKfqclvmV" +
+		"NkM25JZTEXQEDRUcaS/uJ7+r+7PVxnlH+xRfO/gVYevEzdqOe/fzU/a+1QASKXZ/GvEksmJxPxf3" +
+		"c0HmL6km93PJu+VkcbG2/ege/3Uxp5bd479u4ofE6h7/9ZJVArDhBoeW1T1euMeLZ/d4+cM9XrnH" +
+		"q2v3ePXkHq+v3OPNtXu8c493l+/uEdcmPD66x8dL9/h47R6f79zjy92Le3x1j6937vH1Gf/iHjcX" +
+		"7+5xc+0efz+7R3GPssVfImTmNVkr0W+axT3KoAvkpMVTCdWYdkKIkUi9pUz+cCaw+yEL1wOP8uEN" +
+		"dwn6uyxxpyvxDHBKSLX4Bgt/aG1vkw4Q7s0+yn7VSJWAuZx7lJnLoI6FJJsc9sQTvJ63FJorMaFv" +
+		"j3S+za0MCUxj5c49PUkoG2EKo8BiHMSOTBqtmslMrSHR+kiRIiEvhCJmxCfj5oqOdhSaJ2GI/Yoj" +
+		"EuZ63M3N0/peTNLbSDOMHUAew3wUxD8NiQEz+1K1nCVC4xCEcT30UA792dcj+n0IWMpCMXvYtNf7" +
+		"HiXrCO9zaqVQn+li9chFI4EBQ6cM0v4Ci1DSnHsxj8yGKlaIVTPjx3cjHjndpBQDBlx9TB2KoUH3" +
+		"IcXF0CBjRHmUevTSIa4IZ22koMcNFlscM1+Ef5TGdPf7Y0A0GMQOg3Zitr1JaB7RdAXvanSgZvLF" +
+		"M6RjitT55NLnUT5Xzz4tyxaZDQNMT5KTARqCukcV/IcJctxyWv2oQiW2dx7ZXLUXpEo0VRQDekCD" +
+		"/EcrkW6OGu1E5aNubReUBBHRrZZdo0aQOLhHRbR15Im2nXtUGOmI2Gn71Dgl3YPzXMGjfiCUnfIO" +
+		"axAEvpsSMkd3CSLTBz4jelS/jf4E7G9ZnRn896PSzgfEDpH1yCT1h5NHTKiwyqwB5aaz+MqA54YC" +
+		"1Lll6sMb5km0WEYfTe6ydDW7ECNIQrTMYFtTVEKNCU1RY8pigHAi7VYLoCLSsJoYM3qQolELl0gb" +
+		"cUDFCcYZ1xT92EHoUUSvrOmQwrgircPw4EdWkMMesTiQZ7YZ3uDyqNBAA1SebwSgsmrhl6getRQ9" +
+		"0Eoxbct73gsFNbpXrZNJavAKFmA1rHYe8VGPHML0aPsgj3r0FLZ3BxZNt1BWfjuGjK4QtU/8bQsC" +
+		"BMZ/++mKR/0L65zSU0KRKuqrd48e96A/+q3yMA6JlxXjmRSwLCZNoS8fH97cZLU5KqhvM3ARk7ED" +
+		"3AxTXW+SePQjg4Eb7VcHg2HzCkf4J6j+y/43leb/Vw3fdUfPWnWPrOI++C5jB1OixfrgW9pLEAxe" +
+		"PephtclSBhxaPZOzmfFCBXIupLj7pAOoZoQxb6WhqeH7iZQtsxF7HB8oxFaYqPmQDrACzujFIYW4" +
+		"IqyiYXr2ej7yVcOJrMhiqIaUUbTRTarLAVHiSv7RR9Ntf00yCtGmA94ajj/400ksYw8HT8kz7o/+" +
+		"UHiA7tEfzDPUIl+2xov8SfTcKj+BldWXbvuELKxTk+jli8VvFM554TGAjo54tJ9CZ23sBfOlgBMO" +
+		"UP64rma/KDziRedA5MsJf6hOJ5bs6bS4xz9tG/6wSgQ+t1iie+RFNI/RPSbBExuD4h6TvejIxsWD" +
+		"FUeMB1jKmUTs0rCfVEcSy1qeh3xM48k9JkUNSfrpKeHljo4mjbuM0k+cEiU03LSHLdTgFHwl8Mpx" +
+		"YNraVR7uMc1b0yT6lSCGM3yCn3E8g+YzQRCY9aS4+1CcWCQtNStGtxR3TZsPYBHZlQ6KFKDtp8xn" +
+		"To+JAeexmOSUtRNqaVBPjCbFQA3qyXCFRKxMaaY7BpvN2TnIbJJmCAVx5UZjOhNqU7/SWsNfA7Oy" +
+		"mQ4zuLESA7qe9Q0ri99oOXP/Td+fDXq/l1oelVpHxvNoYuBKFcymjenTRAiEuM3SyDCjSJ92yvQx" +
+		"8R26R1bGxO8yPWb3WN1jHd1jc4/NZkGN7bNt5ejtNqfHtsX+ArDNWymTd49tQG/dEHjj6NP4xqlB" +
+		"IqopWeMbP51N6GhBDd7sVu1AT7nQaMM+og61IWv1shIzWtxjG0dzRoQuL8yAxfETU5BeQu0Do10x" +
+		"9ikfH3DDbrPtUkFS/I6yUMAe2l+jW3bFLSBzZq8ogsYQ0Au2g+ZBOBg0DvI2n2k8if7Yiq/WN7Vq" +
+		"mQiQeCac03zxclaAHSH+6rxNLe++Uc3/pfhuy7L/hD9a5zEd3ePyP+5xkRDwoOBxGdDWlqHx0rXH" +
+		"hQPlwqNiBtBkFVpQRguSzo+nPy5o+UvE4Lmw3BbrARZ7Vv64RCqi0a5HC1ytLQy38KPJj0vxpWap" +
+		"1ErBPbmnC/d0sbl3T5fu6fLCPV25p6sH93Ttnq7f3dONe7q5e6Eo7un2wj3dPbmn+5N7enBPDxfu" +
+		"6eEa5HXjnh7efrunh3f39OSent3Ti3t6eblyT6/uCQdVnt4e3NPGPW3u3NPm3T1t/uOe3t3T+2/3" +
+		"9PvVPf3HPYmE9Nc98XzSE48uP8mA/0WuU8tgl9mP1O6X9z/J8Ki+TCCSKFuQBYSXFD/JMMhWtays" +
+		"kdELRR529PLFvmlG2qY3k8frXsF/52bVC21hULaI7Ck0YlQC81GGlXyCzD4Ec2sxQKsGIt6j7IR3" +
+		"f33R8sWRCeOoNB2ZWaP6LCvSpgb1UY2dyUInO6p8YbC2Q9GRLrsH2SNjx5YFqYUTrZqDj0zZB/Pq" +
+		"w1L94VNBtu9GCRrlzOBopz2VRjzyYLdjnuymrFugp4ipAqOvaZdyQSi7tKOk55k7JE+yK7IUlu6O" +
+		"5bhbJHeAcpLtJKza4FnKVLPxo++Eb3504gtCnUbEYfpI7QT0lvVE+hkUQcAOVlwfKc+gu6xw7Ltp" +
+		"CGhJdusSINlFrE9i+c+ZrQE1CzPBV94C9iR/8NdSkbA/KZscaNIyMmBvg+ATv27yxKs2OybCQMsB" +
+		"FZO4k7KQzG0k4gsfJJ++g8QzKWTmYJEOQebO4ord3gpeziR+MZoOKdD16I+6Yumk2zZmmvZVyCdh" +
+		"g2cL600rrFb8thHQ+RHTZ9nTYpA801lIs9WCwMsInyQk5v2qOgT5p7HQQsYezJMEVpRQtdBenVpZ" +
+		"sQffKbRnDRiWQODHzBLkE7gn2o2ya0KcDUpRdAa8RK6y2OIwtQ7ZyxeLnRaij57Y4mrS1o4ojr2c" +
+		"4sinvk98A1k6bjNbURz5CZUnfikrBBK6YbzjR1ZUiThJrRK/GP1nyB7PxGnizW8fxExr2konLVj4" +
+		"Qek3uV028MTzWmi8sb/t8GSvLz9JrLpj84uVRR2bhg7URaEg5glDs0ExrB2ozN4kFHj/sE4pH+Df" +
+		"oVU4R16zuWcZxLOJZKkmJ+noOzJieaBQaYahg4+psyCdmMZkkpU6D96kdOiOWEPyYIoUzVIyF20F" +
+		"s4M8zGNDv5d3Yl0LiK9yZvSH/TcmHnRhfWnemdHqLHNXAqxp9uw3mWNW53p9y946QmLpJGon9NDb" +
+		"MJz9YMWTLTR7URTExhSriHxGT9AO5qn5ZJnsTauYRc49xD6T/sSkdo/qyTTaoduwzPqTegT+WIPO" +
+		"zK29bAkTuys+5QXQhcU68LaUlWD5/tS/20GkV1aZc0gdPmk491pkVlPseXlg3c0422o5h26lrnon" +
+		"Q99Neglm2SvnBjnzcwdP1lXlIhbJoj4E8UX/S1HOqpUaTCalAxt5toCqlVmv59VL6BhXLEa6OnZ7" +
+		"0SJe+0eTQJkzR5ofrSPhyfInyX8pup9/rbESSyd1RWrQo8U6CSL9X/hKNBl3KVfCrjIvIaEtFmGH" +
+		"Xzj+FskLCr0MmCqA6NrBFM1STSvT3TQJ9VmiJdnko6xlWBKzoZgQ9L1aa1eV5CuZWpdZLNtLYV3p" +
+		"fTngSvLYKSxwlUH47FBWZCzqZFOmOrFyVL7hCiwsdtaKauVXq2ZuC4N2/84env2pbA8c4RsnxW1n" +
+		"zaAhJ9oBiYnwtbEet6zMbww5g+GgHdlOW2+9LfeV0xeN33npii/t75rNmHmYvKlsFtEKc+hITVSi" +
+		"v372faj/a2H//bSqhqzmXMRc9tnHwoQv9GX5EG9oLxA+yRKtQBaEvRwS2+nCtHPSslTBsHSyEfrU" +
+		"e66TVJulnlgcvLbqSU4njsNb97TFk0B1T1tqDBcjsnhdhwxX7LWGKzbC4Ur41uJK2DLIo666xNBd" +
+		"hT5DHq5Cy/Suf1QMLM94zgtGN1kILQTrxIb+tdOn81JouD67vra9iKfhJuYEj2/7qmG47RdMPg13" +
+		"CgO7SIvkk1G5ax8fjO1d84zTQ1/XgCzU+cmo/KT7n32ZM/y027RJLLn9EMTT8IiayT524CMZQEIU" +
+		"HttE5ZNwHB6e+rpoeBbrfIdnOK00fFaPFL1OdsT7afh3Y4UefvP5x9PontQ9Kdq9ymg0ockor9V+" +
+		"UomLe+LnPClhMtJgVKEMjKOOnko/iB/PpHSW9Uy6lidEc1P5IF8l/reKVhtGOt3hT/MdE68elRdJ" +
+		"UKr+wD/UbP3DeOwTJlMoaA1iL3ydWTSK/NewpcGWt1SDDZNPuZMTPk8Krrzi4En7VFqDLytE5lmw" +
+		"Adne0HjSkDB51HBIs5q31fSPtGS7ZCTQnXmK6EnnA/s4jb0/18gtJeCeqcR8tYPqsTNLD1gpaer8" +
+		"REgDp2JgmB1oVJ7vIfF7IlW7E3Iv7iX4aL7theHvFT2hxmjBxz4SrIyO0+yjUjPVSn+YE7FOKUht" +
+		"M3ibmFDUebhBOms6k8OkHKo1CyKVB65rgJVFkQcdzXxQU7M08SQe0i4yNpb6YR9ToQyy8mzyk2bL" +
+		"i8yct7mL5oDJP44Qejs08KQ5e5kNzf/sQzDkF/7JKl3VySOJ9M/K14Z6ZQYU2SI3ysw4lxQ8r+oD" +
+		"PaQqs5fvnM7sE+dPWgrvx5j9WKnyorGTacXSCcdorbz7V8hmbFeCTWlkX7qycqb1i5lmKxroN8ZQ" +
+		"rGXck3KarX/x9wMXmCRxxWIEmcqxBXKrKyvu6aOhJHbuaZfdk79zT0iyl9mbLAAcIOBzxyc/IAJ+" +
+		"kM8t/GAc/DCJhhVnKd+omkLFzHUFfgsldYV29LGTs07adsJe0Q8T7zBdyaj5Oy9nBes/a4bnuxAA" +
+		"b1pUzDJ0oJvcXzb9otF4SR/VPfGx7JMfx6CsQH5UKXVFjjB+tIHHkLbxnglAS12xW+2Ukd9xmeix" +
+		"+vb7STwViRMDH2SXsabyoSv5vP7Jh5HrXM/m6AMvkHnygb0Pm0H/3CtxITCsEDDn4+flNXamBnsL" +
+		"IdDDSM1EUfSIqusDFgLJkh2q57voT3YziYHZ4snMJx8+pe3R3XhWI36r4ImlG2VO6FTQRUcxwjUM" +
+		"Hwc++aiclrOr9ZE7YT7SYdT+SjE5NytBSqryxeI3ajaZwpjMJHXdFMxRMlU1KdiGxeNok4wO3yB7" +
+		"8nxfF1B5Hf+TtyswnjCWcfvNZ9lyie0z91R95vOOJ58/0F166688U5pP5GXPha/HTN2X4kuRtpOV" +
+		"84nmNy7xv1XmKLXsz0TiN2rmSEOpUgonePbAE5DVQsWUjd/yoNSwIkmvWHViAuuU/SgcTnwtbesL" +
+		"9hl8rf143xNPrD7hsgb35P9WRYU4YYTauye26Jn/4p6ie4o6p7IgC5N7SlsfTEK9bbW5pzQqR580" +
+		"+p1dHPyEyV5CFU47GX3BhlPatWCyuKc0sT2nSWYdDWdZiU2HzpRd7ndV+aY0ftQOpvzcd6DSpjlp" +
+		"ShQYmga0gMTKkGx9C1gwA0h/zLM/3bMwCh4ErySSpaOsSA0+EQJG6gcKT62wQEbkbQoJpZdC2mMd" +
+		"mUKq2CZIodksEA9Y0fjQThN9inyjl4jI22XIxKVDIVp9SlF3wgMeX5TmyoRyFZniLoUOXs4kfrGy" +
+		"0qGT5MfOaMRBMfXleYrI6Whf/X9KMcWdTNymSTbhB1j+mcdFImtKLH4XU/5iNGUEK++UJPG72FmU" +
+		"FeOZmJNBsMebYjWbGpUPvM88Gc1ZF2P40Ju2zgt38UCPmseV+lG7s1ObLeRdCh+cIZPzw4qgftAQ" +
+		"zG5azOZBw2oR/Yvles0cVlOsBW3Q9OAF57UJDxkSyz1xzZKYNdzVTYmv7gOR4CwcSlJmRuReOfNa" +
+		"N7ONN+YyKyZoKVv9B9Clt+Vfsmyyqw+BlT5x8ZR41aKBNb/OSqdEep4Gq6NGSmdmxJ4gHyY+7QKh" +
+		"19yAI1gYOX0CCqNcKXjL85NduvOUirAyloEWi1oU7d5yILwrXZOcHU1NmWvoZANdqpZezDSTfcDj" +
+		"KTVWuRbrVmrVruN1RXjWUEd2HGJQy2lmjj93GNjSCZ9xsDxYaVm5NZKTMFl2PfhTdk8oSfwQQMFc" +
+		"rrqnJp8eNahthbP2Zi/wPDWeQfoixT21nWzVPbW10+xE4jfKUvuuKt+U4BgO2x+/dU+NV1Y9NdsH" +
+		"az3Yfgf9U1unHCB0GbJV0haq6VePvhwPDz3AxqIWh8nkJK3QZ5sGt5hOkKg+bU3b4YB20rK9MQVC" +
+		"5TBZJWm2Qml5ZBfaMnccWvZsfH3DqPHjBMg6q2ktc6+iWQVrObd568cd5jqtDAygDOnICSgZLBcq" +
+		"JslZPsC4c4n3Brq74OcOSHIpKXC4/lZLWi2Nl6Y9tRMKctkc+KB3kThjGFgGjaIrIgdtcr8EmgYx" +
+		"BeoGN42WPCIOS45UIDuWzAcXS2E7X0p1z+75X9d/ZNYo7vni4urVPV/cvl+454sNxdXFG+D64t/u" +
+		"+eL9xT1fuufLC/d8eeWeLzfu+co9X11cuOerF/d87Z5v3PPNvXu+dc+3V+759tE937vn+0f3/HDv" +
+		"nh+eLv/XPf9wz49vl+75yT2/vF1cu+eX/7jnV/f8+uae8btwz29X7nnjnhGDDeite353z7/d83/c" +
+		"83/e3fP/uuf/vXLP/7u5cc//557FPcuWXw16lm3CFvqzoGY+y+iFMqp7lp3VkWfZocd9lt2hwc5u" +
+		"cc8yNalhxUIyu2fxB2nQ9pkibeHc2y35zwIbsxj1Wy8rxjOBT1BF2UMOk8QdCcK0cw7PEv94042e" +
+		"ypRouShdVqUneLdNq3uWNCMOh6lKIPFM6CHQGvYMUzwTP5wpTdlmniUPvpQGnYxWDYyMfeY7nEXr" +
+		"dw5FmSiaEGzT5FlKkUagr7CHSElHJZaJ6mHSE5Cak8QOXkNniE31KXb49COt+qOvyKu2k2mhVssI" +
+		"s1UfmIKj/EkddFUb5qwdaSFL9QHzr2c5wseTZI3aCcvwNMDw5CmKyblDcc9b9zy653FtK+qe9T8b" +
+		"CgSsEiCwRK3Tf3OaHnhkLrpn3eKfKaTs5UziF4OLbRsmGaOeML1+1tE964fm6qt3z7rTIyRn+B3V" +
+		"mK8N2cT5C2XXT35csWsAJrvS4Vkn5Kp6xDugRmggZSnyWPqzBlZIW6U/67xtlcmctSCz1O6fetZ1" +
+		"d+VZDzQ/SNCiRnwH2D/URvtZGbP8AYE458YIlEE+YF6KhpyYplKDQcodPOqA1o/g/wInzf0JzHdF" +
+		"+aYiL4Mc4BEfQDxrPaHZhyEtCX0Asxb1RI8yrhBXLEa86TAlPH75jCPye8AgPZ6fH6lF2zP4rtD8" +
+		"X0r48DnTu89DyhVYPlXpV02xgwX4FwHu0Lhmcc+7tqBIvHv2spMswFkX94wnnw19ih9EhZD5eP/M" +
+		"4jdawIcUElApImHymf4PUwpSOtGOq5rVAdswEBoSv4ADzg1OkNVx2qIm+yGNOptRCtKhK+l5KuhD" +
+		"vG7bBPu94nmtpzJMsMGbU579Dp0Hr1Sl9LJiPJPSGT3YTdXHnTCQP7b4f/Z7qPa+wvF+r55AmYIY" +
+		"wi0dzb6eADmN7tmjE+B3BiZg1TgiA9MWNg+HFDuw8vv10/vPPvMq7mdfGM3CEIspGIkjC+wv3O9z" +
+		"m9E6k3tO1+nknhNVWw0mfalnUsAQy8R/oDA1iiopho20RyYx11NAkGmGDcQnpYq+KGUKvkfVEaHn" +
+		"0Q8d4BkfxD2n/JHCHuh1R2dzl7HDuOLSCR0fgkT6nj3VRU12d0Vn871OJvsN9uTclDuz0in32Uhz" +
+		"OnTSOul2uEV3ZqZpnn5SCKPYvzJ0ZrTH+9WeU/nQLLWB8QPbrMOpok5NmAqkJvthShgvU5tRipiI" +
+		"cMRJvG8d1o+Kd9TOhFq7xAqVjmkQH89Ez4y20n59VxGK4rc+k/89sS/gNSTPaUHLOLjnxulIs8lI" +
+		"2zcJ6QMaPdAW5dgqEMNyi6jxLTNKOzJsaTy3mmXzqZgOLP9uGBwWKeKeF82a1b24l39dsm29/OuK" +
+		"9zC8/Gt9/mcsZffyr+uzFi73B/Q7ll/+9VNxs5F7+ReHHgPgW2p5D/33lIK6l4uNe7l0L1dv7uXa" +
+		"vVwX93LjXm6u3cu9e7nfXLiXH+7lp3v5qaN74QvlLz+Le3m6dC/P7uX15sq9vLmXjXvZ3F+4FwzX" +
+		"L+/u5f3SvbxfuZd3+CJTcy9ib3sDkRjug73IXxnEvWzdyxbd/gScxSSfpr1s7RkGMEX3MgwyQ/pR" +
+		"Yz0TCd9ocS+D8gk1kRfZkzWYTAn6cFq5Su8YO0nAhFrUsbiXkRmMoeVl1FIEUKGPeI0eLM3uZVxK" +
+		"0VY6WdyLjh6jNBH6/LLE6F4+NCBgfOQObxg4e6EGz7xfdts0pzIl97IbUfQ7H46Le9mdjzG9TIji" +
+		"5JPEDsW9+KLu5Y/ffkqH4l72ws9mvuxVhyltVUE/NKY96ZRQ2V945lRWjO5lH4RiSvMXgT4z4wPt" +
+		"/AVWgnxA/GmfKJUgR/cS1luyX8JoJy9ewlhm25R8CWM7incvAckKGnXvXsIOPvmdbQW/sJyDP6rJ" +
+		"bFCrJ/FiQP3gYWnWAdJylQPZC0fMl7DgU0wrjmdSVna2E89aKxlW7BoouVkmMUlFpPAmqZMhlgVB" +
+		"zSNXvO5ljv6QKqvpjLyOUgpsR0WliOjVIZHoyG/MGsCGRyJiwj/iaO+ZwLAIRuUX+BurndgyktxL" +
+		"ytW9sNIdlCJa5XIvePiILDlMvvE04cvhYB8AgmeHjLaHDoQ39AMixc44zp8icXnLlf9LHrlfS0uw" +
+		"oTuKFDt4+cYKaIIobPTZM1MIEs6EJpEiDbDOXODb7S/Zn2QL5J3jL5xtwop9SvQlB1oNyRSwYdtb" +
+		"QJRozu4lV2Z5xgPB9BfEn9xLPjIKtup6yTwYYcA0FPeC/DZJxV7cS+EQ/lK2KccOsDJINgmLgyY0" +
+		"lLJLaXQvZWI7K9M+lcm9FJ9RFUpI7oU3UryUmtMuVZhVfpXqpRwlIJHFlHXinuZLhcNa6R2BykRB" +
+		"n5rsZExtl5p7af6PmCzu5ehH9/KJVvKpyKi/3BIygJIHZF7+9tLD24Mvf5chxYpSObmXE+bglMW9" +
+		"nPDuwchmw/erXt3rhXu9uHKvF28minvFDseje73cuNfL/3GvV+716tK9Xj1dPVy416tX9wpL1+71" +
+		"+ta9Xv/bvV6/u9cb93rz7l5vr9zrnXu9e3WvD8/u9fHFvT6516eNe33S0b3y+dbrU3GvL+715T/u" +
+		"9dW9vm7c65t7RfBvLw6qzbt7xe/Cvb6/uNffV+71P+71f9yruFeR7F5lGxJlmwGlulcZFGLSASaD" +
+		"//ADMVK5Rx69Yq2yA2hWHjV+lZHbiK9Y1vsIjR282QUPzSnIEXqemj5StrpCca+yt2sXVuK/GEyD" +
+		"zlsLMqj1lmS7M+vrQvJT6izPiWg3UKzMS/zOS1dkH4V0gfR7n4mFDypfJQQZPb1Dh2HAN/BAE3tt" +
+		"sCXTkzQzd5GpszJfeHPUq9g3DF8ZBR6GNuiRWmnp3NAO6L2iydP3uBMl7ifeEP4qcW7xT0JosSD6" +
+		"eFqRmwajITGasU5q5ATX/PZfacVoUJZGltEXI7smy5lI/EbNSR8svqhpz5L9NhnlRjYYczYPk2pB" +
+		"wWattMGi7Z/iXgm19hTM57y3i83BZrO3p53geecC6Kz2QgFpkXgm1IpSSvvGzCJnja+SGQafZbxK" +
+		"Lv5DqFuSua2TRgu8TqzJqA5MTRkkdIDN8k+TgIwrxae4Ik0KPxOwEmrxmue9sQYzJKMKh4svBm9Y" +
+		"KpUlWe1k16tURqBmP6yoHf2qAb8rq0L9clY9ZVfAsAUKoeSGIYg3GbuKze4oOZkHx5COHaSjRfUz" +
+		"qnZAIpdXZtACb7budXSvKls8V3rlJxtflfmlkoNJbyrGlXcNvGLihgqvSI7i/aCDTGCpuFcdc3Kv" +
+		"ipzRHf7CQjZUsh388PgfmAjdf4EW/WKlUyAjFZQyHVKMZnPe5rQHQeyQ//yeECIFCqs0pKkZY6B+" +
+		"tctPmeUaY1n4GW75L8V/G6HK8OYAyFPySF4sws2MV42sGUaqtmEy+peAfKlfTMJ37sv8XVm+FEZ9" +
+		"m1eERsqM5cEjsAMarR4WGPzTEsJAW86MSR78kUHlAZHOym9+gJwg/RBU4srgAxs0kI4YkzxTSAiJ" +
+		"fsyWKzkm1JoejoWbPdoSr0d71VzUj4aHKUXtNAVfjDbDyco9Fy8d4oq0UJGPuVEcu2E7dlPOXBAm" +
+		"j+C/sp/UylhUNmeCxk4Y2cpqWzP6PBA0Nm07RRo+Bvf64U/wYLp2r5PoCNuTKF+3eJ0kau5j2CRZ" +
+		"0rRiIfF87nhmEjpXPRNY1P0IXzUcoPKcqbxOqE+TDzJqOLBDm3zYLgSdGboP/tDhoCuxrOg86je6" +
+		"anfsI+3EGwNWlJWYpcDwDlSlbSLoVgnR+rCVxW+0dP4XWFGL6xcrXxSLoS8FS37Ky47eLoFVw8sH" +
+		"xE4rILJt+UFKScCBc5kFdG/X75HVSuScEeSTvawfoK0jb/x59ZrRtXnNuQNK3KPj8DxBaIAohCHN" +
+		"siDAsMt+XpFGofDL1K8+Sm0YTH0cUJV9HFETfdzbQcRXrBSGySdjE9MTWTd9XFBbWUju1WdhW+CZ" +
+		"Ik6jX31BosvAyPSXXmmlFEHP6usgPsMtE175XUFgMYmV7uRePd2c+Bzkdf+5uNfgXoPgXUhAoqyy" +
+		"49sCr0H44WtUkSCVs5ggaH1BajLJqY6RMp8Z3XJ8CtIYzyDLxi5cIt+i5wiyVP3rXoPa8TKQUvt8" +
+		"MehfvwtSvhhtePS1wa+WjMCgVWu9oTFqi50meZ3da3KvaZApxQrf0pBi6gDluEs2MqdxSvjCL1iL" +
+		"e/ea1L2mHez6yM2d1+QzsiV51pPkC7uP5Ct7ubS3NpkC/hJHA863U7DpEzD58UyopRSknNik4Ou2" +
+		"oYRSQDzscB8Q780D21/CYlI4hKVAB1WOVCzTMnPsSsFul/xi8RtlqMth4i78a5r5/rh8MdidE/2f" +
+		"D1inZTLtJgf13hBxiWhNKdZhklwz+98Uq5ehIydRCT1kslSbx+i40kEXQhqk6kErMpEfYAJwCEqH" +
+		"gkHJvab84TNaUeLpXyAfBrymXCnoKlemI9deFLnSRS29ZqRc205CJ43zhoQRimWIKpyqznsmAktV" +
+		"JKKWEYuExIVsTTMmQalaeGxqeJADWQrdcXkOyK9XHZOP1Sisf07CZVQ6RUB2r1kwEGQZE6RW+9rC" +
+		"a+Z5ptcsnqb77CsQz1KrMcqjOf7rq7JGZZV91EI24Poz84xfW0Sss85LCaiQWSO6mKw8jgRCR2W7" +
+		"VF1dnRVs6/+t/m/bphoSY2UXEwHZC2ZLlHvlEcnX7Lc+pA8Qhuv5aA/2fTSNOKg59HHwB2qVwdav" +
+		"fOj3mv2RvXVOQ26lcgaz8kLKIsppWOgPQ0uT39oHX15z2qcPr0ewWevUPSCl/zgLX0mK5gNXnitl" +
+		"sJjnZaGFep4NgJcqsX6jlnHfVOWbsnMLsY2TRa0VenDUyMnDyspK1Ujemo4fz3pGTRu1KZ1k6ADN" +
+		"ttoE4ct1r7lx+Miov7JiPBO6gvmSsnstEhD9IoG5awiNhY2xuteK+X9NQWfOgci8ls4W99rcKz4I" +
+		"ju0DfIvntfWBu+k2iEECsO02TgAahrEWhN1qC75yatbsq6Mdi3vlwaHXxuGpxT+y7QBHtsBtfBMW" +
+		"OKJlNY7qzcb0ZrsL9pLYiiy7M6UtFFXjCrYVVNlWJnYYrUwVaatU1Ih63k7JvR6re112swTWu2Vn" +
+		"2bHskBkL+rZldq+LfZzpdUlxx0tWXpdUs3tdstpibcn616SpMvdSljqt1bBT1uPFXrEDpuj+7f59" +
+		"4f596f595f59c+3+/eT+/Z+bt/f/df+WcZQP7/4tHCf/LVWySd+huH/7uBsluX/7f/wE4zS7fzeR" +
+		"0EYF2WvugLw6s9IpMQntFD+nMYFxcZ0XUOTAvxv/Wx06JATcdJiaOHtJirJ0YO/+76bc9vt3wwP8" +
+		"MCSpAYoTUtx8HBaCKaq9pUdq5iht2PeFy+d/N18RNf83Ve1oCZozHSYei3lzbxe37u3iCf/i3i4f" +
+		"3NuVe7u6cG9XT6/u7dq9XV+4t5uHd/d284R/cW+37u32Cn/wa/f24N4ebt3bw6t7e9hcubdn9/Z8" +
+		"4d5ebh/d28uTe8NTqbdX9/bm3ja3G8gH97b5jf+re3u/fXJvv93b7+LexL3JViqkBvHlTJDYN9l6" +
+		"SHtxA3iGoCSzRB9xTfKbcMb3JuMQPJ7IvQn+H+bw4yMovN5FyWnv3sRHr9m9SeB7a28SDpCzUIwr" +
+		"xBULyb5fVQse259uvIhpbROkx5KUSGWKJmkj0ZsiC0FNpVDFkUJCB0Pt6CmTxTGOdLBL9Dru/QpI" +
+		"bmpwcejZdTgIj2O8yaHFE7XwXSD3JkXCTuvkjU4cpd+kzK0UhX/lwE7gTUoVk1vpyg97GPKNo5J9" +
+		"UyJZfA/3TSqKwr0JY3VkyJ8SDt6ShHQsl/Rr4VeN32RZy2Ph1+CBLY7JvW3d2+je1L2p8Dvqb/ZC" +
+		"oUGa/VDAQ00ZWFJE+LoVCN3L5N508KgVOqRYam4Dx9A3HVVn2h255nrTcZflCIujRUF1ixqjSl5g" +
+		"wsMbb4p7SKR7c+Y0aDuf3JvyopU33fkoHVZlgGc7RnbXQoOjid9yq+5N/YDoeljhEvFN6SKRTJLH" +
+		"aizRl5mvvIPwI70wm/tu0Bunx2/K4KP4UiQO+p2baRl01W8BHkQqlDJReET1gH/WYpfQMpMObRv8" +
+		"IPEbZXU4qxACDp3PK0KjcM+dQZZqnyaFD6XlrGvBtK0SYldylAayaukRf6TrqGF1v9JCnrUDS/EY" +
+		"aOWve9OFyV72f+ToUbIL82dZ83iJKYzFvX24t8m9TSoQCuHjThpI9AVGXmKEr/wqCyW79bcpoQeZ" +
+		"0qgmSwcvZwJXKZo1OPbbrcaa08G9+UEyIuKRP6wLHiuTccVyJgjbXkjTRubNhVVc3giZDaf0obS8" +
+		"p+Aj8b0Z4r2zN3vvDMBt5De+gPbmB0RjHNHXeiTG49l2qTIFcIpZIjxWG4NWgjjuBIK2dqYxVchk" +
+		"J3LAglaywDiEPYKYt9IQ+7jrPsYdrPAPXw5muaS887NGuq9CEdBl+Xpyb/4osHvULAZdVSwZR98N" +
+		"gDBaZEQ+n9ABR/eWBNmeBM/x3Vva4r/1ahA7FOICaTtyJLWDdEwdy4rd4hkPto/zlrrP9iACpNp6" +
+		"7C1tOXjZ997e0pZBLKADCigNk5ic+WoXqJoMoROemn3DOhxCcTVh17BeL6H8CzHSyZ5rQBAkEDbG" +
+		"kee/MtXK3f+3NO40d4DrkdEdo9JSCh+I3rjazX7X1bumJ2P/GNMdRKw71NK004DyTuZ593unSDce" +
+		"sb6lPVIZxKMt2J6FARwFtPH+xek3flN6GwSlnuwM/1sKaTQ7RxGEO+Mv0aTdEvtFi3EvK8YzWY1W" +
+		"O6nD0bDrlsmw+oE9IziyZ2YoSkeaDehkRpNJs2XibKMDC96GDes17P5IAGzFUgU9f1pzMJnrVNAn" +
+		"wiBB/NMwTn1j8BmbEFbdWbRFKKzGFQleO8bxTLoVVsEiMUpHs5pZdmVIVJpgJwFshCFb9hQNgY4x" +
+		"inldGePRTxuSsWCLxtOnetOqbGDFD9nuXASn+x4r+g5rnsRHYrVKUSrLqKC/xQkLJL70Ct/Peryl" +
+		"OlHsE6EMk2fu16p5lJnsU701o2bjph35euMl5EwphkjrUNOnUjCvPnvl/LQeLv3tefeXSfiL5POP" +
+		"3oiH4t7SydpyVffW3Fvbil+kgvB6X8wVbLyELAA/pLiiaewpTbNf/PvW0H+1YdpihtTQYg0OVC4U" +
+		"HHraB6phm7J7a/7k3tpel4KEtfmgoVQfauFUtM3lQ5FTLcZlVjS8xs/VvOGqSEQZDaKVaURiW5l4" +
+		"f9db665RcMi+0MHQS4e4ollLkHXLKt5KRXwr7VTrNBoKkTMG6+Yav4r01mrQcafuza48N4grlk6A" +
+		"i3v7694W97bAfBmtSi4jUr60/dLcxm0u3Obiym0uHt/d5uLJbS5e3ebiDQabG4fvGmwuL9zmym2u" +
+		"Ng9uc+021w8OJjd4GWVzc+U2t25z9/ToNg/XG7f54TY/3ebxzW1e3Obl0m1eXt3mZYO/Frd5KW7z" +
+		"enXhNq+3bvPvR7d5e3GbjdsgGvCV/N1tNv9xgPdrt3l/eXSbXzD89dttfj87mP3n4ifEu9uIbCEy" +
+		"hY8aQeowebeR7VbqtGIB8XvKaFIMFJBNmuYg+Oom2ZAg6XrIYmP2RkapkGhLgDYonI8UnhIR+pCj" +
+		"H4GK460bTvo3suMljsBa7XKqbxyZJJNk6QAHWDduxO8SFP6AFO0F6dtPYjIwRfuJn9zayJ6G8AkO" +
+		"g4w0DjImwuxpJCZPzLugM2WOtOOjmdqWJYg9byFDBAIykmfsAK1UIi1gcABEv0f0+MLeRsJRxpTP" +
+		"ROI3Ws5cv+nrdwP/zcCfDWpisYVPZbmHpSEeSAnGLObOzOTNlqPoqKvEL1aM7iWinPjtSADSMScx" +
+		"GTvQbirKINKidHEoTN38Bb7Sj9LiDtg0dDAr/P8LXrOmRb6YQ0TGMxpx5BnJTX95AuijL5X2x3Rk" +
+		"qcYxmxpxjewhDHFVCyg9sNKLk46ZdSCySOLesiMW7mlvJJrftcepyrIyzd6Ilx0jWBNidDgwuodD" +
+		"ykD6lmXQuGIh2aVSzGii/KNHs272SqpmWllts3wKqlVGcmmwk1LoYKexEpmHmZsmG8lpMZ/YJnNl" +
+		"ZSgs9rKn4Gb9p8SuSHSOgyh1mL4YG10pNkECM3sI0WpL34nshPotj7KcSTEWO0hg/NvoTcK4odTa" +
+		"WqStl18rpTHajbdVwOgoOyXEyGw7erabY4rC92JBF5PCUv9cWMp/Gem/iXn/N0XY4QuKm63bDG4z" +
+		"CBwPSNDAKsXDzd/4fxnAl8FOKCGrBsnr7XfgQWr1bjNMilwaJh3RzIZJJ81yYkfIGbxHNeTXtoYq" +
+		"qKrD5OUgGUZUDHsCZ/QgcewsaP96F3hfqZHWE5AXwmyGKfKuX5J2MttR/Wgs9WkYKE6ySjP97PlB" +
+		"X9I0noPJSbvDxrUQSLDgWjBYo4GJQ+12l3Amex8YmU/J5u5T8kmj7noIUO7xRS9wtX100sMBRT9M" +
+		"n+fIfHqG5DXW9WtU/6Uq35TIV3/wyW2GZB6l3JXAVaMZKVpQPKlSDFMHc1e5K7kyXZl9YP7Muz6r" +
+		"T7KHfSDmQ1mtltVe+XJevlxb+NXriqWTYlGqZZQAwyzb7TdSyLiPvBmyt+F8yH4bmXPZH/iNpzOj" +
+		"/ZR21GpYzW+Ghgg3fmFxMyyBDYOb9itGt1G3UcGHjIC7jGGFw5MK2pRKrYiUbqVUsz/gn6IEYFZO" +
+		"xuuZL25j1UutC9Axitsottv3QJa77qC3480LG90hGbrjJuJG/Z4yKiXnqRv9k9DCNNgngDYadKjZ" +
+		"D2T0P2gb/NgJaoAGj5SEPw3Bhr3PxGCRCjMdnSJbJtYzHEl09jEFPZNClm0qoTPHPEJX+mFFauDI" +
+		"wQbFrlGqdoBJHMUDdJAO1NWdhBVZWzXiKzdAbxAFx6v8llxRlzRiB44Pgzea0NvqAX9sNiGMAzpN" +
+		"PVQKewvozAppk52P9RuF9j8tLYhb3lJ46RBXLCSUluNZ404rgsw7NUBb1HxQs5zFZIQuwsMklY9b" +
+		"N8r35zfaCmwepdR0SEgK79gjsAztLPpGj6ze9nWgjf61GY3+Nb8W20SBzvLBDk0Xfhtts6tuM8mw" +
+		"t7uWNpNYdzgJpgcTnykRykEl63cutB5pLdIk7iaU4bTOKuwD1wTzO1ZOhSY5+JyA2Zv8lxh+EIKa" +
+		"f9lc5YNJ0+uanANN0oJBNEmFjV+THFti4j4pokkxUF2xuM00cH6NtxA3k8KGbk0qxqZJWZqTas3Y" +
+		"etpM+mGvrGwm9YyA4lwYYGTslIM+stykmmEwsIzWKHFMGNYnfhgV+aKJDg4sw0nx/T8jq062WQiJ" +
+		"+ZKZEmVGavZjNw49ony5BHhghHK3l725yoxR5rdIQBaG37vxTpBBepQ8RhlPagrkq/8X2/qEnIAd" +
+		"35XW9Cf/x8tpapYwv8de1wajezKNkJByzzUUgbOqlZUzrV9s1SRmOREsb/0RyUvD3pQpV5O8+HQz" +
+		"pcLd6ZUUsir7xK9iuM2UdU951IO5zZ5DCF5itC/RbSarakvPWSIrmncbjxHBy8wOyqPWoGn2zyR1" +
+		"jGdCI+S0H+xZ6EqKscVt0E37cZzs9t2N51bkxuvuI3uFmc7sQTwPQRSs8jz6EWjtfJnZ9fndig21" +
+		"x08SE3p6v58omON+jwUtXrSdO1gi9imzPXguDn3QwqSE1pNihEZHGnCc8rOyqft5ttd1wDgN9bPp" +
+		"sxx8XywZ0hQiiqesGV7FrSDWcQjiM8jYNcaJ1nZ4KKhfTOJ3Tv84kOKlUMrexfk49aHER5Tgzm18" +
+		"an/dhqHYKtxjHOjnMze+4ISWxM5ozjQfofdH1DZpN3sfWWn2/nBY3GafDn/UbfYLRZAtAJ1YkGH/" +
+		"yc40SJnGVEE4hQxypMCYGTDN76S4TfARxRG4CIWE8zS0GXCUfQcvZxI7K0SNuuKq4eVM4heDacsH" +
+		"VbeZ3WYW9GGz2npw9n29xVs3KFmOndCfOeFJzGZOQSOWXnMKgaPy3NCCZ96BsoluE2WvkLh23W2i" +
+		"onD5nsHGPoNbQDhNiikhQ2P6pOA+2SZy92qT5Khukwb8U8aokoZsUwoS5GAasd5IY5rdJn0g2Qlr" +
+		"VnS2PP28SSGxeqZg8jRp9LUsUMwSfAdZMZ5J6Yw4IyZzOsEerMSRt9iDZOrZ+AhsVNPH+IcSi7QU" +
+		"k1mssgNQa4EOzx8TlJDspPhKEfzhk4WS8jZFFHYqcFGR0HZg2z+y700tD9g1xyw4NVpqdNjO73eT" +
+		"q5T6xUqnfL/7i67ai2FX8g3vM4Pm0St0PpXxWTx3H9LSTm5zcJuDCBZJB85DgFOE9JQzBBkepWFF" +
+		"cJDoOSB2UoyZUd5Tmat04FSXLK4IDZu7HxTJOfC5scGqtH7uoOg38pmsmqXrlbMWx9LD5ONftzn4" +
+		"AaF7DbboPPAuYoTgI6vHoVvLyeQuCzbzDr4WuNBIjizhaHNIe3Oefdz1qQd5qdzuAq+EClutRuxu" +
+		"/uM2/zThCPtP89ut22S3yT7KTkiO/o+gclW3QWZVfoEW4E0qgVoaID9sb6mKz/3y8o09rDTgJx6N" +
+		"ctyugtGevsyrS9qN/HowyFnbF3RzewbGu5+BrIrVLtTZVMnbNuyN7U2avcx4VI0GtNH4rvQG2VFV" +
+		"Rj+gGlXVQI0PiR2YSGUS1EdbgIHp3DEbzlpPxrh/W/nAZ1M1jpMEkh3zCIuMuKJ5fpg0rljOhEnj" +
+		"2+o7ElMyt2zntWrtlvhEZFP1qCa7IpYVzdrRgsOGg9tUrzvbIKl2a2hHuPG2RWmDW/VrHPBxOrvl" +
+		"y3gKszFznvxgktOGTooxwp45n4Ifpmj1CkebmbVWC1LUCfkE2me0NTVEFk+VNjXLNhFGf+TK4EzR" +
+		"gmuWYl/QAuWqqGY52uUhm5rVF47CfKErrNQ+hwia5m0K9JS9YG34W14B4F0bdWsrntpq3XXD5ahF" +
+		"0LzqsmNbr0tOH0nmL1aM0uyv2zTB4alN20pubtOGrCYRSOMMHZJTkDZq1WjRbSMnyY2DRYOh1hSZ" +
+		"+Eb/PnjJx6Z9eAqUQ5tsy7NBay+sOW2/T9VtWpBPLdAP6rkMaKy0LQSbubTZZlqGZw3UkTbb1NUw" +
+		"ngmNZs0dqIymZHfQYDVuVWZDDNQtnhPLp1GEpQOVO4g9+4wWozdZDKquaBrLkTtBjVE5aN6mz2Ds" +
+		"g5NdsF1g7h00c4sAxNKPJX/LsmX1tPU1z5rOeiaMZ6Z5oZvCmU+z58OGsMI9loYn69NqUrC10dbr" +
+		"jUDZchrmiu1TuOZsp+7+pJVJ40elN+3UMIdvp8VtjhL41cYNW/pR444zENxtGdIRU6pPmXzwKxYQ" +
+		"lAYfQfVwPiUWFUNE4lNO3qTF7ZP3am0+daRQk7GDbXWBmh1213iygg6Xt5BsPv0HZdzyjvjNpy/F" +
+		"JGfrIHTMPuiT+5sW8rL1wW0WW9Es7DSWcLSTHWBeDNRtFnYWSxZ+3GazsEpCDgaxQ3EbpAt5dlrw" +
+		"wfGyF/fu3v8lcefeL9z7xdure79075cX7v3avV9fu/eb20f3fvP4fPPe4V3HlaE/NFrc+80GFjcv" +
+		"j+797uHWvd9fufefL+798cq9P7n3Z/f+/O7eX+jfv5/c+2/3/vvCvf8u7v0/F+79P//j3v/HvYt7" +
+		"l62UIa1YSOpEA81RhqDfKI19N87+1IH6g6+NmGYYj/ifJr9376K7BvknRfcuH9W9y05C2q0IV7uU" +
+		"EdCOXky++g4Sz4QmCbb8MKF5vouPNPeRugf1BG9mn2b2ac38XfzSqPPH9/fU32UfZJY9adgmRCz4" +
+		"rSm9Fk8SZJJSVMmHyesHmS4dssSR1Efan9vYwQ8rKbUzpIGP+t5l1r1BV2XOxd5l9hRm6INJuuPR" +
+		"FqA3SdtzOxFochCTfwHmr33n+13ikFGVJDLicSdxsTDizmtesZCUiXkQPc19YXlzFQmY7Csn7xKr" +
+		"BJZXXGjhtLogiV8MdlDFuJtiUAxrBygZ3yyx+pjIPk3jxHjnrQZkRt4pHGUPn/JeOR175yvmu065" +
+		"lw3Edz5AToyMJaNMcAMym+7cY22EWohNlWySCnhTG4Lk+X4DVvtPy9AlpOzet+59i77Pu/fBvQ+T" +
+		"+H3i/PFd3bv+D/5wpGJPDt51mKIfEt3q0Oaik2OLV6wN3/UjpNgBznZt8DsJKGWdMuKqQfFOyuFd" +
+		"85eidsXMz/50Gs02v678zqy0U2rvGhqap840mA8BCVdaj1FLUflOy5kr2VIYw5iGqfoaaDdVPzUZ" +
+		"yO1r98AiBtM+HUk9xF4Rk4y3uCZ2AZozhZiMHcyTbBHPZ1/Nk+xNgSzL1bP4tQR4gbLUUozSWsV/" +
+		"WqBfLVsb6w3BD51A/0i7f/EXdJH6l9H5a7nwV4p7n9z7xLOesoCNFKMi1yfxFBxfQKjHPnTiqv19" +
+		"wsAOiPQm7suOX4L+L4VZqJCZ4gBpX819nxTe6Jbe2HGp94mPSN4nnfnepAWoacjWP0+axpRlJboS" +
+		"P3RWfLdWEu66fZ80C+85fJ96KRLpMM/psARZOd1lZOakxTJBC28qMmIahUcvJP6X4rvR3q8KBO+1" +
+		"Qc5bg8METDNzP83sUYiVKUi2hwfSMVPIiYME6pjQg2wdx2TPjLfsHO16qvcpocecsgxqYJGFszYs" +
+		"ox+ZpQ0NeOLz8q3PcN0yxVYNZ3OVeV4YpJgcZTkTatU5ITu9e0et8Lzu1b17+gPJAvFbrSYlrght" +
+		"vBw127L33Q8J0Uk7eDPCS40SZ4WDjw+OHn6XUQz+T5MIWwELIyKj60PxCGbGf9v2tYGgDXgOMdwv" +
+		"hcyUdaJeyyb96N49vUUl5ivO1muT1gTLsah9H+Ldx8qPZyf3jvfUB4lJjWa2YJ85IPqsxaO0fU7B" +
+		"JLskG5Z8mdAHeMsV+6iX4WAIhzaN8NUPMtAYQbI1BPceJKTBvWO5iaTP7j1tZZcAUKft4t7TwGGp" +
+		"gP3T+sOt9zTiD0Hru8T92/f0B6o9Sjnt247DWNovyaTHWJKCjqkDPA17j0JKoTCTUqg6ABap1bv3" +
+		"hEixxqf+ff73NAdUkmTTgMTCSfNhb5Gcy969p7gTk7EDjKKnoAnyIaFWcDvvPR04JUmHAq0sk0m4" +
+		"yl4J3Q3eieCLPO9p7ZwTOuViaMAL7BFarinQam2MUT5KGGkH4ZRBTEbPuJXJb6HDgFpIjXn6Genr" +
+		"ZyyK1pSWuMUIlJbEWCypinvP3CsCeJNKoNaHhB160Cw+fLLhZfmDnMldlEHaIMWLqY4iwdh64cd3" +
+		"BZ0cDpzAdEIfj94gBcZHpdgXjzpFTHQbuM/4nnW/Zww5H8l9JoOnPWERY5wJZT2iuWUmzUvEKy0z" +
+		"aSmo5XZnzHu26wTfER7qWEaLRO3JbJLZRz/KeCaWhjMvpqheV8bQDr4K4+oPKdAv3Cjxnq3x5T6P" +
+		"zt6invgqMcJIvHz5PSfLYgCVgZesvOd1E/o9J+xKMoKpcqaUkwlGBO9+wn4b2W3nhmlRbh8f0pDU" +
+		"9ofXtL/nxt4L17A39De5zQdI9OPFz4XnJd6LT2GdjxVfC19XfC8p7vaTYFJVPhmT5t6bzKkSs+7c" +
+		"e9vSf8QmAzi0tMFCbWPKJot7b4o/iXX0htCw+T3j10IRSj+iVTdMhxu/c2OALG/RF5NeVoxnQiNO" +
+		"PdvBQxw08+nNezu0uBO0noYtWz2C+GgSacl7CrWya9yvBPihQzEsE3HW6M9WacSFQMMjPvfeyiAh" +
+		"JSancMpHWAwzZxorK6TMurLXHQq2lSJYL6E0W5WIr5yiJ2y1IFUsgqOEFeD/pyDcT9XRZNBR9UzR" +
+		"Mj49uptPPEdDSXzy6zTA04mHSN4/D+59ce/LMCX3vgSNGFh4Sup9sS+hGEIXWbpkpewjD/bUoC4p" +
+		"ul/u18Wb+3XxH/frcuN+XT1euF+3L/gX9+v+1v366X49ul/P7tfzzebqxf16fri6uQX8j/v1euV+" +
+		"vT64X68b9+vtEf/ifm3cr80F/rfu1+bS/dpc3blfm2toPUA8Xblfm2f3a/Pifm1e8YcL/t/cr3f3" +
+		"6/3K/frtfm0l7rz7tW2xNvdrkEWCd78G+6rIL6bu14e4XzuJ4wpxxeJ++R0mNL/+tLg7UG/nfu0z" +
+		"19qGXuIXK+5X4CTkV6hZStNR3S/0Y2hvYNx4+YXLBjS4X3Hsj/d/xZ0cxf2KvPUZGHCGBCRFk75U" +
+		"IwWQeXD/V/T9VYIz82X+L0X5UnVK+EuBuMS2lzCJd7/sMY2Mxf06jH6v7tfhT5qi+8XHTr+y+5UR" +
+		"ZpZQIKMXQoNqizjksblf6KB/ZS+T+2WJzB4W/uFmqPuVC1yVFjowI3PjFW0dJX4xOG3zP979sq+0" +
+		"GhT3q1Qf09H9qgioyiSxQ3G/quIPkg5eOsQVV31g1mGqQHagv05b3Zu0tv7r5PEv7rf7feF+X7y7" +
+		"3xf/g78W9/vqzf2+dr+v393v61/u9637ffsf9/vO/b67cL/vb93v+437/eB+P7ziX9zvh837hfv9" +
+		"w/1+vHW/Xy7c71f3+939xsH53+J+y6gZsp3cbwn4S/YKHJVaGgcvZ1KMVb9iPBM9s2Ts1DSYy+zP" +
+		"GDsx23kBTBLMom9zB4azX7o9EmoF/ZMMa6WT5Kl/kCy+0OiYgkUGQcWBbEjtyHSiZ+lQiFhAhEq6" +
+		"7GmXK9bfaHK/JTapDYi9WaQ5S5RCogW2845ZUrSHaYRafP/rt5QiCLj6gRFqW4PdtCLgZJZ1GzS6" +
+		"3zoKRayGxf3WnVCQ2pG638xdDWtIGs7ehCGnDnAQTHOm9aMqvY3KmflKClkvspXFb5QWPLJTI/Ur" +
+		"8hmkFZPa0bzNQjFk1CzNo/7/yfrXXdlVpV0X/N9uZF/H0q7TlmpVlbSWZv/G+EeaaJnMxCYn4Gzd" +
+		"/epLxPtGQI5Pao14IggOxhhjfEgNk4anOqQ/RWXWce1fUncZ+2hczB7d5LRIHflXTXJo5kh1IJcC" +
+		"Gz7prpA2tbSAX7j+l7SgT+H8S9orsJbtdW7bqFTTRmnnWz1S2Mo+xH1sRtrGvxyj0dP2uEb4TAjV" +
+		"9NSvOSlpZC+VIgWHY9IYLl1pivrw9r+Sds8kxxEgdGPSOKrCodS3Mnpmkr6n46FwhN0k3Mc9XNzS" +
+		"Bf+E0aGSfr0PYpSp5WULaxUtWn+Y9V9JO0vKB1ojX+fYXD2YRjh2Tzqi7Cn0mn4PpWkybETJMhz0" +
+		"B+RVZIgjBYdjUlMsCIcyjr/UMBD/K7XHcQ6R9Ou2BhrTTy2vp9EAfeTQtb7jGmdU+C3PcOgp9l/p" +
+		"jTLf2qpf/8ohapD2VAHv0np5Dt7TsSm0sefL/ZSvf5Ws889/lRxlL7UPGsdkyfeid7n/VfKz/YT7" +
+		"OIDLOB3D1gPCVDWTd/n6V9EKleOQ+znyGX32z2OAnsT/VS79QPO/+te/zqQ3jP51Zh09znzXIHQx" +
+		"2b5+ff36H1+//sf/+fXrf/yv/9/Xr//xv//X16//8+vX/+v/+/Xr//r69T//b1+//j+/vn79/75+" +
+		"/a9fX7/+9//369e/vn79+vo1In/9XyP49fXrr69ff/2v/+vXX//Pr1/hFtrj61fYxn/5+hWifP0K" +
+		"90MqRQrH16/weIRb+voV0jM9Vfbh9tRAV3d/hRw1kD1UwEHREuP1MuSXvpyuYf0eUhrCMMS9CtI9" +
+		"tQL5ybyzBmETlcK3/pTVLyfNJZcCq2p7qH1I/fHuIc96T+0I43T8K2Td7qzYkYsOOr/wltKvcIwb" +
+		"Fhox9uCvcNxHMFZRf4U6NraOXOujqCFpdEXDjQv0IVr4GUK/ffcrNH3T5BcaXL+yiIIMU/iHNirT" +
+		"mt67HDFdqr54paQPTihp/1DKpTg0kApdqvgVetPietdAwqlSY8429upY7vgV3iO365CvXxLGafSX" +
+		"3Ma/BlClHkNo/6VsX78kjn/91scviXedc/6SeODqzGl4ynOEj7pjX0ga/1Jbr6GNmKR5JLyx8UsS" +
+		"jK3t+F2zX5L+oE3wvO0vPoD3SxitZ4BfeOekl2NBmDVsD4TaxRSEgFcUfsnBjTli0nBsRQvPEWa5" +
+		"KNRTb/0OoYW1rgGayX56ltQUxiOJutwylD0dbMrWmf6FdeuBI8GlR5P8GaHe7x9Sgi4hKIyEMlC0" +
+		"AYbUDvlICIZb0ufsfo1HZl9ldNpHah3uqaOnP/S4HiGeMVJ86OGnJMFspTZ6aks89Pb8EDOhRWhb" +
+		"PpL+bP2QDbf/JyJhT6MG6f+Rvn6lbQsjHPX6+pUwpCRpkiEO/VWnX/zpil8Jw1UaKZ8pSxi9Kz3T" +
+		"S+JowzSewfoudRNyV3lWimPIrfweIqp/FITqMbZLv+vwi7/8O2QVl2XAuBNgMh2ayRPfUlDSLHAU" +
+		"QiIiaxAQ7tK7AFFIlr31AExhN9kcmElCqoQIreeuee7YAPuIyq+Umwsda/Bg0K+037JEUNMNPfCT" +
+		"tAD0TcdRzBFvVdBl0jG+QTDEo8hTofzAp5VqEoYfbNkhLbyGZDMOqaUmMzxHR03HIbo8/Au/Kqzi" +
+		"JfcBOFDSYfXBj8380jZomwblaOmYpD17UdRzmMZwmHq/+0kl9R8dOfVu86/961e56U8V/CpReNCW" +
+		"/K0BEHzXE0TR59sLUQ/LrYbvPlRt25LfUu0xy196E4HiVsPXL6QcI2cpUZurlPg4x84oJdbyo7Ih" +
+		"HM9mKf3QU6tVsm5rKbMmJePe9K9SsDdL6WMsGQcFDaS2oD72/qvU2Cw1vin+q9TRC0tXfpfnqPef" +
+		"I42Rsfz5I6NS+lsQo9Z6Jqz6yZQh9ECqZcvjzHh+/TofI5Oz8kNyvy49PY23VfTB/l8XP4L165JR" +
+		"g0t7+zWG/quM/12HOUjdbMf29V9f//V/118c/6//99d//c//99d//a+v/xoYjhDPIfpD11j/K+jP" +
+		"hPyXfP2XHOGZ2pApjHA8XXt8/ZfU8hvhyFYqRPv6r0dp4eu/0v8Rjq//SgzaCIs+GvFfaZdj+KXj" +
+		"fn79VyrH/Ti//msPTYMRU7ZHGk+Hpq//wqNAf3399T//z//x9df//H+N4H/+6+uv//31169h+TUs" +
+		"Ydu+/gqPUkbY/4gM+SOPr7/CM+1hiLMjbM+vv0IeDrn8jLCP6Hx+/RX2cA+q6U+V/BV2/YXU81lH" +
+		"tsddg3Ko6H9GDsdTAxGKpnKE5YYE5TziiP3Pmb7+CmO3/xVqaTm889dfoQfNeuyWv25ff4nOef+S" +
+		"0NvXX/Icx1vCr8H8JTmXn+eRvoWMc9tfkntLx9df+otUGiaKRtmH15GajIgq7zD8xurr11/SdI/+" +
+		"Je9+toccz/L11/0ea2gpf/2Voj7g9Ne4OPirxPD1V3ke4RV6+Hk9VCsaPMJoYP1Ay5Bjs8u43da+" +
+		"/ir1qUESFTh2nOCg9+L+KvW8jdQN1/V/ldawvPZXOf/3eRuWU3M+j3tDkpfm8Goph6Onr7/O4X1u" +
+		"oau8Fw1GSzuksOCxcnNl0HPs5HP0kTOPMs4sPUVxGKaxzefosn+dx6GZ1fT113vEvfWc9de7HId8" +
+		"/f31d9geQZflQJdKUXmvcvv6Wy+MRjhq9XfYb/InqUyB4jDZFMqh8XI8yveAUofjKC0c5/Yc4k+6" +
+		"hfr1t+6vIUotfx6XKHbZhnyNiIqgP87WB0sYO/Lrb7npsvbf4pWXKE/C2Ml/j7e98Jry3/IcnjkN" +
+		"6zjN/i3H+B+9+W85ygja1994+FdzeD2ueg5T619/y6BH1E/r/D0ehdUj/u/x9tK9fP39OMfvpf6d" +
+		"buXr7/Gqj+SokEfuaXyg/++038LtRxzCsWAbvONZx7/T8a1f3vv6O5VDg9R2k43QTcIwwlcu29ff" +
+		"x9ffRb7+LjmMYJyzJA1bLt/96+9xb/Tr71KHpRbcEidhz07WQj/Utuqq1PL1d2mjNevX3+3r75bu" +
+		"407+9fX3eZND8l2O85aotW0chmNfnM9Sv/4+86kBdqZ+Zejvc/Sas6bt8fX3j36A6+vvn1Kv59iG" +
+		"q2qvuM479sOfP1/hK4THVwg1vkN9OrSvcPsK4wtjGp6NQhS++wj1AXdKNR+xHCYlGo2zAVF/hoOs" +
+		"KZogjBAWz2LaAyGjHxLzRWI8824PT0lLF4QRwqI7Enbz6iVVhxF1Ew2AzQT1C6EqpSNUpWogb4qE" +
+		"4g3jwm0y6mBKOT4U8xsypg0ZAqKTRyIrYDkWVA991ZyShnSEPOjcOoVEA3FoJGZ/bpY7yKJLdTDT" +
+		"kBL2EY589eGqTmAmhiEviqZTR2TRUS/prIN0lKZSPS5d+Q63h9ofpVaJBh5TsftJ+SIjzwdySpE5" +
+		"pcgQVjip1IQp4/41aFj+LVunwKYNyhZzoBv9+/QtN3RzqRdQKCJlNUlXoUSdMnpFZp/I7BHZ+kO2" +
+		"ArMXl/HNRwOY/qgBQdWwje3JpYwdmE/L5fRcRnUPuaN8QHRqRqgFUHM4St1DdrB2NO1y1gJKqCPT" +
+		"orujYHfgM/WUEp08DmWWnFgkaQzFi9I/FE08Dgk0AvEy7DIpTvRU3E5yOVZWp5ruPN4Mp1kmwcjh" +
+		"stSOEEXW7maWUJctqeuG1Fku9zUAG1ThdB6RQgxYwHlEeGjOSP8W2xk1bCEOoRyFIlI2SGRVQ0Nd" +
+		"AY30Fod8OfIogaIkQTeupngXk9GgEVhSineO8IbqUIImKOyogGlqRsyleEc1dI9SJ6nxfHUKqQbN" +
+		"TNiu82XHvv5v0lxKdHIbKtG21FpwaEaoV9vKEU3KpDqpGVp+BTu0ScoUSCgp00VShgfGP5U0dAom" +
+		"OXB2AOCYApu75chBdsCerKqu/CPOWom7raWjP7SYks8+IV+OlkKVhbohm0ux7RP7gkz3FpPRwGK4" +
+		"NaXeKOhSb3Jsl2Of1IgzpZ02BjP25dUbiPJ7SIdJiU51UjNk3gNhkz2Vc8V8LYq1VZfDyiXCnA7b" +
+		"74q6Mb2GbYLEBfO1KJ73UK1eyuVYeTotyT8SH34sqEo6mzhYyrP57j9rpJBqgF181phWMnc75YAv" +
+		"UjZgxvrW1iaT3NgdNA3qd+J41vcVIBokBNrl5Mh3+sB3LuOejrZn5yAGgB0h9sFp06BzTNxvV8PZ" +
+		"VKVmeWlmV2u0axW2r7CFLQWKYQlRdqFMwWBzCHnBfLmSwrFyc8VJjDTRMa44HTRukygUkZJm3bRt" +
+		"kyxVx2vHuHCb/OGvHW5RFr9SV0bE0SmY+eG5aburPEN26rJgXHg6Lzmcsz6vTuHTRWpZFkQvMNWd" +
+		"tA86useS+bKtL6vYy2vy4rY0E/RotitaS0u1hpZloluZHc9CRp5Z4jWeKZY3nud1/iMrx1VZvWZW" +
+		"Oi22H7A2CHnBfC1Kc1bKIe0mJRox94H02r09c9q5o0FxYnO0DNI/0qU//9Rl5bgqS25/PL83xyay" +
+		"blnJIYqDRpZ9L5HVNI6rsnox86nla9XL8akx6SscSeLCi33nvpjaEtn6yjPimuQ1euW0yYLNuT0W" +
+		"lLgqq9Oak13/f6hwrpHCjibFThKLtdxqnK1Uo7WQkm2eKW1qhpD0a7Zjmu8HS3SyAuexjgtQszjr" +
+		"JMO4L+i5sITTx69yeqzOTAnm1/EzdcazmU69ARK2rUpM3cE7iWoSHZmLMtNNV+9LlWPokDQIRaSk" +
+		"mflpyWfup50CjOOqtEVhQtNQhXM/s2UAjAu3yZYayprYNoHae8ms1JXhpS8AALo45GmzIf6sHIUV" +
+		"3Npm8W0pvM2im12xgzk46gxERaSsJulqSWxTm3fws/WyO1gGvezu2ouOkqMIjZaKlTXCNDUjJJV6" +
+		"S5tJDtODtZ/DuYc9jXtMckDrYhLRSK/3PyBpuMbZ8itsDwRRQ1r0GnrDMPFI2hKPpDPCId88tga6" +
+		"jAYcQJybKdXBTQbY2McUuqGPUkZYyxjfR6Ufw5qiBmpIMX1jhAU10mUSOSZMUbekE9QtxdISXBHq" +
+		"ayuD1Pt5lB98EnvhuCptUT7TcONXdTjrHHHXdNrCJV/d5bDiM7+UaqgHQlXOhgYAhLxgvlwZrv85" +
+		"Qzq6A8fqqbRP7ZFebpDoiI0CI0WStsmkOPFY7UdfOV9Tm9lY5qmyHw10GQ12z0yX7wjNyMBza1yp" +
+		"mtym8paV87VqHDr+cyaWZ5vcMUciudGqyGuITeteJdxdNgUNtbdW/QIVpB5E1XpkZZesyUawmvZy" +
+		"4DJ0cr5WbfXUiHIL3WTaJrF3UGuGgOPaTcLwepSbXsPUgt/zdcKmlEZhZVifq1cOuy72DkybyRE3" +
+		"6oUWY2v1dKRz/7JLWxXsB7bPOn4K0CA6eaRl9Q6ey5unExJdmQv2+LKz7QzQ01uXNQDdweI4k+w2" +
+		"kWQZzL9Kcwk/DXCuHsLTn1yWBfkMF5pMihM9HWe2gzOzqMmKqF5AZVwXk9GAHt0zmsVzg05rsxPV" +
+		"PPVOx3aOu8b6QenB57H1T0aTLZpmoTXgOtPmi0wb51FYaFIxEl9bLu9Uv0L8ClGPoYgjKIZ7KhSq" +
+		"7uHoJkfWMbw6Qp/6QclCwmY6NrBEymqSEdo8CpZsJtKuNGDoI4PIcnTmG6McJgJloxydPerxH7Gq" +
+		"GGPaOgUSJx4coHJMMncUPwgWeruz3f9xzJcrjfQWBzWh+hlVyLQxZ/RsSNvQyute0rSJu05iPqqf" +
+		"m1BESprhJEc6RGVJ0WTIRsNXr/5HqJslth4ccWEe5T+nzhoVujjkaWOCh1ShiJR6/gJ1B/OlREUf" +
+		"gut2hbc4eN5cco8pcCCOSU6EatZ+nF6ljbT/Hv9hwwIoqDtozfU+2DvkBRe7TBrZ/rukg0IiAdUe" +
+		"BI+zHibpc1Z3OivvKk/2VAqnTokgo4HFMJfTbrE6xoXb5A9/tOpUVr/3klmpK7dV0XYZw5BJxNaZ" +
+		"eV2yrkIRKWm2WrVOwb4/kL4N4wagkWY6a0KiOUDi4pMwTHsYddOxNu6JYWIRhhIXBSWZ1pxxtbQo" +
+		"8UP7cFzz8AZa1U/vt/xDzddqKPVTY2IITvFIFymbNKfcLcprU5mMG1LZJNU2unKbvEUqLxvinpbV" +
+		"OGpZJrq1HJOQWUdIhbNnRYkOzKB7O/5GSJffrNhvj8ZJ00hji35o0kDixBnN9MrsVlMxN2svoptx" +
+		"RJTxfxOEI65kn7JPXiL6gmrWgbf4WbXw9Fl4+ix2+ix2+ix2+ix++ix2+iwYrov1CgCHz2I9pHgv" +
+		"KBVeKBJdoLAHFLRk4f4vvvv1ymmETHWYgw1vZQ5uuL6KVXDyVImTkaLGpe+RqJZkIl8EVlz/9dZW" +
+		"tFtb0e9nxXk/K/r9rMh7WHHew3IcZq65RFtwibbaEn2pJfo6S7SVlOjLKKRLqSMUPDji2Jy7LBgX" +
+		"XlyszO63CKBIneTu0lac2aSJ5XRkLR+lsGyN2W9WMVCcOONZq/3mlXqPfx62KqMBd75zM8UAmb3D" +
+		"0TELJcWJqPRU8jXVGcN8WJwcfRyYZ/tQkNJqIQdHCFKcWBdcfFcs+5K0rTFt8Zu1OvWHnSf3lWeC" +
+		"cn5wvlbtsILqjUIvSIzyNdn8KBuvXYxl2pmoicnsllleY5PUJqx5bbzKIjMRIztbk899gJpMihOt" +
+		"i0ytTbUuuJgnzvzL3ESwOalM6JypzasWVbKbM200SDTpEVZTq5dXymrk1Ul28wioGZQtbAZdHKJT" +
+		"M2Imoyz9WoV+u38E7SvIPY0Qh6mNHTZu+Jjh44XdQPSbh4JWUqG90Mji8kWAQRCqYgsdMpc2BprJ" +
+		"Vj7AiIxVDxQjGq8j7Glb2VNSh6P+XIsBTEc4rQ7AkBcFPq1kkzS8wtj90vpD+oRmlLZJrAk1PWhN" +
+		"aV/hO9Sv8P3tnej7G11IJfRUKYb7t2ydgntkssVLdEAWwgtTo2n1DFLBg12LsnpZ1pC4xvr+Thii" +
+		"CdGpGbHUFMM79UmIz4klguLEGW8Z5ORbawwnfjyHqDVOdaeYachtKm9ZOV+rZm6sU92tGnVH1G+E" +
+		"jP/NBL/plkM/1ZLT1k1KNDKv5O2f02zknNjK+RS27qDugKrqfTwVS9dRDb2H9/IUWCDuxw2pYy7B" +
+		"20g1SyOteyppqFANFwXUcnSTEo2YauDw0o8dQAw1bakcOp91VLPkkYFeLHznsKsoYWSfz65T1O9S" +
+		"hlqwoMy77UNtIZH6Y3xTTr3O/BW+K2KqtMeXPqH9jZy6fjquPxZsZHxlZ2FGbDpqDhI7Ap3pcs/l" +
+		"ZxKNaddJiCPNOX2L0duMe6hP6St7RH9MovEo5ZhEo4lHeMuCbi7bc0Gae2hdFjSzt+ZUGKWvmDmZ" +
+		"sbgRHe0e0oGw9SFfMsI6gi4I1WtU9a7/cQQ6QA7RKYaTZGku82V00HpsSQgap6t3KmDtCFWpoZ9a" +
+		"wtDuuew89U2Oq7J6ad+eWjk+NbienY/ITo6rsnpZhtTK8ampaw1H1LVlx7gwZhCuLimYdw1v1gYU" +
+		"JzbH1ZenB9PKsTKS8EFnozhxxluW/rDzZDrxyp34lgXztSjcyaqWOglG3LskRCePtHqUr3B/BO1U" +
+		"KQtCLYaDJ+b7IxxJE+6/QkYDi6GrDZ1GFl2qA0yvWl5f4Z4ljI6X8WGsgAHjfvBGDAHd/7CbMvdR" +
+		"7zKKK3juZMg/YjIaWAyqRtLt03tL91KD3Rdy3lYeGVS8fGaAqoA1WgQhzjFG3D/QLlCEQG2qWDcl" +
+		"0V1F0gcCpX0qjONTDPlDwcjwoX9652tRZeHPlJauHEXnjqTWF3QHzRJPid9P+QqPr/AIX+GBm9v6" +
+		"Mnx4yP4VHuX60vOO3khLehLGqxN8c0JXwtO9Su/iMEx5/Kt7lloOkxqFlBnNCKn2EexffKCLD3Ol" +
+		"HYMlZL6MDlpHcPwfI586/m/hTtEgm5iEQSIF1FIPONSzPRwkTmyOqE+9nY0CcXgL2mAPx2Qhy49J" +
+		"TcFZP8FML5MviY4sc7D6IepbT+CQauacgwCTHpCpfpeUTSKiir60HFK9n7pDKvY4pBqSVIimfknb" +
+		"vbJnc3VTharcQ3XZLzWPjlCB6bublGjEPAbS6xCT1cGjAHrKh1TDjo6mUqIRs97ZpSr3CXfHK4dD" +
+		"HBpJN/BVajepEQj0eQRIGsqPSRjS9jRpDcCLqgGCqrVe01MmNcOXg5q67Z6e0E1+MOsZcFFALdwy" +
+		"/NyoQu0P302qaYoRtCwIR1zfHgiH9u9Qv8IzfIVn2m9liOMr5HDD648h62uCOWxVzy051B2hREht" +
+		"b0Kmh448hAZSMYLb+A9bqeKg5tBxO98IVkkjp1vCCD6gUGi0BueOUA7I5FJXjAYOseGD1pPMOCq9" +
+		"lUfJJtM2CWOwac2x7YawvYViqHpvdYS6ixSEwGjuPaJGjgyEi6SZn2QwGKlk1/McpBpqR6i7wtaT" +
+		"BmiVpXI5OGOdJyOXH52wD4m6fo+/Iau0rXyFfA8aiIZ5hHKrwWTaJrFtVBtZ3UtN/bFPStvk4ZAC" +
+		"Q4mU1FHzdEsIEX1LblY3baUkfDZDqU+ITs2IycUmpAPNz+PQJQBMqUIPQxVIcn9Yfrh6yOl+IGS8" +
+		"jlyQjDZ/LvMbMVbFUzfA4ofUVUegRCPm5KnxmE3WqWb2iWVOV3hQDPUZcqIQ0w8xwPH85OMehOhk" +
+		"CTAPG6j3wgkae0WEQ8njP1wINZsc8KzbAPXw+bMjzUIRTeaLRAcuzjiaGdnL6GHbpJBXtry46Ehs" +
+		"fUE30xXfFJ/I+Go2GrJQUD1TcICp3uVwSJujxbppqWi9Wz3r3aqpZEa48UVkozixOVr7+EvI+qMj" +
+		"GsLp+gmXg5q8pWc742hkF8qJb8wZwWg3bRzjwosLa5TmTZtFWf3e8qGgbbg4TIhOzYjZl80zL9vM" +
+		"unSEPNpIjKKwbLvnpU+JZp1PjZCDD+gisc1AyOmHGf1YPj+wIwmPkGJHSEHrt1dCTmc0ESkbJP31" +
+		"YkBFpLSNIjYypSezk3Q+G1vobNY+fI2J4G4+uuTznXBsjQ9M7g4ap97cnD0cYTM5YvcxZg4P/SUD" +
+		"iAYp1SQM2uM1y73ZOZA0jEU0UBwTy1weAaGadHQrWoly3NsDs4yBeg2TS/lGqPUt5ZubVs5Rrdf4" +
+		"D1ugGBEvzf71wKvrhLQtGPKHkq+p2kN1n3pbDLJyXJX6oXykWRU0ODTaD/1Ns+1DCfkfqlVT0+Ck" +
+		"8MJEO79GUCXE4VJ5HmzlK+QBPVSErySbLNjAKrT2XXhTfblFm9djXRcjp31bI7Qx7OGSATzXk+LE" +
+		"fE1ujms6L+FYSzhslHFecuLcCEqpC9KsQlcSB6RduM0gjU0dRzFATdqI5S79AW89kfR6Yjap0B3S" +
+		"tiD3GFRNNQI9CMdEN1DSQP0IApkgTqQZ4VtKDtVhmHAK2L/CHvJ9Sm13x7hwm6zN7Uo5PhT3UzhO" +
+		"OZpMTIo1HP3hABPOwnuoV86pTULixhBVam5lVbqc1aQ+2OKYr0XB8W/qbgibTgh2/YbCrj8ipQIj" +
+		"7SD1+sMCbZVqD3+w/X/wOTrQcL2F1kIsdUEdT11bnXDd+aHOaNxVXxStyk0qwnvV5rrxZE6AKcrv" +
+		"cVj1yzXcV1+UjDhs5i3dT96XIl8kS3Y/ZxqkYA8ANKNzoXxN5i64pXfIYhVW7gsiRRaEEaJSNEgI" +
+		"7I9bLS0FB23mM1uDgPQSzBVZlDaRV2qLOh07oYtDdLI8eHDc/PEYR/fg3NQU3dSzbSHKpDixOVrW" +
+		"7UFhbg9zwsLVLjkVTNEc48Jt8oc/6mvKW5Or5fBbdbtdkAFgiQg9AtUXfrlgAI8iUgNCWHeTgwXU" +
+		"TSgipaUmNjIlC6lp02nKrr/W1R2GV5r1T6xlYu3TtsRtFrlZbNRAEGpW0Q7OdC8IVTlU0b6NzHiS" +
+		"2O0Use9FgyMFSq3tftozdPsh6MMqN4dGMosZuk7VSWa7TKJVjlT03WgMGa4lagcFVT0J7UVugUIo" +
+		"GySinyPQ3MvRU856V3Sy+taQKdAYhavbe+H4U3zoKfN5nr3U16OcC+VrsvuMqRX2E5jNNxV3cx+J" +
+		"Th6JFsIbnLu9grn7G5hK6qynloLTRPmdtpRzGgW+xr/UcBeAiQbZwhEnqfGBl+VkZUSkG84eJDeW" +
+		"c0Vtjtcj9YcE9K6pwK/UYFJLWWqrLfbCMPrCl4H2V07f/gD0qjVTJTrVSTPa6DKJxntlToiM4Kde" +
+		"iieqc2Icf524b0WITh7JbM/ulT37rOnZRUwOw9i2M4uJYdKLix2vVw7BQYVEDwiU5e9X7lcOI/Hx" +
+		"FQ58nGgftD1qOf7BbVH0aFo1HAJH2Ire5iVoEqn6lCzBTWmbZKnxJBCkOmYN7hg8SNsk+CBtLncK" +
+		"XCQ4enSSZvRHJsWJM16bSVl7qZHn5cesaiZPcTATElw4YIwaUQySQTdJAxtaIeQFWZOLw4Wiy2hQ" +
+		"HZqRAbfwJa2bTNukZqhQt0faHKz0obTdqU+azjMDTdIfsgeH5tS5S1yJH9qHI2vecYeQEPKC+TKF" +
+		"KXkL0ciNf2RSnDjjZ1kjy02aXkMRGknPCEbZHDG1dRRX0kT1fSDLR8Fwa9SIEgmoi1KXSeankzuQ" +
+		"GL2k6NhIpbZyfCiW1iSniMaWzztZKW+tMWbUkFKdmhuzu/EoUQbkHKwJlIfr2MQYji4mR3RMtRwm" +
+		"1VCLLkMS0ka8cHuEfIFSNDmiZIulh2wkDhq56/CCj8sc83syh+yFUx1H+JdDTKpBm0MfIMRIRSxj" +
+		"LGn9vxnap+UyFceNYZvc+spLhM0QXJeV46qsqdCV5KxX2x3U4ecrHHfJCL/1etNIc7iL3lSCDA55" +
+		"krbcXTK8K0Ktyp2rIAM0Nh2aQyqvHDjTc+0Ct5fUfUF10S59z8g0o4zMPLNQ/JS6T4KRj3IYufGP" +
+		"TIoTZzzrnU2W1yNlWbAZo2sYqrnUQAG19RMW3KBWqXv4jlvUx10PijtsrdeyO2gOJ/fLyfdbSB7H" +
+		"Wp45VJPJmhfKRWTveVwRk9cj4cbKkXY+812OT61NtS8ocVFQvmlMkSk2nR45WixlF5PRIV+G5uQF" +
+		"dK9fn3XDGA2ACZli3yeeC9JuJ6pk54S0F3vgnYyS0TIoCd94OKw0nTylJqhuwwMkx/OhwfB46tY+" +
+		"s9zKsRCjKDrFUA8+TUOgSYWETCGRgJY4xDxyiiZh+I3QWsqQkcxHH5YdktmlR8JagGNcuE3+8GcJ" +
+		"UEpdGUmW9xJcGy18lM7SSreySreSSvdySvdSSp/bUTqv6YGlTkL8qaslhOiEefKiTO86aRqdrDqo" +
+		"PL/uagSvi+Vc7ssp90B1ObE3/eV0RXic3J1nwmuNRmZkE2IN6zhOZITD/Tgzu8eZrXuc2TYVhNQs" +
+		"49iSt6orI6pEQQgFo2Th044Ai0E547l+MalR6egUSGPfv1BinQo+hHGUnbd3lcq5UL7IKg8NNPq4" +
+		"sCShxCQguL4euKt5lBqeFFBlw8FPovF3Cgabg8ZhpVtLbT/AH7svoCiRgA0cZGcDZ0s95Nj0jk+u" +
+		"QKpRnxNs+4KLuS+Ytg+FPccMM9EfWTAuvLigxj1Utokei53X4QR1l5tkXas/umwSschp3BeE9/bg" +
+		"Wu5kRESEoYvDNDUjVkti0ht2hypmzOUlDkhyBEzxuhxHMCkG8KmpoD61lN0BcRrqA4MqqFYKqCln" +
+		"kzAUv7Ikt77yEvFHVo6rsnpxAx82M+z6s+toqUcNvyHLS9e7atpcT3HB5jyviD/0fH1YWv+H+pHB" +
+		"9NUVq7T9U//ID7a2fxpwdPakwfxI8KKwEquOJPPxxKOnW9g6329aNXjeuNLnSHOJSRy1pvTaAgf7" +
+		"nrb0YockxoXbZO4gKuX4UBY/Xf4eytNyenrSbPcyVUl72LxCplmTwvJ7QasMp2okM5bt+YNpSU9b" +
+		"CXcu768aXYuu0Lb9Q+ufGl0prg13pievEdyCKHvZauiu6odOrBJTRVJejQFg+g5ts4qQGVFFcBQl" +
+		"XAiqTNsknAxUQZKH1OLA/B+p2VrlqiHyeejTmUdPefRDULkHPhC2akiwhw2/KroqFpWD99Q9dI6n" +
+		"aU9bLTePSK3hYqLzcaijp+PcsjDT8jtFaz4y8n+Fqn3pQ7Go1pNTmWTRfrkJJcmMQA2wQnBIdu2V" +
+		"qlVjqkz2KIdDyAvma1GmM6nEkCe5UcKx4DQbZft6smrjIgOl/Ofk0/KTrX9TbVORyZ66y6Q40T39" +
+		"4P/PaZ7u517Tx2ZqYJRS6wO3tyfDhaHsyXYMmBvQ5NXc5zVdXutYAdW86rlPojGHt3B7W9msDwIt" +
+		"k1doe4lp+9SYwXnbQ7WDp4cDB0t/4EYMMTlaRYEhfygssJff6ZjEtPXkGPCWIx2TEP3Gty6M3Hga" +
+		"vVPDa5I2lHya4PaDQyyLCexNLiZ0fMgLkuevgm96seM3XMEPiVLa2CL1xJL78U4Z4VB+J2GPGHSp" +
+		"RL6/7R4IiMtmQ7/85HXx3HXpg9ujSlWGxJB8vXLQ6djVuQAA0JR4BvvAY3Iq1PyDb/YcF84YpfaA" +
+		"sKkYuw7Pf79C7QgfkiII03ejNtD29yuse5hjyit0nca89H9kInhs9CU19fTt0JTwnghBTRr81kD5" +
+		"EVoKlJtJjZCMCMk6ShA0Smv/0AnSuJWkRxfBTdgGQ27Eo5aYcPdy8kiSOJYotO5A00iLjv/yvPDE" +
+		"EV6LeOlizovPc7yyHF1l2W9f4VW2kK9XkwWbM+pZtnrp011GeUHNs9xFKDRxTjwUHeGWy537z3CJ" +
+		"ScGhGYlT65Pc+EcmxYkzHg3AGearvDKvyhR/M/uBiO74tqQS/VoPjX4DL0IXB4vrrA4xLry4sEat" +
+		"ZzHZHPRJlsF4sn5QHZeYC6pzf8hmnYLKRbzvDhZbmkzU7RulvELOFBIJqBwpX+QGWUoLk2CsoW+P" +
+		"9FzZI86FhCzZJMus4oVWyeZ1dAfWovJGv6O6SsgUyM7Wq4yQGGtXQ1YKrKc4WqxlUj2TyqgmJqMB" +
+		"Rydn96wObjKwfDOmzEbNsIsTt9YYLkekCHeZZHGy2R2pqV6mWMq0uUPaUk+usGpHTL8d3BWy4vcZ" +
+		"jCROtuSqwJuDMcBMf6ROcqOl7v4G4+uVwxlNyqQ6qRky+UCzYYdlhv/WV3MGNYpwbozrFIhJ1jVA" +
+		"NM7nXKhlmejWo0/yhLYjjWdEqQvSzA1N3MwkHkGp00JCdLIG4CTx9WKlLkZgmQxSopNMaoZLCl3x" +
+		"BFpnN3Z3QLWtBEmcbPmp4tlUb4qCPVV4ZHGsehU7VkpjN1LIlyNmMFCsdPtarKN61JBayJPcKA7R" +
+		"qU6afk6sVJUtWScgX85dFowLt8lrPlZ7V1a/t3woazGlrtxWhV4PDhggWdjLf9jBrdi8KlTWqLd8" +
+		"KPn6UG13VDl62mTBuPDqwrPdqi7RVkPbS76TfHfMvVHC9nDw/VL4ZWzD5ujpbrPxyXR6Vd+P5Lgq" +
+		"+Vo133Tqq+JFQSvHp7a6lvqhMOodsoOZxGQ0sBgv7u3jaC2/KXj3a3JclXytWlsUz/S33xBbNXXV" +
+		"4KxdDhtAXVmj+ld41bQVlyOyao64Bz+2vwypux27ejh3PTDxCMDLHhgnqI9Wn08D/OcMGvjL4P85" +
+		"Qz6PuwN87DLTEeYjnN0BJr4LaxQnzvjE/Ks+m0hANEKdUEJi1gu2yKM7wKQfzxnD/LlN0Ch8zOw/" +
+		"Z/qWahIGrNjVcJP2n3OhpshzGG8q1LA9jhQdGsm/JV1vSZsdsgHwDIlRnFgXXHwXPOvKM0JbDowT" +
+		"xGR3wo1rY+xmam7vMilOdM++FIRuTC51QXWHpVQJmdDPfRJ9KN6pB1lQzWc/XaplG/8hCoWatFfh" +
+		"Yd06hrC6PYLMBfUPNV+rofVPrS0qHdNmcibWq1FCM+oONOEBDSMYb6k9ymvBmrZPbfUDRwlbORak" +
+		"OZVNWjgWRVam1znG7A/2mKc4wMQWlIM/vkTGVkulYESlmSq3X/r1CtlRJsHvO+nZ1AjGJK/UtmIJ" +
+		"0cG2R3pJDveyMv27bH0SlrRXbXFbEJ/DWLV8rbp8KJawhrcsSPPbsrLINxsvTRffjrf1DiVEs+jD" +
+		"do4u+UCqgYmVO7YPw1zFPTwVmom+4VHxOkeNeE0CMl9GLGbUVYIGWUM16hctqhwBodpGgK//1Lt2" +
+		"vnvBBfyASwXidHPuJwdDBY0+oWqr3E+pFPBGRliBrfeT4xXABhXX3vKp5etT57bBonShLpdeqFQd" +
+		"hJNulo7T/OEB/u4AXzjDDGmEB4S6Y25UU+tlqwFfSnLtmtwXTNuHwk5mBs2gP3ahHzHkDyVfi5rC" +
+		"8amNPJ5f+GHluo//EANFg0w5l0kwspV3PyXsgZ0eoKZbOKJJNYwhrTqoSffp/n1mCjU+ShaTakgU" +
+		"2oJ4iEcmaZxeNqqAWkI0qQbt2DuerVRpemV0CpkgZkFxxTxGQ+6v1CnUim+4QapBg+FZ9oCwQfSH" +
+		"1PDC0buqH9FMiB0K4B6Eos7au/ARnFrOpvXG26YqaNatKHz8u77kfk/FYcTV8R/S/TAp0QhpFLmn" +
+		"nZspgOMuJqOBJyG7Z3Vwk4GVyJQwN0ZfCFnCZb46uFUsCVXuCPt4XvVP51XbNfVx9ceewsRtQW3F" +
+		"mt5TNoBQREqamXe5Y3YN6g75InZxiE7NaObCkaqWH4T4so0REvzUUlDEDwxXoRgq9nzDx5chYcYp" +
+		"jR2haTGN90gIw29odpe92ueFFXA0ktzYtiwVq5cV3x2uHcfxENl0jqj9UXGPy2gaSeVV4iQzjlO5" +
+		"rLxGIL+0PcpTJrUvv0cKGb/mfdIBZ8aAC6xOXRaMC+drUWyDqC6MBjalHB8K/ASloa+OW93bBHh8" +
+		"Y2gD1Ekz1om7iogTkWmscs5SF9rDsSgCxTeoMbYF1pa1bGyYZlvbuCeXeyWqVAN1y9Jc5svISkPA" +
+		"BmnWIjod6D+lPk2q37Ce9zOHIXcN2lcYf+H1FdpNcJe7bTohVIHD0vBy7JMshVga3YFKjMLqixGN" +
+		"zIHJuQJrhLmLaRInW+aq7JaNrc42/rQYJNoUrBcIQPUrHaEqNd2sxJpuLqOBObHwmvh9dLudvN5L" +
+		"bvL7DNmkdiegRj7Gf9DzNSSsWzgoRlEPRIvatHD83Fnjz53xOeX20MNOhTqVOqr+qGGnUGvXkR9S" +
+		"DUPT1xcbXl9sCb91ApkvEm/Bk4f1Of7ZI/TRnPaUnxGiOqOTNdwAaFnk9RXsPnkb/Ao13M8G6mEf" +
+		"ubz0qkGFRugmv+SAYs9eAy8AOxNJYx8hd5MSjVApRXiVKNkBpksfWyR0WTAuPH09Uyjl+FDUT/vD" +
+		"C73sleI4nsOCMOMMTTBTF4fo5JEs2X+NwtE9Sp1EI3O0/Cy3dJi8G2jMCPQ/pEzB40Lx6JPMTSIB" +
+		"eTV8c6210Fo6HLBtznFV2qJYJtTK8alNV9CZu0mJTtXIcsPn+1vT46BhBqSymmS0JdDjpMl+y+Eu" +
+		"C7rZjRKd6qTp55QWPO6Gl4OeU4xlMl8smhrjsC/4hajmP86uhJJqp6BHdY9qLSvVW3XgWyblazJO" +
+		"NqpBMjTBApYIK6m13WoKNIdSHWCCE+Pf/JaQY1x4cbFi3v51kdZw1QOpriniCSIlvRQ3yovVtlEn" +
+		"8iqs5w+0zI3NVaJBdbA4Vs4n/47mUKqDmSB3vkPgGBdeXKyE3d8haE1nGyo4UAOnkcU3y7V5No1R" +
+		"f4RCVc21bAg5SJLixObI3IrfAJq8OKGrlcMqWQ7WUQGO6MDFOnDxDlzsERpHS6BSI04euScWcwGN" +
+		"wHzO3fbxuZuIlHDd3dPO+IbNGZtyVtuU0269N/xGnYpoMl9G5kGJkvpowJEIw1iXmtrTQaIj3ZUb" +
+		"6IDQx5kJjFHx2AMF5iwkTkyoqWe6uw+w7SvDR6vHXwwiSJzYHFlT43y5xp3YcREOKU6WDshkJyaV" +
+		"vfKn4gY9HzpC4m3SxulOr5ji9NGw+JK2cV8wr3bkXHK4ySQzlrvUBacZF72Lkq+ptr7yTEQX3JIx" +
+		"StvKIa9am4ph2aUuOM1pWzDkD8Uqh68A49mbq003aF5vV9uqq3J2oUCe/puKipVSMxr7dRgufQVC" +
+		"xfC79l3wIL5jyB9KvqYqMwmsr25rPFA+0RIf+q49xv2pjLj+FXrAL22qNF3zAbUBOkccYqMYVhn/" +
+		"eJ4Bchgf+N67ym4ybUZwqes1f3+kCtcsXUw2QtocsDlUWErm1Ux//IQ6MklZw/Yo5Sv0HBpCzW8v" +
+		"7YWHFh0Xc9pWZmGuD8eSM0JV9i97jXx5c9xeDPfXwgHVoRkZ6DGOn6HuePxZhZ6vuz0EjV+J7nj8" +
+		"YgiMGx0P0Xc+itHL6yv0qvsKyy8ddyx7LRueFTXK12R0WdVwsQGEx+uhD66QGsniMF0cqEN273q3" +
+		"XgXOK4ouo0EjIPkgbpAhHZ4UlvIp1cFcPI8nLOmg8JuifV5PK0bmgsvq7tfUfV5QO1qekJpO9lc3" +
+		"KdGIOQxUX11AUIGzomNz7pPMKJZIxMHjqoOZrFA9JQyJU7SRG98yKV+Tueu7HHh83ShOnPFemP1O" +
+		"bcfYpmI1hVl2s7yaJ8cggAObB2/XyzQVkZJmJuJDAYRmlHC8kHHgTSV+aG3VmK1WuNRDLgd1q2Hr" +
+		"Jq3fKB99QXeVaMR8q/2KsqP7clfU+fPHrtjOqFjC672m2znLH8pCceJ0tvKVy7Hy4vSWlfO1anSz" +
+		"1CcO65NHyonxpp92wIy0P/z9g37xWWXCyPi8Sb3r2GDUBp71+AonGwlSopPUBZuxlnhaa54xcEgz" +
+		"ytdkNKVqXc3+u5MDs5hsBETglUQCogpCn+OY0vrKzRVkZF9+cKQDX/x3pJmih5dMovGd2hnyyohA" +
+		"+doNhkTj2A8PEzzSGg/MDEo1qecIR492wEbpVuoM8MTkD78mc+JD0Cd/Yh+So8GitEV7iykSneok" +
+		"90Wt7xyHz/tZESLZiQmRAhzPSv1S0TqFVAM3ZXNhV3mO/wG6EUdPQgHbCGvQICPUDGrQCNELbkg1" +
+		"4KYBJA24QXDWgmzwQYezbWfGE+eOceHFBZtoSjk+FPXDZ3MhzcBDxDBfi8ItH80jJvNlVA1aJ3F2" +
+		"QIYjmqM/BC9FGrF5TOvyocQPra0atnKq5fiHunijDv1RKgUzLvh6jpEYJ9aV92NBnS99rZrOLVdD" +
+		"myp776Ll61M/ZunWYmD3+zM3zDV3+yOT4sQZPytvNjxteXbWmxPusxcNbuFxODRQKvcaXvr0zIf6" +
+		"GZ22f6gh/zdDvj5N8pkFYrcc3jIJLv7swulPLoC6Q9oW9LI2PLGgVNDWJaao52xHmLUOkyROZjNC" +
+		"gXfadz3fGXKXlT08pU5qxC4O0ckj0zbJq24LDMTj7lgOx7Y7/pEF48Izjz9LLjMTdqiylxtOOYZx" +
+		"4cXFMynWwe0qGoRhxJBbgwvos5dXyqVPguurJYlOZrtMWomIaOf9Ls2bYdyvk7qgep37QYGOeO66" +
+		"lb/Hf8r2KhX5Ujq+wvsrvEPKCP2a4O0L+28u4b9tBf+NBfx3yOHQixcjNVYdZVVicHXMmm3rI+yh" +
+		"UqiHnlXe8fWlx8FbjruJSFlNNgIlaiTHKRRqV288rgkZDfJl1AjMoVY6VTPAoSEP3FN6+y0lfKLH" +
+		"vs7jH+Z5YwH8PVDHzbc1+xtt7l/Zf/OxZ0g49IpTE/C3A2xRA+wdPAb15hdzINVJX7fGwsG7bCEW" +
+		"kzSwcFLIk9VBCymJ32QlXSSd/g0SemGTsYj4LqnG81VQ8rk9KCQaWART/WiAGvwE7VH4Hr4KFPpj" +
+		"viP2PDhJMBo+P1/hJ6SOUCKkphrQhngKwoMCXk/+uocRXQ9KCHrUiFBMiskGcD/oiOaZ7kdv6eqT" +
+		"gj8jQjORdB9GTc+fQobMlxFTS+v1HBf6P/rMxwizVIPWSRckE2Eejdb7eXIDVEp1at0wX0ZMPwrK" +
+		"A0absZ2skbSF9DmQEQ6H0a9/fwX94JH21d8pZIQjZ3z46HcqO0KM/Ubmoys8v9MIRt1/6/D7O0uv" +
+		"Ig7DVHLp2aQaDg3aV9AfNsHPmly6EBUek4ZRxn/7Cn9ClkAx1LSf/WFyGHRZ/o8uvd++biGMf4kj" +
+		"HBtzC6F93cJtHCQQkbKatHhKpLqpu2SEGgWHhAwS0icztj5kKQeFms/2eAYHNV0a6C+RD2BhV2oP" +
+		"lS10yOSyGXSpC04z8ti2kMNZJXT51BrUqjlv2yMcIU9KYeXjQ2lTA47Kbw/JpTpwS6CoF79mCEqU" +
+		"p0Y9NRjODrDf5Ngek8yauklxqJPca/qzLQZ2OYBlHFNOdC2HONBUBaPIULYHmuu55dIfk+C6lf3m" +
+		"INHRU5f9Bs/I3aEQnTzSUsSCNn3qT8cZwI3puOW22fiROCczVnGITh5pxd3189RAPDU5UeqH0hYN" +
+		"/AhHdJAV8zWVuuBMZxV4BM+tiEkYzEXHsAE5tIcDnbI0QrmbvEt0tExyYSYv632v2aKv2aqvpWVf" +
+		"SyVeuharJDHkSRInu/NQ4F0xIABoKmV3gInhhneSmUuT0B3okaI40JT1PdaVlwjPy9uuLY3XcooO" +
+		"MqkuOD2XRJ7tK2wyKU5sjtM3oaO1Hm43qSu3qbh7D3cxSnWS+ZaXw0vi5JmBHTeNn+E3vkhVpC/Y" +
+		"nPv2WNmT1nEKnRgXXlysAj1k9LRew7aQxMnuW70XnC8KqD82aPGkb3hYx//xsezH9vFPsOFRibGl" +
+		"xIX2cCwKh8jLCrssUwwOXWoKDtloS/FDkZWbKVhp/NRC/tBb/4f6kfgy5RzHzahgRB+NEUdXjEko" +
+		"RkKtR7wzrBQSCWjzeEfDxDvSpAP9LWaOSGjsiHaKaKG4p6MXUDn7w0GiI7NX1ny4p76/s1BESl1e" +
+		"BFaT5kqJ3PQ/dMlZJg2Pu05M7piY3MdsE0LVO7aI4+H9noSiUjaTHaBbfL8n1thGz7vugfsrvcRk" +
+		"dWhGChqc0rs4DNNoqEfXYKgpaxAwF0tokJTlooBP+v42SYPWJuX0k7bnJESWs5uEgSH23wDdg6ky" +
+		"1GhNgvOxTRkwzxqrFLfwRBA1rAjRajxHPEUHkydt+sqZgZqQ5zOHdxiyPUT0GM1ho43UFHNIzzAJ" +
+		"xmMTk9HAYpB/vpVAoREbfocZNCqoE4SMc3TGsTNExICReRjl+M1BPPNIyhzpMw6GbH05x5o2kzAg" +
+		"VC+tLvIRbfQs6Jkq82VkmaE+aFF0a+5qHT8zdkB+ot8Oido+zUlTPDVbLSWHSCFSJzVivQg0tG5S" +
+		"ohGzHgivrWxPB5joLTUdYRLjOgWaKQuzuYddHGhKNTvQVH4OB5isQlgIdUIkrjZUslIFt0cMW1/Q" +
+		"0xAYZ1tffOMLXV+hPh1oyvqc5USa9csNTjByvgOAiSFLbGyJxj2cm+3i3KwuDTuN4aMUB4mO5jxY" +
+		"s9Ryd2a7W667DXAZ1dk113Lo+JNbQLhToLlbgG/DDsYNGAMzVZx6DId5v5VCYeqfLJPixBmP7Rj7" +
+		"FJdo+uwEjkjFC5Ahj0Ch8VEDjPwq3dAIzD2G4wgOiLyV3ybpLuE0iSjml1xWymayA1hKotrrRYBj" +
+		"lhClLgjzHtiyhjCXLFInwcgQI/xhs5gjtm7N0Lq7/YR7OSbRmGJ/TIIR9eQVuV4dHhy/cIUIOxsW" +
+		"84vDGvWehQKeGiYN2oNCokEjMLE9O6fIVkjeBv8uCHEwAxih4qkBz6nH81bK0wEOG2Z3AJhQF5zc" +
+		"Dp7UDg6sx/MoXRwQVUvODhIdLclgep6v7rAlmdbtcrQczlf3HM4Xtgu1xoh2HKjiwSpydDsODMsq" +
+		"EaHhf07pJiU61UnuxowGuq3LJDUieIiYVEMPO4WpP/gN9lVBFIrunIUe3WahJBzJVq0LHfpiuX9C" +
+		"ohhquYUbxVBf419Bb7JQhmxkUd2kzWCoXIa0/RGT0aA6uI8BNmI41JsGuPPohCpNpU3NcXPgiKbK" +
+		"TLik4hgIdtc/MilOdE+r5U3f+JzEwmQ7xSE6NSNLbXGZwiU9TVYK86+eQa1Wf6mVhVczcO5oCHMS" +
+		"CqrMKfWzYj3JGQ4If7D4U7dQS15IY7VWEa2ugwB8hatyANSdoU0KSWg2+S6lOzDe14GqPCQYZuEV" +
+		"iN6Kv9ki+wBtK9QL5436rQHcv7m935kN8I30ejlQ7yEdJiU61Unuxnzu+szbAEGIVIITSNX3cQHw" +
+		"YjLumIetMyjNOPWlmloPJmHvWF8EwKRXsTqjqmzop8jLpNRJzZDAKNpZpo57FRdPdQ8pmmwA3SRu" +
+		"GadC1aZCVac+eklUj7BlcYhOmg3mRvXAtRmunurReqn7JIkL15UXd9YaCuxcdQCoiQ8bObmRw0VZ" +
+		"niQe6oEQsx4AklhPU3BbN8nTEvgyogkLHdWupSomDRVXVLX8B8PGFBrLpZ7qCz11LvNUW+QZcMYw" +
+		"ica7mIwGFmM5wIPxGIvwdUKAu2E0qtjtVXhcDMCOr3JY21Q5KClw/gPAlDbMbElx4oy3otnFhmSk" +
+		"RWA6VGsqFFQ51yHBiGuGapcM+uo3hKoa8AFSJxppYSMNcIu7sE5W3IUedGEXN93xjb2rhdwptPM1" +
+		"0YAXm4AG4g0GEowwcJm6jT0kDjRpKRyFGgehZotDAHgelkvVEKz7UyeXnFpyYtkemLSqzJeR5cFJ" +
+		"Z8M2+bHUcE3ZUkaYtuDAiNSeDjAdCFmeAiNQBFpVpy9Ii0OjPaVTWGsSG7leBDPoy883vpt6C6wA" +
+		"jsHGYxDbx+ZpKKIxi5YO6Q40tW4ShoKwHCbNY14/KxMgfnD/C6AmTdPZJYa0h6Q+1TZ1WXBJJYsL" +
+		"t7tb/xqgjdSRmOnQQ9i9W2cOltgqwdJHRbHk3Td0IVv09rsRmjsyR2Z62dT1/h1XP/WnISDgQD+u" +
+		"gfQHfvTdCbHMfrymZBIRBaKWmziYqewOMCHs580kDLYOPn4cdhvPSq3cqOD1iYU1Ij0RQkElExq5" +
+		"40jtOFCxHNzZkgjowAvS3kNOZSF1YmN2usjhOkvrYo6VwjzqtFRzSeJ03CeZ0eSlMjEDzgLsznHv" +
+		"WSjC74UaMZosVRb06CrN2G5KOntELUdf2SL89uNULIojoaGZq0kzuBzPDk6k2bZxNMGJq+STN/vP" +
+		"qIHib9xj/okaoKV+bFnjJ2KN/CfaEtJPRKjWrIG20w/L+9GxbMRe8wLgsguAS3251HBxZqJSotES" +
+		"qWnLiVCVEfwJoVKomjK7G0mNpTyDyfZ1u+WvW6z7102+bhK2B0K9i11XblAkUlLnXhw4JvqTGK91" +
+		"HvAjAfmVg0I9NEVErmhjCWxj4UxCQsxCgTQIrxHeEXVn1FMDZPdEbk/W/anhrgEcdma/a4xW67iF" +
+		"u0lY4Xp8S2h9EuKSUFBFdserZHFAFMNX1ZsTjjTj/qCRGrXq+N4hQTe3RoRCySJrxLRCcDIQXFCp" +
+		"gGp+GAgGtIdJ5my/lD84pyfKhTpOsg5qQlu0nrnLlKbRMtIn0W/eYBrfbaN0wBMMzCrgM77M4oC5" +
+		"iCk4Xa2aJ5HoZDZLeHHj+yLpoy/eOMF4pKdJGDQ8NQB2hFLONgn1PPnxsIl0YbXOzw05Z9WBdcGZ" +
+		"YKY98yQv8XsCN5E96+wa80a2b5w3FOj3Rim38kI4lE0Xy1VIJMB9wzK5bHrrQragZ3EZlzmyPctB" +
+		"gVRP3ndQ0lTanFsuZzQpTnQdqF4FJRS9NTIkHRR0w7f/nFIlTxqOI78YzhuF60g9SL3CH115J0Qn" +
+		"nF6ouCcT304TGmO/4DUR5lww35GIzcMNaoncxIjmGgLRwmtCJU0n75RN0uedrBYDrZqiv51xk/gt" +
+		"OZefSWrkcBxtMI5pR4jdOoB5ph3xf+QwSZc/nIMA1SuHnULVVzgooJbWTaqhhjuGaFKcOONZRk0x" +
+		"ogq1bE+TcCs5m6Sh7CbVgCBFMUlDpzAVww0JxlLFJAyvyrYDwdjN1s2kPx1COQxDkRvTip5KhWdK" +
+		"2R7H2R3U9K3B7ax3qQsibtNbzgSYtO2Eg8SQ2tTybaOtYJVM5BvaqOhzEozXCPUTmpRqtuUmIzcy" +
+		"R1yril2qiu4leWmAajG5pbWEOqIgqiaXqDg8q9ZIsf2E30NqrM5AVUTKBsmce9VlRwLiNMQmfetl" +
+		"pAo5CEj5jatKGYfKENpDvtFBvhNm4wp0B+mY813uCO9wsqfKBmly9KTvggVQxTNTiOmW5EQ1apIj" +
+		"OshE+imr5xkxEQJEJ44HUNwTyfVfj9G7nn7vwrBB4HAAmIkp76FSSCTMmHwRGiV0etJPZw33hFs2" +
+		"hEZyl4On4zumFveiXe1ejhRMwt4RYtpwrzj4VEaDRmDm9Yx3cYhOzWjxs32Mupwpi8lowHZWrg7u" +
+		"b8BMQcjzxPGjEm47Qihjgx4hf0OgAz/CWygiJc3If0CpDiEvmC9X9PaJc1+5uaKEYctW6xVYEse7" +
+		"h17IyUN2ff6VgBjkLMw0HZHiERw1piCLkjHQK1QHc2G5JTNReYvJaGAx8E26n8noT/+WH8kmJRrB" +
+		"R3F44afsKCUawUsRXp1x+NHlQQxpvyzFpf46+GdeLmWeBLJdDmUJ+n1eJ4mTP1w02TcuFgdooUkQ" +
+		"RohvigZJ8eY0m8iEbzNZYswNAZbSs2BVEpYDCNGJxwOUZsREWYMQiz5bIDnfymVSfZEl82P6LJ29" +
+		"1DBtiwIfvQsDCUPaShMnvR84mDmzRXK6S9V3oBflWpS+cv6IYQbcMF3TGVIgyw8FC8R7LzefieF0" +
+		"k/OPYGHMEWaUdOEReKM4sTlattft7LrYNJlOuD4A0MRExQXyLnxQwagRIdk9hoRBN1FbiAcCTxIZ" +
+		"bdN/wmVSDec9UKjKWuypCkWkbJCML+GggEOxi+pB6opLjl2/30uZLxL7444lxSGRWF8kGKFEygbp" +
+		"0bvehTPSWHWNPIhwd0EFIhN1zmXsZI1RTh8ckUN0aVQOiWnrOAGQy7Fyc0UfSJZj3F11p++wOA2l" +
+		"1AWnGUtuiyJU0yYOx8p9wXy54slSyAtOD74TNzWLsQzRRZTYLDa/OuRdsteCvET0BVHgeHBC4iSz" +
+		"HhQBadL9wHE/CD5qR6kdS/JynPuNQkxnDc/9pq7j2kcldth/Tu5FgERHpAM3UOsmhwH35VVESpqR" +
+		"tArO8CqjAfuwczPFwBJ/w42hxmqEHsE1jf8BTw0k671mQd/W1T695Sq1YpjkeiQWI0fIYurFpaDa" +
+		"mJe2RmVTVGuHikaoV0Zo5V06DDVeDamUOskjxQn5GequbCI7hUSCu+1I2RFSYRPzctCuBluSu5iM" +
+		"BtXBfQyYy47lOJUSjZZI9d9T3R4OEifOaKbRS9eGC9em89qGS5hWeP0xwJz1SxQ3aS8+VGYUJzZH" +
+		"JnrxboGRxMkfLkiJC0WVFssrRv10Kx8DkPYqTzF5DNCKscodA4ZKHIyK2YhdTJE2lNVTpaiWU60z" +
+		"DonKD0XIDhbDVHbiHYSoKj8m6VPFnar8HAZ0twUIRXHwWE9aGIuYJhlXBIbTjBR9/AcNnkJxUDZI" +
+		"Our5sm8PdZY8wkc6nibpNVAdH9Y7OurbE2bvnbXuVmdbo+jccyolGtGpPLHK0/WRQdFP7UGE7NBI" +
+		"Eg2qg8Uxv4rpQK+4YlAZ8iSPZF7FxhNFjdUAfdk78uzFgzhkWm+2A6fjTNl5luw/WLXoP+n3cH9r" +
+		"4755afC2C4M3Lgv4/rmBmtB93y5H02jwE1KmkEhAbj94+0D0rWKISEkz/R6pPblxP4mX0T+JF0eA" +
+		"xcZExvlyjW0BRYtIej8XUqKTx1lefY63qjCnoV9FZ0O6K/9os/3RVvr+uj3Cv9PXbfyF4zhDdhgZ" +
+		"6YV7wpMPCU8pJD4KkEJ/5HI4jKjb+M+CUA0ZrwcN8E96fGhtVdP2qYX8Tz1fHxb5SG5x+IzRwuql" +
+		"wZl1yT+NBXC94jbSq+y0hXqjKIee/Sc3Kirl6HJwPHTtcsbzRosCtxdCVfTelgqJBm6p5oKm3p60" +
+		"lGPTKcegt/weMD6XEx3U69qymIwG1cF9DFjIoNYnjeiRPHIOzaE1YT1chbrgefHE9fEU42iHqAkQ" +
+		"IR2hKnRKFb9WHfKnpjtYN8NbEZAvR4s9dwc1VQ0GjreN0reut6ZvLKrizaP0XdCrVKrhrBv2NClO" +
+		"nPHMAlyOlYfTiLyHHc0HaCB0t3vYR/XvOldJ+ikRlUkoGmR7DImFHMhGqKiYIRaM0/1R6mESho5Q" +
+		"Fb7XRlCTJSwdIXItnV150AWp6g827Sfdh/rvciIcPe8pGkQNK8KmQkO02DMdiWJYc7hhnwJg6jwS" +
+		"Sbqjs09ogWobBeY7Q41Kxx1DFglHMZV8TYZ3OV2yHXKq5y2NJsxPDXR7MjYoc4v4dlLSG7spa3l8" +
+		"4S3lzCfFjOCCbLJ0CteZE14iSjl/63oaAaaHnroJMCUrInkJbJxsGWIFgHBHj+bDA5AhVVm5meLQ" +
+		"H5NgLD8UrD/nZ4kLIyq1ldHAaPF8beFwGBH7rSBURXrIOW0LLmbdgfuoAbcQPDIeefqYy+H2iBpI" +
+		"pWAkh6Yh4YVqq0QEwh/RDTu0U2FhZQiNvI86H7pUpJ/aHILP1RLU7UCigyUUfCPFSX3Kjl4PUNMI" +
+		"RgFle8jOM51zvlatudL6gvVaFPWJcq8h2ieEFkOWqa0YF15ywKaUmPRzO8hqzFh1P5VvkYgHzFNR" +
+		"v3leX0/qyxn943T+j3P5eiKfZ/GinnzXeHnNOC0fcL7N142NGlEj96BHeNklWuID2R0zh4Mb5V9Y" +
+		"dmbGrixuQOT1MrUliQa0XBRszypc+3FUP/wu0yQ1IuDjbEZq7OP+49oibtDiuh4mL/uVm4l4kAqq" +
+		"Dg562y/xCwhDjuzxa6OUaijozirRF2rAwhKulu1S2a6T/SI56ZBVI54vJDRQDemYJHEyHDaduhNg" +
+		"opNUClj5HKIRjEkoIqXVJ5kDdayMKOwAxDdh0lbo+aOPUdaV4cqdW6X3YFJjsNH9EcPlwAhk3plf" +
+		"t7y6tVvnaqYRY185bLIgzVilMjIjZwKGNJvAmwyOw6z/25m6STXI1ikkElDJJlzgNDL3Uh3MBPkb" +
+		"Z+r5ozvGeaJ66t0B/51PJ4tTuWNi01ADfWZ4DImFYviMiuuVk1032VXTkJgsKjQ66tOACpwb2KXV" +
+		"AESVdJhUB9GgIoRFQybj1Vjqe3hRaLQGehM0dSbng6AA1KhLReou1VytZgMNXP6I9JURwS3ttqHa" +
+		"8P3ECa2Pn0/ArOgdsHo74C0mNaacYTOJCRIQm6k8/H5EnjxpK46CLsGN5vRn/OOdB8hhfX7d8tct" +
+		"h5sGN4mU1eS0aFlAzmoXpZlGMGdVtyfCsJdSF2QknlY3kjiZuUAxb5uWmnItOBPo2Gdk3pgnOtK8" +
+		"nfgxwFVhlFVGDpPT4mXJYd7cON3PA+6nF6hIP1xdGNHo2eklyIB/e8P9e6nKv1fff+MVmKyf7rk7" +
+		"mCm1PkniZM+AV5mKbMg9pDxJ4sJ15TYVz27HWs1AHCiD9HFNAk0m9tQfC5r50BU+R5o7LrUczVxe" +
+		"Di+Jk71W+HRN5ndOIGEQhBFCbZoGq0fZvtmRA5pU64pa4ldRBjCMEJmbDcrX5MNjKgS1n6LnHWmr" +
+		"jnQsfN/LEHoLToVEg0ag57HtQafSjnCAPzf/iOygR2RfU5A4sTlaxtFfp14UumErj2jbcDwRMkce" +
+		"F4cdF8dTXLqLdckDr6dke296APN/zvxVVEEYIWBjIrxiB0mHQ64lUr2b5jCmdvtCcWJdsE2emFY+" +
+		"7s46ok/Ol2ugjpBldRbUrRRrjdbL97cD41RoBj0cG2vQ9ZcHARbXUSymOZASjVgC5z45INs/gjBC" +
+		"VArGQTDln3JQ0LscM2Z4xqHgcfhsj70DqkMzMkAWei8l8/FzldpiEtAXJFhfkAAHPBgHoKv+aDxg" +
+		"OsMCh84KdStTmwBGC6WabAD66lw9i7wQ0v1F95e5v8xdByDZcbyplOjkcfTGgW4327Pdbc9+uz3r" +
+		"rfXMR90yb61nu7ee7V56xs10jkn4ETLKfBkdM5aSaRXU1FGhn69bxqw22y1lQHVoJGTBeW9Oux6N" +
+		"aX8hRDOkXVtFVxBGKCarSUZjj6YjYqnGSBZmeVCYzG2cBQ40yY3GMoSKFGVSnDjjLT+ECSGinwiZ" +
+		"7skt4MoyyNI9bbOeboHe/1CISbVrk6GhGkN9QoWQL0fbJg4nKiUasbQ2P5EAxZOoRd9BU5FNt2w5" +
+		"jKQ5eAxsZbcU2EvYjI4hJNlW9D+W5M+zJllR49/SKVT98wcTJ8AwFT1oCw/agjceIBmNAgqO4hI6" +
+		"7hflctMAU9tiM9NyU69NgydCTAcA0alOao5OzG176uUygbE3ftZm8hLhCa0o83QvzhLLnCUOxNW2" +
+		"I82eG3QN1cKJTMG6NyRiObMp2v56Szrjw3u5cAqqd6xGKBSVspnsAHdnVyk4qkqJCLH+YMSo7ax8" +
+		"vnZoQtcHXltzpHOyeAzzCh6FahS8TKZg9SjRC8hu8nkZ+Vhiep8J0iGT6MGPpS/MCBMPq2h7FFar" +
+		"6Q8OTZS4KJausxcOrBL2lc3ntE5ivER4tfFbWd5Mprb+oefrQ51NAANjTVjmqNXODdhZxm4V2d0P" +
+		"+gsh3V90f5n7y9x1cCutld2kRCP6tMZMB2i1dHtwXipcXQA0ApvAFxiAyA7LCfgiV+bjIiqrSUaY" +
+		"fy9D8NDDc3PZDz8+IqdL9bn8IJMf5vHDz20oaaE/+qwyJCIeGOwANJUsDjBxg35sc36s1Bz2lwN8" +
+		"UQAuvFTCjG8OEmBC2EvdHpMYd74ooKL2f6wef7wif0bMyVWD01cNzrlqcNrSgIK6x7uUw0GiI1Mo" +
+		"axLRAI/DEhrJlgLAFwiX/gBGluOQvqCZ8VCuI8xI/Y1RDcCYe8rZgaYaGsj89WK9L4jof+MNxnza" +
+		"CfWUozRxgImffDOisbpxkMTJbCwo8NadwOzwDThAQxYu/LQxFSZCO8IPmepFyInbuSqrSUYzW97p" +
+		"HaCd/fz+tq3F5cvJeDYWTyoqb2drK4trEp3qJI9mljZhPNFW1k74WpTKxghLgOuj8+hWRzah+tcb" +
+		"QlhYB36PA4Dsql9enNWqwq6I6lS4sTrVSq8oC+3A88aQ1SSjhZLJOKU4fXIHmjam8xmfIi5HfQ5y" +
+		"2nzvHb9uJYz/+sXVscI3Y1VWkw2gWRI4FVk1euG9MiMaKX70NV0jGDVE0BGiAtjWYtfFA3TqS8iX" +
+		"I/ZAsQvngqtknTNyysgZo00YS+he/z7rbsl7LiE6IErvQxZ+qADTzBH+6NndCMaLLakwTLevG6eg" +
+		"5XbTIWvIg+JOCXMWikhJs/ldCFv5LXVB9dpCp1C14NKEoCYEzHzIuGJd0D2515XNmO6PSWrsIWWT" +
+		"avh5pC4Ow7RtaRjGTKeMQu0H2m4ligZqlHugQIyGd0EYIWBDvWLahELNCU6JmvYSbkLUd7BVaKQG" +
+		"Gn/dTj4pNrlNhckvLugawaPpLRsCTPiwShnDIUVTWb5uZeSkm3iXC6FW+M7lqQHoagOEMJJjLl/4" +
+		"yeFinxq2qXzBS6IqIiWSZYvX8nQf3NE896L584UPvRYod90jd6sE6zDWt8IEfUqAPNx132O1uGCh" +
+		"WAV+F2nhRuWVQxcybKijShg01O7LK+ti18yFnTn50OaYr0XhqJCDBopRA60Tv9Nb8J1eB3hoPvaB" +
+		"3qLtmqUWCtg0TO9QTU6LQtYAHUYlrOk3hUQDi8DWZb+ic2zGAA3L/dANaw/do5mjZbYzA4j52BCa" +
+		"dYsxIub+4Jb1h20OB8Cs/TifDDVuv2mA7VEpTuiRhs35uBti7Xiy+xBaN5m2Sbg1yV+DKHhXXAVS" +
+		"Wf5sof32qqV8K0FvD8l5Eo0YlwDDdIzPQZpUw60cFKpq98BbFnpRXPhJ2IJFq3JEewnPEa6o4IHP" +
+		"ng8pkIhtZm5m/ylm+im0aTEohe8XEhabZsdbD+VAPVgFYfjgkWgIM09UB37gAlJjtNoYb/iSU9HH" +
+		"cUYIBw0fZdfOd6ADHByRDk6JOJkvR+qFQq1PDZC71YU9Hkc2pus2VS+H5e8FHKNbHOVWKNSrhTSE" +
+		"9tqDvVYdh5eegws6UuE5uLAH4bRY9NRaYhYKNSLBXSpFg+TwOAdpDqxDREpmfk9iziz2gRo9LHf7" +
+		"2LKSelB9aoBbTaU87UErR+ZHbeXL2bLaQhMHuKL0pxwuYceWPC13y4GHGdeHSnnaK++OzdnSZOkm" +
+		"EWmnBJIbLQEevCBIdFzjmYiLMY4w26mFBCNDvmPgSPND8rfT25LoUxAEmnrIeZIZS5VJMP6Uujuo" +
+		"SdVdPwkNCc8dm7ijWrvUcNwneaR9E9kUpsUG7Balmva0I/JkMYgTA8O4cF159V+YuR/sDjokFk1Y" +
+		"2RHsCzggHvvOSIbcEXSEqAnPW8VOUcUm8fj8RSnq3fkcgRFikEMXoYD1gRAKa215djzCQ0BUlrtJ" +
+		"tjiwLuied89I7mbE9rK2rdfwmvSSuCiWWDX4a7v9EYQRolI0SIhEM8fA8oeZjeUX7aosy0rR/GsY" +
+		"Ha5Gybk4NFClkEhAukG4Z+xoCbAo6mhmlRqBrCRqd6/CCQYAXhUhFA1ZKu9K6D3+UlGOduY63s8x" +
+		"qSn085UqJBpUB3NhviAzqmzbo6vsIZvUiD8lUQxVO7cuSxasPxasPhbd37ibVti/G5ZaVOruaQ2X" +
+		"Hq3ZyZA31obU2XNrI3749qDPRUKGPClf5NYdGkhjtgdCiZTVZCNQomQ9Mjq9HvoaZTnIyMLu7ZRu" +
+		"eQyp93hKx0kZFehZKCLloV/lcTSvatIMlCyml52CGdloNohH1kCVZ07HuZO0Dc9YUjU5fM7vb70B" +
+		"TlDTPaTjnXKW8Kkh8oGQiiZNOYdbSK3Jp9aolsNBTZz7nTbxO7GVZ5Y3JlgkNWI2eeL3R1RWkxZP" +
+		"mSyGXWpQvgjsVIp3AKKQa+Rj2kDGsMQoB2U1SU/LirdLjPI12cvl1vATXkb5muyu3arCr28Z5Wvy" +
+		"dFYjvjkPqfaKOblKGO5S0kJJm7X1WsbP+MJZs+hJn04kaIqOKaWsjAgN/5TzmRyG6Y2B781B762f" +
+		"fNBR6Cdm8R9s/lTb1GXBuPDigrbHiPYjGaHGoyzsJI5qPxqPg+8H568fP3398NT0g6PvhwffT0bj" +
+		"q4QBW/VjY/lPttxVw6z1B/NbHTl/2qum7gBT55BBUuMP2uYHycYp6PcWKoWatKa/NWssZHENC/Oi" +
+		"3+j7v9nzeWX8G48Ll99cYfxt64u/8XJ6+T36jv5vpXeTEo2QjeJIdtmHg4zUiN9ehFQDZjmXTXKu" +
+		"Oce5EMjhtyJcG55/igbt6/bav27jfFzD+N8EYYTQOTKhgSpFMWl2SgjdnMrnEyvvO0Ayb3uwqtqd" +
+		"iGpPGBKwITVsSAkXTRv1C5yQsGqoVUf5mu/9HmLZUlm5UandgSZUC3MrSEawlndVU0QolIxMqEHK" +
+		"WSuRDoTjweG4YBVGMDkuHgGtk2y702GZH9lNNuYNfuH4q1jcVYHvtDtKXBTPTTWm6IKvTE9mBH5j" +
+		"zkjiwouH5/mjrx4a0QP1bEIRKRHbmPYpCCOEHtkKQlD3J733WxaTjaDFqLM+nlTtOURAIzCDY3vg" +
+		"UK06j6z8JQOV1STSYL0WYBZmEtlR/fFEw+borjRdFLSrFZng1opKdAIczUNaX7BQapJJZqRkZ1KQ" +
+		"SR7LzDH7U5jZq0XjcTzw2fDqHw+u+KJuDf3nrK1PUvd3iHqYoRO80SJvQUFvsXLeyPOtiwDVOsqb" +
+		"BbzZNG9khWP1fdZgUg049HGCUVlNMpq5cGj4ORByc378SPuZR9oPeioC5Gu76VKHP4IwQhwUrtP5" +
+		"jxzY4j/20X1FykpBFYLV+pPMjqzwbGK1ZxMBjWAuiIj2ffXJjQpumzm6+bcD84xb1W+nONLMsuN3" +
+		"1RsJjoz2iuBsbcRYiv4wScMPvxg3GRFPhFhSMmJUuIuDmfSjREY0Rn3AwIhGFvb0gr51TddI4mTb" +
+		"IlXMG99AdqTZXXF1MUhndQR62b3yig9uDNEfvGxcFEadL5M08MPmjjDvCKFwU1rHiqyjxXLzWv9e" +
+		"6ANtS6BZumnlstVkc3HJH0xdFIvC7SZHmNkl+sP3dX+EfP354Lgq9UNpq7YqVmd9VhDSsvEsZnJP" +
+		"mjySg4N/AUiJZ1/DfC3KMeNM9tk3TPEkKrVKggNc7LimFSchlTQzL+4YQSMJ20e4/fJHeDiAaOSG" +
+		"yR/bLvmDwX+AVV2s2eSPRvUHpin6NbmK78UN0SlMZc9626NpVd72FRjgBdDThvxogCSYtgxB7x9u" +
+		"7Q9ODPyIS5Wf13kzCYcRppsgjBCVgnGaRboJVOSkvyM6wlvoDg2EbDZdWoZkhCflbyA6Nmd3oYnd" +
+		"Pdkv7BppdAyZAqogvOOSwZGRJvBLP44w34WCR1DCDWiVuBXjaAlMWhV5k7qmyBwyc8h0zexSJBqZ" +
+		"SJPIN0Ks3xs1IHKTb7Sc4DmcAZbDN67IBmmfTMInciq+dogHWirPjEkT3UMUk41ANxCNRzSJM4ei" +
+		"xeFrbY4wPzqFHA4SJ9YFmzO3Rdmt7tosT2zf/dFtA/H0eU1ogJwRJvwErOHl2Cex7lDy4qI5aT/a" +
+		"9WK6Ji5SVX4TutonoWtCH9v5qmdNR8wu1ZPmO0KpJhvAY6BzD9jUal7D6E3yEe72jnHFDfKKiwN9" +
+		"Rd2kmu1X+YzU2J4IUbeGE/qQKI7Tn2RTn9Ts0G1PtFB7WoUaSm89i8loYDHcHP/oPZA5ss27Vbgz" +
+		"p66Li81t1UBzKOMfc7pic7pic7ric7oSEBHx+56DNpzgSVJXblPx9BvPyyXgY+VAOUyy6Gh9V5EJ" +
+		"LHexIvFkrdIFufNtiMHcXDyGpOIh0hc0M4Y6Upw4470u7afUuKC64EepIKOBxTDptpWcFB7sQST4" +
+		"Pc4qDmq6h4MC6ikUqqaMEOXhuRFIRrPYpGM5Zj/49pYK/yjLqqENn3I4WAuiRw+B4YpkcZYRj8jC" +
+		"mWzhhz4GaI47G3q3tt2T/gDgAD2iy7EhfCSTIRv1tE1sxJNQKOzNgUUxD0qIXlo464Kr+fxUUNfj" +
+		"j1BESpq50QVHj40UuhJW+Xy+ymqS0cksPIrtOfw6n8Ov9hw+waL3UGUSHSng8kTIwp+WCW4qV7+r" +
+		"XHGDl2d4ntyH6JiRGKpZAxyx/SHZJM1STT647cbukH2zVLuMaFJx3h9hdzCTVvp8hEdw0KgfDfDb" +
+		"HgQ5HLnVynBHHAeZH5w1h8QO+Dn4c0EDk5hkUssNv6A7wLbm58CVFYAmEzyHGcLMUCgiZTVp8ZQo" +
+		"esSeunR3YkXtxNrVybWrIatJi6dEDrhKPvGJ1CHdrG5Ynjpteeq05anTl6dOvZGlolF2cYBJHbSJ" +
+		"+HCuygbJbNpD384kMM4v05wZgSVqkE4OT3w1ATJfRtwfytVAd+2ptytV8MdbyZeR3YagJpPiRE/H" +
+		"g34wikcrsAk6eohKRvt68fl1O2/j/5aFIlLqZ9mVGqSOEIDWSccdcA1RNID7CLYtHCJ1ksTJSEpF" +
+		"vZ8ahFqKA+xcmTGCEXnpXOa0dXIFnc4Z0bezNEx5hrzEJAzmkIUiUlaTjUBpCWrYVcLcwo9JGvT1" +
+		"oQH6yCoBcV2kP0ClrMTCfx46kpzb88KnFYw0eclpM4n9Dh6RIz0eCD75TbmTT/9CwjCS6LXRieuh" +
+		"IWq6h7pgA1N0Cr1aVbJ0bOGIH1M44z0JBVVED2UcauOmbi4mJTo1IyTAmwonXlRQ4bo7VKSRTmEe" +
+		"3T06N3kgpEXpUzaQeiUM1AMGCPcRjgT3oM+gQaoRv04FqQa0JG6MqHAdZfJWicpLZaIrmsqeBj55" +
+		"K+XUp31PffLnxEM/J573Ofmoz4mnfE4+4KM/yXPqnRR7FNofg/ZHoAk0UZwvkzB0hNpv800DvUV8" +
+		"Zj289ftxI4wQsGFrsm1F5lZk3Yqc9lDldwpgl5tJzeOpAbLl25MERLOIpxXx5In85NflTn0f4cxP" +
+		"LTJrEMvdJCusSP/BjfBHHKJTnTT9nCwXJpBOkQ4Hj+J9KnIj8XnXyRJXbSZR1VJBfuNy3EjqyouL" +
+		"ZfLN62lFPckacYu+a7k7wGR7IdsDq475WhTbD9l3Vsa3PggwJWaQWJ5VTM94Q7aHSeY+z1cZr/me" +
+		"9hD1mfNLDpMwVMuQ19wnRsyc2yN1h2nqEheuKy9OlumPXpkTEI9KXvTAdAMSW/ijj0JCDsM4Y+z6" +
+		"43oq1IQT8BA3WcjiImU1aRGUKHsffQjrF0NUigapRwtXNc7xquG5v+D6guuLrq+HholWHmf7y46z" +
+		"/WV54KSmEhEIO14HMcrXZM9CbQNHDuiGnOrZTG9I1OGwlz997jfgUlEQMmGxWB2esVJzcqFGJc10" +
+		"QxD0lU+COmCMOO4iFLDiMTgCTJYRI1gQR+jDhmh+l/y0j5Kf6JgHuvyBZ0zOsf5z4nnqEx8fOPlY" +
+		"9XnYG+NGMDKzJzTtWAeuEU59tPpEvtpy6OZ8aw4SkSMcD1uc5cI6mkI3mWHR1IWdXB+YOEcFKzpu" +
+		"ZR+t7JLVuqS+eHdWXVNRATdbUVEyDzyfdtaIY7xKOCm2GvCBFNeuyX3BtH0onBi5webVi0U+tfip" +
+		"tg+VVaZucSo1Aq/CQko0Yiq+B8ufOFMB9YGQ1oebIXKoJtkCin9kwbjw4mIF51DtBKOKu1wAZmXZ" +
+		"WBaWvOxcS5sMD4Qn7/gDNUtdJ1Ghdgz4Vv1nQKiK+uXwUgG3LLieMlqMzZF140VJtWsSv6g/K4rG" +
+		"7tedcgR21SNYJ+VOOpDJwe2yHcZ5oEqJTnVSc3SytEXfCSYgVi/LIWFAqEZtAIzGlaNr1cG0/icg" +
+		"VEXLxpSSL6kO2YtJOEE15YeCqfAYzOmP2J7+iO3J52rPCjuCgFAgKgX3ZQvmhb7UsBiGZx7OypVW" +
+		"PvhwwodDyDA0vL9xNn3YSwVU1bTCaLx2TzWbVMNDA7jo8o8K11kGFoTsRNx4Oml2Nmn2vS5bC4CE" +
+		"QZ+tUcneb8hoQfTPQ59nN5K4cF25TcXKUgV23WIcKI21ZNtYXXU57mxe42TP5BPxoA4VVh4sk8cp" +
+		"vvxTnXnwvaWpWVKtr24mt5AbZ9uFiUCzLeN8oGHj8BIupBo00I3CScka2Bq3swV6mgb6swC9j3Dy" +
+		"hsTJ+xGn3Xk40d/0AxhnQ2CvkgJho/NlTcr3PfU47eEYReij2ScfyYaUaIQMgcfd0G0XQQ2YEfAB" +
+		"aowAXcvpgjBCVApe8hs28oYnGsDna5I5eD7foRvp3SiJH6r5f3M+bjzt1yRuXecPV57+2+pGVtR0" +
+		"3PU74IrH2SdZIpdbYRt3tlhnxlaA5YlZgUpElIOCG1AOTtAMV3Ob7Bkekywaq3uOMOP3pAgSJ06r" +
+		"5QTL2I7fYxX7HHThI8+Qw0FvkJ+4N36ycS+cHi6eHXQg/PNHAyx/2SeUzj844f/BZekfXpP+wan/" +
+		"D8/6f/489U4pAVG4XURoX7ffX7ff7WuMtmM9TNfCLvzmpIqh5vCDUBW9MXLxsaXrFZoJiQZm0Wpc" +
+		"L318RYVG6E8FXq9a4rn1SSOuigaKOmBcvFl2tc7nDY2GcRw2l3aVS++8X7jrfmEbL27h9Yd3gbev" +
+		"LXxt4Tb+Q0aobwI7NSDEESigVukm1XALdzEJg0QVierY+iGvEcaa3lInqQsMVNKBULpJvF63Km3R" +
+		"mP3Q6mXkDjqKKaspC8IttD6JWUChX4S417BPYhwEU5XtUQ4HjcILlwrNpcbUVLL0SWpEMHanwzBt" +
+		"oSBU5SEII8SrdAfGU9BOKyqpi7kQkbKabARKJiivB96OMy7n5NHMW08aqnlkGgN2rEp4KyrEJBSR" +
+		"kub2MJkvIz0FDb5QmSjHJiajQQP8CSZh6AhV0Y6pC7oqKgXjIFjEns6xn2MVhBqpwblJotSNknbW" +
+		"YHIYvkUD4Bj3wyQak1SZpMZvDSQd+i0Psjp9a3wPB8VQdUuwIaj4XUZz6RKjCu3O95RhZQty4+7l" +
+		"zGJy2B+ldIqhpj0cFKrWA6EqrZXDpBp6+v42OQz/LlkoIqW+GA+sJs21XgQaUMWnaBA1bBrqV1UM" +
+		"1ATXPP7DLbSHg6bIfrjlecDlsIeaHGhCQw/oydxSL+eK+TJFYQtV6LClb6mT7Wf7qUl0Mo/LJDdg" +
+		"S1YDUJzYHN3XPN3PvaZPp4/24bydmSOdYl8oLpivqTRHy5O8ONlGkpck71lCqQuaS6JEm0WpwaQa" +
+		"5IihOkh0ZF2U1fNbA51hbyEnnDhUNkDl1oLixBnPTMHlWHk6lbogzFuhYF7fpR5s8PRiRV7C6g+y" +
+		"gl5Wu9eDwiqpZHGQrT/kSNvKGvHUAJnbgaBHRdbjIQeE3O0ZDZt1smUAE7LAgZl5XOac7Oc2VmWN" +
+		"StuqtP6prZ4X+LibZGR5iQNMpfnxp4yUPLL4TTojt1nGfohmW0lV/qHgppQfYV3LjzshPE2wFC/E" +
+		"y9B9uyN6R4Y7s9vNBXXYLW+dIeRS0VylJjFpEd+IOnfpJhvgSOwXxLjw4sKSqZRjKqWu7ElmYjbt" +
+		"uesZPOOwfaOgN9zezP56tWJSI67fCNVttMweatDPNQzGkbjf2P/3m3X/ncfmfkshQ567SUSgsfYN" +
+		"r287aaRmLhnhI6QKyik4wC9DFIRQaqDA+U1JjMwFl/nOMlknjnt6HvyltKE0nOwAairnd8bp2TAu" +
+		"XFde/RdmQ700COl+OEicWBdszpZ2MK1HyjLJjParNh/aGnkps0SWZiXpp1QMYLpXfS13IsyPgpRJ" +
+		"TLJMqyjcGs5YAJhOE9S1PortEb67wzAd4///GLZDO9UxHxOhIg7RqRmhLgeub47wEgqoNVJQTWa/" +
+		"VLQehmQlNkyZjk0yhUSD6mAuLHiT7KfTqZgTJVNzojFIZZQcbjUsuJj1yDpiihTB5MaNUL6Mukzy" +
+		"6H5WWdkisiXjUHdwLn9EyzsLRaTEh7WNjV4cnAe7tBxc6mNuC1vEz7T/TDMbNhZkiKpe37mwqpx5" +
+		"HVpDwbuDTuqCSqNCrA4iMP86kquaET5IbaAxuEblB7IBdH76XuQ4chzhliYgZ1bhkJzLkYDW+Q5M" +
+		"m48j4YIdkNq+cE/b1ObRQF1WjqvSFoUVVo12bNXBA/k4cIlxHHaNcViTHCyvHCHKpDixOc4kN0xg" +
+		"DM3Fk01PRqEWWociCCMEXYtgnAM0EETWAwL1xO91TcJmlWNpOleaaeIQnTzSyqc71wAA0ckjzf3F" +
+		"42gAIl8XBV3Uim1CEG4Y448ecjlfKzbw0udcydeHavuvhx5MwoD6dJHDJO2Vwhysg9sh0NOWxYGm" +
+		"LG+pC0pcFMsAGlPQVhAeFNhZvRy8ujRsZMpKQRVCw3cwwWmxoZklGpiFVXmHZpbp1KROmtFOTHth" +
+		"Ay7vyheq+hr/+FU97HcqFzALJfSNczfSauV+HFoPWChRtly3hEYhuRFZ1ITre5LEyagvFU2mecNF" +
+		"N94ud/xihzMGdMOX8ELtlXK28yi5X86gHrJJDGvEvmDaPhQes2Zozn4EU5UF48JLClY/9TVTi19y" +
+		"s9HglXrJJmmwS23DuPDi4kXNa+qpqB9WCF9cHnwVDQ6EsGjIPcGp1et1bls6yoLqVdMmJs1gnYmY" +
+		"r0Wx7oQgbefuQNMfMRkNLIZVaVi8UokofdbaAKYzh0oQk9HAXCzD03YjKE50Tytc266HdJjk5Ad8" +
+		"GUk0snQBa8Qv2ylD0iv50eA7qVsj9tmEfW3Ant7sD6A4ccZ7ru8wi33zUs7Q3C0zT+8HuTKqwJSW" +
+		"CrM5ldHAYlD48K9YxKxcxqy85lKJhjeMC7fJzMouxGp4hU0cYOoIobzDYdINzQYJ0y4w6/K2vMc9" +
+		"Dko16O9CU9JwiEkYyuOKXBuaCqNy2gAHRdi41Ge/1+0UJ854bjvYM5mLhKr9kUlx4oyfudBWzyPq" +
+		"wVfHEwEQiEJ4HjjZktzI46reziq9rKguW2gukWhLR7nLsWDaPhR0ramvqZz3MEmNupUx7GV3aEaH" +
+		"A0x4rH3D91dVVArE81OkE2FOYYMUCprv4XCgCdMHQL4cLfYOWSh418ewTX49VrYIv7ieyhLF4sp+" +
+		"lZe+yg31dea98Lpy6O/QfOdFJG+PUF+TpK68uChqI6D9MHUbwnVuIydzVZCXiKeo7lFxBgb1SZYQ" +
+		"8rsKShxvGVBmqRNbd76MODhWufM2IAnGLG1Cvhw9FVLQm6EJbof1arEZGO5XVum8i0iCE7fpp1Rt" +
+		"sG+24r0gRFaP8qJQNd3KaRKGza6aDePCiwsrRKX1DwV+dM+5HA4wMS3L/DemsJU/o2hUJ7kbUxLd" +
+		"rKCPo1CqgXcmqt2MqAcWtyoPoCNwjlr9QJrD3uFzmHpITuGYBCM370jvkB3MVGpwkkkzupwfnK9V" +
+		"sz6il4a13BCqSWdqlfenKm9KVbspVe0eVMVMrmLAKjvdd4vFMFC6oIVKT9EkYs4WsoOZxGQ0yA7u" +
+		"Ux3cZMDStQe+kP+LuWMCWF/I8SVm5ZUaSeJky0zskk0RI9DLEllEp7iF+6S7xFWpH0pbNC+q62Pd" +
+		"QE88YxGVENJaSjbJFMV30kCkKbWbhAEhOklFo1Q2Sk3sxgrTxKmEMSNQaMXah0qLoER3r6VTNEpk" +
+		"xhArGwA4XFvpDjRxs6o+8wLgyAhSNwRY+lJpvV2TdG4bm7dj0zs3nZ3Bdnh/lNpkEowpWx7JVqeB" +
+		"iTf+q+2ybkNG9zGjl3n3aCprVNoW5QIfFPQznXNuJStyYOsLehoAvmvhBCPCn4dIniRxsuWuinrr" +
+		"DLvihkjlXnjbIly1nfHGYlx908x83ja4XoEjg8IwtXALFKpuWCFTGQ0sBtm1LdRgUqM0hegHtA1g" +
+		"1xzkEfTB8okfdmZKDekSvLk/AYjhCgsAJoS8KQKQuqDGPzTg6kp7WF0fXlc8vsIb9u0hPxRQcbyp" +
+		"pF+yIUuRXjRwstAeOx6u4EjZuBsaroUbr4Ob1uQpnQI2DVt4B5MwSMVtHlKcOONZWtP3bA0QnQIF" +
+		"1LI9TdLwYyd68OhN2r1bD4dMaCCMUQCYBGG2VQYuRttSdGNFerqHLpPixBnP7QCXw7nUBelurmzh" +
+		"jkbqbKHO5unWNvpDr5SIYaaWYbXqVa9d9crVWbc6qzZv1GMUaGfIFPkicHAcaJIXyopww4CiMm1G" +
+		"1eOG93DoYctX2yeFPDltkxuw7DcHmL5DHs/wT4Q5y6tdRj1tC9Kh3E1KdKqT3A3NpEjbK5ik4Wri" +
+		"lJy6g5m8ItcfcYhO5vbHitxDDcckxL/O3B0kOlqiwfCsYesONNWHSn70duW2KGn7ULj3e+jlSMFp" +
+		"m6SJ9XfUKGEooZtUAx+o6P6oRJ9PM3R7eKBvD4Tm/JgRUk2agTJZDG+ldHs4D0AvDr0kGl+COx4D" +
+		"HxWnAmf66LOeTjRqItkeuKAjNcc+yY1/ZFKcOONZabmXyjsozvlyTZqjL8eu6hItC8aFF5elTBRR" +
+		"EW6lHsZMW13HDrAzCIAmrDyvzAiKn3DmSRIne2ZDUe9vPMOoEnW+60yuPwKnV0rJiIcYCEaJNeRJ" +
+		"NHIrFdhKxnFV2qKweqrBXqKYNAMrUPICuKizHWU7COv3/YmV0a6vVWyhH+FFgUsQJRZ9YOm4H0l9" +
+		"lPXXpCjV0EPKJmFAPt0mW71jkoWviW72MVGFzAgO893GFZyOhsATHUoC0iR8orDbA4XndpqQaGAW" +
+		"ZHrGkCm02BN3tM8cazkcNE1O3/bckTMinghRRn7S5enRLIqVwkXkELa8C75ImcB9paQTV1xr2rY0" +
+		"W03h5eXZ+KwOwGIoKTDVAMDEuvEkeS73XZStWuzKZ5fleD+9456z256z055Llz09TbI1sdMX4M+5" +
+		"AH9a3zxtAf6cC/DnugD/DpnzbBKN6FCAfDkylk0z6DKJ7gTU/qTN/ZbQKTSF1vTNvvcW86wIqfBq" +
+		"ioTiBU/a8vICVxXvFDShHibvhCFoyGoSXhyPADRB0M4uxNsDb1wzD4Eci13XvXkVPWbkPxr3gwid" +
+		"m4+0lxyHDKkGdLYLXWf72kTPSoLLkSHwzJBRA6JLGuVr8uExEFq4bEGDPMJz/9okhopweI2dK3oF" +
+		"pV+/1BB2DVPOwSQMmmfKKT5MNoBFaBtK1u81j8yz3BDWcPRJzbDLpDhxxiNncjlWnk6lLriaLzL3" +
+		"IplWGGoKG4ERTNV6MNkIKeRJ2vg53cJm0MVBU6izniaFZ0jBnEgybsxCwr4zuQJMBeHrEdCWucA+" +
+		"3iCQSTAiPK240ws8c+rilMxYUjTSjod5kwqJBtWhkbA/OLVSqd1qFzvzAEeTHOWGkgHNKG1KXW8F" +
+		"EDRSx1kVUEulkEjADlA67kY6Ijjma1F4UAzV5SO9FM+KhwQUXUaD6tCMDKxslosTBsAsdOkahLOa" +
+		"bIB3MQmDHKGmcKy8RMjki3SwJYh5tdO7UvDW0GSLF7N+l7yixds2jBmebLKyuUCme9XzBHGfxPic" +
+		"rDpAmncx6Qb322X6vSTKJBgrt7/ycQfD7tgvRzuhmioLxoXrym1RFrZWqd7q1Zq8pu/zHvLK+Zqa" +
+		"LBgXXhJ75uklPeSVPSfbWt9WDiEHw9M7zFltq5XMODJ6PULW47CGfUpkadiMDTyWcRIyBdSbjXbA" +
+		"c1+QHjWYzAZdJsWJnoBDTl1OAYOXu32qW2nVyuKAVn2wqrIXHjeG+VqU6SMTy/nBS4I5vKiuEUmH" +
+		"0ppQGX1CXSqCkA6T+TLiaQkMa/pOHJeM3b6xrYzjqrRFYYv15d2aVXPXmYMnt8Iuz6OfUSbBT8Mz" +
+		"iw5Z9Y3LWAU4vNPvr01aqPAAjJiGpmk2+zbSuGYmN7xSN0mD3tkgDFMPG8oAtK/t+2u7f22Pr+0R" +
+		"ogZthN+CMELA9o2QRnzAyMl8tCEeeKvsEUy913SYlGi0RKo3fMzD4z22hR8H82mcOJLhWRHSp5pD" +
+		"zem7T6KvzqAJON0tivkIffgS/8L0oODj/USmakKhTnmTqAfAI2TpFIhJm5iE4YmQJyVHRgpteokB" +
+		"aJ2Eg+1hb+88cA06BErOctxlUpxYF2yTJ1qGOakNL2M8/G0MUObeNHZX/Uz4wh5BqEEruEuWckxC" +
+		"bGGRZU9ZJsH4Qhjuh0xiFCv2smJe3Ij9lcrhYF52+Uduk9g/XGGUigN7Dy+NqswmM6d7rl3OpS7Y" +
+		"VmZZBx7SV1hy8SxoYCfgL52BrBMcGzfn2KqYZAyyiZItAyBi8cw8ASb0muMu/vwotSwLesmqmlOk" +
+		"zDPWLsygWDWB5oM7lI5mNpsbKC2Tg+1/4D4caIn0iY6psmBcuE1eksPK0eCwkeBA23dm0FnLbrXs" +
+		"cjaZZMbLJA1pyyJ1ZYtgBTBiFIR6kQCJs/QjaNd5hVpDntSAPZk0g1vMhHtpBJgknCZpyBRUazkc" +
+		"wl2cJTqy9spIZeOEwsbuDeVypFG6SRh4VL9elsyO6tcLaWHlPnjZPlCthls4tkmICRudQfqokSm8" +
+		"c/OhWmsvpiWjpYN9GD5c5EOJH9qHI7cNqh1B0IhRTMKAz4M7SZxsmakC762E7AATEsRyHOFaEJHY" +
+		"w3Ue+pUHeOWhWe3QrDw0qx2alWNNtbGqJrajD1k1lW5SpC7o0YS2BwfbQ2R6dK8hcJbRbXCyNY8H" +
+		"HxZ/hJpDNFMO0Ww9HJP06sKUurgwWRKTMMB7ZzPt3Kzdtmm35tn5JvRA39M7BM+x1WY1TIvG4rBT" +
+		"rRd3OyVX3qkwaoaWi/ei7pZqQ1VlU/vkZs5tsNFwayiqoZzGDDnGNWaLFmvYmNYw3OgtZhXY6tbl" +
+		"MCnRqZmzjSMDzVYp0aFa56xLITrhMmdRmmt10jQ6eYnsHu1kn1JQN824c5jsNkwO+A3IIR2yIOKz" +
+		"GbObEHJn9i7ZpEVUk+M3siaKx3vSOn25XX3u9d7dlszPDkne+XjMWx8Pu/fxwB2ORxif9T3rJImT" +
+		"6QlFU5/vZEcMsC+Ytg/FBlYaRnrRc5qElxwmJRqhNEX6SqXUYiS8NEMJL26M6JsOQ7SnXik6Ihap" +
+		"OovozI4Nxm/KK6i+PRHyvEliFPLA45KQNqGfirnW6cwStifL5KOVA7B0Y8TYnZsBihNnvGegj0oQ" +
+		"GK0PhBFo0h8FW5Bm/ZUAJxopzpdJNURdQX+IPBHqb385NSDqKryOUcDmyRN9cIDtNrFWkCd1eLwQ" +
+		"Mq+XOaEazJiNy4NC7JAQO9MDstSFW5/K5ei1qVbp6pWuVumaFjfWp6ZikjFZQpS6ske0hfI1eWZL" +
+		"gbgmFN69jJspJ0/OU7EofBTF0c362yxgy/IVfHOaOVozNG+GNre+uTsq2sPDpDpoX0Q/1I61Y4mE" +
+		"kC9HuGBoV2mGbrJeRogqCPWnul9yYpxxA5zPelc4UtYxXareY4eU6NSMuDlVv2sDiThen+lv1w6B" +
+		"6PNGkTaDnQBvve/2YGu15ruPSDNOvoKvCm4PtnPjod7083KSPxUk7ezeCjRxhzUb8KX145xALwrd" +
+		"GruZaaRxb8zT5I3Zv0qN+NEAdftBsh+m+WE2P1b0j3cVLuboI3oP+RnlDl3nmmn8h1radtaxW7U1" +
+		"E16zhmTzgy8jmLTItKHExNy2R0LsE2GIIpMYJYdJiUbfMtl+Q4cqtkDxVX4bel76JeyFGfGS4EAT" +
+		"xY8VlVE1NlXaim9uwcbq/F9/uUiFxkVWJ9qUMunRluQ76iCOHz6DRNPor5ypbBBYjDVajFyhWDSN" +
+		"/P4uh0nsaUONxmPckDAc5TCphscZHmeYpMZ846UiCcaI8CY2JA0l1f5YkJ54ZWWQ/i6NE6NxyAPy" +
+		"5WitnWP+oMWuT6kM0t+cqiu3qXj18D3giRIX5dOLySt6YE4IsWNzRsjkmQVnK5RnrpR50Cq4yauf" +
+		"rcTsHSTb2kvKefpRqMcuCCNEpQgmGyFtDiETGQfBwvdDLpOIeCEMxx+RBRmpQtvkCAh/uHMPrWRY" +
+		"EL6IRPrjiRDVPzif0N97G8L288HD/bAj+igIEcuw1/CaBGP/Q8GWV0LTH/2PbunxE+4mNZFm8rKR" +
+		"P73283g6wKPk0MMkGDHhGbKaZEQSk2Y47g40aV1Q6+pPyw9+lYiTqnNzxVxq2HA6nUpbtFI/FES9" +
+		"EKLS9cUGqi9rn/rC5GmA9b1qNa+sKapd6/kyyfzq6a4VE1AM700yhUSD6mAuTIoXZThKd17XAZoT" +
+		"x/u+XBSlji7Z+Q7DI/XSAkxQu1Q/tN4h13KumK9FsW3ng0EPfeTqgXdxH+mdUPyb+/YNF9yFeuSw" +
+		"XzGFSTJxuOeCRcFcasTJM5ea4gQ6JbyM6hgXXlxYKJVymMKk5TufpRZ74/W/mZrZSt0XlLgoXoRq" +
+		"luL1uHI2zqH1lYfTyK9sGjwRhlt21hKKXVThaflH2QofVHSkGW/bG03jpaQ6bt8UzjUK7+IUm3SU" +
+		"BDNesCXAo+62hOHMCBV6gilPWaaYpl3gCFEp6AHBDcyyXa3jmZYH3kpTESix0VnnjmW0a9FRuPDm" +
+		"ScFnmiAbgDljQMbXEFVUk2anTBbDw30Qe7riHaDbpGW/8NMLTurJMa9wzFM5LczFBsLy4iCjgFJf" +
+		"HGQGWPE2PBYMigWb9OKHgYzUiKNnCDFpdmTLlXZ9QnqE9I/sQQp0gIBZZ4Clir33O1nih1Y/tbaq" +
+		"afvUvE5mOe6r+pGWjrAl65AgGPUxKEgYOtsAL2UAqoP7GFjpZ6NgKtu19bRegL3edOZTfjTA3Eql" +
+		"OuPiovCSoeglAx74eGidDweJjnB2bq4YJR2ya7HFZBLbEZp7zHarZReKSNkgWWLBJ18HlFb2kCfL" +
+		"gkh0sOhjKfjgFQApTqwLtskTrQqHdy1nOulb5FvIn1q+Fl1W19Y/lCXKkvDppMnqc7WQ00JifPRx" +
+		"Ib5/aiNSL57P2w1HsULrJB68p37s+YGr6VOP15Pj+7k9x5g2iS5oEfzqHGU0oEt2HzXwwYdTbXgv" +
+		"9cFfChugPs9noFB11wAdYvz6j1QDbMG+YxwaYJuys7+c+46oF0JEasiLDJUSjZjMrjowfTw5yz0P" +
+		"Lq4poPTD19ROmwNzqnkeuqZ28u7laY+/gcyVu/Ss24NCTL8XqQtOM9PW7YHFDCUx+uE7hYtiSe3W" +
+		"A9m9LsyXDeGeEeKKDpAvR9vomiGQFcaIE+93QDLa6oy66BB+Yvw+O65bVMLw56VLW9c4plO4hd6D" +
+		"QxtUNBi4hRgooHZ+Mwf4e4DePnVoRulrS9oJEvZ2uoeKEK8HGjEq5Vwm0ThEDkevIyqnoOHosniu" +
+		"J+EXoSAbpLbEgHIEB8ThgwQENaF+1keTd1G7LtYJYjpkDxSNUodd0Dzffert0/DPBNdQj3DTRjkO" +
+		"fAAkMaPXgzV6PaxGluWIrltAGPVEkKoOuioiZaPsJmlAZnXTt08gQzZiav7UKlEvABzztSjopVCr" +
+		"R7iJlhyqw2rU28qT46q0RfG65FDzTO4uXSbFiTN+yQBz/8nTqdgG7FtqsmBcuE32TFUpx4dift9S" +
+		"8Z32VfuIxAPo0LP8XtD9ctnOWW9XP6Jn7Y/w5iuuH2r8h94+9bk1ZijHfzNYmlfxXbq3raabfCjx" +
+		"Q2urNosZ6mspxXX3f8nWV158h2o9YW/8wtuixA+trdpSgx7W1jfV833rXVhHiYsyc1GtHIvG4kyg" +
+		"eH3bVYWq9VFaMsJYQoIxaah5tC71MDkMo1I9RMkm1Wh7rPue0i6AamN34+GBZE+J2kE+fsbsMFkv" +
+		"o+ag6z6pV61mryhmDPU5B6I619MEytGsrh+9aE9vHYneGIfemtUbW6v3FUaIsQzACKvzm09xKdgD" +
+		"K4tiPn/EITp5JLb3jQXC9MbCxPNry19bDgr8cEy2L8RkfBMmBzXiN3K3HNKOEI9sKB3doYGQEVYe" +
+		"IRnhmUCvb/1dPVmUvrJ6aYH7TW9/EWC/STUp0Wnaqrux2P1mNtaQcz5A66R8ETDGA5HBjqhSKZhN" +
+		"8RL4VRolyBfCOH7T3IlRTP6y1C/YNVTvI0rDb7RMRvWQAs/sqawmkfqezKNUk6wa0fKB7YmQ2VkX" +
+		"ONAFjkPnagRrloPhzrrivtcAzgaJsGo72AKqkSzMIl92Sy3zATKV1eTM4DXvzEMjWD5Qsf6bg45C" +
+		"KtRepVNAXZ4tXzWPlOhktsskC6z4jRpCah/cXCFZSXj8DuQZecFdC0Hb41anygZJ//ZCyOiXm+HG" +
+		"kLGNiVvaTIY8KV/GeqFu2Bf09AZSDdzPnvqnMpt2UZvrEhdczHXBxex4OdgmNz9m+bmvAdlN88bQ" +
+		"0PA8hhGNeHbCiEZ852SQFokHfLI/yJPnMztATYY3dzPew1VBs36joNxS+FR1f7wT16UcGzmLA01S" +
+		"TarhRwPU54eV+dEYrfQlEEx2cW+JHqgSDu4wwbevVVaTjWApkDuAcbiLRTI/u4+lfEFOiwkxGQ2q" +
+		"g/sYeNHnyySikKbydEJiFHOuzLhaBSt/s1nZch7AZc1Va66SuEHVN4iVrfrWNAEmlNahvMNdHGii" +
+		"gVV5s55v2/63+bGO3xqo7bsjVGXHI8MEJNpFn9TNfLQOEqcUQ/Pr2CS9vlQh0aARWIHRgry9bYom" +
+		"rXez3anjbWmQGNn5gUx72ihCdsAoJP45CUWU8ESIOtan1ezJWMzd+LVpFROw+9/CffgW34nvZALb" +
+		"8aMByuBBpc8+5LQ9hCJSwvxEyMMpcVaV8HQZJB2RHVatc8LeGUKyTLI4ld/fCO3x+sltKsxWnxsb" +
+		"opeXg5rSCHY8tbwZG3Ux2Qge4/tgKL7KuGptUen4m0KiAZ1+s577DaE12H6jK6Z3ySZtab95Cuhw" +
+		"R37os7YoAqgO5uK+zMlCulo7HvfvlHdQsjiOaskbGANBOrClB5qJX5EnZIvS+b1RI0I+EbLa7CWH" +
+		"9RKbieHud05ll869RmTWU1v9lHX3+8wrzQkW705n3p3Odnc6283o7Dejc0LfwTQqcRo15AWJCSIg" +
+		"TxuPq6RN13FrRgG3MYETaNNw9JwStkAhKp8ItdqFPwE2gKdtUgOquKEfqZRoxIQ3dq6Co7mwi+g9" +
+		"yMx7kNnuQWbcgxziJzUhYWpA0mhNw9+yyyXajTBHOGmouWKxekgWow1eUiuHDtOFt3oIEh3pbzeA" +
+		"lNDEem8w895gtluBGbcCM76enwuyxwkf65fZZqFFO2ZhxyzWDwv6oQ4pvNGX7d5cxvhS0DQ4nZcm" +
+		"36l1Y+0Zpclezv4wI/tIaVLO7kBThaDWGG/CsuisQBNLd75MwmAO7M4Fb8lDqkGz7A+EQhEpm0l+" +
+		"LXFRPArPiU/2iFc6FqQZ4wrATKiiPhCVCzakcxO7bWFnvbvV+4wI8cvSExkpjGZ5A8zNJmeKd0B2" +
+		"E4XuMOyWk3U5rS7cSTp7KW89i+NVMBVZwveCbfJbTKFUodfH5Ye98cf64Q+vPQH5crTa42q6aMSF" +
+		"xLweUIkUOhE7bxrYqebET5OqrCbhx1PNefsW7Wvn7buUCUxms0QSkmb93c6sN7uy3uzKvNmV7b5W" +
+		"xj2rrGPpiSi+c5cxsJ7006P45FF88neUAK2TzLNBXCqaeTb3bFjYGMCmO3e9njmRgx7wvCGlspps" +
+		"gGQWy9IGBdyWyrgtlU+OVqcPVuccq04bqvTXoyEkGlgEfXmddfp11jmvs067znonDUb4GwHCt4bj" +
+		"JPJbXX6rz/61HSny8xRG7WsrX1sJ2wOhRMoGqSUOwLcxjRirU1kFIeCcUEL899lLnaQJ7mfWVS4j" +
+		"N3aZFCfOeFbj7mv55FIXVPesAXLJ0jZxmKZjsR7dsRmxtPwdNnFALB9jNoLR/JPVDaRzxEVpUwPi" +
+		"C9MEmDTUcVZFvgiHSYlOzZwPVmCg2Sol6mAhmqexldFbIRvgfupUyZFmFtD4RpARY1VoCczY/DtO" +
+		"OSV0TFIIMCHE988Iajr7o1QHiY7MVFk9f2sAj9/YlN/ckt+o2++ELf7NtL85Kpbb+A+5D6FDoM2H" +
+		"yk1HSBWRspq0eJf4MY/JjEBpt+PsFGqvAaEqGvzIjeIm0ShJndi68UUYCbegAfbFtiWEGrOdENdd" +
+		"CKOVxoEs2hTbI0swKQbVQNM8NdB3NiFhDXvYE229FAfGdvy+iiPM+pGGuiDNtfw4wKQtoK8ZZQfG" +
+		"XIz7xs+oGaGZqcA1sSy23Ia3dIbk8cLzUMHjFSqayYfkvDIi9EY6JAyv1E3CUDl+kpghQ3wH1YjG" +
+		"E9fEjmauqBMPCgDitA7a5iUgVHtBH1MJQzko0Gb27WgleIxwxMWggao6WSq81VFizEIRKeHFnq09" +
+		"g0nwW80lykuOiAUXV/rKmoNuNe6y4zOMJY7bnyXi25GQsN/he6cze1RMW8omYZiLrItiURINqoPH" +
+		"ES4KbhwFv5VEUFcEPyHrVUDRvHXZtkg8vRLOesDJ93fasMQxWZNkObrgszmLolH/OUM2mS8jjaqb" +
+		"UETKatLiKbEdA1CtuumjukXeyPxteb+Z9W+csVRKdMLpkuwOzP03T2zyu8vRUMD3t5jAq1uOjczp" +
+		"42SL4A/cT7aIV+mTzEjJBDWGfZLFQaaDQiIB9R80PAbf2Xvv2Mo7VwXLPXXsJEB0akbI6+73Yx2n" +
+		"x9szKHWSxh9ho6DaxSQMNq8ghWz8lkmo6vidPpzAFI9tom7TgSVHArIvOg2ShdNgjeRH4wnD9Ai3" +
+		"1E2GY0WPtmZQRaIRmklRXSVVCqhVKCIlqg+6jLpDNhuTM39pLF3YHwH5cuS54FE0qJ0CthGmbw30" +
+		"ubohkXP6/sYIDYhOzcj81JA1gBsHTgxZ2hkTPkQBCeumN80J0Qmt4Dx9j75gyB9Kvlz1BKzDwbyl" +
+		"UsDBYqG1k/cTiu6kxPy7Ti/0mMYJG8c0T6rDJQcN8JAVoSlpGDW45cLzR6ZfxAk766+rlszfVsVH" +
+		"7EqWpjd5SpazUQjgQqhK2hDqyTKnJufuAIeeVGo9c7gVjr7OcVXaonD7TCvHp4aBczF8pH3Lp5av" +
+		"RS/1Q2HCu5g8DBjzauIQnTwy4egHW6VZRMUrzo5MVKNJM1jGdeaQuT/wCFbJ2VvOW22cv/KC+lyG" +
+		"a7bR3q6zTWd7Lk3nDeONIuF+yiQat25S4iSWJlv3BiF7MiveHjJynB5vWXDJ8r3k8tb7NFPpH8rq" +
+		"9yd8FPS29jElfmgfKZe6ljrJfQjsLPhlqwHsRmK9R+6JH1SkckyaHtyzKbqMBo1gFUp0kWoymY9U" +
+		"h4tAQ7NmUIKxbFZs2bxLKc54K7RssyXLtnSakqJJ9kS8DTDgPxRnCnlB23uutUXNlysy7ee+oJvh" +
+		"erLNTmuz09rs9DY7fftPnqByuR8u1R0O5ZBscruM6GCO3J5yzK0h95Wnd74czeh5eZqZYHbbcrDL" +
+		"2m/3keokT+Q5Wt8tB34N2ShOpGtpG3/51zXWlCZo41O5DohBBfBlYgIjZt1r6GcNC9JjXK3HBe3E" +
+		"w6UHlTR8h9YnTUd9hZ2QL8fpYE1QrX2rt29d27da+1Zv3+pNWWdT+mhc/PsLxrNQCr0lT4CptZAd" +
+		"mLa1RHnSqZc9yWS69YrerxG6LR0Xbhnbc+74xUkjGg8KnInOHVOQAWwRkHkPOYrZgwa4JPDLAX0e" +
+		"W19x0VOhXl7vt9ApwrFQI0okoAmV3jKJzTY0SCZAyVwaGltkO8vZIsRkNKgO7mNwN2kGMxWEMCM8" +
+		"W0/2PTtTs6w8/crhiK1DgN8hIqi34Blygpk2Snzf3NGiDfR+BYEmrRs/1AdonWRNixPoTg+0CXOc" +
+		"myTLFglywA6R8/XihNRZI3hlv39bkd/JBOIxv1fJSyNnqxr1y1iiU53krtxZxJksL9kBNArNirvj" +
+		"kNyXdp+84NO8fB9LBVS46ZGAQ2HXOyyQ7OfEZizuIFIX/LCzIGoz7UwwjYsvnqOZ7D7FwU0ECNlt" +
+		"qu0cV2X1svKoleNT+3B9r1mW+qHQkX1GITr5lpgyvZ28Ioeli9aByJfzrCeVNjV9QAiK1yAumZur" +
+		"f8jctf6peHlW9c5vipvi8TNln0199NnSR18a+uiz8Y6+tt2SdvFmbLWWrRvmIIaYhbj2Z2kdWuRT" +
+		"i59q+1C9YNXztShwTEJB9bhnmRQnznjLMzXxVibHVWmL8pnGtsi1D9e3ZdlCnZRC/1DaosmiLHgZ" +
+		"zvIGhlTlH2pbdImrUj+U1W9uUptbwDp272dAizXZQ57kRiu5d5FJuE9oiizKTCh8Mm5RF8c6yZNY" +
+		"7bEcrbIR1jVh1cvZFmQnKpFvGpDNWqpMYjRzOvy3aFy7nB2lOpgnT057Oazww84+ezleOWyyskW4" +
+		"lKOZw4+EvGB/fChMMTd+ds7zCNmB1TgPMRkNzDttYU48qGdZlSXm6CsvWXRZOa7K6mV7k1o5PrUP" +
+		"1/eaZakfijvO/GZeSz76OJejm9vu1CelbbK7ej8YzJbos4X6shV93Ya+bMEcec9l4D2tzby97Ag4" +
+		"/QA4vfms6V4ahK2blOhUnZpHW7JgCyCK+TKy/vniF1OM3Ejg5aNSOSZZU7h2TW1JgKdVTcPlylTM" +
+		"kda69EjVskx0q7Wv8WcMs6zmYs1UPcZbpqZWjgXNwQdH45A/teWktxrlvxnif7O0f1pmbeZA/ArN" +
+		"peWBFxxAnsbPHEQejlOztlkvMlTLsmCbbB74DK4jPFgXTj1fNrl8SabI5pHtJExkvlje2Mc9Qxu0" +
+		"lc99QTM32xpgXHhx8XKONneKKYsf52kvYc+wOfNLrGf4xPklVrCXavfJDNvktCqXY5+U3Wqes6A0" +
+		"69zTUuWevJuT87VqfvgOvdQFYU55tkbKS2Mk7vlk+wo/tkVwHwOrqckcNm8K8DW5L5jNng6HcPSV" +
+		"mysSJ9YFp8csPx3TOrObmbUw6zeUvrLVSnz8zH61QOS0jNqHl1diXlS8snWWLNPZD7yBvquy2FCd" +
+		"vS8oWfFLAb7zgOb826Qn/z0df9vtQ1MkLspMYKc2KFbR31blNBswefOlpfH8tE+MK384tcm+acsE" +
+		"wBX3S32SZeT5e3Zzj6WPPZbWPZY+9lha9hi9L4ssCA/LtRzuy+WFly8bvOYKwUCviS0KvIoJMRkd" +
+		"WDKe/iW4u4Hn3lKXSXGiZ5P6TNXXdNa+5OlU6oJm7ia9kCUz36yTA2fpLhl1HtHBz9lDkWn3DE9e" +
+		"Er+qPHjtTZRVMX9oS4q2nsnMkuVT/Ygtx6f2kdlbPrXPpMs46xZT2wSvuZ+kBy7VmqfsKm2pUWul" +
+		"Lsi0qYlDdPJIz6rs7ln26Vv26V326Q9TryXbiE9GxJm9YsBpfsuC+VoUb54zt2LXuVSmo517z2NO" +
+		"RslMMKfTxiF/avladUsmJqNBdQgztq4zNuhr3JLap2jLj3ZSc7sBI/H7B5D5MmoOmAGPso8tdOEk" +
+		"zTmuSlsUZO/aRw5sggO/0QmZLyPulWP+MCYZHrjCVMnDVVmiU53UDK06EjJHPWXGRzEZDSzGE6Zu" +
+		"UuKkfE32ikvqBu9ZR2Vzf7vRc3uLJ/JCD/76+uS4Km1RPtNYg7s2XdO2ILvmscmrm/S0L+/KpjRj" +
+		"h3PGn+u1xT8s7cMkn1r8VD99fbtUt9rWw6TESTPWU1UrunaTnmLZf7Xj60uTPVk6woIzcTrCLGVo" +
+		"S4pp/iMLxoWn95/F375ouGruWRzcRLBh2ZCLcKu6eoa8KvVaNLg9ECYxSTNEknqXSTTmZF0VGBde" +
+		"XGxjoZRjKqWu3FYFNcQJQ2V2ix91+DEKQOskKyDbUJODHWe8IQ6ITh5pFfWb4obN+S0L5mtRvFZl" +
+		"6yZZSNm8DcrmLVC2eZiUjfu17PYcm3O+Vo1uNZrk7Ne5TaU7LsT4szaZBGOVLg7RKV+OvpFV+kxk" +
+		"G1fFN67K3LjzZo+XgReiwyv5ixCuocpnpagSndwT3BblmtwXzG63up7sbmdzaSU0a4/T5kHAciw4" +
+		"PdAlouyHSe/lrrSpsWsPRaJTneS+LHsgbbbGYLiYvcRlKUE197fSjiZ10vRz8nLHbogLyqp8etma" +
+		"ieltsjVcTHdKOx9Hv8o5YvItmI+VOudr1ZYEEheuKy9OVtM026UgzGIyGrBvEZuxgeVVdop0pPOD" +
+		"6WnyEJPRwGI8r0rBCIiTFTk3pjytOudmKc/Net+5dZM2IAz2pMuCGtXsTjM33wenPW9GXFKWOsnq" +
+		"03l54ew15d49UzepBs0alYMrHpo8vsONAs88KLF+3+FmPvaWlnNcldVrpp1vay0aXP2hO0OpHwpP" +
+		"hq5/ateiMSZKDVuSVbsmd1k5rsqSwqtOrRyfGl1Z0yriYDHWl4mL2bOudigSzcfWssHZyCpbq5db" +
+		"vTl8bP12U3MpcVK+nD3ek/o4+71OXFxpi+Zc6iQae08KyU6DIFmwTTa0aNbWH3c2dD/P076etyg8" +
+		"VlzPizq5OnimBtYWaY6q3+l+zn2iivcI15qr003ixBnvJRxiMhp4jyA3Uww8cd1NLpWh0qbGE95Q" +
+		"rBR+qRxI17bZgQGMCy8unrBtS6ltW0ttdiPO+SMC1cnhvjSia41qF4fo5JFWi7zmsCRPW3ew9Gmb" +
+		"yRIHxu98ehcDTjNyKGzlUnff/4OPbbLXoXy0fvEGL/gNPSOPtvoMbPvEvuB0Zr8utsu4kAaQiZ4r" +
+		"186O7zG0Vb9HOXVkWKWKAx3K0R3m9rkW8qc+U0l0tHoMhgPe81EZHXiEnZwWKbi7geWl5CmsXvMi" +
+		"4ftcqnuyE51Wq9O6kC3GHPeAEA734Gb1u3Pp4+7LHfe5snFfVjbutrJxFz6CS2IbQ8nOffr0aa8U" +
+		"3E182e248+Lqzt9QAnkt7Ek/4FsMkTaX3U95rsQP7cPRsjW1HP9Q4V1Dd/DZgCvxQ/twtPxNLcc/" +
+		"1A9vDhb3KneeR4jNucuCceHFxQuV+1IklZD/ofJQ/LD0/25pHybT2gSZuBbDH8gylskvKa8si4rf" +
+		"8P5QZ572iIxrlpMPaYp9EvtZPX0kUDYr7rAeWK3iE4rHXLDCY4lH4pxnSEthMw628781kK2fnLuA" +
+		"ZcG4cJtsGeCtKJUSneqkZrikcFt3QM3/fd5Ddpi2LpPixOZomZ+z2xjT6di6g3vMpWoq77DyGiEr" +
+		"rxF8OwnqJGtFMiPqUr26Vs+8rcFPThwV3MeAm/vUACnwtPXBh635oQt8/KYc7H8HXrtRaefIw+6Y" +
+		"KjHVMSf6xzJfP/ztGsPFzO552MsuJPcgoCgrJ/mSKhFe6W2n7cPKs9XgAdXB3MXAMi6pNTnrynAp" +
+		"87RjvESwsNJdRgPzshLOG88f/zmlmrRWHSzRiEkGljqpGRq07kBTaj3EUj8UjUIQjvuZDinnqnJH" +
+		"tC3Z8ELkg86mTu6pnP/Q8vWpHzPt9F39/pvP4QMrPhc9SeJkNs3yhWlTkFQ2OycS48KLi+UDpRwf" +
+		"ivmdtoONWXvL9Ghcsldsk6yko5v0JLPkw6r8Hx/XjZeIvmAK+VPL16KbUt/BHkYxbcbMbaXCc+Ki" +
+		"93/qbTF8eL/lQ8nXh7okK1xDn9oaWVdOn1EfiufvmVmz1qU4b2BY7JJQwY43U66pdFn5M8Z7alqX" +
+		"DKY2IyU6Wj38UrRxnW5I87sfIpPczdPeD5uJGrtPqZPcSLDk3Jn2pj/IOhu5LUr60K7JfcHs9qWc" +
+		"uedS813lnaf4DcaB3oBlmRmqYgmLO7PetlLYSk7R9hU5rsrqZdWjVo5PbXUt9UPxqJmDXZ20su8s" +
+		"/rCzDrE590n5mkyH2k1a3WtPwcnKrJ0roI0PlRz6cfxzRZb5SpvN8ozztWreg19prqBBuRytFcg2" +
+		"3Ew1f/h6HuIQnTzSNsdnEKBmqL8D8aleVKwH2o2bNu/aNL9l07rkpUu5ZpH1WCLTy/oOMC7cJnud" +
+		"02tN3M/lEKF6LUpfeXp1WTAuvLjMEvv5UeK8kFlUG4YXS7/+Ycn/NLQPfdU4nvaKZ/RIEid79Sof" +
+		"uyP2Bc3MJRqQZzIXaaiU40NZUs/6JJsbGi9ehufsV/X0os+l6HMt+lyLPre1eanaoLno7cOwam/5" +
+		"UPL1ofpRp4ZSV14iHD2zWXfxSNuG88afs/ANOTNFqAZdJjWiSz6I0s7cTS5TBmjX5MWpT5el9GVa" +
+		"TO1txdteOLPvgzNbLr7wRjSz2Swt19gUrOuD+8qevDoZeNn77k2z7z4AgPO1KG3yR2Lf6n1ft3p/" +
+		"LTGv2QBgddK69rC5tK3v9hC/EkvrfPL+6OHOnEHTeK6Yr0Wxftd5QA85S7NDu9tTtqTliRMzyIcS" +
+		"P7S2al5rv4bvc9gYaNOYbgNID7s9COy8RHRZOa7K6uUl7POlXdNK/VCQDDnxnnL3e8V93hbudlu4" +
+		"y3hcuMvKcVVWr5n4Nc9Gpr3lU8vXp+4ZlRoO4Vjuuu3oRc+rh50+TfW47mB3JU2ZLueSez8/su7r" +
+		"xKHzjnX329VKdZK7eVNwldqmj92vg0D5mrwUM5vymO14+LHWl+vP/nH12f9x7TnnqoP2pRaMrjt/" +
+		"KXdRLEHrJv3AEZ+IEJuxbVWbdW8W+7ubdAOvGonrYWcW+dTip9o+VC/y93yqq6e7rf8NtI0Emsth" +
+		"VwKKfVLIK7epGN7nPIjaNbkvmFe7+Z/BIU9y1zPMap0+rVbF28kUj/IEEp080looHXOBFIqX6S10" +
+		"fLTQiQl455S9+5S9z4m6YjkW5Hlpam1RnQlnNWk5+3rdQHjVcAs8/mpYHiw07S2fmqXauoN48jka" +
+		"qLLycZ9cjpVnjqUuOM2TbLeS2Zg1xLT1BSUuyiw5prXsmD5KjzoLXDOcMePg+0zqFnP6zuVnQTOn" +
+		"7OCmI9rbDasa/6H/w31uhRnK8d8Mlib3MsmM6559rd6v049LU7xh8bFfR06RqDVnWbDPtEs2PnIO" +
+		"5YfPCHY/pdTQuoO3RVs2u/n+GJPfBePCi8tM+V5Ge9foKeNsRE63s8uCceHFxTNWpRwfyuJX6sof" +
+		"Edzm5MXZDFFxNlVa8vd1aGPzebvVa8x1adD0c/JNKNmkr6KoInFiXbA5L1mYcZSVQv7U8rXqsvou" +
+		"MX1BiYsyxxOox33RkNm5B18JdiVfrhpJtuKVZwpre95p7nan2ddAut9L7n7/uM/7x+cR8VEbEoz1" +
+		"5seosUa8/avShnHh4yPG9vJ7+dT0VNaohVnV97xxQgzZlbcYljoJmfDo8kNrQHVwHwNf2jdeIvqC" +
+		"+TLF6ufRHb/QNLm5Uo4FQ/5QeI6d+h/5px7/aWj/MCyVWZY2qIrUT21N7Uzgw9YK0cnbBjx9bePt" +
+		"yexBXpnKdwqBsxWohPwPlbOCT0v7hylfHwaPFofoNH29dm2p3VKhJSerQ/eMutRJ7rY81rge4sbT" +
+		"zwu0bflN4al/W0VNBM/sCse2YpvsGV9W02ua7IC4/HjAY45vTjHePr94z8nFe84s3su04m2ziHey" +
+		"yqTDDvjklUqzSkBuVXpzOAXZVqtCl/klO2fkiU9sqowGFmOFlWxnOUXPJ59LPizHmqp4UxW2y5mb" +
+		"OEQnjzR/f/nLcHq8ZUFs2QjK+Ncs8ePapTw1uK3QlOCFO89DYKgvvAc9pGb4tO8GkxCZhIIqyypn" +
+		"N4kIhD948R+gpqwBDtliv0vA+wsFJ9DCk2fJuPwr+PBh4TnJbgcU7Ndin37DyVVbDe1VjvZMh4Oa" +
+		"XhqgtBdKe0nF0/tKfaE4sTmy8Ne8A0V+y8r5WjWroulTKXVBM3vJszBEma5ajbZAYxgXzteiLP7M" +
+		"gko5plLqyppEA90HHfuA121Fd9tozFfYpKdNaZT4Eg2ihuqEF4bxkyIqaIXIpZuE4bhTQC2ny3wZ" +
+		"sTlfLAc7cojxO3sL0qna+tGLDTmkZmbF1TBCjdIuz4+8vjAovzgiv84cKEzt4hCdPJK5zwexgG9Z" +
+		"UJ21MhePVQDsW+gm3cCiFFnC5bJ1k/DHL/eV11X5OX+SxMmWuNqn/V/XT03cduIw/+f/T9f/JTuv" +
+		"wmrc4P07kTOO/qr6sgfhBCVhLwzegJPXa/Rd6HkkyD7nq1oL/SSEwBjj/84pnbOF4kXo4hCczIvR" +
+		"ge21oK7PUUfdcEyjsikkpKrcJFGoGjTBJotfdh1jdksmMfUrJieUjClJ3RakWVtYA84xKn+cqNRQ" +
+		"MgVrsSewBtHjvcEH6lnL5aAmjYjikuLTL0ssGvwqUihMj2If9p+KZj0jUlWifUyO04X+KEz9YQ9h" +
+		"uq+Y7isnef6MIX5JbKT3Kp9JEia741DUe9fEvgBKQo6m2iH5tqXkAPutYvOsGb8JohJZ94pv15Ng" +
+		"lI0iUdKMGFigzNlyAJvKI2+V/M0QZ2Z0Cqj2OzMkGpO345FweUjpIyvCId7FJA104mcSa8bpfc12" +
+		"bm/vc9a82+LhRzpUcolqsd7P/OEOvPtZc+tb+pnkxqrvOdZ83ssRtwXhoW0oKW0muTWDPRemvFEk" +
+		"k2b3UtkKZS/Dic6QZqkmzdBNquHQpNTNZHJoRl0mpcuZ9R3LFwuptJ0sHk58eoDCOCjUhIIqHr8b" +
+		"IIDTXp8CdlCj4BwGciPvBlYkaEjdeNVAiavYFrXiaT+VPEJXluBUnZq7WhQ/kzXc0qJ4DdgHGbo5" +
+		"XUY2Tqud9yrRxP0eKUyc+d4e3/c5L05vWZkZOtMtKF8K18Citi+9f2trprfJtHQtOjxjKNWBJqGA" +
+		"WvxL9s5hVVYvq7EsX7JftC/X9xqy1C+lfWloNb7EpzIY0M+XtDSvs3kFfuJARiF7XpcUJs58Czuf" +
+		"13Wm09FNWoTDxut5+Hg9j+XElmoSZ6vlmJUcX3UkL+fD9MSjh7Vxt9f4Ef/atlhNwoC5h7/qqMAa" +
+		"G2ejjk+5q6Thrwo+tD2AGbFxB3PmoNe16mkv+pDCxObICs/5kk998zgKMFz1/6WJRuFv9fD32Auf" +
+		"YxtiPrFn2kWWYFAdzG+GgSULhao7D+sBnCqh4Dq383Q38h/tXbU1E7EYf4lenvZ9dHLrK88Muo/I" +
+		"WCdUpE1ufeWZwbJ5O/skZh8lxb7lLwU7kqnT1QSqPPTnz8uYSfC+HknCZHY4FC2FsdhsLDYfi41j" +
+		"cciZZ5laqG+VgpUMMgd815S3B5qX42lV6/x4tALD+cejG7YvdlnHgyAqg0F1cB+D6Jlx5qL+UU/f" +
+		"7OYkabjowO9So0tY551hbBE9poTNhaRGTTq2e5XVwbMM2BD0XWc38ZOn9r3T3lFF53H/ACtXsv6k" +
+		"7MpWxKVIWNDM/JkxQzN/SgkLmnmsgH4lwSkIaWSeeoR38scjT/4A2pCYPkg0otnnc6sUan++kCLE" +
+		"0710UU49FD5T+B/dX5wp5P/pCiIUcIsQ+CS8SjXke0wmcQQFFMdSJ3kRfxln0TzTwd7JMYVBmyST" +
+		"Eoy4VMRmXOokNwI6Uh51gS4QP8NOojvr6mJPoDp/ZbRFsUZhxKnE3WFDCYuyePut4EVrq/qWRZs5" +
+		"9XnykYsvfTr0ju/VL5qEb31thhq89G1Ly6KbGv6j/8d9CXdLuJc5Ffe9py3uX4qEL3UJo/pS0n85" +
+		"m4Yz8cWeVXX/GVfaEQu/5QHD320/0n9VL/nw2+xQ8UklZwlfWv3W2qouC/Pgx5aglJhW9oylgP5+" +
+		"1HwW5Nt2TUuXlKLdZlGbbUzAsLJ8aUtt9rl5KLK1pWepLtkef/w8nH3f7dvQVkv9Ujzr2LKsvGTU" +
+		"vvLM4HtqU5HwpS6LpPpassmXEr60L8c1Si3zuxJqqbLcK/iPxU6i/5f5+t9G95uAYz/nbR3fbvhy" +
+		"l7BqS7vtAJBK/ln5yy/PDb8dS/Pb4Y1u5yzfJZf6pXiJ9xbTyhJWban0vS1j/iO8hrloltkmWMO8" +
+		"hR4vudvs/Wq/Wzn4MulbRr18ENer4RMKzhbi+iwZcx8FxYrTmVV4kUMTIAJywjnYKzbhHEm6SRq4" +
+		"YIfv4A4MtYNjRi9snLy9oRJHU4bpWhQe/Y1pg4cSIBhxWKdSghHrrzzS4zeXhuClbEPLDZTVpGWY" +
+		"5E9an3YL9cTmzfukQ9oS9GUB+lf7u7QtT3JjFEcWsztchsy3Ze2+XN1bkzzTjpwVZ+XWJP4IM4mZ" +
+		"JvDoN4nGC78NR1Jju3NpW8wUqtrW3n29dF8JOv3oj+SPFNdoARIcsThgFMoQFSlGzFt/Ss6BHgxT" +
+		"LUbl4NPf3O8ONFHQjt4ZwA6zG9bYDbxZ3prq7ezoB0C6HD0KgyBHr7yPi6kfdOkQuEQ7yFeWKhdB" +
+		"DTf8JrFKGGINJmEoF4Wq963fX1IXVLM2HxeKP+ywj3UYLxp/nrEmk2p4YQ+sEgapwSQMmDJUqoER" +
+		"NU6K9x+TDcBcrp8PqsHk9MG09LEbAx+/NfA5tk4BFXfHVdLwV8WZbdGJmotzuA/n1w8SnGmohCHF" +
+		"w+QwjIB/+eNSf+3HmPBb4/iyMn9S/G/74FF6wMgZq+PSZlzYHC9dpRfX8YVzy4snkNdxIh3Kr3bB" +
+		"r/CA75cP8fzaYze/2Ch+sYn9Yol+udX/4or+rw2m3+vP/Qh/7kf6cx/LVLebJjcJlNUkcvHT44DW" +
+		"SekiIGq1r0MNQNazbsxJcayYql+NqtsNB7xVD+ZHijMWowZEY/B72JD/eAGwOVYC7qga0cg2KdCU" +
+		"xGQwsBz3Tov7Bam/NExgFsV5mFRDQAWB8QPDB4v+6EiR/ejs3Ue33n109u6je+8+upemjov+Rpin" +
+		"oYnZ7SsSqnBnb2xOWpFGfj7ZkufTWvJ8egOeT3iqsmuyYwn2XapJ5O5s634gpd/h5pJNogA6btc0" +
+		"34RXsMlabxakAQK5eit1SMTF998g4fCD9L41mcQshvrhQucfW+j8w+7PP7702QZS/qHeXlyRRJpR" +
+		"MEdGz7YgWTO0Pw5BqhkHOwdPBkAyI4rJauA5aOlhPXocCI/89iqlS11ZM9oLKWrENdohGQRL2hpK" +
+		"tsZK8DuCQ1pndEEaICqF64xn1XbUYwP4vXUKNb8FaYDIFKjzLdkqxX6/4guidXtbNNtQP5qELZiE" +
+		"NSFF+E+SapLZ0Szs3E+yzv3Y9qp7IxXHdpcFadaWflRRvPCb9QR4XSVtJmnIFGjaVWwUX9woLk1/" +
+		"BWmAgI2N/o2uo8m/HLi/Pm5/GXTM+VW2H6QaTTYOfYXWSYmeFkLxCaAOjx0pY+0MtfOSKNCBRazC" +
+		"3SvcrcJ9VrhbhTt1eDShCJTIbebdhSJQIr97vp7dEvRK+eS2KH3lmWH0Fod0OXrjeXcQpLeNBpZq" +
+		"knl6WExQ0/0lFKrqMvAWogK+5eAoYVG4hNQa+XdzgMlvZCknMaClU2yexauKiqwwWneGyIVSYAXd" +
+		"5KfU/vI1agbEKkiRc3qLzqRHzkZpsVoctEGPzKrID1IoCSmUA6lUkzRHs9jwk4PDTw5vrHBSFTmo" +
+		"q8eOo7gBXUwGA8th0d0H2z7Hzo534ifKYj73lWcG6kaF8M+8p20UJs58tiP7Pe3J6lSwivGRhCql" +
+		"lS4OwckzGU93XYJdlxzahfPJgYrvzZZJzbA7wIQUVtbX+mNLSed/eytuQHJnFX27C4dJj5mC23Ln" +
+		"HRglyJL1uEHeW2sTNO8d72JSDZ8/9jzREIkCZ/n2cFEVnJQMiabqvB9vmtz06HyABMpqEm48RI56" +
+		"IKxnQSOF9/1Hukmpk5ohyw6kzS2qIwyKspguWfyRMYqijqKIURT3qI9bELANkn9l5bAqbVFYuWpp" +
+		"FjcXv6+5amsmihxIWc1hQQ946n0WSHo0208qqldGr+cnXPJTaKZjxhG/ymDQCOwvpdYNvaA2MpcU" +
+		"s0zSsgcFDrBVBoPq4D4OeM5YmdWAUBUGZ2wR4kDKp9EcmSm0ob52cAEa5zkFN3F6U3wCWOUxsyhg" +
+		"b/daWltQwqqsOQzZBfObAlad3plXsSUHVNzjHVsuqRn+yqQwsS64+E60Vujd/SH1t/wIwckzzZ+b" +
+		"G2+q1qKHSYWHScUOk4ofJhU7JCo4JCo4JCr3l3STEpzqpGbI8gNh+0HKYniwfQByS4hJJsF4moCu" +
+		"ZyPlwfoeVpudOBacopTY8BaKkRp1dOOZw8rHGqre2R0pVpJeJa18Jh9SqlPrhuky4rCyR+8HZLn/" +
+		"TGIuREbK6JmxM5fCtno8L1/LoUnS61AE2LFR8EHrao9RV3t+uuqj01Uf7DXZZZJmNq7wxmkUW0Fp" +
+		"7bZVBzPhQJhkRuvKgR8H7DrIMtlccdQH+kI2nRrd7y92JlHCongJ1ayEfYx/UTzLzYupexgLzo5p" +
+		"tnhc8a09YpVJzH2NC40LSliV1ckrWoEOGFF++llaw9VfIxqPWdmxxj+i3GVBmmvZwiQaO65EldY+" +
+		"+tSqEbM/MYdJbmwM/yk1TNJsNsmb022O6L7pK+kinnA+raNOK3V6B+mlpnJyhgc0I6yL80CwXrJJ" +
+		"9dBx+OEQ/tgI/gSkQvk480KrlS2AwrJug073h2AZP49SJsDFSmSkLJHdDK+Rnvj1aEgdA+edZy0A" +
+		"OuGeGMltfx0WG/ZJzpahz4kbmfEyiaadWkcI6HgF7e4zBPUMgjRR42A9A/3NGz+8phCdtIwecp6C" +
+		"o4chUUK47JIspCQrKolF4aldfOp+4OTlxfNhRnXEUD1xo1hlNdkIlFzqxObwLeF67jekCLDz0rAC" +
+		"2rvzGPfcua6GDAaMkbxYmuXsbgfZglyQzIC2W/ndi++z9A6nQ7pJ2A826LAGHdYgOxY79dd3VEgw" +
+		"qAaNEN1ktQ+06rNtrIO0JdwZnbYzOtsWxGQwqA7uY8B4WHlcRXqJ6eTFvCGrSWabmxV+2UHmiTa3" +
+		"vt1lyws2coJkZF6fVrCS0QL5Utsp24mD/LNhwGH+O23+O3WzxCY58q/bdsNYHqQWhLk4/V/lKTne" +
+		"J8HooI+OIJSejl4HDjhVctq4jl6euGZt2CYf6LlF+8pkALWxa1TyjIBsH2Rxfc2TsCptUbisqqG6" +
+		"eP+P4TK9/RmL3P/ce/5z7/XP/fxzP2/j/3a99HTeqA1UfdR86knoiVPQ84bceEe6JcgkJpE92nXe" +
+		"9MBviE6BTGhQSgwUqo7k/lNSMClOiKlYLyP6FwpVzx0tBgzTiBH0rooKUxukDk9A6yStK+ix6Bme" +
+		"kihQ9Cnm8LTn7RRVjmRUpK5axeOhiRoeD5R8PEYOOOJxZMhhThHPxpwpaaIl+fLEqXdrz2QfrDIK" +
+		"E2c+S8wPVU1Wp9K7mFTD4b+CrZzEAJYau0l1Vw0vn6g0nbn2+3nA/qUsFCZ6OWs6uGTnUheEO1J8" +
+		"H5yA9uIaKWT4Mx+FU2AFuF8PObL2Pxg2FBIIcLcBpbLssiDmsXO/4Uvm5x5Ho8ctrZseETlq5s54" +
+		"qviLko7a/v1MEaLkuN9WPllOZRYegZw56wNFsOKQCFKqU+uGWklWVRf9GP+3stXgoOa7vq4GqYbH" +
+		"mShU1S34iDgMGaBWvV+hQgJB7ceGFArycGp1HlWnlWNkjQbP32S3H0w563bfCqRO+ye+JnrWrSK7" +
+		"mwiUcOJ4sndPTn81+eQLKOf8/uhpr6Gc9aYJYnFSqzfk4PmoAXjvzkhztQAmnMoJp3LCqTa7oHfR" +
+		"bHjoWsJ7kRyoVfiw91kf8qHQjLgh1bzIFDmIHgvSRsHDRfIFOpvJ5BYOZFxRPqt2CBchoYWJTUxo" +
+		"UmKTUsTMBwhOnsmWccKtNt9WO25Tggs0bdM+5taSF/yyqyfez4SEwR4R9Z+Ss1PX+SNyfv561hrv" +
+		"+r6zUXVEJ+Da6FmtjyuKX3iOw0jCZC4IFJahTbUmSAMFGtQQvpknB6u9xXTWhrFqv3ELst5rHLX4" +
+		"0Vt9nOyseBYfUoIRKxi4s1uMmykG2aQXz7M4+r9jjXauUHZvt4b1xv7rjR3Y20XBUG+7Lu9ohd+8" +
+		"12UEIwyIyg35zXH1tnH1ttijroajbpWa3V7Yr6iUYIQyik1BCx+axEBRqkMjQWqKBCFt1Q7gEuHi" +
+		"h/0g4dk6ZlyVmtEL3vEm5UltQX1eQ7ULcqfgB7bIRlIdGGXnI4GTLcMPR6nJpDBxetuC6LN/5+jc" +
+		"bh+RPPv22S6TarjpYziQahBNtM32lSv8mvLZpcUJutq6C/gz1TI8Du12HNojVChpay5pQWzOZ0Ne" +
+		"kJ1CzeXxoICKBcTXP5iwtZ2B+quWrU+CEb2kMl1GyEqCW/6OaJ/ukjt2iOMG6G6y/bl//tw//c/9" +
+		"2rImuje6buMQI+FRBFMu4H17yCTmZ7z45kjzoU82G9HY5C8BTzE4an6pT4qh3tOm1ycJMAnSAAFb" +
+		"vFNsyUFbfMcUNWTrJlGm7Lgm7khzFpNmYPRySIjbgs3YgLLXkicN4zNLpxhqivj8AMFMle3fby7Q" +
+		"alIjDolG8TdxLv9NnEF6IH3h1PTK/Nl7wjDxl98v/7X3SytpWIet43deLtQ3X0x0dPOlcKSt7ZM0" +
+		"SOe7kb9b1STmzWQDtJ2ym0RG+xP+hP/5/1T5/S1/wv/8f1vXH8AK/8+fsN3G/00CRKVoKtVpu92S" +
+		"UATKarIRKFlgaHfRRPG1IYXSXmcOk9TYr0QR7w6WM+QoELaxhJDdpGaGKJDXSIsmsIxE9pIp4p0w" +
+		"7I9HlEqpAR8P7K8HlRCTA0wj+EP9Osp1Fhv71CFYePTB8ymVQk1FEwFrqskptUrp1yHfWvhWv33R" +
+		"y68UN4qRHRP6ICZbhqitirljKRWaQye5k5XKXS3/nrFGB82pLF8vpGhHHG8pxDCpGWaCEPCQoiGs" +
+		"6twQtzF+G/F1zGFgpRS3fJdJNEqgrCYtA/JCimamfetxy5OGzxhN+/YUiu2WDANlg0SMfWs/FHRo" +
+		"PzNHXbU8Su3w2emxx9Hle9bEasp6/q6ge/VBKJZx420QA6DFhybwOSRTuC7VoZFY/BAGMBdzQC2H" +
+		"uaE9B4fDuFkfth2JJIoH+xh8kWDBUjRsYlht+a7ty3epFMyDQM05SEIXkBpQi4TospnsgAfGAYiZ" +
+		"j8ukxU5CESjhmSy/jktBA7Sk2jAIMjbkIcrZJqWLDBvjPFHPk/U8Ewsn82PFT6tYp4E8DjAgkP2j" +
+		"Ccr+cEl/UOMPV0xucjZxGKYDq3aICVpYH2SACJQowGZU0SQglXdMk+o1uSlWpFCYburFaDiKClv9" +
+		"0UQyhQSD6tBILPqDUVp/zEXbX3+iUCCX4dkX9UffcjFQky1Ziujjqo1gE7JgXFXfymo2A1pk7UFr" +
+		"1KPj+osTchAQO4TaWbqztO6ddN22l5UmIUdgkUpBK0T8iSZpyE+T2gE4PaH0eR+a5w9T3zS5bU0c" +
+		"YOeupyPR16UM1EEb2PHjXwoxi0Nw8kw0Ed3B3ujIZU58C4Vaz7HazpsmGu/EQcjJg5CTByGnHlSc" +
+		"zxdCA9LlqLm5I0WgzCoB8OW7d06rNXuOiuMVs8lheEs+Su2T1KiT+juq8sEs8+EsM2Q1afmUaNkn" +
+		"awLvbLbhMpp1oUOGUNNNn/EN23XH9noFfVDXQMLEumBzRgVgtSZ9j9EApgInVXrU/dkvkoBUu+xX" +
+		"F+QXEX9/k1AEymqyESi9ANfI7U+4pT/h/ieEPyGM4novIYwllu1eMoWuGiMxhuQ164lh4cVFA7uC" +
+		"0OoabrJ1hwYqqTvAJJkCFQTOWUr0qBANRV8Wf4BMtGKDURDHMAAWxoZGaJOI5f7jIMHRQid9ukjp" +
+		"UnFs2aS1f6D5HxsXQb8DEWR7aIJFftgiP3yRQYj94OI/sAwPLsHDGps0QSZGBOS4SDIRZobnpC1b" +
+		"QqoBtS38mXiQVo/Ks4XTaqpQtG5SvaxsRcurNRGNqv1FAVV9YOuvmwSHRkrlM4nGJG2hxBj6khSI" +
+		"Fjr1uh2TaPzo0y4T0WidMeU2/rd7EpNq1MW+6Q5XhQ3wwRII6NxBLKOXF5WqOVV3gk8TikDpZYDm" +
+		"RWmlcUNJyUSgrCYbgdKKnveXSQlOMqk51rgql6MbGVOyXqZ00vyYYmfriGHhxYVBqJRsSpxOWnXs" +
+		"SBnHy6GPSt5idUiXI8fgrXDBiy948WUpc1lqlIdJCUZLJjcdZYIKTbSJvVQK2DQ9n0ifEgyqAz2e" +
+		"rOZEDeNKFATLZBtiemVqyI50y10mMYuFrJ9Obcd9C0KR75Muo+6Qps0KQj6QmhDcjV2V8KW1VUNj" +
+		"hkozxLNkkzAkpFD2g4KReTKltFub9UxK7hvVbM3I2CQA5mLlbdkOG6uGYeHFxcodc6xOZfErdWVk" +
+		"jBmry4JuLnkSjOj7i824rNpLcwWzhspgYDl0FVuX4itQYqcYNzGd0jWZ28rQKN9iMhhUB/cxsMqV" +
+		"GDjha8sTw8JtspWFUvJUSl0ZRXCLC4R6sr7R6MTMTsHG6O9/2CX+xSBfSvjS2qpZKw+PcPiq50/D" +
+		"OKVrsvVsvEkyCUPgVH6PwUQwiQgRBz4qWUYo2ZwY9Cd1BqXI8Q6Ccd+SyWXhh2r5XRyCk2daNftS" +
+		"VqweJbjq80AGvlxDkeBowY6XFWsWtFlXxsaejM07MralH380ub8w6ZOQg6p+9D17A+Sw5h+06wf7" +
+		"dhzI4TNElBKc6iR3Y5ikHyNSmP1iPDOK7jjvafMOGTj96+rPm/TGs6zZrGnVWla9YVUMvIGtxYf9" +
+		"MM40SFiVNpVrQY/xobBSn5mDopJt/RFhtgdvwGIyGFQH8xcDq0F/vt2sbxwG3As2k8LNpHDjKLZx" +
+		"FG4cxTaOkpLokwQLN1doLnnZMKAuORIWbpOthn2PzTrBFQlf6n99EWY/SpNJYeLM95KHPspg1eCW" +
+		"yEQJq7LmzACqMYA+EMPdpSsoZr/uubCEVbOIppb8H1XvJPzH0v+3pf3H9KVT8V8o/NLCt/rt683r" +
+		"85cKg9jP+zglCYsyC9WCeRE7nmL7r+J7r+L7rjL3XGVu1WXZqMvcpov/ZgCVWQH3a6WWc4I52hMB" +
+		"qlCc6N/zsJGtFCY2R2uffnHIIDiZo3cAauXBR7ncrI7Vjj6qH35UP/6o8wCEiEWowiJWQNzLCu5i" +
+		"R2zVT3zuVY7YHc7AKHxVcmF4c2bjact8287Xi1vkWwvf6ndpayqW5HLVDgH402UGwakZoUiYM7Gh" +
+		"e5Q6aTGOKsN5F4pA2SAj9ieDUAE22WBb64Dpo9iMvQAbNDc/+7iZU2IrdJVoYAkUCIMTdvlnuyhU" +
+		"3TWBI3eZovtLOTSRTAEXu5GiBDed2AU3UET0xokIb5yI6OE+XKr+AIhC39KP1JXVrd23hJVkGBZe" +
+		"XNgGKto3j/G/3YUiUPLEY2A12QiUCPbYEgcIKUxsjqsv6zWG0z7tflTx0BtiKgJlNclSrGK3+Gfq" +
+		"JiU41UnNcCmhNlzEe4gtgfBiA8BcWErwYXajPskcIa1nxDtGvF9kdovMXpF7pzD/6bT4WL+JjWZS" +
+		"uiZzKFEzLNXBTJA5UGCnSbI8sVypDpZnDcSB6GNU3Otcoqkyu4nJYJCsreR0rVpecg246RPN3ZvS" +
+		"rFtym92S29otWJDKKwVKHSdZzixnl7pILF7Nt+JEYxCXyC6EPdhJrIwXNx+8Ia1gBpzDPeKtxjQ3" +
+		"TlNL/dY0arxHP1Okcjl2kkt4oYFWBl0YE63JN/uIK64qzZWSS8YfgVYyESiryUag9KKxi0O6HG3V" +
+		"DMU6ADjNb1kQZTn3PWzie9is9/Ap7zG7FITrs4/E7S35BpfmFpfmJpeWbS7NjS75BpXmFpVsk8LP" +
+		"bBhIcLT45cMuKk+kOMRRqA704IHOozxhSDZ4QG7sMilMnPkWR7lk51IXhDsvgQOmd8WnGQwtoF0o" +
+		"12fWIZYy88xxKFZ898K7nQ2BL5Ja6nYGkzKpTnI3xhtI20WBzbXyutMA973gitsiKunjN0UU6dW6" +
+		"SfNq3FeA3M2Lsl+0HF07u5bbfbexf2aMyBOz5MlJ8uTMd9oUp6W4BPq/NaRCiYID6CRZ9Jl6wy4L" +
+		"hoXb5LUoV6UpugU862ZXeBTNp+JCrMpg0AgMisPmJw+SX+e+LWepVGXBsPBSAtFe5x7taY6p1C+l" +
+		"rdqiXAsy2hXYP6QwsTmuvmy1cqkLmnt5Sp4hqYVv9dsX4aPuKSKOzCKOxiIPxiKOxSIPxdarIdYV" +
+		"tqhYSl/A+MxI4fTMblZnPbbBcU1sPd4hNYsbKTbQfzB9/mOzJyBZFoL+49PoP5wZk26TiVvikNUk" +
+		"s1EyYcNM9iMyjpchAyd5om8BwakZMaA8v4pwQuKFkiRWtAteoQBTWggPMIvzY6MTw8KLC0PoRJAe" +
+		"/YMrXylqcrNt0jAsnK5F4Vxh6sKsgErJX8rq90ZF943HD4oXoYtDmjavdSitSf5SmGXdFu1HeZ29" +
+		"uD41AClhEn2fL17AN5x2Wzg8bSBJT2GxtQ3uJueS79Gq2HnhmGTlPeYeGTOLLX4WXweKM98KZZlV" +
+		"ken07+lHZqZdk/uCabXTn/leu1c4PlFylwXDwrkvSptshX1Ix7qsm/q1bvTdIAOYkL7Ze2+/8g3O" +
+		"d2cJTnWSF48ykS16L9mXgz7maYxRpuNBD7JTwY5RZTCwHAYeD91wQYjq0DekquByS+LxxJA0M8aJ" +
+		"7fnktnxyOz5tGz55pRGwJcdGeosDuvr8q5Y30wBRKRokBOrYt2eWPveJpsvKYVXaoniM8oz3STyp" +
+		"Ue2USXWxNkPYcqAQA4uNM759q5wzSGHizLcy9T6Xp85LADse0RgC5WXjTmlQqQS4ctMAYHn0hE2h" +
+		"xm4S3i2LSTXEZwkmA0a+8/QA/FNe2QGmFPtWl7ViBvlSwpf2VZKLFfeSw4azp6k012BvJgIlHJoF" +
+		"aZ3CHDDPAejbpzMsfWtNJqmxaHJDiudVBrBoucXlUiNVWTAs3CZ7cdju1XY44MuoO8T7gjZaoS71" +
+		"Q5eVw6q0RbE2DA129G0JJ08dDcPCbbIFgMIW0Nm+E7Bwm0q8r+xLQ31xhN0qSvpIL0HCxOY4Xb1F" +
+		"yU+Vd1q+p48yZ4+yTB5lmTvKOnWUHLe7w5YW5HLkaPmrzaqYFczwS3D7qStXWJ6W1v0t4aknWZUl" +
+		"p8vKYVWWeL4m83KFatG+XN/yrX1VNy8kLZZVLfVLQdbX7XmqsmBYeClhrYaJi2pLaQtoG44vo1cz" +
+		"l8wfdjQMCy8uFkKfiZX9THdZST3PilQo0D1n9X45cRwwJCapk5fMAPTBYYNCdbA8tuOE4Wp9PcWh" +
+		"LmHh6XdNQpRRJNuP5ftKmBb51sK32r5URtz4YBggODUj+oWKC14AzcW+N8c5xHPk8OaTHvaYR8YS" +
+		"ZpzNZp7HZixrjk+OfVKYOPPZDDAWWx8HyLjFn2Ova8eOzdWlOhS/R2kYFl5cWFNZ7ktOZUtf6lKs" +
+		"1JWR0WeAvvh32zJz6SYCJT2sEaddzjRE/oljVkBwmr75/h9vJ0ZWXXeguWHc52bDPjesp4ZBn5ud" +
+		"MefG9azmjs7onDZ5QJN7fFSc8Rs2cKZ4QrZusl5G9LReo84R6sPyvCexo5WphC/ty5F1nn5xJ+PQ" +
+		"OfPQOfPQOduhcx4dNZ1NUR9tLDfIEvDry06NOLdOaDIpTKwLzpIyEbXobytBSCDMHK50wy19KbMh" +
+		"q03+q4f/Gtp/DN/1cZWbas4mscJIasStmSHmbAEtyUSzijnzxgYxeiyeiwL7pLRYzZct560d/KCd" +
+		"98HUwrf67csY8d4p4B7t+pBSyZMaETLZB5Fd0XamLYvJYNAIDMzrOodd2Dnsas7hl3MOv55zpGIH" +
+		"GMSLaCbGKFwtqVSLUeh6mcs1c2yIEVlUL2wdJa1nElRlwbDwUoLRS+KHTr+U8KW1VbNymW1SgMdh" +
+		"x8KGYeHFxUIc81gYL7aoWE2+1Q+F4WoXcbA8Czk3x8LNAU8P2aND9tyQPzQ0oJs0l9iXTGtNi7Mx" +
+		"LWJ/Q4qymBe8DGFDPVD0pwkog4HlsPZBnPnBGq/aLWFSmNgcLQI5zZJcHGW2sMo9zpBxiRmXoHGN" +
+		"GpdA4X8xizSXmKMN3Wz18PxxeRTL0BpuD2UZTve3LOjmUie58Vw3FDPIlxK+tK+S1rD58o5zoyIm" +
+		"g4HlWGF8Ks5pl7AoX05aUntNXwA58MLGcc7t41w2jxOrj8+0D0lzdEDwk6dvgOBkXr/uN3q+bjFR" +
+		"SCDAYVCSsy7YyNwCDc0MmZ9iMhh4gYwrZNVeZ5V6Q/vrTVsjT5thDMPCbTJLU9HuqpKw+wCsRi2I" +
+		"h4srHy6ufIK42hPE1Z7BrfbkbZ1P3tb1ydsaG7aryk+cgazGZciA37IgzTSxKW5mU/Cwst4N7bER" +
+		"/SegF7X1b2311RbuKF546wMQnDyTFRe/9aGI7wy5oqjfnadsAKniQBPeaTZS41sTXLRQqb5tS3ba" +
+		"YBgWXlzQRFNKNiX+/keVBcPCbbLH6hTmOGvRvmz3LYnJYGA59OULF83fuGjz/YpmL1i0O47QVFqB" +
+		"+fiMsriDVCMLw6Mru4Tf7BS73Wu8cRQqTwhOdZKXESergg8PNnt6cIA94ee8ZLxl5XStGjcV6KUu" +
+		"SDO9eQzU5M5TVFKY2BxXXzZLntVu6zmHVWmLYuWpMQIf7wBIcDTv+YQHGBH1nG2OPOqycliVtZRF" +
+		"rp2CrnjHm9BI60m36l54sXG9SGXr6ltMBod0GZoTY8W7DWFQM+wyKUyc+UsAa45yqQvCPUjd+jZR" +
+		"b7003GNu+juuMilMbI5W2XNu/8bm5AWlOljeLA7LcuVQlSSTbPhGO8RvsTKbVVQ2rFpYPrbcMCc3" +
+		"u9je/Mp64/zcfjSJKZmUYETPgXBF2sthUg2FO0lAcEqXoy1BsR2nEuMX32m2A6+PqJRgRD9iukzR" +
+		"QIfULZRJ4tYuk9I12RpzzDvO7Yh363Mg/HEvQ2UwaARrVWQ9JSaTEpzqpGZoJYsfvCgzfz7k1I7i" +
+		"58TG1+S+INtbaMOVVQBP3lVpO0nrsm3et1SFo5T8peDm9pf+7a1a377ut5guK4dVWUuxR/qyM+3L" +
+		"NbehiMlgYDlxkoe5IDsOVEEWuJbLpASnOqkZWryKk19/CWN5AwMY58ZLPclU8nNyyZPfsnK6Vs0G" +
+		"qb940U7hA/ftTH4sBB7Ut/uLgiN5oASDRkBrBnHsGcIhJgqWtNHaeUjdt5gpzCOLOJiLF8peS9RV" +
+		"2bF/6/MBmW47uu67ue47ue5PLvb5UHC3Zxi7P8LYuSvsgjdvVZrBQmUbT9izdalPNg7ErGgvmDiH" +
+		"VVm9rLnUWI89fDvIHzqlsmZ4jX6t2nhGWrYCqNNNwoLpmkpdcCk40Zu9R74YZIyJo9tzwQqYgIB9" +
+		"EsNVDwZD6xS+3M2ef+rLI5ndHsjs/jhm58OY/VVLFofgZN2qinuycLEuLLbLBjWjxY9NKEvfFrum" +
+		"T4LxrBQMaPdHBsHjL1Lm/2V1f9fjJKgSJrrT5WBx/xLqdu8mJRgtmYw9sNRJzRAQrc9AW1o5XVOj" +
+		"e9fDBrzhwhdbzi58xua9pdMWyrlREZPBwHLQ6PfW+Eo3KUxsjquv7euplexc6oJaWFI5TEpwqpPc" +
+		"jVUMZOc4b+lbS9eqewQFeyJe4SJ0k3TpYjIYWA6b4Tv797zn8I6MfKd3QsogyYrynjsgXY7cd7z9" +
+		"8OIdE8+i+H0rwbethugzpzMLx45vf+TqvTxw9cYh0ZtHRG8eEL3teOgd/Y6DYVi4TaZ/iZpfku2g" +
+		"3wUPHKkMBo1gpdCzvJOmEk0tuFyrshEovajV43f4DJsz6KwUrMU2/zc3/3c5O4VUg2YmNui0B6U/" +
+		"499+D5g4XD58qfdjr/B+Qi2HSTXgTuqHH8v52HdxPmk7KFQd0f52qbEbYVWS0mJlhL+9liZ/wmur" +
+		"+/YnvEqPSNsQH03anxC3G+5DKMS7A0wlxbvDlhYcVcaNP53mtKXJGiHITqGqVGniFJWeWRtKCE6e" +
+		"qauG6EZWS7T2UI1b/tbWYmAMEEK6HJGrF1ch982qMsX97cIr0dqJi64R8XHaDzkt8T5ptTZDggZP" +
+		"DJzwQZS4pfKkOMUBOUgv9l662GPp+oVFF2vHV0zixk8LEBqpxvukLa2MBd9LDib1O8sLN1MUjq2V" +
+		"7AATajzwkKYCl+9gE47Xpj+RqVi35z7J1gU0dcFGF/GxWEoY6ks2BW10O0pVtfUN4631khwQt7+k" +
+		"7urey07BnILqOh40ilvHRTDCyMPnZVUESpqxgLeh3UWToCkMo777q4yqpDmXc7J66FQxhC4n7v/r" +
+		"RdJ4x7cGo/4MPQQq+LG+veP1zCEvCqj4shcBJqTXLdYwSY2lX0lCySuzmaaqou3rG9IuJoNBI7Bt" +
+		"dgxgZNmlOmBjck6Xa9MZ381aFGRZaH3Rih3sb13Fu14gimH8b9wuAawlhCQUgbKabARKLE4IVu5q" +
+		"/55bV9anfmN4So2hlAXVnP9HvdReEE3X8ahYa9ULgtgsDYeXz6Y+mUJrkihQugnbJvi2aRSN3q0/" +
+		"Bl0q4Y9JwaYE6dwxAGBivB7xKVojZI708dBEA+KdQxWu4+TDsTn3SVjrU2mr1uVbC9/qty+au+gl" +
+		"Tz1d5OmF0lF/fcYpymJO12Ri8GWKwZYjhlmBhccZAEGC45pvDeQBf9SvOMUHXsWCDAYMf9pzP2AD" +
+		"i8qHxEHvEWXYn3qpTKF1CgkEv/hBLYlhfhqVPKkZvmWSGVXq7RoVUBnoyT3bM9633SQMHemWTMZm" +
+		"pMv9XK6PQxGH4NSMWGFEczJP30hmu0zSO0ffVMB06NOmlvHwrEnNwK1rSAlObmN8vy3tOD3QlUP/" +
+		"EU2CpmpA8Z++dYphTdsR+blTY8ROW19QKAIlinLNpsWxVJPNYCxsCgWpWmXfN5MwdH4qzXEx69nN" +
+		"VMYaT/HJrUepO+hKTkkTdLdes46JGZd/QHsqbSqLE9dmOtGsk8t+smln93wu+2ljejRwF034BR6j" +
+		"LU3Ge+OmAaumyhHfRiZImDiz0YI95hOf3XCkizXNcJrfsiDMOiZ37QEepu5sEL5ZMKRu83bkunP6" +
+		"0F8ghAiUNNPvQFA1fmKnMBX9msf/VpGOrCyaaJ42A9ewcPkqZuldTKrhqclt6yZhRYDnK9LrdanA" +
+		"wdGQDQX0KuqQXCg+CRDxYWsVMBek1JCeJmjVUCz/owmq+4kum0mt/kdLcGm5uJl7vwGMZV2QpeIL" +
+		"tYp4FS9mq7G07awOWkCTUVO5S9uyQyOJSTUEQapK3fbNpBr+xiAmacgUQz3G/2scGMeNWPJzkvqk" +
+		"EoNJGvbN5P0y8qzu4F44xjDkpku19ZVnFCUcxA+hql4liviCuApYI3WuIfwa2JBajfq0gnTfctwW" +
+		"vK9MT4iO42+AmuzMPB79ur9MDoOu04qzwcor44Bq0CwPbat2sdxoS5M521BzfMskM6aLkGeZUh2w" +
+		"L3CeHtHr45Np5IVwyD2VmWV1wqJ3miIezI+VS4zFfQpSdYxPHmWQ1PijiWIOiUJVDdBvW0oOsGOH" +
+		"UPGDESrNjkrxaxFDWp906NxJDFPb5sdRTbmASUwGA1yQA7MAp8u23XDUBghOzcj8wnvL+H7aooQv" +
+		"DYPrS0/Xl+Ur3yI/8DEXIwmTv1ww3Kg0YuSXHBYlfGlfjjNe9BueqvILDpMlrNospypi4sV8gve7" +
+		"KVx3brhcCU4WdrCvI1NmaVDS7yATJDhakISvI8e27bf4PK1XqNiiHodsdRIuJ69am6qEyVaLKuZT" +
+		"Yu4LSliUWYBauhbdl3aqa1DjWm5r02t5b2myLBgWnqXfsxVQ2Ii6U2zeEHxqQ8EK1X0tgaiVT/c5" +
+		"hoVnMFWXEh5SP7NBmGWvNR/FWpPdxhQ5rMrqZaVbK3ffHEwJX1pbtf+Us47Gq5EEFui1nCuyW94c" +
+		"k+/y2dKkZsja33OYvjlKb1sOJsWJXgOtM294e3ZApZhZtVrZWr0s2nyTFOXh9JYFw8J15bYoC1to" +
+		"U7D0t7O2LRmJQ3DyhprSXHNi7LsmWw0mxck88DMTsd2lZpP0kpq5czK+nPNzwWm3tg1G2NeGHR4p" +
+		"TJz5Fi0eSRzaJOx0VYl5q7gAs6pt1a+pyIJh4cXf6sZHWgkSJtYFm/NajFZc1AcEJ8/0EvgFsonw" +
+		"KEhZki+pSls0LFexOCWV6uDb2dSaqxbT3pkg02G/lRsfDv5Sw3/0/7h7JDdYA/ZH7JM8ziMuZR44" +
+		"eyJaf5T9UWpfcBa2NxVcs+J4n9gxLLy4zLKHrSZgWLhN/vL35uW71L6ghEXxIqala9EZO2celhAl" +
+		"fCnp+lJtd0/DrCHPI5epra7G9kTbongd3bZVoIQv5ctt1jy0/bug1dVjPm3Xv6hf2UurXV8d1sIS" +
+		"VmX1WpoztB6/c/u1aNzDTM0WjJNiqWE2uuIJYsfpK+7tldvEWSj6S/49ZWVmnBbznL08MM+KXf1v" +
+		"dvvWvfIzc39KnH6ri1krj7GJYeG5Ml1dSy08A0OxzrHdFChM9LJtKdrF1wiVGQc/R7pozHnbovJD" +
+		"J4aL1TrAvnVibBW/Vw+ErRJin2QH264tXhImW0hVGlG6g9SJbZo9nHTfrKscG18OdO2abIW7OHiU" +
+		"LksU36qAtgvEZ2ZzX5QuX0r40tqq+WLG+RjXVEv91tq3ivph5O8tT0zXovhSnCbEwKUtAtDNEpzc" +
+		"Zq0+7fqyoXqELWaTEoxwqm6YLlMYK+Bxv9jwdWLIYICcHb/fYuTdBVXCZAaFYoUL73oZL3bbPFW7" +
+		"Js045SNpwRnoYzfBTFtjfXBfrEm+v9i/RAmLYgGgeQCoFgC/7L+whFWbMVRlqcc4MXzFJv9Rw3/0" +
+		"Waeb/hPB4z85y4HCxBnjuU5xUCd6oM5fA5wcVmWGg94WxWL8e8YUb3jabDR5TCi4HD4VCd9q+1It" +
+		"kulea+si+ySPYt9CNkXDPbZ3qQ4SHOmqDM/45IEOKEy0qqe2lJhoIdc1+71e/9da/V6n/1mjz8JT" +
+		"B4XgZIVVaUZWqG53cQhO3MqN07VqNgup7uQR9VfmFgwLe2OgtslW/GRXKASnZuR+rZuUMIktPf2C" +
+		"EXHaNZIOrde4aIemjsegSo7c2ULTEq97Kv01Cfmy1S55QQmLwpqnlq5FZwQWoPaW5GBZb5+RlK3r" +
+		"VEExyy6Z+05QuiYblupgu25TposExxmaA/51bNkkDHV7moSh2+QKgpF3Kwbg5x8dLZ/HADhji8k+" +
+		"VzZZwqqxZaZat5iuUWO+S+bV7qlYVlp20VBlwbDwUsKqzXapFLTlb6VNTcLkr9JetV9FjfmRliYt" +
+		"n6Md6lP8wNwVrLWYX/iGmKMdJFOVsCjeCNUYrws/y7Io4Uv7cvQYVK2NXIkKHIzGEr61dH3rNp2Y" +
+		"ZSq+brM9mG68NKPS/y3Nd7n/lLiAhAXTtShW9T/L5dJ//OrnP2e2WyZgzvh67PLDO5IA9ccRCn4B" +
+		"DzIYWA7rSOXOPgeFic1x9WU/G9MpPMUhOHmmB7i25JAuR0wOOzJ3y9o3m79BYaJ1L7TmyIr2jQEu" +
+		"K3TNHHiLHfyAJEw2V1W8JvGDoD02l1ua5EYJTm6zoNEelTDkstrZ5j7PNvfltHD308JykxD5CIIp" +
+		"fWUELDdxKcGI0QYyWIpPmRQmznwrVINUBwmOa779YKaplyONz+WDxa7LymFV1lJejy+xEsevK+FL" +
+		"a6v2FWFpRPTrKOAvP0T4ZAr6fWweLh9sBMdmZ+ugMNHGELTpzQjG6TKtyyS3+vUSZVr9fInoRU2z" +
+		"4vrzlgQJE+uCbfJEayR2qIckboiDZh78Jfs9R2U7p4dyOVrPu+JeXtYqwaehjKafk7fBrr8PEofg" +
+		"1IyWEtaOyH2XggRHc422mzoSD1MVgpOvYlOmt5MFS9tl0nsr2VR1JJ+rjsTJ6kh2DgsKE2e+F5LN" +
+		"rnwevCp7+CXZY16PPexi7FHmSivztvBRrD+LzW12DdYvwB7F1k7xlWMXZOfV2PlRmcnm0qStKGFV" +
+		"1pwZq8kc9HXjQTooTGyOVrKW8nBgdtEOKflb29J/9C7/y5Cub5MFfHtf2k3JeUty3pBcbkeOr5pY" +
+		"kdOvXx2nHdCBmqG39uzLlgPl/FY8kgVnK04eIilYETFgs/49t7S8QvYfQ1ssElZlybkW9Kh4pBdg" +
+		"Jf0xXXBzOnGP4N8zzhHkirpVefI2GUgW5qkjFFYAhUXxrrfSXAmmXK7Q3A4ebxMlLIrXZVq6Ft0r" +
+		"Vw21l5uYDAaWY/7n0U3S6Tz6kskuITbntyzIlpwHKm5bj+3hT+5S51o0ZfG8FmTVjR3RvBfaXMg2" +
+		"zyvacoOj2bM8JDcSGGa/JTtxmPe8lzvewLpgmzzR2zIvnhqHVWmL8l3GFyG3b2SR3E1aRDvzALmb" +
+		"h822qLUv4euyGesnKO6yIM1vi+wX0hU9tF0lb/5oN7BPYv4e01YX9IMK1y/Xom1/UzNXv/FoHFZl" +
+		"9bI2Uit50Ur9UljMChxexTErOGb4Ywl+zNDzqYf1mYf1iYev5x2+nnZoJZ025gqfvyWla7Id4jZ/" +
+		"ZVD5LQ7ByRzfXp/fPiI2Z6yucwviEJyakQU6Nx+ZA1G7huj6U8yQDZAosBh+ct793lX3pvR5v8r2" +
+		"Qd3vqfWtdXHgrGe8uCwX51QHyY6nHVuXHEyKk9UqfMijz42vLxufv1DvCDOevlbJEUBsk62cKZ4l" +
+		"walOirOs1AUvQ2syH/ZuPeZ7d5A6sU2zNyLflzbMU3zjdK2a9+fQl6zF/jx5QdHYdmqmS/jSvsrZ" +
+		"ovCgsftBo1I1WtxsOZYjoG5HlXx9nCBhwXS5YsHmmyTOM4pRtHjn80W0Rff3FojTakN0vsXgbO2I" +
+		"N27pxLBwm+ylVSn5S9nSl7oWe8uXstZa6srtS+HDM6tuDtax0fuG16AVrP1n677wYKt6XpNWRoyz" +
+		"3kz67EB2BwlOdZJnW1AgazvrTR3OzD478UtmhOBkXta2k2+rND6Uao+k2q1Gfxz1Smm7xfufEHGS" +
+		"a2e4dh7rJ7Edp7udPz4NcEttpOmslrrlxt9Qi11vbXfuNHsvSBGt8xmf3gty1fdXE/U/K19QA8CE" +
+		"oaNSe+w9/jdNEvbg7y0jbSo0FU2CphXpU0wGA6xHw+bcjcyEhvMw4s0TaJXpMuI8M3g9MaAuYeHp" +
+		"d02adSwF7a43FS/YlyCdDmx1t6p6vJ8p9thMtQo6LEw7BYs199Nv8lRZ2Yrs1kfYOt72k+yDTATK" +
+		"PIG+Uk2agZI1z+PLN674v3m1f0h0OF89edvLJ2/eA1DZAYw1wTotcst5xzbfjFOFy2BrIPqrQcBG" +
+		"eotDuhxtAMRWqkk1lHoXk8HALeI+1qvGnmHARSnwQv6ZnmIyGFgOC7w5/N7sgPeFFNl/oyQ8YPr7" +
+		"G8VkpWwmO0AX+PfXrmP+/kJHrH9kzDWbQ/sTyp9QMDjKbfzrJKm/AULxpBy+44Lylh3UVEeE28D7" +
+		"+Nc+UqGmmIQiXQBdnfqre/zJvYLXqVU0yE5h2RjzSvAwHR1DQBZTHejl/vMp1cIDkX/paTdBTZro" +
+		"Lk3Flgy6TDInNKzz9l7hGWG59xrzFqusPDOSkecj8wx4WcgIxp1dqcBrka5dk7Etrtp0lODIlp4c" +
+		"uSXodq5CAgE+AVu+ykulrsTwZBogdooGWSmoQkSaMT7tVkoJT41bNBmeI7K6orwg0d9AhoRBX+Au" +
+		"4+yg4JXnwrd9i54plEHP+1a7yQbglWJHNYsmeDWgoMHPB36pjEBTe5mEE3pgiHQRsLUNrJIUsORD" +
+		"NpMdoO19PksWk9WgOXSSeWtFL/wQFkGdoyBVJcmTgk0cxAhJnuqj4+yJMWbfeSBgDw6l7U59khZC" +
+		"Yk8MAbVcSxJMak6vpZt0A8YkkG37lBJMDr+Id0uLTmY4Kil4Z7CkUPUd/6JDEhUmnMhBpsuI60Sv" +
+		"qxSc6ZSkfnoNQYVmMUqy7iUhL5pk+XJQsBA/h6QET6TajF0yhRrLHnUySTrLJP40PgEljlfMJjWv" +
+		"a4IxqDJdRraA2rf7FjOFqqJJ0BQGfMqFwHVNpcvKYVXaomBJXSvZNUzqYC0ROfMDgpNn4nMhZMSN" +
+		"vLQA6g7pcmykLg7ByTNnPGvkQP6yhil/gSLVwWKJzYTO1gTB3LF7tsfntLvHXCi0spGLWStvmqgR" +
+		"7bfWW9u95d7u7NO5ThbIxLbN93AKHhlToX66lWT9/IgKVTOKcDbJeEO65Hzdaik/C6q3jk48rsLK" +
+		"T+34ErZAQfVFATUJxe18TrK8QFlNWgYl2lZe/HaKkeYmDteya4JYXAlFp4OyN/ykqBGNYUM5vAdE" +
+		"0DxV601ScoD9n22/OcD0w9/ud6Q5l5uDm3g444wMHDcN2U0yQ5Bx4KqPETKP0roDTEy7HA6Yi8js" +
+		"FVXMtxwOMH22yyQMPB4CDNOxaYLvJZYDSdC0IlUvQTqi4dXfwjd/x7yI+YktOg5Jzw0fEl+U4cFj" +
+		"m/qjCcJUvuNd9HnVUseb3qXumuB5ZQX12FFiZ7Q96ixSd/+pBzIKcZbXt+AK7rqq0BGmPiOj6d1w" +
+		"Faqqoh2APYNuQS3yy05GmoPl1WVvLNLg1OjBEM32OCQYI32jOTPeWFT9R8s61ljn+ur3slOoqi5a" +
+		"ORrIxmFg9dF4Tjb2UB920dw9d7zRqxIVm7dO9LjKrCJQvmTDAeVUGjWXh2w/5G6SmYh+3lIp2YF5" +
+		"qBElOivsrKtbNbhYRLAi8yjgvHWrwhf4vPXkuYPMqqb7SygCJc2M83whRUcpaE+dz1c+JzRQd7c+" +
+		"/dCX+mmFcmp2FQq4VLSo2kLoYMGFJBsvvIykN2vLW+6lm3SDNCMAUnypliDB0cJ1fK+24BP/5bNh" +
+		"y1U57J+ARfrwOFBlB2izP8FmgI8eH+ozjfasuD8mXvCAI342334z338wv3xYWdbkJlt3gP2+odIc" +
+		"6vZYCLkIhiPsT7ZYD32RnSDZkblREorxJ82NFmNzZDMzHkoNK6dr1awv8ium5AATezJbD2aLmsoW" +
+		"HHiaDkWmfXVGvH08/o9IeFyDIMHRCh14SmMQxiAAJr4gPIgnRoPebC18+JsKg17x0SdJmGy1qcJy" +
+		"MYiDmX7FITh5pkc5ytknMZ/zolKsbuxmrLLtwI8H+vC04pPxXZABNth6lRwm0a2WEDhk+lknIPuD" +
+		"SRlAU2QUXQ3cH310P/PR7fmD7fmDfcSH+4gPNu8PN++//suZwFH80nZckrOYbIAhfse/aBI0zUgb" +
+		"hO4C0Le4mlR4EangGlKxS0iF3T4uJB3xTzj6n1C3myZ6HV1lg3WUrNsNJet20/T+2jeTMJQct/yH" +
+		"W+xIJVCKyUaoJmmIZoms84FvTChYvRxwnAdU7FuehLsT0MTsnzJ9PsXtGluD4ax6SDbCzuqVMn3g" +
+		"n6Wb1BY8S6Z44JSSfJFoMS88qw2yyMW8mLJvns06J2akuh0MuBUdjo70EtqkmmQGa4r5iIdMYq6K" +
+		"H0Gqyv6HF67qxjXLCwoEHFNR8fzWJ7nRnqlctZkpk8LEmc+G72rJPyPFAhzwP7CoB++IKpm8IKEy" +
+		"UONStGUhtNWdI3XsvL13XdEYH03wuU4C7Lcaw1MWhBlN+7BBH7aAE1PdPgkp6v0ky0/I1S77gFuv" +
+		"lgtUszb+GihbQCqUeshESJcjtyLhnmVAwoXVgbng/uVkZkDsSG9l65OYxXr1cB2SGdEs3J4GsUF7" +
+		"9Obs1pwdl32VPBOPiw+i4VNqCgvSjLCor1rF1SuuVnGdFesYEaw8wQVQldVkI1Cyndr/46U1CgkG" +
+		"lkFHVNNwymH3glVWk/QQymg51u7WvLk8YSDQtG8/UhecZnfWhRyjlx9YhQwG1aEZGSBExGE9pGah" +
+		"MEqymDBVxwdT+HGyjzbZ22HDAEynAOZR4Nph1WOqygchVFaTDcBYKe38KMhkeqjQ2SPmn419gE+Q" +
+		"QdKBkRRoUnFoginHvoRV/VNY1b6FRUCR41KhyluQJgoJBtWBjrY0eINpyAxBT/Mz8cKe1pBmvQpA" +
+		"gMnCWvt+f5OYDAaWQ+ffX91i8JFkFcOhxIBUFV07hWul+KMpwIugvVSSzT+4iFrLLmEzd2W1ZkEa" +
+		"IDSXO7BSElJk8trvAPUqB1Lmcj0Vu3ChZE3gxYsB1LVyjXD86FfJCJp9YAtQCYMeqELCwGrx3TRI" +
+		"ZrAmHxys8mj4OPQgrRwt4yTsE7CeAFa8s66CRhW6m+DpULXTCwIdIJpQBEqa2Ucfm28GYe18fOKx" +
+		"Y9UBI+u8aaI3FFVWkw0AbwBMmmKePTnPDlkvAvNZEAndns8oJhuBbvhdPQLzNCJqfbZeqkxSo47e" +
+		"Mwa9ETJArco7zkIJsKeYTcKAfd2JL0FCMoOt2qHy03ZGatQp6Mw/OLwAMEOyyXQZsftPzlRDYnkx" +
+		"LZ16JHTiCOfkYyHao5ceD1zYc+vF03oxF428tJKLFegUcX303PnckGj3DNmvP2GscK5vrm5b2yfu" +
+		"J9s6P29RUAAPfUMmt6BCHQ6njv3zvnWkanpJcymgSDlijA497z833ScQGohHaSfuPJ93XGxU2Ux2" +
+		"gDb0/pNWgM+hK/nUtXXefz7CWFq3lu4xCSV6ho+KnXdeccJPEp2jXBBNgGp8ShmdhaOvU9s7fCRp" +
+		"op7YJ5zcI5zcH0DS0LpJGDTNeaNQVRP1U6fHQxOtAd+SVoEs1KD3WceWdz51alMxTK8/4RwHYOeP" +
+		"hLKbbAojTXetKN3xbSOC5iRNdLRDwirQ4Y29w8nd+Gk7iRNrVvcUpyb7TRNc1yc0JQ2041T13G+P" +
+		"cuYwSRZGHVRQmLFZ634rSKHob0z9KH622KUuqB7h3ClUxffnz33XoIcmqP9A2YPlsG9SiVbbnklJ" +
+		"G3mkFZDFlA05mjVj7LzOMbT0NtuJZ6rGsld8nt5RzeoCj6cmWxVxgF1bnTlgVcKOa1wEmCKjqPaj" +
+		"CUpzU9PZ6sStqhPfch1Cl5t3rs6cR79rHQNKkHvU1y4H5s2kGbTXy1Fwxq00ekDX2iGaBE0rUtg1" +
+		"RX36YbCR/qVAZuItU6MwceZbBL9VSi51QXOPvZyTRyPnzxmefNH85PWVk186PO214RNnEPwSwlm1" +
+		"y3RndbYfTTCMms1u7ceGUdNJC8aOOVql5nU8SUGASZeVI8vGVeuM3y1+9/jshmbzXut6keXUZ9RU" +
+		"HptLODB9ifRJMI7G6kOSJ7/nc/qnfM4e2U0da7tHPb2FpIef3OqvJZ9vreGt0T9bfSCVAKktV2gv" +
+		"gx0w/EVuSFVJCalUkzQjCoAmiP4nfGIOSUwGg0bQotcW4v1PuG6384ci7oT2J1wy/sNI4D4agL34" +
+		"JUi6zuvYpV9jir50Rr7ytse7yS1NSpexubWd0MUhONVJzdEJ9eZtLxTIwSUWgJhpVIz3T5Qepz1t" +
+		"PnlLqzYKNvuhFyMa/8bN4O6geYccDZmHHCwKQnYtTb+gcrVe8nD8/SN/5H8kj6T+kf9nu/7I/+9s" +
+		"8f5Htj+y3V9/RG+PaOqydUC6IHXoyfZMgpRKpxjqKDoulIpJNd7OQKGqHohSqkE0ewx3CDWqkkLZ" +
+		"TcIapVJq8+yN50HlJiZhQDpaX8cdBZMSjMa6JarvPoYapRqyJiiQUbNeblTZusl0GVljBgNYR9bt" +
+		"Z4Cmx6tkcYApyl0cYErn06QaKqNVi4bkVbQp7UgRNzplq/2F9KbHCYpiMpvUj3Ios1/7yzq2v7xn" +
+		"+8tjJvdL0zEt2WxXf6FP+uvfc1x0nchsE6/tx0vr3QcneoynHidN4z6JRq3ws/1VEZ8UI7OJJkHT" +
+		"hBR2fUjRACZNsZiNy6gni0OwRxqajLxuPdy66Lg0vAjZpNRJ5pX3wjBceoBma4DrWVDb8MJrkpTD" +
+		"p+u67Bq546qEAsWIwAGhS/cWTYBNrwQ4HRJWpX4pS4kD8ai1P3K7jf9RGudHcrsNa8namlvJ1x+5" +
+		"jUNffSDesE9K5qCTpdzvknuN90k6p7uGB60XdclTe9JEWtwm6Xy9Kv/JsyrcMCK9JJVsUg0xlyB1" +
+		"X1DNRRMJEDBpfS+svPur+Oc7hjI80hYrBdQ+UuwLDNgqKLHtzloiHk1MBgPLQb0pHoyn17coh0+5" +
+		"xzAMJWlSnvHusKUF02VK65MaUTOzJoW/CQZeYEsLpsuVZiROrU9y469MChPrgovvRHTBYK2zXa3L" +
+		"Pkkd9Sc92z6xLzg86vlH7q3rXEC6INGxAC7X8B1PKHOZDdPlCupSBo2gv7Jvf8aihW18BvuPhBBV" +
+		"DVppCJcmujxB0kdiG2aUkn0bylM0CZpWpLAj/cSmHhGZmNmC/v7FEJjZwhN1PDGPh7F6g73EMCgJ" +
+		"Bf0j5qEQ7x2pKv5+ClhMMlMCZTVpGZAXUjQlamBOfCF2FO6WyVq6HkwNKJVCt34jHUOLEr60tmoW" +
+		"2X5BzNm8XI67sAOHYfyfd00276/zzmafesJFGQwaATWe3mekLa2sI/NLbauerql9uX47Or6tOaU6" +
+		"IBMZbCXbyBb6zzmL/PwRSeO//RGpUZBWiFG/6J2oITBUxpHV47HdhSJQIp5ig9TK8EsEEPDlO+6k" +
+		"t0xK12SrTF9thxwX+5zcV7/KPzEsPAt6jcjeY97ul2OXBRk4h2gSYSqXT+pb2l0WDAvnr5yvEtg/" +
+		"utomW+PYDm9D916I9+2u1ygXTpdphCh5wT6JnnpFWgE6ak2lLg03TUun083nYsIKSec7bgbjROCh" +
+		"X6+GSGw3MV2LkmeeylpwoiV6rP54jBeTWK2ixjybUARK+DYuBb4xZ2B5b3FIlyMa8NzCSFLs+KT6" +
+		"wjqjT3U4P8f/jUdoRppxPw8KVUf7nk+9jkWpZm3m85mLiiNtuTuoA5KXpOQwTCM/Zpk0jGX8+8GV" +
+		"IzYKV2NfNS2HBSs6r6iId4ctLYhAmHKe/IEcg/skzdWkM3Rn7O7B+xK9f4XvjF/libFtlK7JXFl1" +
+		"CgF0pO2PvP5IDFKR6jN6Tg04xFMyft16oppfHalIdmhO/bUgzTSZGsWhvybRSHH9kZhbl5h1W4mt" +
+		"SwiPEhYcnvpqrfyz3fFlCKcwsTnqmDIueeXpVMaW9Y/Owf9wCv7HZmCFkh0aqFSTNLQ/Mk799IRb" +
+		"T/xwqpe2G15LcwoT0zUZ69C0iWsUbcdkdVJLDkjVogfKKgIlzQyFQZeWMwEy22bviBj+yoJh4TZ5" +
+		"Ca1WLDAD4vFKSbZctkxcnlv5IFXvG36yQ6GWsoNGOR2+I7VflXblAt71BD/hW3Aq4dP6H/2FUKQ4" +
+		"Sklcz8nWc7L1TBCpK0tYtbWEoBofHcn22ASaSjW5pUnpcu6yoBci6CRG2NKCFqDiB54XniWxMsFu" +
+		"nYetpktYuK68FJLJ1yTvj1ruWw2x6I9F/y9L+1+m4/V/mP4vt8ttZ5eVw6q0RVnbdC5LSs1dg0ya" +
+		"RrumSl3yXY7Xlr4W7Mv4f7oer//bGu//t/3/JYgvvJ2YrpqXwQ9bu9Jl5TVj1r7j4ccv7b+5X6PM" +
+		"bG3/Nnj4vczBX2ef5xk1x/u28hI8Lz0+V9CRti5fSvjSvhyXtd7u5ZAvpa3abFF7jVecTeG57qp5" +
+		"uX4dsjIzVMhe2qWfGJAkT73tA+gmsaBPPTqA3JJRAyDiUx21i3HGALmlSelyro5a+HixSoW4cVAc" +
+		"LztKIqv1rQn7921d+7ZefXuHvn2yePu+Z2CpDjBJpjC1iVF/OQzTY/zHrGkbWWOmorDaBksgoCmD" +
+		"RvGoG24MyMa2i88eSIpPP49X1hnfft3AKUxsjgzgLyQuPJ1KXVDNjb7N/FARI3ODiTjyg9SMv7FS" +
+		"DHUMwnHVN6XxjyteyS50KUSDEsOkLU2mA8YwYEsL6sBJw2u0SKetOT+SMKSooMlT0RJBNgpV8xMd" +
+		"CwhOnoleBZa8oHroRqUPeangmCfRA4Jx/uVJF6g76MJprzX5vKQOOu8xbH2hMLE5IjC55JXVSQfb" +
+		"icF2YrCdHGw8h0p+CpWWM6j01l3pGztRHA3oWzqy/5F9wwfEDIJTM9IqiKNZ+xYTUnjjHSAFLZMZ" +
+		"L1u4bNGyB8sey8f1vuU7voI2MSy8uFiQu30JzZVSV9YizQ7SHcPCiwujtnmgvt+2tFNIMKgOjYSS" +
+		"g2DJPxQshGcWQBhZjlYAsj6LSQlOnmdR6s+f9Sc8FqarVVx/ZhFm1a0tJGHhxe7FqKRrqr4ErqFg" +
+		"wyVbJbj3jiFyszQ4sAIJWkJSwoAkSVh48bBSyX9q4kuDZ0UKRZ9INQhOXnYoddIs5MQ6Y+9SHSQ4" +
+		"rvkWVhWNkLbfkh0kOLKYshVTBcVkp8ChgCNmT1XVET/KQjCLxSvh+jN/iGVQCpIdJDiu+Yiiz+oZ" +
+		"mOnXB1zBKCo2hAZUB88zsPjn/XVWXSH6Bh8lQ3y8M8uHMep2F5M4awIHA/NiwYqvATsh228FTWYG" +
+		"LkgYSVh4tdfFPcqq5OdUFvtFTCY9dJpFkq2nwSh8FQq/L+Ra619Km9pFzBgp9Soj8y6CNECg3rto" +
+		"8yUHpNYxhswUZqOQ6Gn9LnVLwSQMT6EIlLpPJKGnwJdRJzCLFdTYN8oTOVoAY19w/Ls/xv+A+Kx6" +
+		"gEkwUxeH4OSZqCnarydMpAcLo0DmggDM1E3qNhirJqzW69JhEBs/B068AKy4NatWI2IBOlvNecan" +
+		"EHvEYB9Do/wTR2p3dI2a4rmb8bTZUEdC0RYWxC9oZulR7yMSaEI97J9iN0WMMCOY8itfSvjS2qot" +
+		"YTl9Ff+pbDLhLSbV7dj6i2NgIKokhYnNETUN1uJSSzWpTi88b6AQHX5lUpg48xn2xbkYwEU5XleT" +
+		"fRsUq1A0yHg3uaVJ6TJGhxL7glreP7S+MDLKRcEpcaAEA7eIeUt1MBMXKZXLqwCag8qiPw1lABPm" +
+		"7cPn7WPO24oWz+bwA9eEIdGkjn3WkJXS7OgGfUdTZWbhDt3afVXZ8h/sA8/xz0M8P77zg7t5ZDcP" +
+		"6+bVV+DbipbqgMzl23+mSphYF5wFxPFysCa0GYoNaH8k/5GMVakiUFaTjUCpsfJ270jhz2uIA7AG" +
+		"jBpQxS6JQoJBdTAXBgKZ0SQeYcrbXioFQ+HjZqDhfB//235QSCDAaxDbadjIkEfjOp0cVmX1spDU" +
+		"Sv7W4NqEIlB69Y3BGuPoZa+o2/pU1OW1xWxSghGLDaRX7ibNK+usbuRuXtR+J3z9DbuFvQA2psni" +
+		"GiCmLWyT1BiPl1QHCY6sXRme9Z7EIThZc1RxTxZPmmxvMakOqTQxGQwsx0riV2udNFuvhqoIlNWk" +
+		"5VMyStl1kiIgbz+2tpCEhRe7RUAjOJSHhI91EH/H1EnC5C8XFMMPmTqFidaPU1tKTPSY/kspku+1" +
+		"6IOjBAkTmyMLKltdrsDtbN2kbyquuAvD84FsInKvo5uk13W413V4zOtAAbxKYSDBkWVOnqwp4Vn1" +
+		"hTVDl/+6J+xHHRt5XLaJ25fylaXb8YXlvmzZLl+0q/nmdmEhh0PAt4cMZNpQDMyCULSobJUCRWSz" +
+		"AqB0ka0o0QpTvkt1sEDvMkO9Cz2Rp49/QeIAA6wuz20Xk2pgEE7u+nldCFVxWAaZLqNMKx6fzAE3" +
+		"RjAthnKvMcukafQzl2/920ErKU/JenfZEZWXqt+4U2piMhhYLxo3U6qDmwy4/HZ13qg5xrsj2tBf" +
+		"kuKWjCu7u3yQsjkfC/zxVn1mmz4qjhJzd1CTJqcgDRCwMR7f6ABh+whnNREoUcRGiL6iK1mPTDMe" +
+		"WcuCJ/+y7GOppD5xbG3EgSP1Sbf61ENjQnCqk6afExog9YlguLJPCE7NyNzt6n5+iGBpAcGJnQql" +
+		"GaH8Y+ztxCE4NSP6lRQoxGDmqHOpdzHJflcOBtaOwdXByxowrP/M6MLhz39/OnbR25/vH43lL8ZC" +
+		"hD/Lb8VObGRKL2bzzVMydqMACY50VdbS2JCf+NKhgQRHFoi86aykstSnmAwG1s5Sn+7FCHoXU0WD" +
+		"fIvJYFAd3MfAo7w5jz31VWdKCU5usxLFLmHm55keFChwpge9zvRYnEaMFzc9lcGATs7uWR3cZIAq" +
+		"4nPfKBolNse4fF5V8viqICsxHO7j1xIpJBAQdxA8LqQcxCDaWOayIpdV4SeBA4dIG9arSoZSDgYs" +
+		"6uylqoObDFBv0kd28iQJk79crA5oGgW7lRS5Q1cQB3OxKL6PNzQHyDdb8fY2vGcL3kv9uKuYd2kv" +
+		"CgkGloFyg1hs5yP0A8YKyAX9qDIY0Fm5ERAqn/GP5FI9TqmMhO8TOqRp4w5bPzwn+UhbFpPBoBFQ" +
+		"y79nrFIdGsnmEB7U2hGtHbH64Wrdjo69EilMbI70jfcXhQSDRpgu7JFaUqKAN7+vC1qcbL0OHlL/" +
+		"7wXbLSA4eSZC8feXDDT3xcMaQHBilVDck3FetZzBQSau+VooPjMF1IQebjyXUhkMrE47wRrAiBmn" +
+		"5CqDgfkPbgT667FGw7FGw7FGOy0Pobj62okRobIRKFGgbzFRSCDMHDai49ag/WK5Qfjz9VvlqzK9" +
+		"nRh1/JnUPG2gnZT5CdkAHJ12qUfFDthwMbs3FR3qPJPrUjtO+EkSFq4rL+4ekUq6pmrLObWloOKr" +
+		"bik5SHBkWGULowqLlSwOwWl6UpneThb5bGIyGDRC3Nq+4GLuC8b7l8I9mBm8EOqLd6EIlNbYOHel" +
+		"HW8xDOnFvE9jFQrXO4DTZI89iclg4NX0ZawNxcBqQrCy3ygQouw3OhTfMw9skPPOjWutfymLIyqw" +
+		"6wV9Xgfo9X+2OwZ6tY3Hjv0Bwcna4OfLSmwjER2EuyaQdDwoZhC8mAbyIAfcZesm6SSb9XW1V98c" +
+		"WSnehQPQIhS05/vLQcLEme2Bs+8VpkK3o0qWs34pOGmb+rcr3ijJvdoi4Hyv8yJJ9yshfV4J6XYl" +
+		"pFf4Xny31JGZ42tJBmr6YGeiMhhYDoKfu9i5nmJfKExsjksxnj45T6dSF1Rz5kMfRmHizGfs7A9+" +
+		"5DH565z6llQOk+IQnOok9xcnRFbkylRGfi67SQbM+HY3Ub0ie0nhUoAz1uQbD6pDMn8eE71jtXvu" +
+		"k7f0rem9mW9D/1+G9m1J16p7JqBhIwcEJ8/kIvIxLoI5RpumwVpIj+/fOEp/K/NwXqW25feyk4rf" +
+		"axeK9kdKilv+I0X/h95iLscrpgXjfVHaHzm2M0k3OQwimgx8ScCx0vHS+8MOadJokV5aOeKd+22j" +
+		"pohUj0AgtzzJMxV4xYtgse2aF2jOxIve+n/UL3dGqbuVql5N3WObxFacdUsOanqm0ntcEa443YXk" +
+		"OoFiucfLYZq0PUmOZsCCIPXji6YENWU5eOR6xPGcZZRmqHHGda3tPjE5dS3TSnDZCPHuYL3duq2E" +
+		"QWtvm063JCYRrZekd8SP2LnY3ZbaL7QRz53YTcKt7GLSDHqtyihMnPm6dRzl/kKq1ZS7VlzyhROp" +
+		"o/zVmUQl9AspSreYSjbZ/si/p79+ODAJJfSEVAKkhviX378C2BM1UMQhONVJzdHJQ1qNyMlxtzq6" +
+		"t6qbCJTw7RbDW8Ldx78n9h2QkUuDfci/p70BoaRVSet4RctRJ1HXmrPkLF+KZkX7Dc6FsVgxbR2T" +
+		"yWQUSfGGe9T/nhExo0XL5d7Z6JjLX5PMPZDqpExgBvYMg0pszD3QZ/wmoxK8vXeVLpL1JA9EByDr" +
+		"jXRLeL5kcluU+KVdk/uCabXTv9y3NCldk7Hno9ZlwbDw4mKL+fZnpxZl8St15fZH6h+d/us2Zgrt" +
+		"G2BfKExsjlqjccnOpS6o7kgseGMaICoFnBC/MTbObiFHxk1Hjtrk3pFqHMEn2QD5SSjZoYHSBYnu" +
+		"rXzHBpIGFakgyB67mBwZw/gsmvAVfiOsPtPUt7QutSTF0djnsO466lUMVWfuinunFdN3LXyPvPKO" +
+		"afVbLbXgYGPIt3ro/kXFZhINKfZBBKVRdixo3XKgaJBdRd8oYEXUOq9qDtau17N2fmal1pIF7SLB" +
+		"VbsUT/BUTdrWfyl0AVvX5PPCOjt1VZ532wwdG1irPG3FnlhFZ8CqUZkuEssfHSlKHlbymPkWHb9G" +
+		"rjD0q8VDkvbCNc6171eXlT1jv+4x/xl6u2947oEQnDxTqyeWvKB7lDqJRj1gJ1hQOxQn01OP6Akw" +
+		"HVsQB5poYCg9FoS0fEyjjmamtHoPHVSA7kAnP2JblCXrUsTWQEBuLUkcaDq8PUA1v7Y+j1tc1bgv" +
+		"+VBgEV/yYZtf8tHCpXYKePDXgkHqUWMvscqCMJcPhapnKBRQ+/0lJS84zOV4bU/ZkmE0ODW3S413" +
+		"B2xkbazwY8NnjRdsyviyEEGCIxYBrJ5y150oAYEjCkTEiiXrbrQdactbWEizy9kQQH+QhDIYmAvr" +
+		"rdJacdDMGvsQWjGOwppeoTY5nNp2IZVAWU0yGwUHtO7APBXYI0PSgMMHo3RNHg4jzNjp65PrRhIW" +
+		"bpNRPRUOxUWDZxcKVUV2CgQV2RlFZIdHRapK3LFHVOoTgpO5cZgCS17QPUqdpEb8tIdBcLJFcWV6" +
+		"O7E63e8ATuS5gO/JR2aVrj/St/Hf/ki/j/+XJhIgKkWDhNCaIIdBat6SyXQZYW89mMdnwJH/2rJA" +
+		"lFEQ1byk6pZH0ED6/uRIt2TS7CPgKyM/z4zMt2CV4DM/DjCVtkPT8z+pX0pbtHj/UrwWf11zsM9t" +
+		"i5KWvNZXbq6oy1p68V6dp6+qV0Iq2omxcP/VeSo+aUvO0gxHoPjvKb2P0mdgOvKv5fRyUdI11dWv" +
+		"9ZVnxvVHzvuWxkNKTmdbUFR5VaEIlDTr8DrvKQbZ8h85n6KrGRLNAWsLHJvxAGvraQ0lxLuj5f6K" +
+		"Q3Cqk6afE1rIRc3n/UUxHMZ1GB1eRtOI2p25KONqJi6kObqdssbN4O7A4lV+RmYtmhx6rH3WMsJ1" +
+		"fnbrHFva1uJGxCL3Fzvrvd1x1koITp6pC0wseUF6iJhUQxCkAaJSMA+CIZNVnbzq5FWnWXWaVael" +
+		"auW3Bsj+4RbjrvgUvchmtKWVdU1NNV2r1lxZvFpfcAmsA4r8KwuGhRcXLtfBDwIYhYnNcfVlJyiX" +
+		"uqC6N2Y366DG3rFXFkGYmzVHsiZasWRdV4KvQL71OYogYUENwXv2kBon025xM9NCP0TTX4MzSJej" +
+		"l+oxoEmdul7bIXAv4trlnBy7TAoTvRh7EktZn3iayagBq0ieBGPamhclp2vV4LaXinjIu/Bq2KCw" +
+		"EUqmh75sAOSXKAfyXVa9+fyO945UlyXyXEqhZAd6qgjcAADByTMZIWCVqNQFiUmTUKQ6NBLLJGZh" +
+		"bODHPCDNgEBcm1E3n8j2WGusLd6SducNGMewcJv85Y/lL/f/UDN8yyRtVvkRpAFCPfHa5ruk08Kk" +
+		"+Va0Kdjgp6IlEypIb8TD675DasSPjP+qSRtp+yN//8jf7aYnppDDtt2l3rYuC4aFFxeN68po7N/t" +
+		"3pGyTJdK2ZhhhWy4KlrZiH3/QMvDmvuL1xj+bs8nV41jWDldi7YUYK1USv5SFr9SV9aM1JF6ucQ6" +
+		"k0VNaNquib+5P7lREZPBoDq4jwFj70cSk8GgEcxH9XZ4zxDDyulatKUAY7iy+mE57hse5CQEp2aE" +
+		"GMBZhEuuXOokNQriiAQHRgGliwzvhDTROfEiqmGUNpXLsU9KZrVaEku0iLbJ0SlYx2GLJYctFQjn" +
+		"Wq6llXWnPNV0Ta0RISurq15fnRVW82omxEBnESOEf+H8jGCte/FkjdSMWMdLL95P0vzYhCJQ0sxC" +
+		"vEFJQF73myBQkjiZzbovduYxvI3KQTvXFpGxpZo0A6U1yEfsXVdD2uJuUoIRnQc2wO5tMp4Z+jmu" +
+		"v3d8dAEyGDSCRTytQ0BbvabSDN8yKV2TObNRm8jOLPt+Zl49X7XwrbYvlQ2besn/1VGgRgtcowdV" +
+		"nPkWrMYZiKxO1dda9S/EDLZD3sltKvTvG6WYDAZ0tuqrWOUgy+Z6qud9NtUV9POZDi4lKExsjiwK" +
+		"LtmZ8c9q6xiku95FaVNzfMukdE22NX42206U4NFoYCu57fHq2F+5a8JHREgXqYtDcGpGFsAndLkj" +
+		"7+we7ewug4E5WfnTiytJXblNxfEtk8xYqoOZ8Gv7xn8HPQWLPyAS9LyGoCNN342tDs3pIi0vcH7r" +
+		"bRokLDztM8TFxd+PTmFF+szxOpboOjKkclIFBKc6afo5MXLtSFmuu5n1Va+uojZNTt3GHiVxEyeF" +
+		"ic0REcka9LWl/2IDC0WgpBkhXps+OAVJl7N13H4iT0eLfzaOEsN0LQo3F1UV4i12kxKMGFex5AWx" +
+		"M55a/9baojqXOsmNgLRxSzMMCy8u1h4oaJHeMFCxmmbv8k6CAsvjTsLf1+k7K0OahSJQ0ozikd9j" +
+		"IDSjOPEidJW4Tz1AL/v8jbri8GjvELAxuHYlH79XybpAzbA7xC2tjDWzqP0/alt1HRT2QP9fy9Ui" +
+		"bIFlaU652TXFvyWcjUJb5bc//5ZsR8ukMHHmIyxZ10HRp+f7JHWut9jxiKZxX1DbX2wmKD4TFN/o" +
+		"y9zoFXcHz+0Oamo/kqSXvDIyDpytG6nRX1z7W7qLzSQ62G/z/uVt3r/H+NfbvCq4szh4+UUBbT5w" +
+		"E3jIJibNEL0Yd6QkzNNUULEr/UtpU3N8y6R0TeaccWwI0TkHksLE5mjL0H0OPLZeZ8G6lKxL0bqW" +
+		"rWthdZB7p9iwrQGnEeNC2YrKvF2tCqq1JxGUSp0xlNtUuqwcVmX1+gq2VA2fwB+PcFzMcVUuxz4p" +
+		"LVbz7eIQnOqk6edkTbQb8Y4cNdSmfzm/OF2rZoNCTyMPO408/GzwwNngIRjrMsc60XLF8q1cDhFv" +
+		"vkw2X8omJs3AsSvL2JWvsSt1rgJgWHhxsVbUiImSym5rBPhl58buqncvdAmrUr+Utmiz5t1XdGWl" +
+		"PXKZq42G2ueiwdm2Lt+0fLuaG9XcoqIdjR9xDtiIbo+VAdjzsVpxlEhbzCZtrSa+0AGif8J7GH/1" +
+		"/rVVYjwz2JAkPHIhwcGeewL2hcJEd7UFTfMw1dmcYndIl6N1ZypBTAYDy7HohTGKn56DvUoob8+x" +
+		"ULaVgprhEpe2usSqS/NLnf1VqpgMBtXB/K3x1StpHrnNuM1bO7ch8OwZO6k+iiaZG0XJc1sxTtei" +
+		"2aKoigAY2aV6/y3HcYcduilUByvJBeHR3FGYCkWgpNm8m897RMvHASppMWIJWueXUyaHVWmL4lV1" +
+		"/3LKqtGVk5sCTGcOJmVSneRuVsPJybBKcynByW12pGA/TG5Y8oI8UnCtf2vx/q23RU3S/qumazHM" +
+		"zLcsuPjM8VW/Cn+2FeFQDj+GMA6rsnrZ0lIr+VtbXUv9UjTLvi1kBCOuCqoMBpbDCs/6ZBNBYWJz" +
+		"XH2tGvJw+veMDbt4Uroms7vGSuKxT9dPSm183O9LTdd/DFZaTeLkT067vuZJWJW2KFiQjr18n3v5" +
+		"bnt2herQSLMcLTZSFUuetKXJ7vuWSema7EvHfsFs0yXjlqFRmDjzvUnn7IqKK9kAuFb76PDksCqr" +
+		"l4Wsyy2MRVtdS/1SmGVLX/PycPu33haDrBxWZfXyVtnVdKC6xHzvJiUYsUTMdl/J0MsQnqc+vuVo" +
+		"o4GqhFWpX8oaQVbFKq+88EgKE5vj6suWloQUB6jdXnYepOWwJ+q2y+m+f1EqeRKrdyVdU5W6cpsK" +
+		"ZtOpWJbKuiG9S0onLrwNrZuUYMQmVb+zZ9iMS53kRoOz1nifNfgvUlFZKExsjl67nzs40+mfM0Q8" +
+		"2Ti0JE/Dfauxu3JWQ5+sviaqupUaYt5wIOoqfoxp0akdhbtm57AqbVF8EaCV/K3R1YTkVqySLrXi" +
+		"lYv0vwxtWvxHu/5jwDWgXrf3xm/IL0pbtL5yWgv9bl+KFZLXsk5lF5MsK7tPh4PdzS0YmZWPMZGm" +
+		"rZsjH8ggI7gflSv2hcLE5mi9P4/Ke425xfskm4tqeYvdH4DSJ0mYjOi4paQyGFiO1Xp6OL+LpKh7" +
+		"kfMmfBWY2Cdpi077qutf1MWaWI/Vcuo97TNxlwxA+Xmf++R97tPuc5+4z33WG9Itm8RGaUinuEG2" +
+		"P3LJ+L9tKZmUYKSxgep500ekIdWgnv5DmY6ap8/uqFD1mbY2QRQZP23tZRIZOBocslNAjYGCapbq" +
+		"oKZyCG0gN7Iq/rosQXOR4JeMLml6MAOJnF71LPWSLtK1nb0UwIcN/9hZn6O0P48/j+3PY7uN/yRI" +
+		"A0QbosY7xdZlUpjYHEfrnUteeTqVuqCZVZ6pnBPS9eex3bcgFCPnrorc01gyJ+QETXWVDEDakTKT" +
+		"DRyAmkjpmpwtQFcRt0QBJ50Eh0xCQXuKHR1kGBZuk9kEKiVPpdSVvUicpVETA+Sn2lvc2RZQmEhP" +
+		"KOqs3WG18tEDp9h2UxrhJEwsMZikoVIIMyq7DGROUZzy0+jXe0A1mRQmernfWdJsF2Q/dwdkIT3Z" +
+		"ktPW3piIEPBM2pujnhAiV3oIsb1MLrZuEgYtKJpoeY0Y0DrtFalRKIb6eGiizvhFviFHziD9Nbkh" +
+		"kPF8lk6hHtAKx41ZR4IrfI8tJk00Cm6vUKpTSvCBclYxqYasiVQIXdCYmSJg7sztnv2SrXou69PJ" +
+		"XyX7LWZtadTCVSgQoj6rXgOYCFd0V+QQUQkDYlcLXZGOU2ZKGKBeacthkmbpdBH7a8z2BulytMCq" +
+		"kJK0hdI1eXoP8U/sG8VQf0SToGlFCrumWLQf7ZYfLEi6l0wh1cFy6kVQQ9Jku6OnlMq5YrqoQN62" +
+		"+4+CaBXJflUGnMSBy6QKs7WlKZXHwyQ8ytkhPxQSCF7kA09NmyB9lRImMQt1Na++oQcaBkpq0ntx" +
+		"gEcUCqqPyB3X+rnlL80dJTjVSTPb6DLJBWo2Cw/STGwUCR8OAtAXlK7JjTjkLpqMMntMmCkVqgNq" +
+		"IPsMabqsHFalLQraAi3NcOZivsjJQgFre1EwvB7sABgWI2630Taal/UtTcotTUqXMXYviqN4vpWL" +
+		"QtW7rcZ8x3oaspqki7jsAGzPgHQ5cjjlezSjW6Bzzeb7pb9Y/9j0I6zFQX1D0RY/tiom1a4lMWtj" +
+		"7eLLcg5qysjPbHNmhVnrV1PHzK2ShhY3BzdJcHJb65Pc+CuTwsSZzzZ0fGzJaUsro8f0h7MpWewX" +
+		"I0XliDlWTtU9aN0CKge4CQUrdop1w3xddadVcYRW76y+3r1u7W1sV+jwKh9JyUFNMW8Ud8F45AZY" +
+		"d01QfJdKoR77C3sGAE36XqETjBaKW2/dkxVMXpBply1MgvHaanBQ0xhZtW5Pl6ioYoirhKF8KLAA" +
+		"1SbVWjGp1iY8rqt6MiBhQQ7y2jVBlr6FRqmf3wUbMJYCypplaO0eNwqq9yQONGUc95LCxJmPmM7p" +
+		"mlrJK7NI2ym7yXg3UpcXy73sYdrJl7EEpzrJi7NNiq1vK7uPgrahc08KaKSUHGBCnV0yhetSHehp" +
+		"DQCZkZLerDsG7uUN07UoXOvNerm7wU7xNvTaCNZ1a+t2tK/QHeJ9QW6OUBuJO0JF5sKL/aNDAd3Q" +
+		"BbOzynQZsXUYHv2FzUelODGrUlhG5TFEf9nudhC3TUMry+M3xYsAQ9kpOHIGsoqyW9yye4Ci23qP" +
+		"z1NMBgPLYTEKW0YkBakqGBrdhkb3cdC58jtWfufK7z1SZ8f36BlWC1bEyRVyYqioTG4x38+GdCgn" +
+		"zo9PnuueqSPVlp2pP6Ley1i4TQWt1hOqatAYAHvdAaxWkf7JTX5cTZ7OFJqXNdmQaob20/nGCB6y" +
+		"mxwZb9FEUU9O36VyRYMukC7l285HB3RxaEao5I1Tzk/WREt+sEl/uEF/uBo/2ri/41/d/mqov8gc" +
+		"NV9a7EJf6aGP7jmx18TOGvtN2bQDZKuajHzZqm5SkOkyQsfxW/FDJjNYFxPdkWnZZUDz0wLlZEaU" +
+		"bB0pWsBpRjCnyKaZ2FYh7ffZTK14UoLqpDiLYOTI3K5l2YLFtlzAB7/m/aV5praXqp7qQgYDj4jz" +
+		"XwVUeKt6FUX0aET04ETuP/QHpMuR/XjXk1gVOvEZLcbmiHrIJZPR66NAkLolkzrsDPuCzdlODUyV" +
+		"BcPCSwk0IfBzaiTL7+IQnDxzFl1q5R5bQqkbhaojkT/41DoFe0z0h2kgdbkEg0yrlICdiMLTpBYL" +
+		"KIUpiC/6UMKQSjcJg6ZJE5RNLJueuhMR/FINZbqM4KOpJiNsfGak2sz4xLY+YLjoBRPhBROxSyKC" +
+		"CyASG2ZIgC5zbLrSU2iHbtz8wVhZMCzcJiNymj8wuyruF2eRcq6YLlMAWSjUKWmyIYVlxFVAg9CT" +
+		"iT2ZuAp5qUBwRUDFvmUjMWomX/FYEGZNsTwls/18fVyJQputdbKLrEe0q8cms29JKDjkBqqMGQsM" +
+		"SJej56JfBrbdoDuY2684BCfPRIP2go15PyvSkT8WTy/ZiT6Pp6JSNEgIxNC5RR8rUFEp1CWYi2p6" +
+		"9Z/jIWcd9dpjowjagd8LoTTXysf1SAipqIX1h3EeUtU1YxAPqV1SUYGukVp4FcooLVauhaFp79Yq" +
+		"nQJV8zUdkLrqLYch6IDxUJff91YNNdYziUkYUMt1K1ufxCyr6cIIHaCrpXZM/0OynT0ucyw0mRQm" +
+		"1gXb5ImslA21dr71+VdAN4mee8dAQb2MOrQfW9OqcVzPh5weWCVNj+3xYRcIuNq1KSUt1eN7Sw5m" +
+		"EpPpMuLKG2zTChgepWSTrKrwvAoI/5H2TZNRab+/kEqgrCYbgRKRANomnSIxbDAtdu2oHnnmayOp" +
+		"60UsFXRrL2zSoD4p3ierayr3H5NqQHd2687u3dnZnb3riu39vN8xw3QdmvgiyEPOoMmWKNASEOo/" +
+		"sZGf3KpP3arfWu+b1b6lYqEA6XLkor/RmDEDf7TkB6Piw/xR4Hf868h8/HlEvQsM4bIRVLZ7oYDe" +
+		"NRl4G/83CRCVQnP089aPSKvUW9GLIQMfMSUQ7rIqqqgxUSTdDzqGhdtk1kGlZCr0gKp3qoZsEXad" +
+		"MOJNkzNtFEI5mnt/CdKm4kSqyk8SCvSnYqUcHc2vF1BuaZLPIv8xtNUiX0r40r4cseh3fzmYTB/e" +
+		"qHM0h7fG16wQklAEymrS8l32eP+hEs0NewAlbUvQWSoGwcwZw1M6BWp4crtUapTqed4jvtpkPKyj" +
+		"dfLQRK/AQjYFTVNAKiarSWajNkmBetPZHiQkXPc1krqyFssBqW52BF1Q7HWj6IVMFTDXu42NgZVS" +
+		"+4pnO9FOdQa4rxbWK1AqhvEhmlSksGjaRbJJN/TXJBhhQHMfZoxisr8cYBp+o8v0zGqkOBAfJNVk" +
+		"A6BzcdYVnziugFSDJqcdjhDfsqC26qmnZyoCJS4FOTKQUOrMSnDTaEna2AYAxj9Y6Sa1m1TD/YVU" +
+		"AiWtDCeaaJ4uOzcLbBRJhzZu3MQUbyf2to4SVuUrp7ZFY13UkPOsIg7ByVwHw5EGPVfBlJrYYLbY" +
+		"mszWJm6IA1g4YUNMuBcUkwWdUeNBwaLxcJcDHkjHyky7JvDcMdTTzqGedhvqaWeIfce9USNkMu01" +
+		"HpNg1ErKSNjdnV+6B0owqA6NxCqtl3Xop/5iI/vLWtlfEcvSX97e8UPAQ+DkmBCcmpHV4aNe/7dn" +
+		"EpPBoDo0IwNEwa4jbyxr2bgMQDBTvwi+h/F9ydyPzH3Isv+wfUfWw7+YeSygMhhYTtzSJBbj3Tkj" +
+		"d0V03cQyNy2dSHECYxcR7fohJAyaaiPQAswT2ebUjPKYLjEFZGnHmQFNTAYDujTWhPWcn4jznEcm" +
+		"zo2KmJUln3YbVzFNa1rM+1Z/FjRz1pt8jmY+ql41mCxh1WYVqlopkz0ek9TIVRS5OnFTbcgfrial" +
+		"1g2tU+P9R/uTFUYY2XfY82XeCgZUB3NxX4vRhQIrUHvlBxF+6IPu4YrO6jYsI7juFqrofTxIzcGj" +
+		"fwY0lf3mIMERlThPZ6O/JoWWqrcQjMwo288kM8b7zyQaz6dJGO51u2OCc0YGKgnbfijYDpckdeU2" +
+		"FVsg3+1WeXDmHnSpfJ4YziRk8u6mEYzJq01LtWmtFgdM+kytSnzVy6kRz6cDTfoD30q1lMckCZNt" +
+		"cVRBOZRh5UzvVSQvSHMM4kBTL3WfBKP+Oo2Bmc77zyQYPzauPj6uPpv1yUcvFSrUHwf14kLY+NL6" +
+		"dzsUISEHi74j5I6NkLeYY92x9nZukGz9rr+T/YjoEJRo/VZqnsSsF4du64iEvUPV/VzF8V3DbNB4" +
+		"R09B7S9NbuWTHGC/62NMBJh0KTgBtBcfbVPCWFESI8u7IKG+SvlxgCkyHuemxp1w832wTSvttRfO" +
+		"3YbIztJNwnCUHBxgwpMWBAmOFppPYMT2+uDQe8Cbrdb2NBwX4k0ySh6AtHZWl1pEG9Rt4yYhR2/f" +
+		"QMIQ7+fjAUSKM1OVZsAh7YjF8vzOIIAdhdXfOQC7Db9u2ZpgN4NLGpHXLyJvNcbOeabbLXrQcNHj" +
+		"d70gEXGdgb0zJtK/7Ie/WzcRKBskwv61Y5S/fvb7104NAGpCYcE55V+t8i+qxGz9l9HYsr84CPqL" +
+		"2z2Qw/77q4lGwyWP+PuL0TakLvAvD4Z+f5NQBEr4J8u//jz+KTUgbX8e6c8jbTdNblKfeotssoRV" +
+		"0xhTbVC0KQArrENfAetsoFru9xhM6uIC4X7/QaoFtKYnruITcl+wOXdZMCy8uLDlVEo25dyVniz3" +
+		"ND+dCIYUyGICMY+SxAEmvIlgdBl1ByzjE964c0CgqW+PxyQzliyT1BgTUrSZm37Cdp+2WJGqoh36" +
+		"I0gDBDK40n5snf34mvphvB9t7749tfh+M4E4+03ot98Qcr+Vy/qAfE3uC6IndngynOR7cUA82+86" +
+		"SlgUq913vYMrBVWK/qrYly8KsixIzM/i4FmA3T7pRiXJJPXQoZHRTflJ2w9SNDn/SDXJbNacMdhx" +
+		"pwNSglPvYmz+WbCaMRB1DB3/cMtRgP1AFH1LhJIZDIRBVwVpgKDtPEzCwCLthfTG6kCNeKbbJBrv" +
+		"ehhnZMbzJpNoFPpJNWkZLjvgeWYH5kUrFd2LE5DvfIFcDB40OjIfJbBoWCx16jcJJmHF7RgCTVWv" +
+		"+KSt37dqElm48TogtpeDMLOU7iDBEbmxluwAE54QGsCouPwxQNvfd86HCsixLqCj1dMlm5wWdhIf" +
+		"4Ul8hgfSvepiq+42i9pjw1DcwSxYSb17AeuYXg6TKHTiy5aGKHci78PR2z96yEzQvBOeZ+aintnq" +
+		"AqFtJ3cieksq2XM0CnpskvyRGiNzT+xQPEsDWTCffTRBoA8Lf8z/Y89eEG3FfDT9qwlWivpcCHMx" +
+		"zDW8ZNNEHyqAhPWm56oEmI7YTcKgqW67csfMJHdORIINGcbwNMlph6QeWovcTQTKarIRKKPlcNsc" +
+		"xMVWfAIuFaYiF+6dVXQG6hanm7evUaBH72acFhU62IRzjnBTFJtGxKcRsSlDxjxhmWnmIv44Ou8O" +
+		"jKU5YyzIX01Q119U9defUVJOYkALKv2Lw5TxTrQOLb0v1eNzyP5ffcTV0/mRSqCsJqcFwSPP41O0" +
+		"AYArACnCEyXpIky10ufLBJ2UPM/W7eA00eRYwLiP3acDDgDIbIsqWmZvrGZvVsveMLkPsNr2pha9" +
+		"WKdCgkEjMLSlDsjvSFlZ9kXKvIlqRG8KrfTQ5JHKBOx9yaxJFS13HDw0UuoOiTYWxq472q57QKMz" +
+		"V8mMfaCoWmtHase5hm3y+cXpWjXrUjx0PSQrqZ12dddKYOEOxU66EvaOhQfjBVcgIBvA/VTX/i0c" +
+		"tsUGJDu7iCaKauax+pDVJHK5TgEwaVoCUjFZIZ/YU5Loyhgl2FA3lLAo317NmP74ytJE5lN8rEOI" +
+		"MMNiF3IdmcnKq9VrhT5b+pG6MjJ+I5dnnO5hZJaDF9CM1IGjbQxTqQbNoZPSReDo8GFdMPQwskvd" +
+		"kCYI2OwDuM4daAJeMVCgqmrPQQzMFFRbN6mGxhQL0rhgjROHAkrw8vkArcO3kDI3kNIjzjgBMDWd" +
+		"rsrJpThxYwHQCAx9YsvmR4AMzD3Y/AtGWbiZjzvgGQmCZ3JvBXRXmlBzR8oiHGunDbTTxu6J3vto" +
+		"cn/pmxZGyEEEnDgNcXMDj4QHui26n/X3x+8Mk5+TzJhmPvaiJDOa1EX7WAhdaXrQNIqc4/+mCZp4" +
+		"8smNdOqJyHnHz044hYkznyXAJa+sTloezzkN2SkSdHXR0/cTb8cOiS5RaJ3EDjn58mzCJbMhNJBu" +
+		"BqddGzm5OZz6hnI69Uz+xCn8yVP4007hTz1hP/VY4cS577nv5a9JCU6exzYw/UEKx/yDieC0c9UT" +
+		"56pnRj3YmCeFiXm15z65OTLk2M65aohh4Tb5y5+rZyhe1J2zmSxsuW/1VvKXQucyfutXVl4z4n0o" +
+		"fHYP0AAXBVulG+rJrfTkSezJTfW0c9fTNljeaE9+lz3N++jJbqKnE+1nj7AzrB86JkGVyGBIP007" +
+		"5xnZ2T0kT8LOt95kS6cODo4MDguOieF28WLHhdfd0nXjtnfdoOoZyXXf+OSbo5oZJ8n2oNTLoukq" +
+		"b6km1XBsONYB0NS6STW0F04IADAdOJkBSHBkzcrw/Nh5rKGacV8FUg1cTLyEnS57Pt8IxpdIcmh/" +
+		"HmV0Zdn03KngA9BDas6uCXLwMIHK1gGYDAqvQxU95i3beESg3Ma/TmeFs1kZk5n+sO2DP2r7KPoY" +
+		"VMFn0iGpo0TQnZCKYZfxP2DkPW94b50HVOX5RPueT7bv+dTd/pBsJ4+wyvOp9pfew4HU7IjgmqjH" +
+		"pfuwglMcFSMvJk20Vl69LHrxsuioLviSskpm6hgoo1gKmmBAFjw3VfDYlAr1w0FcSUF3hpDI0DRu" +
+		"T1F517Qg1awfTVKpYhDvTq0vCHem6DkFOtmpCfApdcFpZjt/mi7MT9+SOMCLy/mDIVmS9kHCq4sE" +
+		"2CNl+VBIMKgO5sJ6QWY0eR4OMI0W4F5MWR65LvbEdfEHrgvv1Ohdq5KDntxAwqpNyQGdlEMSikAJ" +
+		"Lw6JrPu+knkgWHI4hUJVjdG3LMkB9pGOlVpu26ivBE2iUGh2YNp1p22kxqSJtqkk3hxUulS+thqw" +
+		"vo1bn0panNhuaLBjyUrCdqGSJfzp11ISr3uXkqCPn3n9gyuFpXSM3sJXNglSF2zOrM/e6hxUY3jK" +
+		"gjBjcTvDWIiHVfHw8q+YkgNN2CoBMKGzu1XPUVZ4SWAA51PF/HSgqeDihaOZS0NgvT0zpEDm0sUh" +
+		"ODUjq+LY+ssBuQduIBnRiGdajGCs+twAgSaufAWYmLIHGodPb36COhRMMKW3LocDHTuHIAjGj2Ao" +
+		"d9y8L2UcHo7TOv34k/KBMXUccyRpxFGubpo8hSJQVpONQIl2Vp17Ki5DDulmdbttQePd0DaVuP8D" +
+		"pvcNr+iVeouBIkg2MidguhbFCkGix4bMCnemAUIv2BLS5ciOqHf0Lt7UL/XOPZICvNkQDYcvYQ5A" +
+		"eGslmqI5eKgHUoIR/fikT6lifSPeC3IrQRyCk2daDCDMdz0aIkhdcOZbucFJFpwupIStiBQmznwP" +
+		"lvDN24XpVM7aJ8EYStkdJDhatMHwtFeiHWnWY7wBts80ZD7mwCqPWvJCyH0WCjHdKn4WDBqxj/U4" +
+		"yqq4u2oIgmdSCDTprZ7ij/aWirfqIKVO8kwbh/KT5UMoE6ziwXr53bXshPJJniZpiPvNgSa9okaA" +
+		"CfPlkK07MEuYVZiV9c4sITjBO5eSHWhq8e7A41IoyB/bU8wLSlgUW3RoKHFstTvQJLWVvCDNCXOU" +
+		"/Htu1bYMMjzqmW2VAGFmqvfSCWbCamkiJm02EPteBTAbVIdmRHhtoXwWlLAoHko1K2Hzi9I0dskr" +
+		"S1i1GUlVlsK5i5GElX08tp+YHWhiqY6dP0nCZK+v87BAkYeFg93Z+sZXx5ynGm9lKtaLAK++tS6T" +
+		"wsSZb3G6pOSwGun6Kqd1RMeBSsX7SKX674EO/mw1O0hwtJCDEfIjnHs+xbarT7HN6MN9iIL6PyR2" +
+		"kxKMGHage3GmJar56RPl0985N2aI55xAn/rFgFKfTOHM8nYoW206feLIoT7N3Cm4MwWla7ING7z7" +
+		"orLb1jHYWrRI+MW3u8W3y+xgcSPXzJPPZoEMLGxBStdinpz3fdq3Wf+JncazdG5FOjv+oHd+uLA/" +
+		"eIyt1B+W/knx0R2QpWnCaa9KdI7u9PYtUQR5XdjH71uK2aDtTn1SM+xRJl9Gv75ZqSaTwkQv98u2" +
+		"7zYN77gOUfeNVc5ovLAJfDOwNad3i+8rlfuCHb2+40skpe7+sF3R39RR4QdQQDiaE88BAOlytEGA" +
+		"Q4RdHx6EZFg8RgjoMilMnPlW25nmMpOnU6kLqpk/MegUJs58xs7+hTZyqQuqe9OHryCzgeUwTNNH" +
+		"SVXqyUltPNoHmNfHjiDbh1PWAAQdP0+8TVI3XeFYFLYd0fvr7kdk/VVtL0FM16LYGumvT2RhDlK+" +
+		"eEWg6bH0x9Q8U4JTnTSzjS6T1sjYWtwLUH8Rd1D2huel3dlOK2uv0iYwONKT3xJwTNei+EKf3Az7" +
+		"mTkkQOadzcbgZ9bdjtRv7SvTFklDnDtS9fhsNZiUSXWSu3EP/dn8xGRguoxsCT4b90DcYbW2aRoT" +
+		"xZxdoMmkMLE5orbBasPe3S5iF7+GXWx3zx2vTrBnwgKeOJI40U8nXjgtJ3uGu5sz7eXsL3pyeTA/" +
+		"4/B9fnnEkZnCbKkmp4V18NbbPOI//ZoTibncYQ66IKF2pDiqBfCcYCrNNZIKbVTlZcyzHtaJhnBi" +
+		"esd2fvLZIgIzx0EujHiPktCc+mtBmmnCNacTj9TrE/N62qEC/aAPz49bR7hthFtG5S9eaSl/n6m8" +
+		"J2jWqyQxSQNWFQAmdJ1K1KOoeZgU/vJq2l9cSPtr19D+0r3X0k02A8zSQPqNwvp1opG2P49x9l//" +
+		"POp23xqFAH6QSoDU8hWPNdbtrq/5Q9Kgg67OLw47pssVdz0Xmvk2xwxND/kIwckz2RwkMYnJatA6" +
+		"SSeruvElVoK+hGxsTX/ahVui1cGLt9UfZTZyY3fAwjw1JyakaJS3KbJH+GvzSth9KGneLkgDRKVo" +
+		"kBS45GUEI9uc70hfscmkMHGxSptcF1zMEz2+fjdkwHhoIjMc/J7+FBcUrJ38g5St+GFdP+yV/PM4" +
+		"caI6GeF+Yr5L5nLYOMw/6MT8Y52Y0QNZv7YAaWPvOIRCXbQ+/hCJU7qMo60MKNP+6yMFqiwYFq4r" +
+		"t0VZmIvRa7zHsKXJsiD8NT0DUiqNPQWi0Z4JNe4LYvGw39HLl7x6aRcvce2ybnhNAjIYWA489Y25" +
+		"kaqD8Il5QOsk89Rrr4R0OXKl4ZW7ITQLH2CADAaNwJB3rHgdwCK3rU0ITs2IheQWxSTzeE3f0cw1" +
+		"KyBW0NNYBSGU3SRK4DLYgJImSF2wOYOsUYmzCSlMrAu2yRM9RNmCgyxYF2zOazFa0Zm2nPvW8DXq" +
+		"wVxk276FQVGwxc0kDZ2CP/cJxYraSxqKV5JJMPZX5JTgvGRYPDzZrHCZhBtvPhtJmOyFeVt6oN6r" +
+		"qSK/OB8bZKKabARKhsHRNaQEpzqpGS4l1KYPYVbh140InHkk/0YJDtOGkr+q/6vJyR/YNL6MuoOE" +
+		"iXXBNrlNb7bzX3tqh6jO+nkYFagRm3N7STYpwalOaoYMPtBs5uZOaEt7iUvmoDXtxeHZXrvVaxNI" +
+		"e/GpwYrCuERT/QJNXS7PVMbteikTEgZ2WLeu4N2kys9V17hVpOh3fGW74lMmNd63hh0qKUxEPCoN" +
+		"iIsKRjByxzK/K0PUXL3sbbck8dDNSPH+phGycqDAtQQg5mfiYm3dOS/uF8EMeCPLEWakv0Khqi4q" +
+		"nh2FhPWJBvMmH8+rq28/CtlhsU1/5XS51khY2STPnyt8boW2EcZnDBTYtQ9KBl5MU50j+NmNIdmP" +
+		"ybtOL6VWHs/FjNWUubyZqyvbch+HWMfx3mSN7QcpijTuShVQRfuJiZ6+khrnw6gv4wwBj1aySbXj" +
+		"yR5ICUYsy2d9auy/I30Xu9BGvkg4VCelxWqt+f1Fihp+ORb4Hmb1FzGrvYlZ+SpmtXcxq72MWf1t" +
+		"TKULcogy/vWMBDduanlqwq71B5trwfuJKuv9NUnCwm2yBcDEUhikHfoZ2IpHYVRgFRV7e8CoGTJQ" +
+		"0ps7kHpyWvWAQR/8qHhXGXfoRro9xYEZWzKpy19yv3G/YSj1S2mrRgVN5UP7hDaJXURFFoXX7RfV" +
+		"c7F4uceG3y1aFcamwAUbIzXqXkNvo430FifIpObIypQxN5TGhWq2UPahTSX0VbOXLICMQV09+gsp" +
+		"YvEDMQDE8u/CAJ8A6hriHP8DbDHnhaVaMGjw8eAhGUG/F1zLh9uEAur7/LLtn1+v+IMhr3Nr+dXF" +
+		"P+92ndCwGV4OqOy896LHxOdzSxTYmgdpZaf2/xk79yCkBkQtke+yGTETE+7pL6cT2fQzcu0oNNYS" +
+		"l1w0MXJ3d0Y+92KUrsmzFIXm7QdS1rMfVs+OxzwGNAj1b/yuj1GY2BzZLOM0S7Kdxixy7iaHYXjr" +
+		"JZWKSyp8+rX/efRj/Kux47WAPhp33l969Ayphh9NJEBUCmThChoBJo2Fr1uc+NSbikBJM7z06OHE" +
+		"m2snjiHOgInzlJeuZMiRIUkT9eWR86kHzecgHVGnPuR4PiOOYwjqcgrSoTCyBU53vaoLqQZ81QpS" +
+		"ghGqVMQFG7CWUHf+2g0BdhRPUilobVrcQnKQnhZsLETi7546hYnNkQF2/ymDyeqEiR4yXUasbiz0" +
+		"rkeRKgJlNdkIlKgLoLE09I5ycIoojIF/7vG55QmN1MUhOHkma4lPWyDFUifBFW7Koy3Dkz+XarCl" +
+		"BfVO3qL1b62tKg42Fv1atLQo9cuxzhwJjmhotkNmvb565rDxZ+0XZhOn2v+jtlVP16ohC9UGVhnq" +
+		"hstmjvDSFN/lhTQDFkCqWJZYNfgdLUj1xuaWnxEpBhEA+XbtiCiTkF9igDyhQ/AHNp3U+KMJlg1H" +
+		"oSrRPXboaW884IUHvO9w4g39017QP/2d/JOv5PM5kyEQLjN+5prN0TPQE9nry9DxJV8FGTCK15vo" +
+		"M71nvenMDynBqRmhNRxHFRefIbW6mjRBSTa9pjJlA5zPl4MERy9wPl/w1FSbmfXBSEhY2dTsTc3e" +
+		"1DybSqQZ18LPqttnxbZZK4rj7SLIRtDOrPaKkZJGregu/u7M6b87owRP7Q9FPF0BietUYAlGLNtf" +
+		"9qzIufz+DNgi0YIdRrXvQ4KslWr4HQ4NSdAUrD3UJOkRMwEmTf2C88lfNjhbxOkaAV4pbUEmwahL" +
+		"gU/+qFArktuJz5w6woyWserGaviE+wBdtmYPup/N6mj6IhckMtSxRx3cPLI97cD29MPZU49ez2HD" +
+		"F6xVaC32Jeuza/s7ms8BwxsmQ2LaU+gO8e5obpyVFS9ASeXppai0qanXrybaL/0XEXCyd46zw/P3" +
+		"F49kE5qSeuN08eTZ4mkniwN0+X7tsPj89YDXn8cn/Hl8rj/PP8/tNv7xUPmkNvAmQcUI/bSP8Dz9" +
+		"0zsgDTGGDESgRHay/Guk4pXIrOTBoEOqAaEYiYFGMvSw3crZHdQc1FPfR4GAFTUHvGSuMNqgwxGi" +
+		"mq7ZJcUcz33g0B8PTQRpgKgUcBAI1PN4DG3QcxvJU8vg9HrIJBTqNpIofTQgarNi1kQLxSyVQv3y" +
+		"40wm6YyoMSO/bTEQLpNSJ5nX5cW2S21dE7h2+mlvjPrSpsl9DPIBat/+ojfT9ndUpUuVJKsrMrRo" +
+		"2nI3mS6j6gTPW7K15owM7YaUpGSTtNe4ObhJjBBeTMAc9+18uBM0eKA3UsJaTym+rdVKEia769sb" +
+		"z7ZZ08pBwWLlsDLloMcHkqle13dSYymdAmpVh9JeFPDS5NyPl0kJRqxyoHq9txzvBm034l0402RS" +
+		"mOgBfhn1veWyC0bLVIbbfoudAmoSikBZTVo+JULvt5IoWKIsOeqqAeU+rmAZwK7+8iNySF0QmTr0" +
+		"eB7x1JvAT733q+m+Zb0uq0qnMBdbMulCQVX7c8eEuHM+3HXr3TG17ZzXdpsjdy4KttZ935BC0dWw" +
+		"79pOndd2TGpqydg4MrcLDZOf9i3kJ35ccYjILH3qVSFulJaDFQ7QZcjPQz8l5YSIVbI4BCfPtIBV" +
+		"cjlhZdo3k2aQ6gDTZ7tMquEfLZKlU6ixJ+kmYeDWywlkrLFDNAmajjxubbqpjVrr9hSKQNkg4Vhv" +
+		"mtBryH3LwPxbHLQM9oL1ZiWTUARKeCXLV/VeMoWqQTKFBIPqYC5xc3ATggZBHP3OEyXqHf1you3Y" +
+		"yQwRKOFhLXuWK4mD5jEcbi0DOGwrB5NKcWKopG8sDIh3ih8NoJccKNWBy5n1uBfAGLbgWP+V6x8H" +
+		"+JQSnGTSYrRQqnjxSUu+tcxYfSrqrqy7xlaygwRHxlGGZ+liMhhUB/cxsACnHm2CsAGR0mK1VaAJ" +
+		"wzKo/t+2J4WqgkBNK9POY8+xJ6wbGu+rO2nmj3QKVfFtEgM1YdPQ69zPrZWXztatJEx4zfcKbe4S" +
+		"2qEJWsGNs+nWqQf/KqBhKuXBvwKc2+iP1mu8U/bYQEVvxC46fnQ75i3RMPZNC7bJ8b7ylr61dE3d" +
+		"8ChhkkbCo0J6gWgk20nxV+W9YhWQJCxcV26LsjAWv7McvqviBD/f2/Vlb9dlPObnABNSTLsq1cCG" +
+		"vLhJKrilmgsbAjKjSuaUinTYzvtLKNJF4Cg+76hPZTQvbep5fxUK9QwYDGfgYDgDjo7PYDvTM6iu" +
+		"0/aJuf3E1H5yZj91Kz8zhuSpQwOQZEIDWVQ9Bjp/Wf2vVf/r1f6Oat+iSUI67O/Su5gchs9o0edH" +
+		"EwEj6OeHQT8/WKbPjwX//KDlnx9omntoghDcfj66/YxcHN1fCHgxjK5bXZBfuRUK2IKmKYlJmCtS" +
+		"KEixOCqDgdRJ7mWApv3GxCMMkmYz79iwmn/HrQcIBB93HOCitxyeslVNxpfHKQXm4c/n1YeEsb3i" +
+		"o0+CEU+xOA3j/acgHUoIcZSR8T+CYg8i8qOJriuVTb3GGhEZa0T3LiJNC/7CDX0nsb22P0+tVV8O" +
+		"MKmzsqTxgvVTUtBEIFCpSvUJmkbNjM8cx1rAz8k9+WT3E8uyj3+9hKCTF7n1BZvxaPWuGk82CMM0" +
+		"GpnD2E07qFl3DyokENSubrLNiqeSrqlKm9z6yjMD7nWjSCb5KP6X1qbaF5zmHmVRLkc7xflSp+ev" +
+		"LBgWXlzQ65m/AknyfJw6KHdxCE7m2GeYpUW87j+V6f72aKVOYn68m5yNindmlrZ0R2neHRiHgDRt" +
+		"mDaE40GayQjZWVdf6tKfp+8LuqtCxCJFXxXRSkZBCreoaad33/RsBUT3zp6O1h8KNOmHrLYKTyxb" +
+		"PNn8jFWQ2esFl/dJ4qC+BYO/cOhXQaqKJrqkHfOJynQZWefplUdKGpKYNAN7ozMHm/WQ+lWQiY28" +
+		"b3lSsrK7mNUrT9hau1WVMG0NwHMIzlaW/dIZtXKV9DrfsDFVwkR3uhwsEtYDRsL5SHLvkyRMpj+V" +
+		"kr+UpTQwZjGZLiNbbl3n5b7pMQchOHkmaix3bfB9QQ6zcn/JHhuWoOjoKBgaQ8S7g5nohy2jSH7G" +
+		"LDxOkoKkboce7Tg253hfcEtfSrqmKrOIWnWaddjSgskdpBm1PsmM6rZrYj8NObkhA8fwCuwt0JZW" +
+		"Tpdr0gzVdryuZt5gNsWVNjWgPjBlRaBoBl/4xSZe2pXvr1q4O1UVZx6TNUPvnGzJUReibrioSxiO" +
+		"9RYThapx4wKTsEVPZXFS1Gj7loWS8fdocw1QJqEYzk8AXSaFie7IraX6DfPBmltyXwaCq61/a6vv" +
+		"6EX8lCf27s4SVo1VmqoRzhwohtr6lrpJGo5ikoYuJoOB5aAGrt1JuiT6Ew/JSpPDqrRFsUjUSv7W" +
+		"4HpWRj6rmAwG5sJIZw4v0SND/ddvQFEOT11aHuxKP8ee//PcPhQj82pSKdqf52trPenBJKl1Q0xi" +
+		"yteQmjHi6yWOl9SfmE2qtfdCoYctRmFic9QGKg+bng++8OCXSmQXb1uZbStL2wrbhtPIIT5VD0kd" +
+		"68qrz8Je2afiGTQqBZHOhFSv9hDS5WhNOVP784x6YX6kaiUIcQj9eU0ICQRtAKi9SPTtFObb3ZfR" +
+		"SqageqoUTbSMVn5jsYSAOK+MAaexKlsHpAsSixXDOI3Vs5f4QCMebIKex8RBz00vq0M2wNXFAaaX" +
+		"1P6rpK/tGGCmjs9tfJLOQYvoWTOfJR4yCUWgrCYbgTJaDhfryQuLA7SykgqFlhhJCpoIRaVAFkrr" +
+		"yU9MSZMoFLDplu1nlFFvnsTUNRm43/RAMe7681EOeiuIrNlYQXviGt+TrfGd62zfBSmUeP8xWS8j" +
+		"z1LLoQnCHQx2qMsx8ke781MqBb7hDxYDlBqULkIzmbdjQTPD7bXtKvXXhjXr51kodAFwGBx5GBxz" +
+		"qTtGcEYiaq64vwk5MmrQRCgqBbIQqoYkFIES2cnyVU2a8HH6ich7lRIcYMIGWm0OAHFbqQmp2osm" +
+		"auiaoBn9hRQZbIquWB2p+i+aDJtmjPOK+BbM9ATYbxhKAJgyUigVKRRNUeEbZ/Pxdy9IVfndajDZ" +
+		"/jzTdhekEiC1cNLHW4bQkyjIdJG6OAQnc+8WIHJP6EgPqSbVoBGC3pCAFLcwUsDdBrs7q3L0Y9qC" +
+		"IEVuxDmqEVsOhR4lbQ5uigYnTFhOPAI8gKmen6Ux1tO2l+rn9NRkUpjYHLkwe8EJsBEq28tZTVpx" +
+		"HgwAtUTGutI3yyBppmOGmf2RA4U+HDZI9SpIAwRsDMAfs3imreWCLkYftHbD5xUXXjJYHNfSEy+m" +
+		"D6mPChBo4iVkR5qjBY9iFUc2hc/dEFnThwuiF+bTdt7LrqsW64HrgP3/y+C/Nua4MkRXpuC27ZBu" +
+		"xqYzCCIjpV+WapLZVjB7Qe1sEU3QC8IPtpG4TDruJZfXuePq01Ci1qMh4k0TPZ5Qqb2j33IbglH0" +
+		"+H6kAaJSMA8CjRx7lWpSgtGSyWVQBhxNTAYDy2HB3JHCgY8oDFC/1rYcolNR0oMwSAlGLDewEapJ" +
+		"96rTCy3s9xeFBAPLoG9noCG3Hg3pP2N2j6lfEB7wizQyAK/HDxr5xcZN8XFTto5UoxebE4s9jK6o" +
+		"jjdNtkShZ7+kPqkZ+pwDTSaFidOb1d44d5cbCtCZjoIPC9QvZc2yKCaRx1llgJi0nKhNvP9IbkeU" +
+		"9KWoT9mRoksVsLxlx1Y/gGO76M0vfaIF3+B/plIjlgGn/ams14ugWf5jelwmuSB4cNQA1VamdEED" +
+		"WtusooEXADVwris2gym4BQtlU1mZU1mxqay0VhIuOaaCyaz0jgHR9XAKEjHfgjRAwMZAH02Qham6" +
+		"fDi+7Zv0T5+4bc5WicZ9YNefGDIYpvO+PbVfz3vReeBEEjRVDxkBTnTAyaU+UcG5a6JVnJx6hoTP" +
+		"zu44VcOEMARWzNnjNyFKhwUb12nj8+y9ZJNWqpelWMchiMKwXPfRK4SSFMpT23Adrz/P/c8zbzUh" +
+		"lQCpCwlonZSfgAZxDdFeSFEO91Sz3U/NmxbtavtoAr8Ps8e6yBJH3+Ryv7/ikLsgVaNeTxpCKzrH" +
+		"f/vzLH+eZQuaCIQG1JfDn2WMqrLpSY0K2Oz2reM0s3BKupbKhm/VTIIRN3oJMDG9lyp1QZg77+s4" +
+		"qllDdBEK2F5SgwNMTPWyBGGYbuNfZ7aCE+rCs2d+U3zIJBTB5PUs5YdKNdkIlCydEDQxqM5xKlRF" +
+		"suPzKgO7fq52weExssL20eOBEu4vPR8lVBkBQ9j2PXaSWXT/OOjEm+kLa9ggzaU2O/Cro04w2te5" +
+		"niW89ASwBJz9lMBVHPjSFimbNSIYR/2AH4F0Fy20F6sXpFnHVvXuhZEakegJGSQMJVNAPfS8pGhI" +
+		"RNPl02dfCx59LbhmUHjNoPASQbHrAcXTYcXVosILRUVXQAr4gWgnWaxSV25TQVgqsEumYCHJ6K5B" +
+		"tSDoI0paqBHz/TVJzNpeDrThsT4CTEz32F+T1PjQBEuDLkvss/Rg8x+qcTJIKX7K0wGmsV6zDpa8" +
+		"6RXlIUdWDno6BWkGDBSSGkWTilQtT020WXxYsGDl5B9NFLOGLrW+ZCGt/rf8eRb918NiFaOM9nAJ" +
+		"t0tMwvqSDWfm/Ci6Su1aH9lzaHMkF57/DcAjSLg6UErQb00MUD/R9KGJPhRvoM7o/PJgLQ+r5GHb" +
+		"TeGKKA9oGg0DunBAFw7o8kzRAYWeaCujW/AfTdQza6J4jKQJ0pvgNz5cuYh49GFggKCmn+92OiQs" +
+		"ChsDDf4w4eZT4Y2nUkWToKkanoI0QOhum5AuR3ZVfbIQglc9KlGhZnRCZSdU/XEjSuQjonV8ZZRx" +
+		"e0GPpB3DwnXltigLWyBOofXQRFG7W/tWh1t76cM4kMOhcUWqVMMhiULVhseES2vxoJBgUB0aidFa" +
+		"PMwy6h790e+vjWJk6VSBWaJ3zdCDr3LiLibkyD11FeHZpiEqBfMgUO2Z9KgHEjm69z5rEAoaIXa9" +
+		"YEGgSbpJNajWsWbPzlV79rFQ7/EvNVPgPQ1gvhtKMGhtkhij3YPwNKbjlhbFvEt1mCY8eKGKGkeU" +
+		"T9ZEK/+wks/Irn+edbtpoocqKqvJBohmweJWe6ul4rWVqm+fVL1uN9IAoccUhHQ5YsGHkr4oXZOn" +
+		"DwRru+P0iJAuR/f/SWJSDdoUfBBVobsMBo3AOgLP2YyQjWIshN4I7J7ACFHXF4EmhoR2bsmknlQD" +
+		"02VkCxFOa+bp7Ty9oeds6Tmbes62Ph6xR4Oi0JEi1qNLNYkCFvCBZuvFcxWqRm11zEgRI2YOiZht" +
+		"SES+UKlIoYumwfZ9qyb19rFjMzbgDe/J6KGd34CbiALH6ZIWTbUKvtgL0ig5IN12k41w5j6JRh4O" +
+		"O+oB8dDCFhyCVaHK5ehGO0JeNWaKmDQD/dipOchJix0+T5awalyHpqZr1Rg9pqJfc5IvlTVF3RMb" +
+		"WfnIx6QGm23fTDKuHWJPTteq0S3L8ZLPyp7RjKLcZUGaj81k9fb6gTsUgomSHczUfe0ryqp476nG" +
+		"Eme+J1kQ5qdQQI3YVnPUm4t1wxvHCugDPSKtHOhsPDfF3DkAug0AGyg+RGzbtCXt862cumVc8SLY" +
+		"euJDAkZhYnO0qP5wgLKVguMhSPExj4nMNPEqzHzHLJOQ/UKKRuAJn/l4z/psD5iF2Lrjxc49Xv4I" +
+		"x6K0qTEALFmSSRiOJCaDgeWwqnYgtT0FHsyv9mR+xaP5dWtMmcspp7UXz60nMyOa1eZJXmUbkDgg" +
+		"QSxQ8XJbxW2EylXJ1dhtp9rnTrWvO1WOHxs+XOXL16G/NM+U4GS2yySbS2SdkZEsb8l4MoJFp9Bv" +
+		"Rw86+QwmsJwrpmtRfJlOjuz3tkt20Ly3IA0U8fmUujK9EoU52np/C3vxzcHyFtMzBVvxtrjUWgwy" +
+		"yYzdJHdVRGZfeqfTCMYIC7vkHfmYmSPNbG7sWzPoMilMnPlLIVv3xtj83taxmiK5CRsJ0mgX4l9o" +
+		"2MXFtJGhvzM3pHXVpemvIA0QlYJ5EIggWxOKQHlsevtGuZpsBMpoOdy0BqWLwLYosg5kwbPfy0pw" +
+		"7QzYLV730/ShMHb30Ch2E6SqwFcC2ybB2iaBbZPgbZMAS0aKu+pGjSipT6KRtWRWkvWukAIry7yk" +
+		"o4hTdCD9nttTJjH3Wctd6srMeJWaJ7nxbLIgzextwTtqA6zOHUeSItmXP1e97WJEo4kPBqJI/uh1" +
+		"lCqCpeMa4YYitpXwR+kNaFLx3HhnxTFdi2IN2vUSKqQaMo78VZqBqxbkRt3c5dAE+w67ClH1AkTV" +
+		"Ax+58GFup5ETcRkYUg1Bk5DEpH5Ea+FmCiDWkh1g0p8hMpDgiFxN5YEUyhvnqEY00oIAgjlQpeVT" +
+		"YkmjvNHLgHQ5sofj+BiASRpKNqmGlJAKRaBkJitKvDpR8cRTjbsmOAVVGQwagSV3eDCfuVinkff8" +
+		"BliDdyuGhcHtIpX0dEfN12XRFRx5MB95O2EAY+VgjecAyMF2GIYwPwsFVGz39tBSZQ8emgjSAFEp" +
+		"4IBoHI3xoK85m7e7u6OqLbFzWrLeacm7h+uY9o61hocjh9y5OkFaQj07EBsy74NXu889IJrF6uzd" +
+		"6+TttQFajX7qkTIYNAIDKU1b64aIpF+CfNaip40FT1UMieyCg3fsMbi34FQ5BALbfDmkxtKS+F1X" +
+		"QuskVGY/7aqoFh0+Beu1/GiCbbf8/LApugGXXa9KQapBp1H87htkNclsFsckO8S+ZSMBvYUiUML1" +
+		"zSUob1sC3t8doM3WAYXvOgxRKRokBEu0m2w/DprV7OpCQV/w4HrISml2VG9H0IXXjIpfLypd2r+n" +
+		"TErX5G8fIMdZ8XFW9FliFeaiIc77i0KCQSMwhlLrhsnK+Uo++eCHEnJzoLjZB82/1Ub9nvTy6GTL" +
+		"EAsg1cHyXuU5yYyzjiV8krZissbls08yD5cv7I+dPcNu366aZ3LcKYrjR1Ja2dw/PChTLlWHznkg" +
+		"5bJbVV7JEcWkGfLTgSYKPLNVeSwzRKCsJhuB0mJh0Tv9u5vVDZsSTjuKnXYoVAc6cgoaNN0WI91U" +
+		"fDRBjA8jfOj6SUgR48OaPlbRJ7kf9Iz0PEzCjBSD7YNxdt40weXhk5eHT7s8fNrl4dMvDw9KF4Gj" +
+		"/7RLxicuGZ83WFvHoc0ZniYCZYNkMQVEVUft29O6D5AuRy0sTPU5BUK6HK1pqlQnLMPjgRQZj4eb" +
+		"EeDxsNL4mAtkMKgO7mPABoNgPJCyH/m9URBqG89PW3UHhWbgatTJq1Fn5u5GATHyE444AVQJV+Ri" +
+		"AJ92nQnXPtufZ//zPLf7tpexcOeW9QD33HIZad1yNNkIXWRSmKhxTXHnOF3itJY6yY2Xg4VC0SAu" +
+		"00WqJlE68CTFkWa9Ak2YJlxVU8UqwtVvI7pib2tEo4ndQmN2O7f3hnQ43KRmfw74lMo7gkbDRRpT" +
+		"XIkaJIGymqSLUKK50lqPu14VmBxWpS2Kl8GseupoOfHrkSqt6wxR2Mt19gKJuUOMDed8PLYPhQQC" +
+		"ig4abjHghOOMgelNbxMbwUcLxyB4n82IeXjKx4jGSkEVAlXrxfyTb1mc9prFgJfeJTeiEUKQ6nW3" +
+		"AdhXGqVrcracJHvpk2gsnctADAsvLtZUBMABCqCZiZXaEfFAb5VuLjHLRqFWXZF4YO+MfasUsfVJ" +
+		"ljdk2p5IVbm/kGpxPFGiQtWHJopJE12upI8mqUCWfTwRrJ3KJzxO7uxO9LtejjvToQli6Q5ehToc" +
+		"dB/z4DmG4I5nHCDVeCtIYzJJc+kmaVARajlMqkEPo88dp4Uqtad2HEWf+jWqcz9wUU3fmTz39ipi" +
+		"MhjQf+BwGt75pucRkGp84GEOgtQFZz6pjjp00sfEfuYfTRQx92RMPHkXvWd84rRxCGraGTmjosxK" +
+		"cA3p5LmlfhRV06YXpYzU9Sh6bW7AB5tSrh6vzpB1RlOd86tKGko3CUPiG0GOMOM5IoKaPlsSk8Nw" +
+		"4GDv1IdxT3znSUWgbJBoT81yUaj91GRge2mixfA5GhXIEohIM0cFH6CE/P+T9S9Lrru+2wc67xv5" +
+		"38oevoNdtceOUSesYMgXcHq5r34Xeh4Jsn5V3egjIQ7GGGN8iPbe9tCwSadAdo3rU0qaVYuMQeV1" +
+		"xX+EdNPkfZww1M5sOCQ0GxEaBwQbm1svGmqOesgNLxsh+jJSdQTIr3MZ9exaC1ybn7g0P+2x9NOf" +
+		"Sj95sQ6ZdQFiMiKYsVWU1+1n53unBPiOMt/jP5dKMSLeIxhxugR9smV0r+Gy/+RVv8pq0uIpNdV1" +
+		"jP/nY8ubQxuUt6ZvKBuZsZuM+yTcrTLNXQGlvfTtZ0c1I0jxZXIYsuz23vrkLS1a6x/KkmhUYGSG" +
+		"JZwLKzgXF3Aurt9cWswLXet64aUFSBh0k19jZ1+4y3TxNtPF+0aX3eC57MscBMaValIN31vaS56k" +
+		"xqIBsO26UmA0jdoIb9EgaDjidJHh8fX4v+PrsX099Iuo+ETUwmPrFvVDuYYSU9QHaSaqT4Slbzwv" +
+		"UUlCsmjd7onNmEBxbskhXY56mFHpMilMnPG6tcZW6Gmp1HGPksPmoKanBpqlPhgKgShkuevvoz3w" +
+		"bAwEorNcJpk+y2VpdGh86JCv4fZjEoYe36OHToRZZ5OPbWQXQtmfJkdsyP83EgRN9q1fBXhs36po" +
+		"6lHw/b7VYDJdRmzJ+z2aEIOHyiQUgbKabARKbN89+ocoVqUtWpRV05oMw4P1js9zhEkDLTUy75ga" +
+		"Ql3SdXJjPSapsWqgkxiDRsrBgaYqW+iPlS3ibI9JKK5i3Ujp7CYRFQoF1YorjIWXCG5ZDfVyn8s8" +
+		"sPVVl6INEBPprCcABe7IWJNDfAohT0Bclm4SBvwsjJMZs0kYGK5t1T4aq7348tWqrFG2ve1Vt2uS" +
+		"udQlXi/ilfUn55ymMba+MiJGrn/GPwJ1//MnIhyKtspT7UmHlzQGNpNq3i8dJ7RU9EH0exSe0Ojp" +
+		"W4MbhgqARn/bzc2F07Vq3Fnp+yV5F6OMIwMM/x4PcUAkOz8App8N3j+xm9SIeDu7SRi0dVPUMTPF" +
+		"Xpq2iB4fKUk6/2yPBRF3bPXpIMGRmSnDsxQKiyqFET8UTI61NRA8EJ47PlCycFiV1ctyoFbyp7a6" +
+		"lnq5Vu6SP5S4/6O2Dx0aWzj9oKsm3dCCylmO7m2bjyqhCXQ/cGtwfCTM/ABM0aVm2Z8LNjKkuWE+" +
+		"/NBPoTy29BaEAQI2+l4Y9lWOiDFGHrez3k1KndQMFZJ0ClUPzV/v00G4Pi2VnvUhW1jQzKgUyYwJ" +
+		"pzNDM5vsRSbBGFkihz9cPg5pOXk2o5WOF9xfVuWXV+TFYhBgx6ikwfxAH4p5nGrU80kOt+1ushFS" +
+		"cjCT/pbDRJqjeUb3LOXpQFPF+EWCcd+qSTd0B5rS1l6TaKyYJpAkTI75PhV6jxUoA/M98RV6Y3gy" +
+		"0ge6HMYqDyUM9zObhOEhKTjQVMxUzBT37eXwkrBwXXl6v6x6QzGzb3dcti9aFuhVWR8sUemb4Xlx" +
+		"OpbDU+r+iPL9ocA5CcVtq5MsLlBalEdQWlHHFoQQg4PkBZszqJzdJA1vqQ4wveL+dJDgaMW+4s4u" +
+		"VzEZA8DEcPsxSYN0kzQ8rLe2h/fWgVYKmRHlEId0OXrrt3JIdeJO8kmDIX27HZNKMPpe+ylvtqB9" +
+		"Lsi4047i9adrALCr4x37886deQ8FZjQjRtTM4dRGDZUwMM+7ZZnZwApw4R682x682x68+x5kZueL" +
+		"QlXdOlytDIEKPW24IzUiZBQKqBq2clAMdZTwemy/OJOQ0jWZO+iVFpkuI48tMZiEQV1ekikkEFBb" +
+		"UiO67BvmTFNBFA7f14uH7+sVWYAdvmMJ67HV0VriEJyaEYqvW2sUdOI0HuBxBjMVL4v1q+1SJ6nn" +
+		"rVQK5Hor3DmDjo3noKlYIpWaInDGCWgkPXnUcCvupTfBB+2lCgG9iISkkikkGFQHc2EVOdbo/SAV" +
+		"2tI1PDaXiH/IFiQsmK5F4f6oYU6UndO1au4ZWWK0IqO+zKrEJNGdU7RtUKIRXu7U9orfjlHlEV8O" +
+		"jOcAUucAUkO3pu/lZRIxer9bgddtNfyUMoFexebNVUcYpJGbpOTQQHwnZDDCFF8mX9ME7wMhFA3j" +
+		"XrpJGFCsVh8DvlcFnSjJf2fMk2BELqn0ehHUrgViRlgPnNxVpsvIGppzM74yZ5AuR3csmIsDtkmW" +
+		"qSrT1UmcyrniTGbPyVE9j0lu/LWLCGgyKUysC86UswK/trElo3CNsbKz2Fii5DYm0t3LXfviVr0s" +
+		"Dte6KhFRSjYpwalOcjfLouCqqL4Yxv1RaljZCpnqhysU3bAaw5YdGgj14OKESouALD8UdLTLQJsE" +
+		"16q/LWMgwdH8Tv15mUGoBVfVqq7QVK5hVvwouQL6AD/o9cC0GFPi+qbTG/dmjeokd2Mpb0GD6P9D" +
+		"A03IVaHGarZHZGzf7ibVAet0Og/HFLy1JBSBkmZm1XBFo1JjNAO9RGatG2YsrXMEtw/rgJAGmXbs" +
+		"GN7fUNDmsZ8qAyHlsA+fzqWybitlfXwhHUJz7fsDIc9KJEaJ2WSaAiW7heJFMEM3SQOq1fcHLsQB" +
+		"GicaaJ6Cccje4HgsL3AMPgpPx4Yww0JFQ90gloglsM4VsI6xeAhVEfBE0f080VkhZm65M8/zJvVp" +
+		"ckSc9wd3Dah1w3QZcSsGqy1psN1FpRZ34lri5KXEmZhnim5A+XqGOfP+oBDoHSHyyqi7SkYzdfbV" +
+		"hxNL/meXc/jqKgSm1W9BT3+jA465BnoHCUYNkeu7jJF/DA8YGzgw/Dw1gAnT2h9OXH+e5oKDTSVb" +
+		"6UcL+EGAVJyx/fRHqdlhmMaWXDsOM5Vq1CI5ulypfHeTGnvYFcx1lB8Kjai4qlJJQxCTakAgEkzC" +
+		"wIkFQE1jHvHls+5fPJYDQNmDcMIjpYusNk2MJJIQ5rM7wCdBVIRQNETf+WVn/EVP/LVuyNPcL6fn" +
+		"v4eOFb/X1yP8N3KRr4f8XxhBSl9YCpIt6OvmBk0JowvBTXqYOk6zliqbTXKN6HD27iDB0ROdvcNz" +
+		"35o40PQQMWPRQ8EI8bpWP4mpWAprazX9jrUhg7tsiHpYlg8eYIZ1weZsVX54+ZGe2B8D0gWZrWKW" +
+		"CPtEtpC24+VgphwcaLL0+PEdJzNmcQhOddL0c7KaYF2PwNjCOL0GVtmsFfx6Z7AwOrbuTW8KS8Jb" +
+		"y0404j6GkRmzSRj+O/HbRgtLWDXbBKpIVa3xK+YBg0o5vrjAOcJ9q99ObxavyyKQNOjVBsFMNeLC" +
+		"fVEYZW3VrFVa31Ka1AxzmGRGayO7VaZYbTd0GcdiXRk+P/hxxolmXhA98Cfm4MCoUoMDTOqrdU5o" +
+		"6YTcE7NLbHFsTOoPil0vkRT17G6Ursl2CKT+4HGi1LqhO8fFVW26G16o0YtVwB7RfLAZFZlWVtU6" +
+		"R7UjrT4lm5RgZF5PQadj4oZmqxhtKjtz7QhtiAQx6iZbn2TGKttzQSsOmjsVq1u/nZUkLlnbPmvb" +
+		"rbb9WxJLfVDU8z6JXg+X1tOMGcE9MsAtjRlzx9Tu+6V2G6JAZpzRvHY0ngmr5OBbQcU86dLi/pw0" +
+		"k/qC/lD6o5bbgjT/2JWsKnplP0gL0M3huM4lDNl4+NjxglbsD8kmQzkMdQpj6FamYz5oaTs39Fct" +
+		"RYcabNzYhvLUHdB/tvcEjYX+Rg3fqMJbcuLOAbZufBGYNv/wQCDSzKSVKoTlGF1nttzXb9/Vb27J" +
+		"G/bL14KGwiHzff2InZaMR2LdjXjsQkWgrCYbgRJF7R2DiUqN6XGnwCKD7L3ohzWc4MafqJhIM004" +
+		"eQywgnS9TsJdEAaIR7k7NKOXw2v68a4FFfpWivLjYDGUEExa2JcUukPcHYe7limBAmOsSm0P4SEl" +
+		"Nk+Q4EeghOUA1HmsiM7hVSBDm8krqYfmjz0m3C+SLHMd/cdcW3AzSr45fnzbqPHNQePbxwzeqhKd" +
+		"msu3HpF34WISSE2xbhTDL35LpVAVnWwIyQ4SHFEG2BKozOV0mS4j1i1qGdUUm7vEmjBvAMCkoW5j" +
+		"xJX9kCwWuyoNYxp9FVLzSnEvL3ROQwmLghxMQwr/XRpVur5nNhE+ry2YpKHU7gDTeaj4O0KtU7rp" +
+		"0rektG8u1TfJrejBl9KjpAmI1IU3SBraw2S6jNioKRWEUM73NqRW5ZBOgTY4MONKB0PMpNLBmZQu" +
+		"bgqWNCW9NEDCFxr0xRZ74bhQmS4jqw+PVZUwJGkT0uXoKTivBsCkLxgYwKShjt24qy9jI4+tR/U8" +
+		"Nv4cy8pbWrTWP5Q2tVGlQzOJ7fWQumKbHPeVkTerfljND07zD5vl40EBwYMCwjv5wnv0chR8Xljx" +
+		"9YgpbgvuK2uyUutju8uCYeHFJe4Ls6haH3rTyRH+TR9DJKjppYF2Cu0rR+v6nWknCQsvHihppNOb" +
+		"UiP8LpgyAXnuzPsDnW8A/POGULPOeWNWWcen/MJJTqUEI/q8sJyGoWjU/bVVbdLXhvF9yB4bqOxX" +
+		"lwXVjB7+emFYffXtXrLDlhwbqD9SyQsO88igbilQiEHcDfKdVC+CprtpsO2CsRO/hawyUaDrkuiT" +
+		"IPlbR4YyifFvDDSkabTCNNzPJLo/dO9UVh6X8pWX8tU2ALW2C7FqF2IoRrab3q01asBvjMkkGG/a" +
+		"ChJi36ojIGaKmVCnd1UKYhpmVFUaEmA/q9ySETx6ofhmS8iZaTtfzO4nah+t9myu0n2C5nRsr0ct" +
+		"AcPNon1GoiKHrLClBXUArEfsFCznWAqKaLYcN4pEaeYuDsHJI7mfFLGBurkFHgUVK7MuhU1V0OwF" +
+		"Q5pKs1PqDKoyTxhxhqwvWQbZRVsjtTCeglXecJFU7XoDHaNJ0n3df78wuLTIm9yky6g7JLN1cQhO" +
+		"lglbxnAmQjO1+fS1tBa1e3c03hCh/J10Ee+SJfZVw4G1aMlizd7krz7JvSgzC+jpUx0pz2rTVRK9" +
+		"qA2Xn/Gv2/2jR80PWpSTTp1xjk34uwXZ47GllTWSw95fH/X+2qD3d+OVAAAmuw4gqVGL15sh8hfl" +
+		"jkpeYTPRvh7fX497/3rcf66vR/x6xK2fjWIkjLdtf0STatAXGmVSmDjjtTjjkp1LXVDdY9vPCZrF" +
+		"vpfz/nCQ4IhswfA8XxT0wpvfIPV4aiAXBWz4ZD8IMUMbOYQgo7ZBNNAfwjFQF+HTC/qe4gi1j0Gm" +
+		"ywjTKeVuEoaKEIqGqC9XR+MoV3MW2KVudX/EfcEtfSjpmqo0Z1gLviI/Me4fSls05RFo298feHSD" +
+		"APtN71EoXCYZoxd/BJh2POhuBKNUCqqtq/ze9Ov6yvacnmO6FoVNe39gPYYgdcEZT8JFlJGEhevK" +
+		"iztanwrtqIZXoJYtOMCEsOtjUwQJjpZnx9NU8Y6bhpB6CieK43D7o3dgVEgwqA7mgtxJZhzyKRoE" +
+		"DStC2DVEusTPJTmlazI3mR8cfOjzoDGlG3+UzBhRel2MmA6w5JiPQ8LAEIcbACZ9igUSBs1b89O9" +
+		"cYx/BZyidKk3sh9k/IwjAJtn3SKHMbdW8KX2yfCoOMMZwciQPQl53gVhgFCXu8Wppr7ocJlldans" +
+		"uYaNDMn0upWjDV43XaGDVCNO1ZBqeJz47N0jvtjUWLGJmEtHzqXj6xWFAk4o6/UqCEPV5xwd4VRe" +
+		"pW/H+alYHMTYObqf8M4Z5TBU0SBoyAMAgFgNaW5nF5PcDv1HoHlgmSLywRSd4cTWt0OnSUbqYctl" +
+		"RjTavGjyjLhAVWcbRs1wd9jSgslT7bE7iyebLx196v84WErIGrFI5OjlQB2Jx0bohaBdBKqsJhuB" +
+		"kqMAKUysCy6+E9HSdkEZO8t4SO1lgHpir3fudN7ajG/RIGg4IrCyGq/t63EcX4/y9SjbPQpFG7IG" +
+		"hGKymmS0ZkGgCeK76pFdNjzmo9IM6E4FNzdUpIuwRFVKplKPvxqgNn/h8Zd1+YsaY2pVbuP/hq24" +
+		"6cuGKqAmoQiU1aTFUzKv24XwUVBjQ3hd2oUJauJPgTipEU/vQkowYgl8orfcMmqe8bslJHPSmwzl" +
+		"VjRQHMEYxfGJORWXCiTRgX2EunxMGKbhG+7yKrr07KhRIxhGfPOOUo1Bg/KTTaoVRWkbogHVedju" +
+		"W0aoBjz4A6kGHaoLfuVTZXuY1P6gHere8FwAQU09CkWghPliTj+b5qSzzcLH1os9s14wvSzYa1xD" +
+		"LbaGWrgPdcuREBuPhn2iMz/Zl5nj8xyHTwoa6DQNsilIpYCKJMkOmISn8CFpeEt1gAnh+aJQVTTQ" +
+		"QgWGUcMUw+ZSghFLHXhsT6kfSjMNwFhsos0XSrIs2gGhl1wEjIgl4VAaAkUnnvGVNBdMUQre9Sr2" +
+		"rhegGqBke/1LifmXH6sOXgkrVvDFA4GkUUfEjin7drY+SSN5RiK46fWYFPfJi4MWqDrOr5ASjFhp" +
+		"nnSLVvDY7kIxbMetCgVUfdGq2DtVeuIvh9zw2yTEi+ASMTu+LTcR5qAhPjQ1CNMcAlyw/EzIrqAD" +
+		"KLROegphuqEaeO1E4Sl1UjO0upWXzpyM4j65TUSmpfWtxebczfsnS50EhxebVgEm+FS2QbUq4CkM" +
+		"Aj0hGO6PYv38ENwFJvhmt9eJ9mwd4xJJVq4rt6l41p1D2cAqOnGYzNp023OdI+0hWCQm0KSvqhCk" +
+		"LjjjrdifKrv7gOGEVr98I9GzbAUVJA6IRD7WRWIS7qTII1x/tO/hfTPmGEwiOhezFDdpiqKB1MIc" +
+		"51ISWa+MJieP8YezTZUFw8JtMuvnx/4xj/djHPFWWL4OkzC8HuUWt4m7YZZJcGXI1S1H2yZfytKq" +
+		"5/1RKNoXc9NbjHhsu7DjI++M9s98IhngpnQRIBneRByQx7WX4zZJwmQ0DxV6B/lxoMmSuHsq+8l6" +
+		"XEcpeZKEhevKbSqez4Gn0Qe+SnegycSJm/yTNYKZPDVAsej+mT2frzMNSVdo2lClIsTCtxGPEWj0" +
+		"22pc8DwWNN/KeNSiVBGT9JBqkobxjfhJZkQ1C5xGUHTs0AldwSBUQhSKBqmJSkjnYRIRpVAwHV/I" +
+		"VoIHwp+Ynw4SHM17sHpqDyvfGsBPb5+rUIdvJvlWTTN9bg8K2JAO+6lwP9lEq6CY5/migKo/T2kA" +
+		"k1YkRcxAAZi4gNVJM3mhvBcLeKVNhTropwdKaXIvPw4a0zVA0i6ZvznhygWsFIhhCdp1OcUbApm8" +
+		"7Sxlcz794iSm7S/RIGiod5gh02XETqkMSNImpMvRPTVEkS/mrcsTKjTSYlVpe8FZiiRh4cUDaeqm" +
+		"wbBXPXHwCrLi5GGXjVXwMW4njYy/JZt0Q9/SgulaFPU5sCyvICY1RvPK48hFTTD+4MsWKuCF81nN" +
+		"PKHVzBasmZOfml/xNQFxCLU2do/daEvOrS/YjJEK3+Rx0tgqGU9sO2KDa8RQBOCuVIXRgcJ0DCEA" +
+		"a7WKX5swYhbfFscR3JBpSqVoX3YRXJvwim5Q+esg9AkQ30nawzBa5DcyboJVaSXMMWqTtOkNB0em" +
+		"sT3SRFdnCXhIVhWh9ZU25v7iVUVtUrcQ22Nlq4sJXcUBFZkUJrJtoDAZlpeNzFhtsleb/HgNf2wt" +
+		"0JUPJyb+Kb59P8U3ClvEbtqsmzZmwI+UDYr7mfCBrw+NvfDD0FaLrMo/3vTV/y3nzaQaRIOgIY8R" +
+		"AGI1RL0bXjFReanU2reXBo+S4mtDdq+4u2wA3oE2vAyTA+ZTZJ8KfuhtMcjKYVVWL6ti5FCjDdNw" +
+		"cxoSVuTQJVG4LtWBnrzEGITFSeBFoKG5tKxaE7dZFjGJyXQZNULscWFGM6HVy6s1wrFtHfO/IdR0" +
+		"SzazwDtdxd7pKp0rRN1WiLD13S4hAYxQ8Y1Tp0oJRqiSonpxxafbuxNG6ZrM0a/bohA/l2qgJqzZ" +
+		"dNxnLD1hGqMSBo22nF5Jb6QSYEJtOd9kwFZqZuCG4HTfOfvmoitPb/hO+MM+/+3nurNqcE9ba5ME" +
+		"HBFC0aqe2pbYzHOMtVuf1AxZZONKAcBi8TS4o9QPZfViRal5DFbGDDN4x0MyjhIWxTNSjRntXvd9" +
+		"1p2p7DRx2mnibJz2ABjHFTeS1JUXF8Oz4SWiRWEUv5a+8BLhtbdbj4PxiQ0jN2YDXow5u0eXSWa0" +
+		"RpzvsKyau+EX3hZmBH+RbWGLSFvuC5q5lpOV53rI6YXofT4CTT94Bpp4eHNQsaj4LZNsp/3gRoMi" +
+		"linOZhkw7Vs0SAhhqQj58RWfI/sUmTPkMSX/uYmetH/C9qDQKF0r+xHk9RP77wLqkDTQfH8wEv4k" +
+		"i0IpP1qbHwSFWV1BMgUmExc+dfD6etSvx0jfvx7nVvF+BqF9Pc7b+Mf9iPN2o3reoF4j1A/WqFCj" +
+		"HvhwGNez9tu8U7uU0dMIEhx1G4zb4Txy0N+1gQiUNCPViNQYNY9v+fA7Puf3tw7sKlsHpAsSw6h9" +
+		"5gef+Dm/x7xyvA5yjtPledeucd45d9Wfjh2h5oX7ASfvB+ibI+fj63GOK7IzbUNNTw3gkJ5qShps" +
+		"t00/K+aIOM0Rq9Inl6D5ueOHfu74MS6JTz1yRygU6SKwngMrpdb14OUkwC26YEVkutjxe5urMpNC" +
+		"d9cojqyBT2OgiENwqpOaoxO29djG1TrT2NbNjdOVOAJNKnTCpSJQWhrcNIM0g8sOYNE33l8epCXf" +
+		"zjvFnfn6Th+oroHfRDGCsWJ3iU5uh4wQp0ZjI2KYv7VnqswYqQu2yY6XA6sUA/cGrquGLNUkkiU8" +
+		"eGgUJs54y42crqmVvLIlQaEHcjtQ74N1PpjbkLdYw8pw0Lshp33hDIAM0VglY2XzPLAxWJpSqb/g" +
+		"oshSSmUfL9U6duH3I43SNTl7jJfAS1jidFDx0gAXkwQJjnCh4YHQNNZPV2v1JxqgqqaBlq9vsI+Q" +
+		"RZAkTKabmYQ6MswBbaPyuyTnZtAfk9xoryStmkZqnujdQyDvu43Z1tvtW05nvtc4oXVSughszXzX" +
+		"U0LWLXwiwdNyf3ruT+b+ZJ5PyxJtoH0kqwH9GA/K2IvH9tLxmTlVIaDBOkOMpwNkQMX5pvJ8U/Ex" +
+		"M8hGoETmNWkAbzqbr7uoVrcHBZzrxn02CB5xx7hOopHuERcVp51ccVq174WctWugk0/IdBmx2Ws3" +
+		"V2xkZ7U7t6lbhbVxOAeFFCe4KKLtgOJYLyPN5aEB0qOz8psiZ3tqgCj0gsZd3rjLGxa9ITtAN6s9" +
+		"rS+1p+UGTWPbVinU2JhL08jOMb6hGRqbgZeZp11mKlCiiFEBXVk8uZKod8HP39/tQSGBgASD2tdj" +
+		"PCF7bXvM/eHQvh7XTVfBVOhpFmRnUWoyKUxsjlqS8rCFuiHMd1mIUbmbpIGii8lgYDHIPlSrVKjb" +
+		"meI+CWt1piFZQbhv9Vbyyoze5fXY0kktXHk74v6hMEqS7Hira9W8SLfETsN3iWkSc9GPmRJsW8lh" +
+		"Vdqi2HZTKzOHYvW25dLJM4LVSVezXNPVouOvTAoTZ7wXz6ezHZtzZRl2b895d86yIFO+0pZlwbDw" +
+		"4uIVeOGBMsfZ+C8+UDbY3rgaPC74thedrLH+6oqp0TBK3hAO5R4lC2XcHViYKq1P0iSzce5L46Di" +
+		"Oh6NUN/QHzDiDjVmmLKukV1HxidJCTBpqxxshEPTvkSDoGFFuO1dH1VzRj98Sb2VtE1qjuKkW/mS" +
+		"utfIl0tWLTGfe7p2OSLzivk7WWd8ScUikZGEyag4FZaunzruK1uEBCugSW5xbpOrGGtXEy8APk2s" +
+		"dHttuyxIvz78Sl6Ut3wo7veX1eyPq+p8f1EwUg599Oso4UNri3at7C3yltwjls8+1PCP3j71/0lv" +
+		"W6J++rqcCmb88NGF2ByZ02OOK87m5Amnr0ZRzVipVogEHHQ4SFb1I/qiEncH62qqmDPbWKlP8uhf" +
+		"mRQmznhWtGiwjatZfpX6epX9UXKocftQPqNQkbLX2KJMvoy6TPJoP4rK50FUgtQj7gsiyXpombJ/" +
+		"KPBD2CXrYqYjzY8tbUdc+LQIqZZ5f8j0lxYX/JWVw6qsXtae/SFzM03hZi4HS/k4VlobS2WXrrrs" +
+		"XZ+DWdXLFFRsYNwMdoctLZguV9pX/Ir/bwtf8f/pCSf+vxK+4v/3zNK+4nbcNIgIdwrEIDxNDP+b" +
+		"/NVAOQYN5BhCDfA5X7V8S/6Ku4z/m9S7yaZQtm6ShjMHwF8KQQTXlSeqed9eFKpq0mPTAg+UO+yP" +
+		"/rhsDvChrZHXV9zjnoRiREUtUOezcY9JPXR8HfKOcChPOD7p+RyOJWsQdxVlT1s7JvYFVxetUcn2" +
+		"RODgYemj6ffrK4avGGTTICGM7TDoDnFfUPuAqY3EqeuqeJQ4BCeP1A0PMjNFjIZak0MPO61L1tIn" +
+		"IUnu8TvqXTdTdq/MojbXJUysCy4ejl7CxYrmHp+xTzLPPhN1TYQnNw2a0esxCUa7D79wulyT6dT6" +
+		"gm4+ZZIbNf3QYtmjwaXiQLhhp5JYZCwHfPns48B25b1uzXKhei1K//Bcc+sIGf8RM3LTXZZ0d3DN" +
+		"LupVt4ZQGI6MEvaAZlCSBluX6tBIVdqK4ko5HZHH7LWF3bRYLy3WSYv30aJdc/xfKSGMu0lu2JWG" +
+		"0/dXxBp05BJ0tJXnOJaa43f7ivdUCsKh4GnBeM+8f6xkIlDSQWujULKDxhUmLWMJc8gj5ijNsZwf" +
+		"PJ0AVU9hTmKcdzHqDkxcGYVK6hvqKlHJc8sbRfuK8SuO/yTbCGKPbcjz+IopDlNUfI7/EZPGv9w3" +
+		"lzzGTLkMkxNj7VcEqSRxMluPx7avSpcPBY7xpmu4TswTCl322E1OC/Z1SvGw/ZlS7FJZpmKXBdNq" +
+		"16SWRWYXTj5MGLE2c8RI2P7ziNkKVe4rhoWnOzrVoqRrUUv+UDyZ5ztznXlajjA0y2SAjmSTp4fR" +
+		"Gzm3UlGN1q29iGHhNtnL7HVW25TV7y0fSlqKKXVlT4SDZ3K6Vg17Sx+f1zBA1AsScbZbDC/DZFDd" +
+		"hNpPblN5y8qei1lZNnaFwp3ygxBZEML10GDgbUs46o3CRI2XPSZxcFPcnXCsmjIqeYs3oQiU1WQj" +
+		"UKKmt+pn8uM23jeOZZJ6noIwQMCGxHbgHfioAGUwaAR3Zjkkj37LpHRN5g6PHR2GMEzHtp8JRRqm" +
+		"a1GYUu/b7eJ0GXUHJtNZeVoQzWnah5dnrk9KGZjLWZlUtnZWto8riAlxw4yB7NYuk9JitSLlKFZP" +
+		"Ir1yE5Nuse4BhrXSTx/KIzRCtLriUTJSyZPcU/fYEe84Wxk1wy6TwsQZz/zBKCH6roq2g+LcQeWG" +
+		"Y0GBG6P46xlAlQXDwnXltigLs1bjMpabQGQdgk4zCIsNZP1RITg1I8s9zRrXLZn0nVWqd75Sbf+W" +
+		"2s21L9F8dm3hsCptUaz02j/yhMt7W1r1bX1WSW2nDnzHmelz5qXdXWmmiUNw8khW5MwlyHfcox2W" +
+		"i6FTH599OlPM/6jMik/ZLrylRWv9Q1kSoTgcumdlHStrWK1+fWmT08Y50LC9xv+2dwoJBCQf1FTG" +
+		"SkGPWM0j1gObamgJINNGQVUoAqUnxqnhhe+hKrxYW6JWd8uSTDKPLJ4kS0ImtVPQp3YMNyS0B5Xk" +
+		"zFwqN4E/mO54Ec0k5uZzSGqeKM5EsWTWpXHcIU0PP1NA8xx7UdkjRxbD5jypT0LGsu0Pk7Y5gyU4" +
+		"1UnN0YmtMtD2lTF89n12MWhJJtJ68opi8meMbbkETiZeEkwESjpErwZwW9hcWOeEkD1F9LEZg0Zy" +
+		"XxhyoBADc8iBHtLrusXQk6wKY2K3XQbuC9IHFbJ5pmO6FgXl1l1efelqZkjyoa1xluU3hex9Esa9" +
+		"qbSppWuy7xnViDyaFGI7Fu4rx/1Ts4PNLDO3xW5GK8H2Xo2+r2pM3glq9BTlXMhzXHtXjW0eBVTo" +
+		"NydZpvSV3U3Wfa9qkoXNHudAILWVvKUF0zWVLiuHVWmL4psOreRPbXUt9UNhVI/L9lFZo/rKVsF+" +
+		"1tvH9sKQ5ENj3HvugPeyB3rUS4eX9LM0y6if5tvPxfVcd1Z3Dw61UXp00hQx38VkMLCuMbgR2HxW" +
+		"w1m9tXvE9qBgHL+8GY9X2tZRTrUkE2nN3eSMzn69oIoEI1Ypccb5StvJM0mUfwzXVJMsDLtvMMiL" +
+		"huaFT7VNXcLCdbJVTnxUTXFnXwWFiTPeks2LMWdzit0hXY7eztEyZq4liMlgYDFWmq4sQQYD+tTp" +
+		"w5WKwc3qVprXrNhpmU4XU5YUuziky9Gq7Iq4inGv5CB+ZnAFTpiglOp7a2DeJ/ZJLLPOfWlseUkw" +
+		"qA4WZ5vBWU2p/bRBx9gLwASZFCbOlDM3m0W/CiZEpTFFYyWa1cEaplni5ruitOjb1OLcpPZ5JJSP" +
+		"OVaZQytxOlm2naJUBzp1k5g6k2D007XSZdQdmP2Zg0lxsq07OVHQx7vbY0EJq7I6WVpodjzr0wLr" +
+		"uGOGJB/aGpdW/kzG004VO5BBYWJzZIXAJa9sTrHJJM86Nj8wVPPE97xuiKpJFp72LguGhdtkr989" +
+		"LxVsW41lQSZoLi07O1irfPQsqFat1ryUNrdDcfsnTVm2RlVOilzrn1rcP/W2qJPfsmC6FsWbOOpS" +
+		"zYI0swNFG+GV6iR3s02MHOZrbCU7WOJWsju2kr2DmjKTgsptW49f1y/Xkkyk9Xj1cxITcoasYI6v" +
+		"OmcC1Bjznhm/XQYDrza5mWIQgw0AVPrKVvzbmqK8Y5u9z7Utfeqee5NJYWJdcPGdaOWds3qnV+6c" +
+		"VdMU08l9FpfznikkGFSHRmKB5x11P1MTk8HAYsw32SEy6C2T0jXZ+q2fPDgI28LjgEqJY+as3ots" +
+		"SfLl6xiDrP3P5ZR4dsayuhw6TvT2/BXz0j3nwZy3fV8HBNeTrApjzsrVT1OmvcuC9Ec9Fd7mAAwL" +
+		"t8moqykzsSedOb9Z3SD/nV4pKNPeZUEkDUf82NwwL1vyFt5SeUJ0pa/MPEY3X7JQFTmkKGvjQl2j" +
+		"kMNR6ta3SVr7LAjhop00412VIXkXzihdk9HFsr18AtL4l85FWYGXnp7+R6NjLb5cAvVV49b/0dL1" +
+		"qVvJr46Q8d3s9X5alngNmXuHSpcPJX3GeSadp4+8tePcHwP62BvsIeC3rJyuVbOczrDs+aEkcYLt" +
+		"ru9cOzXHLguGhRcXdl8qJX8o8GuvuOOKbFFYdn9IxnbiN88om0LNKnQalvVxncyndfJNrwGGmBJV" +
+		"UVSXM6b+FfPIfN/SaF9suyuXKjlI23kAuNY/FPO0YWhyU+U1u78qSZzMtvOm06KED62tGjaFKrKo" +
+		"O9f5J4dVaYtiyamV/KnRNW8hZlk5rMrq5Rlmr0KeFciz+LwUnpei81Lw2dkbDNFIyE1yiFvFIU0F" +
+		"sbmJyWBAp8Yi/ZAgIfpltQDBWHvsZ4Bva1vuk1ibRhOXMBzTtSg8yPT4xGJrxqIq38EZsrDJHvq1" +
+		"FwP1sYmIkRu7w5YWTNeiTGdQ9v5BDAsvLqwXlZKnUurKTALnV/SavqLV7xX92IjYO5E7J3LfRNs1" +
+		"vEIgWNxbHCybOXLtsbFCzSrDzbNN65zqOZpXNWkGSqvMe84KqGjp2tS6FrJfjpp/irMzp7h05sQj" +
+		"KNnRk+zISX7UJHa0IYNBI7iPNRCoGb5lUromWyOVe469TILxIdX2F7gviGzGN/sa15WHZqKabARK" +
+		"VrToDVo7OayqZXuUIJPCxOY48yrBxoKiN9zsxO1qwLYdr63OKCiXKX1ZRfiwrB5JPpTV1XOS7q1G" +
+		"ZY3pK3+k6ZZbki4LpmtRfI+Ny7uH5PZZR7Ml+dfwjwe6Scm7xPdy3jFDkg/N4mZfKp/dqfxPj8r3" +
+		"es7WhHY52+4ip4+YmUeT/07JuB/3qVuKFn3dbKr9H+3TeWlEs3hztNjGDvNqU79WrX8onndJSzVK" +
+		"mq3WXnGfG2zav7FLnfBbFs59QUvVpfWP3QZDkg/N4mw13xVm2cf1bJ3dejEw6VtynGlNC59q+1Dt" +
+		"sKTePxTmW1/F9xI5rEpbFMuPWsmuCbtFrXqvyihdk71Va4339VCBnmRVLOZ89U/X89UX3/PlDVRl" +
+		"a+IQnJoRK0+0RMtkGlqSiWY9F48zsf8Ym5edWkBbWjm241Pt/6ht1dccJUyGU7Tn/SaHVVm9bJvj" +
+		"sZzvqBXMw+rZ5izYFc1Eg/PGws6blaTkkSzjvHkuA0udRNfTJROn3bJOu+edds887TP3tM/sk8/I" +
+		"B/aFwsTmaFkctzmcQLkc+yTE5+12pm3iqTvwxFb5KHfarQEiMqx2CjxrZZXwmzpKjLFxHzSMwzPI" +
+		"rcsEHjNBdq86+DLqDom2+Hr4MBx8KZf4lgXTtSizKLwtP5FurNb31uPdszcNLt+y2QnPFYvJa0xe" +
+		"YuyRSWe3Y3po+GH32ibPNXmWKe6bt5Yq095lQfofeXmh4FNv0yBh4WmfWeDOE5S+uHS6lNz63DHc" +
+		"c/MS1LlRYYFc6wW5pznpQpxRmDjjLbU+HIHzhyl95XStGtK3vcabV9k0OLZez30ZnaclyaeK2C71" +
+		"WPa1qx+x+4fW5VOj71+Es+H+Lu32l63wV6pJi6Bkg3hnsNuipGa0+LGgtVMov2XBdC2K+5Q6iUaL" +
+		"7BTWJnH3eszCO88rhpYWEumY4fe3XyWY0ldmBe/uw/edid1hOnaL9SsLKmyQe95yn2QJ87Z4+MGg" +
+		"TI+igrMFgKXwiQPQDvXYdqnZa9H2+OLF2lCqSF8wXa54vqZYbsuZdFUt6UvyskDqhiSfWvtQzRV3" +
+		"VYlxVuE1b5eqevYlR3u6byitpHMtXPUkq8KYHvPeF/ywz8YbPer0x2j+sSAJm8I6oR0K3hf1HBji" +
+		"O4ZzSwtidjO1/qnF/V+dU5zF1la1f0T+zr5kFvnUwqf6kdevVR76R7mLY5eVw6qsXh+ZLdVaLv2o" +
+		"JllY7WXv1fubK+FDa6vGwlxFaSXZ4TuoO7AQfyjeGXYMQ4Vj0Hmbbudtup3I+cR1xZA2+hAbuZo0" +
+		"AyXrfO5MxIcjFC3PLm6xZH2m42aCPPotk6yqrEy6i8lgYIUC3S/3Scwl3T3a6tA6npNzZP92rX9q" +
+		"bVVnb3WLfGrhU/1M/VmHdLmy+JXzgxenee2qukb8SEommfvARuhfMcvN7pKQwsTmiMRk3US/XJJw" +
+		"2jqPfH/PQVOVaU60ie1Q588YbgN0DDXO5mmzOsXos2NX17i+MjKwl2izJLmzjwL7JLquV6vQkizY" +
+		"JsPj9DO6sdpfHaGtUwvvNInfaRq3+HzZi8oF9MPV2O3uTd+6BV90k1q5QZVh3EwmADKqXoU273cY" +
+		"q0drvvjjjAQ9Hp4ArAnecVkVgZZkolr/bns3OS1Wlb/7aedbY/g8trnQOTXElbqU+rdUz6BUT/+S" +
+		"YCsprqwxnZx5veScrlXzer6krvlVX5hRrTtY8uiVWu5sukKn+UzLoq1xrNo/53X522vc/1VGuu/x" +
+		"v6U0OzO0JBNhPdCnvrejnBMsEgIt9o3uNQR+mWPhZkoyc41urJfDseXJYgzHuneTGHIUEdd51iaF" +
+		"iTMeYxa55JXVCdPPIZBe7EzkD1obNcdzxXQtCjvEt7CKoo9IRlmUci4K0laE7ECkZshj7ltqLNXB" +
+		"00ac0L+lZvPjc8qKxSSdKjfTliO+xZLbblKy7Fun8NaTZX1oKMyvebPhdPgdgyST3gRQLkPYJAWT" +
+		"Uid5JAA/yenkRhYbU6JAhaKd677xxuyQfL/UMSy8uHg6f7/UlFJXRhKuUSwrFMAWjy19av/GegZR" +
+		"Jr5nKhzghu6Ck4ciMqwHhd1lBVvkzH8wrOMC+jtth5gMBo3AVkjbcczxlGoSZ2sgV9rUsL74nbY+" +
+		"k3S7YvrmcxPfyfZEst2QfB+kJf9B3KjEozb5YZvmcZvmgUvc0qI0Y8LfZTRULclEWCNLi15anKXF" +
+		"WVq0WY0i8i91PorgGrIrPxTwPN3rtLMHccZbofM+jDPrekr+3UB/KZBeB4JySN2Z0Bix6EOlstMq" +
+		"sFWUk2Pukyyt7ybjmYs1iXG6Vs0GzFLZBQdUB4vjdvMm+3fpW+SjjPm7bgh9PxAbmANe3Rq+q6Ko" +
+		"61J8RmbR5R8VWch/vmvI1+S+IDat4tUGQnDy+prSXHPidp42GyeFic1x9bXbGd9nE4pAWU02AqUl" +
+		"5+Lwt9+jvgvf+DJK12Tur7vkU0yaAd1lkCc/l+Tnmrx1CgkE1GeuLN15IrknyeMDbk4wFrsKMkQx" +
+		"pSPUmLrFbFKCEQsaSK8uJs0Qp83a33hx8Zz6sgtMLdkUDtj36lNLQ+QlzSVzx48NgjSbE98PyI/t" +
+		"FrtJDqrg3BdsxhKMkKEiYpMlSZ7AR1tDN5c6iUahCJTVpMVTWsH1KNav9FOCEIEyd4d0EenKDKTG" +
+		"btK2XDnvH2ypuuXt2z6w1EnuqRDZstGbLc5mUyx5QU9T6qTFeBmqbf5myKKoy4nJr0pxmHF28qbi" +
+		"dvWIB94bIJjNSopz+SfOC0XFcq44XRR4alEpwQhNEfvHGgdVWTAs3CYvyZOXYvFdHIKTR86kS6m2" +
+		"E8BvWdDNpU5ajKP4P5hC/LEZxB+fQPzx+cOfOWMYWKqDmVSewR+GdE7XqnHY+3Nmz5oIcxWKQFlN" +
+		"WjxldEBV/UbjnxNbdbYed5k04sbY+byl0k2qUcvCN8gyv0GW+dFdhZH/My1S7Qj6loODmrjG9LSF" +
+		"pfE1s5y2GFRkCNh4kyxt13BM0hGqgtV8PiJ8sA/o77LHnMc/HyTlIqov1uf5HAkeOM5SLZnUVpJM" +
+		"olN7sRGzVD7WTQoTm+Pqi13IE0lm0+SopfEHPJw0qtgMUKk7pMuRfjx1ktI12TavWGWL17V4Vcus" +
+		"aZkVJU6Pt2dQ6qQ2UctV/ZQcigNMh99dNr6c94lazeIPSJPCxOaIOpNLXlmdvr99KcY5XavG5ikv" +
+		"r1uZL2fn8vKX5CYjgxpsSd6Q9vuG+SKJw5a/rG80Sn2dHSEVbOUAbNh/J44vlU0hCqcF/52xugwG" +
+		"1aEZGcRJ+T4pXWRKv+fkvKVVa1N5y8rpWjW2K/RSF4xLfoXVtAeJatkCxYioZ3tQaOX1f0vnzYav" +
+		"hpNfs1Nf43sDzV4caPiBissQ0OO8NIWWZKJa9xpv4hCc6qTm6ISWHWiPGjvTZ/k8h6lJVmbDma5+" +
+		"sh0UGoVT0BD8edupyMrmHN+lysprBBuSJ6Um+2m+fEUHaLMAKrD7XVDDsHCbzGaR5VmiJrlZ2vX5" +
+		"xzYf92jzYY/5/eGFP2I8tX00wxhNpY+JzjLmI6TObVHgVDsFN6v6dlTfiOq7ViobEKlMsTQP7gLu" +
+		"ooglK5WNQGldG5SuydY19DcZKL/PZMi4HDeD77hzmjvV/qkx/7xLlUmLlU0WMxdNSWFic+S2gkte" +
+		"eXF6z2xKXRAurxhMWtEvfNcR5I2A4zs2q0uzJ3bnA57r050tNq9f86uuxrtwrdz4UmFu5XvTauHs" +
+		"3/y2abPbpm3eNrWJwcdN93nHfd5ubyXZE7kD/bYTlcuxT0L8wR2qsE9iLN4IauXco+1rsGb0kt2l" +
+		"BCO2w8tnrIbNuNRJ3O+uLE6OgOgLn85bWjVzE5PBwGKsahGXbQB3i32N1zTq1de3+9aHalvfUjLp" +
+		"lXOlmSbBqU7yaJY6kMsxzu5D4D5QCE4e6TlZLrlv/L7nqqXLdKtPtssd57AqbVGWMmY+dGBulpPn" +
+		"4jl4atkQJS8KOMS7JVYKE2e8ZRHvs8kHl7og3bl74rp7ou+QONs+WhPzqRUARxPjdLk2nXEjuPXY" +
+		"zy6TwsS6YJs80SvRz1lL8JY+tXmR+2GU/zGE/7G0fy3/FMu5pOvwR+XruXcHCY6WRfUnJJy3tGpt" +
+		"Km9ZOV2ulbrgTGDkxwZwSx8KR+xVb5+Gfn3o6VP9cF62xR92NmVush+g522ZsE+NmZw3HjrpU0MF" +
+		"xi/H+XTBFIuZzwy41j8UeqatmuRm2vVN88ub5lc3bV7ctHlto1jqJLpGRnYKy2zmMM93Z2KDvJZt" +
+		"ei2b9JrfKMn+HUilvC+ETCotLLHidcvGlYhmKxGNKxHtrPaEjeFijqtyOfZJjLfNqkc581JXU5l2" +
+		"Phk4FfbBVW8fBmhtfmhLy+943KBv+MwooSk9hQJqXp75gJZkwemk1exyl2qyAXB7AWBOfBlXqcuk" +
+		"MHHGo33IJU9+z5SFpbI3drnb0WuI/BLvmZEW47mlDwXPOi36r/yrh38N7R+D1dws6fpQzd0fRDWl" +
+		"r5yuVWtxc3XZM9STrApi9Ctslr8q/YPplgOFTGgk245sliYmLXXjfs8NV6qD1ofJqUtYuK7cFmXy" +
+		"NckrYR9UJbvLWyalxcopNjUi6zhr52cQ53RNzVK5bWbKToSNqTyyFCQ4WuXn/TnnNpW3rJyuRYt9" +
+		"akzBN8VBH+il+SvjXeouQSaFiTPe0+14SIs0nV/9w8W3ZHkDmVqpKy8RjvwkzaIsUaWu/BHBdtgf" +
+		"G24wOm/L4eCm9I9L+ogPq9IWZW7mzLHoN9Pt6Kl7ORzwRmeX/zGE/7G0fy2zqGnyhimH++dsQ5cp" +
+		"Ej7UJSPoJf+rf2Y2NbyAx+5f93LWZttiv1yvWpBcbNVj2l5b7ceS/uMVi0Xv/6i2L/gGAEnCZN+k" +
+		"EJftsaf+Ffn4+2b9woafandVgZ6TzKtlaMzpe9tlUpg44z2P7y32BxELDaQw0bf+m/fwgDMPqbY9" +
+		"3zHJpDCxOXqy+5b0Y1jUxquReW37yE4Z7QiKfvj8ofBu9GfpQ3/WDvRn7T1/PrrOn9lvkrdYmi2W" +
+		"ZoulpcUSPoFBkoU/XCylbG9ZMCy8uMykcj4jq8ubJ6StTg4Tm+PMI9otjM6fHHNaUj5X/6d5j192" +
+		"dXLvsi/e+qOvhqcPj1Q+oy7TXjIpTKwLtskTZ7FnkElhYnN032OrNW53+dTaokpYlSXmWnDmJ/N7" +
+		"F65ei9LlQ0mfcZ4s90lu/P2NE8uC5uEvWH28XgXlvkTc/YCD0hZlbkpcTlZU3LH3WUUos6i/DrOU" +
+		"v7OMv14C8/ZB9ePC23RZOaxKW5SZp09hBrrLOnabZpPdxdD/x/BPDv/Ub10Y+DSE/7H8T05LnT/m" +
+		"Xq5bCtn98PYDOotbPGex6s+c5yYt2zK7l+3Q3B7xtSDNZVwAsxx+Jbn/q4Z/9Pape1XcYK03v7Cr" +
+		"WtqydD9WXmkz4rftJ4dVaYsyy/Lv3K+auzaZFCbO+CUjv5U2tCp90vYZ8VEE9LdMVcLCdeUlzSy3" +
+		"ymdyOtWNT/B1e5pX4Z7PY2Xzltl04LAqq5eXDK3kT639o3pvWS3mNPMq91l+uS/ll/tSfrmv5Zf7" +
+		"Wj61D9e3fGrp+tRX51I/lPhP5Kdm+ZyvPknCwnXlNpVZ/XO5QKCy+BFN7I/ir6IM3ScpbZmktHWS" +
+		"0tZJSvuYpLQ5SWnrdUb7uM5o47CTlcOqrF6z0Jf9Fodq3XeqrzkrS0o287AnUQzdx54v6lL7j49q" +
+		"imHhNtlrMb5azSq8LY+3D5JvyTIpTJzxntN7uQQ2xU8Grvd/9fZhMC3KzyQJC8vKi7vUlZeIWcEo" +
+		"P2Yv+5asg/xY+/7MueLPMj/8WeeHP8Vdyluwta3jIY0ucy+2Hm0UHjiNyDUe9ja9/aRWtp/TIgSn" +
+		"NGMtgXXd6M8JG9IjBs8rhpmb8uLi2VBJ16IiX50RleRrosaXMy9PwH1B+uSZ1/woVC9ok8JuVfB4" +
+		"gkqLp2Qly1+7SWvYnLssGBZeXNZsrEJ12+uWefCM9ZDlwh9qkoVpP+wqdmDbuYLd68a5gkIjuZxL" +
+		"c6b1DyVdH+pMZ2OzK+n6UOnpfa9uZ5fKPle3t72Q7iwfGnMT3F4GWBOI3WDWd56sx1Z7UVCpyyTz" +
+		"vZ/iEJzqpOboxP1DtJxyi/skm83VgpAv15LCxOZo2Zb5IuxUppudqGqJ3SRjTfCOsaGERfFSllvH" +
+		"rr3lU0vXojP39zwZqNInSZgMb16KVbsQG1Ad3MfAKnd6Cf5ouuJbJqVrsi1MnmiSk7ebBzDL0+dx" +
+		"pz8CpfiWSemavGQ55Jk5TgGCk0eiIGDJC6pHFYQBAjYkeW9BKAJlNdkIlJYgxWAStTJczW3yks5q" +
+		"p8r0XzxwKgT2yzA5MV/7LCoR0XV5KgtakgXbZPfQweW9NauW7fBBunPe3jPfEu8PkxKMWPeBjZDE" +
+		"ITjVSdPPyXJBfex+5NvvRc5ZwzIDePtHKt/LV1vfH99sHVqpDlHCwtNh0jVpFo1ozN1UsiRO2uZR" +
+		"SaKxU8iN125TMQ/Wp/p2VhbWOgU95oMDzh8RbVEsr/UBgkX7cH2vWZb6obQP7TJt5s5Xd5wtwWE7" +
+		"svmHUwZ79jNrSPvxTiI205/kei9Pcr0/nuR6x3tM5g4MCy8urDSVkqdS6spMUrwSd/8um/Hi4rlS" +
+		"SdeispC8z7tm0JJMhLWk5ciN/g09oltZpbZm2Dy/5tn1WXqfqy9TaYtGFopAabpYPKVt8dza4vMk" +
+		"Q3UtuAhRGQwshrmUp1AESjg8LT6duW81pmvVbN9TtzivRHqLyWBgHdK4mWLAEs+UZXnQ0fQkqzJi" +
+		"frC8PITr7SuWvaevWEIMQtFUZqi/JgIlYrEaNaqPV+XK/JW9gl/VK5mJMn7RELIRKJlLaS+86OPY" +
+		"JiP7YeibBu0rvmTfdor2FevWZpeFksRJbV0QmoZdMsqsog9UVtENqWgAFXwU37gvqNlEfOWmRk2g" +
+		"A2t9jv+x3RVdoj7V2sohJtNlxEqMVqsl72kLDrALDHGn2JKD5lJyFHiisJKPku9SF2wLIwksDRcq" +
+		"tYyOQCglODSSblHJI2nduCJtFCY2R9SErJs2Hwt0xJ6qy0qmK5qT7GmzT0UMreQ9zhFnsfzjkCz2" +
+		"bQfBoiEuiMyMqTDmtI5jDPu3PbLtDPt9HErYhVXuuFoy4v33qV5TSQvDJ8kbV5uT14j4oV2T+4LM" +
+		"NcU7Wx14IqneMbCNoIIE/iuatc6HpJ3hM34BS2bM/NzEotFz+ar+qiK2RfsWx1QYM8ZDWfDDnq2V" +
+		"mw+bQ/GLEFXK8lD5NKSPaObaa5S51aYh7m03Jo37gtNnZk2FMWX3fMFqt68gKvUJwakZ8fjxeVCt" +
+		"y8dRqr/SSDJb7g6N1MUhOHmkFdN9GkCl5AXVXRc4fXVzrmDO5cs61y6B2ClYwhx/+0OOuBnsX7GN" +
+		"Qrc24tL433KgkOrQSCpFA2BHOJRj/A/I/zes5bZVirgTRiznIs1uCTS7HdD8VkDz2wBGyN2V/qFo" +
+		"Wn0DXUXcDZAIr6QP2T1uEEY70+BS6usBn7YLtrH0h9TDASa92QlpBiTrldROjCOtnQwDRKVokBDY" +
+		"7P444pYBZzM5XPpX7P8XRpDSV+wbgrhT+CmfmkwKE5ujFqc8bPtDA3XFZ/P7/ohSKVsH4NBXuqu8" +
+		"vmKXQ4NZATlQvMpg0AhIKofqmAtABgOLoWf1fKsdBt1fEev2tXzCiIyZa4RGbsRFG3nUfWhN0vdX" +
+		"fH/Fdxx1eKvPmxd4b71weV9f8e/Xn68/22383yRAVArXp6U20tgKECzUS0eoxhHsW91y2Cap8anB" +
+		"lihg25pLAd1K6Q4SHBEbth+TMNBDOoXriH5sxyF1QZhR8/35zPFbJoWJzXHxfdP8YhVfVq06WqMv" +
+		"CDPDXrefSWr879zqKC2IBsAxgVapnXNQ7IhXDRXR/7sECqkGrZPSRWA293vUDO4anFulGGpMGtxi" +
+		"DQ6w36psz0kwCrykUsD6KGeTSTCitlH3c9pekouDmsoLtUrldak4WxSHEXOM/5sGW9quTalUEQf1" +
+		"0i53HFq144hSKbU9jgP1OI5RyDFc8z0JRaCsJi2eEmmzvnZIGbdkOJxeW0aYJRCQ6LVljRcNNE5z" +
+		"fSF65FdDzFGqLDg86rhugFD1XkYRFUdm5UFY+cLlH+zGdsQsJtXw0o3qRYNhOfWrrw7BySOR85k7" +
+		"Qrp0tKhCY1RMF4FdTJGpqQ+P99hnb0kxmxyR41j4uZUsJoNBIyCvH+14UhdUB/jTJ2HDVWrsCEbZ" +
+		"F/v0ZV360uBnS0+TEpzqJHdDCYrD9vurgSb6/RWY0Di/v2yb318k+v29vv7s/euPaH82GcUxXUYK" +
+		"WYMRKy8NFKsGo0Th6AupibWmIr9ff+TPmTcIgTiPrz+SkgaaPKXoskFqdikVhDAivBDeUC0So75j" +
+		"e0xiXpdldqX4FFAtKU0afseBOgwJ/UKI1DlLp2gq4ZXz8CqvcYz8yoJh4TYZmakyElY54hYchmN9" +
+		"aqAZ1KfuQZWtA3T76zNmCu3iSndK6Ah/ts4cNFm5le1wUJd64VejFPctO2hsk4tiqFoHXepUUSkQ" +
+		"hQrYQ5SDhn2k6ClmqQ5q1h+KpVQDAq1dRwm9o407s+49tpIdzIe/4GiMFKMCP5IQSqCsJhsA6X4k" +
+		"VZQ0iCnb15/71594G/86DYmcUcSbaKCmkSqaffD3twaaXfz+Rnh9/YnDAyfGISqF68jirksXQ5qe" +
+		"hCJQIjp5vOatxrb9UMC5cRRSGh6PLSCMrTswYojRCWLSto94p2bIEcNjI/LYiHpsRDs24tFK/hH1" +
+		"wOkr8vQVebayZ+b+sG/EfC+xHQbdIe6OmuypAbJ6MoenxmQN4ghrfMb22BZUj78aIO1f1OIvsuhZ" +
+		"Loph7l3q7bxPwm5ypS4KM4BmiaO74DBVtMhR0ls0CBqOCF3H/FNu41+7UsEkt3D6WtjZyu1RUpA6" +
+		"SSOTNJeZjOCxVXGA6afU/pg0jGO2W/anXBQSCCh0ENwY9rq9JqmxNKFIF4E12fFb6H8KbqVTLiYk" +
+		"OHOgsKisc8MSHq9TN1Orh5qMWmG3FOyQwkOlcJcUHCqFh0rhoVLsUCljZ5VH1gBj94CLYt+esiBi" +
+		"RxizBponBrHCIUzlpRIlRCboCJmkW2RPcO7q1TrCofwpt+3rT9EaaF8vesYvONsXVAc7C+eDwtMB" +
+		"FuAhNHeeR1VWymayA/zVmw+NXpYLd2VK2DeJdvaPpFlhC1NHSejxheNG0XGjjK9fJJPDMM7ORX8u" +
+		"WkWlQJRAIIPWk1AEygaJ+FEFLQMniyEqBax0G3IYTs3uRC5n3oUiUNKMVOdYb/xTTn3sllKaGOrQ" +
+		"ZdgXjPuiNGOCXCYlONVJ7sY6DDy27CiO9CwUqqIeODerrCYZzVxRr7deKKjAHn7jLdA/5SdpgP7z" +
+		"Y/3mRzfiRz30X4u59FpAhZ5bSa0bXgR2J27ZxeFLZbqMzKmcJhBntxH/lKvGECSTxGR1MCcxYIG1" +
+		"wFvNYzc9HdrXn/MW05a7Q7qIOEwGipgc/kHLVCGBoCUpqcddEAYI2vSZ0EH0vuv9LoMtLZguVzQx" +
+		"fh4jyqJchmcVYNySQ7oMZyp9zn5+j2v9Gtfnt7j+nKF8/RnnxPOuO1nFsGMUHqLm7ewLIhZbhtH4" +
+		"5GissppsBheBBqZUDWcOyGHQa8MTF4ZDVArGQUSa0WcH6HbFncPZyYvIM46j+/wTezuHPG9CMZye" +
+		"Mn52lVItSV4IVYmS8/A/bslEoGyQKOS4FYRqfWkAT734VYGoSJ31Pl6o9/Gyeh8vZvmC1s7YHWAa" +
+		"CUZfzXtB2L78a2oGNKG3ANSEfcWp2pljqRRQUV2VanhqoJuSn4h7WpR0CovuInWSOWFzMtbBzvyM" +
+		"QlEpzdyQn/nDfOniFEFNfUM4lHHU7ya3NEkblcdCjc1eHFuVLX2o9PSfJ1etU7AQHU/Pqm1W0WTa" +
+		"sfHVbpUC+VPM8lNg07wwVA9BFX1dZQN84ybW5MvYTuWr5okkOJnNEmIkPltnVuxoowJ9FKQbhTMr" +
+		"P9L153xLjkkchsffvumbAk5h4oxHcjLru2jt6/n1/P/8fj3/f1/P/9/j67ndblvaFEo5hjif8eu5" +
+		"hRDbw6S0r+f2/S3yTNtYVF+Vj7j2EdkY+zjzRjHU2KRSDDWJBjGUtpfXp9JWLe6fmnay53bE5/Yr" +
+		"DiNJvm+1FIdhKinmr+f2Ks8RvjaEI+Z19q/nVrfnmVSWp5hsCojusHa1HZuGWqOq8WrvV4jB5DBc" +
+		"2xOhBMixdxQ0tmiAuMIore2v1v5Xq75/PeW23RC2r6eEu1SRr6dI0mCkF0mP7UwOi03zJTcls6iW" +
+		"NYB/lgrRuko65tHOIln77QANXxoghY5WQzLFC1rbnuIwTCPyPiCN1OkdM8WwDc6oSc6SKFxH1oPU" +
+		"mZXLZQTD8ooaKI7q662WIW8thlGNuj+ifDsMx/p9fn8nmaRGFF5ZUtWLra+ntF4lmRwxeigIj4Eh" +
+		"z7FVvSehCPU8FmzkIUeLXreIl/onahR+qdxAFqwLTlfLQrn1laeTEvJ6lCQm1cwMculiMhhUh2Zk" +
+		"wGSvLVBoxOvM+8NBwsS64HSVicxQDXh4xICmqgenkRp/Sg0m29fz/vV8bM+IsA2RNWhfz3i7JaEI" +
+		"lDRrwfF2O/uvSUad/TcegyKiYv+VYFAdmpEBcxzXYM+4PzW4bQs0UkqA8hdJ9DaWikoBz0hdT9MK" +
+		"dC/f3ybhybBvOUyC8fp6xmEMQSgqRVMZhYKqFsFTQQyhINTIvL0oXhKc6iTzejEXopkh5aJQFcEz" +
+		"5q9nlHTb2ubQjOLXM45OEHX/R22+lIJIdYBda6WXShCwojIqYRiXWJQwaJg1QBaZabQPpaLB7eri" +
+		"APt+7UkmwXiv2+EA00OqdqVUUuxSJyH6EDMe4kaEvWSTMPxsvTuoSdWOejMfjThKLhRDHflo78hh" +
+		"01AqxX2rXfKH0hYtfzhm1C8H9MkcHrJVlO6crlXDKSTmkIQiUNIcWUCKlifXR4h3ADL1iIyvlQ+s" +
+		"zFE1LUSOrcd9EmIQWoT9CBxZI79LeppUg5Z9D+UwCave/KgLwmybcvdNuaOA+7E9pU6C+ytmkzAw" +
+		"HBexz5i1JhgaMseCbGNB1vsUT/9iMkhri4rrcZ+bbZDl2Y4tQ2KPN0yaSWor7Ylw5DMS8WjnoT6E" +
+		"6yjSDn077vVArzoxVKEN3w4ZFde6Nvyo0gDNqun0UAWikaIhu9Yw3qlEhB1kpGEcmY/zcjYpvcvK" +
+		"zRQHfUTxGeGmFaHXqDhOdVEPJUxyVYxm7XTGUTMERsvOIwf3VVQijz5S/UQNvusZ+6Sm2L6e6euZ" +
+		"tr8lUwyLvHrZ9YFw8LHluK28fygjiS6MQARKmrUq6UQ0YxnZfxGapn0N0DoJvWzg9fU8vp7565n1" +
+		"3DZCqSYlGGl5wAZQ8WpM9WpmegvCekHCqE0KOXp6li0gFJPVZAOgRNE5SRbRYN9eJl8SHOk6GM6I" +
+		"MnNC6Cp8NEwJIVIk80nwSX2EP1/PzLM55K1YM8QFrAEN1fwtCAMEbChEv9YKgej7oz9KWbGRZwIb" +
+		"SqFcBDVoA/NSL0fNGhGd+euSLyUjmHH/kU2jsLfKTYObdhxA6yAtstxuEOqsG132cfl5dmAoP3kS" +
+		"vSRQVpOMQCUK50EExnmW5ew0UfyctfUF1ay7s2DvFY3tmCoTYEeIVim9czN7t820dil6pOfyowHG" +
+		"uIzf0qBMl5Hm+ZNED1gjT2Ia3LVtfkaKc38mMRkcsMjxobn3A0eOs0cYoHpnTQiRbZ3m9vUsW9oQ" +
+		"qpI1GPhIGtTtFh1GxkX94T6uv0sZywy1bgsiThuz8I6LAjqs/tDKCK+vZ3nJ/qQYRr0Yx/0NCAkE" +
+		"rXThGbn89PJDIYEAj0Ht6/l6fD3rtiWEbYizIxyKTjVryZuGgrCqKBDnENdLp2nt69m/nifOQSfP" +
+		"NKfOnc/weyIcyvHfuXWT7ev5xmWlCgkGFqEV/vlKX2n7Sttt/EtCKAFyuCi0IeKGMFHAmETFeQxR" +
+		"KkJb8nblAiLjUqWapA9L4o/fOKVrsu4+1UzgffC03dRw1nweDmq6asz9MSlmmcrw2Me/aBA01MUZ" +
+		"g2lqRihwt0cuJ8ID4Y954XhUKvU54DGWF5NRaVpY1ObA8jc/sZTGED6CLWxR37j91LRhdChTQe3S" +
+		"wp6WQcLhm/QckLZdX82gtCz+O6WalGDEHP47sYP2Whqq2rfuMhg0AlN1b5suqHdHuZ3be+aNQtVR" +
+		"j5GXXkJCiOnIMgTUQ68qIai2h0l2j6AOSC9ZQzUzBfPjfgnaeQP6u144DEEfVbRueObLAPbx3AEl" +
+		"DONkKnVBmFN8CqC8JyBOJ88GwyS94ngaeSM3ZnS/bzWYTJcRogQxqPc9xzEnmaQ+pWQKVUcQR6o4" +
+		"zLFqEBBqnBYa+yhI6/+U71pyn9QUGUZt9XHwHZsGDaFwEFAceR03DbawmWyA1sUhOHkkNu24MZ0l" +
+		"o6t+jRXQTSZYLOEzZpNIytDsbUb8lJIGaYXkVtFGJPVBsYIihB3vYOHCD746XoY8KpwtFbPLtpWC" +
+		"1j10/x/Y/4cw1DJi3iiEslJ2cQhOzYgF2IeFFdXCTTL1pcGNg9DxSjpFdJK6sub+QgdTKcHIsmO3" +
+		"O16v0roDTFUukzAwfOBQJqlxBHmLCFXRsTtj8M67VArGUXQKqKhT1hRhOyk0DsNGxjCUw/eWkgMc" +
+		"9Nc4DWDicz4Tp9mKIiPCjG7wwUnxAqBPYcXCgabCcX9gncDYUx9ImgjzsdWng5naBOZ9WHGHF1d+" +
+		"stRJi5HDl2q2VUA4Mdy3l0wKE+uCi+9Ey7SlGBxkUnNcPF8OjD62TBDAz1aDgzppnsjufm53caAp" +
+		"BpPpMuJhn/UXih0kTJzRrN79LNUkpjmGli3mNll31RMN9MQxj5UaSDNYFZ5I/nRdVb1NlTZ8i45S" +
+		"o/tjw0wpX2yJiw0x2u6FWwYGag7lTqGqThNfmBW+Ytg4yiteChmhWrHPXy/pFLCiTXQnvXRa8Gpw" +
+		"RF98NTr08qJQM+dWL5taVatu9erWXXIUp9YnmbGcRpfKB0Ik0twwFlQc2pWHdQ2ROndCZc9jwXr0" +
+		"Vu0/9S62gqlKYhT3UcXZvaKLDWFSs72jT9V7QaiRcesUqj41QP6c8uHQrs/2OquDmt4bQoEYO7Be" +
+		"+S6TGiH2SPz7lba23fNmctjbHt+coxuma1G4fbpHsUObbmlDC2Ln6sbxYOGBYgeJSjVQV2ayFoWC" +
+		"qv7U1KCCEDm2Ymm14bRJOa+wqYQ97TyIYrgOz669AZdMkA0SKfv+wHwboHGiwV5wyiYhRjPvnKF0" +
+		"2/0dk4VO/7olkxKMWJxUTtWVkLgh8dhBennTH4IwQFQK15nXg7V6YDbWuWm2ZXpJhMcXhtS91K2N" +
+		"Ac0o5i0tXOOW/1HVteKEolINGqDBuPVdKrYPE5U+KmAyGHgML6ScR8QZNOC0S+ECYJw7g12Cnjis" +
+		"Tx62px625/2B0DJQukDwv7OJABY3emov+/NTY5YU2Ei9jwkhwaA6NCMDlpb3x2sLkxAdmNKuk0DT" +
+		"Vt1N0NLOHmH5B3eu0hYyzxqdyrH1Bc18mcRpFiiOuA0wFcRUwTEDaJMwozirJIoR9x7/mwY6tL63" +
+		"FKUupE4b36IynNZRRb32emtzvYUtCFA3OKNV3uj4bzubK1SD5tBJM1W6CDzK3yP4+Ur+ntGCzRnp" +
+		"f8bTehDpIjCbn3t8S3VQEyeIP3wSk2QJDivs8KIOLwg75Ae74gfBUX6kTtIUegD/VMk7Fnd+VNdH" +
+		"2CjVcCHhhVRjSPqLN08N1KxOf9Fof3Fd+Vfr/Zd1Hnxh5dZAzdsPojSDiz3+sh7MUi929Ms6+cVo" +
+		"bCs75DWWdSHUh2VZSQWNrFINLykvHRAufM11kkZrcL4QqmKd3Tv6r2gQNBwuvxEKphG/0YzaLr9R" +
+		"W+TX5nW/2IhfNV54kW2Qmm9f6da+0u3nK8k2/vcHQgmUDVK9ZVNrEIoMUSnQmxRd6qHoCLPlpdq3" +
+		"BnpIyvaNnPUnICBbByDJtxXxnaSbZJqE5wqJ8GLYt/ScBOM1wvspFIGyQTInTfVk5Z6YyYs+OzUE" +
+		"a/m0WtpU2o5G2VDWU8vSxspImpEyM2GmOy96ZMuWUdZQm/CFpC8kfbGNX9+13B3uEiYzU1XgaxbV" +
+		"akYowWS6SNUkHZmuojYIBKE+QeLEqAAxLlgnSV25TYXIaIuBeCBkjg+zskLNZEf4E1EtzF0gcUyD" +
+		"s+zPlZspDtoCb0EYIDKF6yz1LWiPNwp7M5s3Kvk2L+5TPbB4XOGsrULnwEbpmswewPO6SsZCRfZ7" +
+		"7I84Krjj+gxSPfRBX8pgUB3cZwKOVWeLQEnhCzeDNKwUDXKIEYONtK20unpl5amB2qoG8KuRKg8k" +
+		"qXYgcYAWlIQWQJ64+oakYUR+dw00v2/McOW76wA5ZDsou0lE6G8hD8DoTUAcQpYHgGmUNyp3x6c0" +
+		"FdS2JYSivfGOF0YMmpGuZjtidq5qt9z03QwAv/lARRyCkyXBcC/3mSFiulC4NDul5c81xUGFQlXJ" +
+		"gQKf+jI2glMNcmzaIzkY3e/Yu0NqI9/vtndtiFIJg2b2KDWbVLN94UYxCSV01DqWzPUDskXmLVZZ" +
+		"2VwgW2LDgMLEGc9Kgkue/JaV0+VaqQvOjM4qKyOiI8R3gw27LBgWTqtPm2x1hPIrH0r40NZUv0u6" +
+		"pY9BlQXDwm0yk/OsfNd5i9x11iL3WsphUqM1OA+hoBqxjCL3n60eUifBqBdqEsd/0wCtqDIYJOtS" +
+		"0C4jfQ4gSexH6fF7UnN8TxrG49gQQhENsZUq1ayNdJSsS8sERtzr1ogUoy7HWREOmx5GuHawKwf+" +
+		"klbiUZTv/UEh2UGCI52VLQGOMlDrhukyYgMNNsnT5UB1wy8KE8zSTSKryG6PcnGahk+PiQK28pWk" +
+		"5JiHt77wbhIVMRRXFDodOw2Y7nC286qyP7YzLwhzaRek9qRX3zQsmWIY2y1u2SQGZEWNQ79vHBYa" +
+		"AhEKqplCAgG7YRA9qgrk1SyvQqtuge4Z/ddnSihHTH9siUJbuj+2eo/7pNWuoKlwqhviVo7bgs35" +
+		"7yQxqwSD6mBxD0zzDc2Mre34sYVmVkiL4xHS+7mLSTWcL4RDOffSyxFl8tic8ylH3Ax2B/V6lv3q" +
+		"MmkY37p/3kIlIZRAWU0y+mHXLc48It58QGGAtvBb0oyCQGYVFwKA4NSMLJtqkW5Ql7j1x5YnwQhf" +
+		"HL1D0AqB++SE4OSRLMA+Yaaom4AA0T9BAy3hJ6AL/mCy/2ONoFfWI1QFDwoMWe51e2G2umhtVeP+" +
+		"qX0mvahkk0jbvtL9K8X5c8bGl1KSIWIzESgbpG7XgJJNakzVYOBt/LNRCGrWm1qRN7SiPqkS+aSK" +
+		"ymqS0SjpJvqdFAUIXWqF1LGE2C/HXy99UdvUZcGw8OJihXPpFoQdBjbo4hCcPHJms9RI5yhGdO1c" +
+		"f51sEVGcskwyo1YucjEXxPaKoVCoWr2EOvMn6tUHNDG7ZlylY8bu2IyLwzDtokFuLoOBW6QZGaB5" +
+		"duEPT09sxuVcMV2Lwr2hD1VBqBo7Qvg+NUBlnizuiaFSX45IcS+VtVcYpuGtL0WkyPu4aEu0ZBjB" +
+		"SKAOogd3lG8NyHqgR7kLQk2QNVDU4NSgS9YvbDr3BYfft2hwk9QdYL+lUgKobN2BkWe5HGD6LvUA" +
+		"3fUbY04wckMB6XLMbo9PIeQJjCvatNj+bxzC39JwfW7kxkjn9tqyA2P7lWQSjD0e4gAT5qYENWkL" +
+		"4CDERagKRLHArsuHkIgY4Z0PfhBg6kIRKGlGTnbJNkDnxITg1IyY4NERwuXRJZucFqmTmqGnR9/h" +
+		"7Qh/bkOhMXP9uUQn5msnX3Lt/yjp+lC5u4dBP942kWavkCWcKWZlB0mYTIdXLeUbSAuHHhKNPzIb" +
+		"DKwR+A37AVF3T/xK8TlPXU+eu1SyUtpjcfg/JemZHtA66YGDyLCRPb1u5VN315M76zlzz5xFD8TG" +
+		"P7GfniwKzNIw6Y8ch/TZxREOH44KKcXX2TFOp6KBmrsGWnriHsAwoNUbk8944Px64FONAHgOYo0P" +
+		"nn2PWzJDQQhFQ9FgCy5h1UzF+rJ+gfTpABeG+5aQFq/tDboQqgN2w8GdcLCSEYpNpxyRBNs+ZDXJ" +
+		"CE/ObnbEbtsGyq6p0LyxIw/uswOFaEugIcrZMMiR1PjSAClfqMeL2/ASE/CMgQJd88CvvwCsPi8W" +
+		"/mKM2zUctqyT3jjuHMbsMKKz3gBQoaq6yXZ3CeuWKLSILDbSkehUKVDXQXS/4QWOiUgQNPwWgq48" +
+		"DIkqWQhfpGZChpagWYrzRaEOdw2Q8s7OrOAWZHeXGsXAU/HmrrF5QhaEqAjjTjTS/WQr3k9MeABx" +
+		"X9DakWpzVorWuNHbltWysp4acC89bS9heMpsaQ4O+clWerKRnvBFUz3ZVFk6haq6eSXJuNkXc+MO" +
+		"UpdOlkShCToOxdx5LPIBxsjqa3/Qg7GwcxYf8EqeJ5P5MX6wmLRIprBpNQ7qsSU4UF6YU71K4y8L" +
+		"JbxZNsSowqvqZTGkVCNkpqjJEXQMSwAJjvRWHp7/jf9TvjcrciiY04Ea6TKJHP475awm4RMDBaYN" +
+		"pMXYHC0P+6GbhadTqQuaGUen0q9MChPrgm3yxFk4bCwHD/UrmAeKrdsIRmFNB/T20kC9G0bAhuWh" +
+		"2DiaNd0LTX9+IOFAwnM7Q2QKnqibzX6aTX6az32aT30aZz6tS42bgkXwuGo24APS5ZjNPsJRl77h" +
+		"ubIBw63vj0ihVtGgItRV4chne6I/xgNi1vZIj4LdO4FirpYpl5CJ5tajASaSPLHp80AjRJKHTTlw" +
+		"e20INnHHcwyxP/Qef+wPXPwvKGFV6ofSFi3uH4ptrRnyfdHWdPTib2osrE7xjosagJm6OAQnj2RZ" +
+		"c55tP73jRFdeH5KsjQ4YC3PkDkW7nx9s8fougJEZJRhUB4tjDW1v9SQUVoeemCpx//TCfqSgPifW" +
+		"FhdMlyniHq1PMuNwe8+p75tH1BtB0JDT3bdPd99zuvteprvvOd1924UcgCYtQQ9e3omNdiM24j4s" +
+		"Hm4ZYWQ01xEGtAeA+vgQ4iQz6gt4ihcFTn9KLBq+9ONTW/Et+qpuim8dhN+cZ/ESkzeA49+vsQpU" +
+		"A0X7Sukr4VWHhHcd0pYLwvaVylcqW9AADVtwCVVw9lHRVGoxRc8/ZfvWAI7fcPym4zcdv1U7NIhw" +
+		"4Ty4bGPKV8ZWFzyqUPCoggpNlplJhtYe9tRo2bJ++MJA4/sDoVAEymqSXkLJnDqf3hyEIcYoXZPR" +
+		"XwpuuJfb+N9GxvpFTRXUtJTbLdIchboWNt791NBV7ewE9RQNNDGS+vo18VcWDAsvLsyey93lplF4" +
+		"sQpyGPRALLh0UUEj10iBF8GmO1DEIThZEhbOY3tIxHShCJQ0m7vnz6lB2TEAQsLwLlUc1BS/9Hfv" +
+		"k75Diy15ogSdXhZO4ot+Fx8CKot9/tl+VOqTVZBwYHjE/phEY5eXwmlC7UWDo9iWKL5lQbgh1I/Y" +
+		"GqjpPBBCYdgpoFrWpzVSEA2ArW/VwUx6QWqkxrsgDBCVgnEQaJ7Am+Xlu2ugSb4xiVbZYNer8iF5" +
+		"mHABquh6U9GnF8rQ71u+CX623xWN22rsj2NS3Cer762Up0k1iAaK2JI7tmQI3FRypFOFjBsFVa3o" +
+		"HQXi5ASJvu8nqUFxyw6aGg3Bhw3KPSKacbiWImxpwXS5oq5/toNC1aLB9zcFbBkhFIT9eokDTGen" +
+		"ULUWvJSf9JmsMk6iResY855Kf0xqihpilIicDgDcUs0F2XLyVtK+dQpVkwaaHp+FTV6TNC57Xg6I" +
+		"oqG8mKS8zNk8EF4I79vd4S5hsqUaykihXT9zYgBoncSumjHMZ3pU2HgiAKTL0RLdNcAyNKGRfkzC" +
+		"oNXD1f0QKP7Oe2cl3x9brA6NlJEIz6YQGIc1SiMYI+vDpQAQo9oDEo/EGzVH3EdZFOYAj/YoFesd" +
+		"rggVW9wd3O0lsZLvWLsu+Y7LRgKy+9FHUMvo4OV7e1Co6anBhhVMI8RoKQXjeeF4XjiAFw7cxQZu" +
+		"DA8FQ3M5NEAGB1PoQabHU8lRKCplM6mZZqijRbSLsoOWl34YwUCdXszjZWnZhdGBxy97pKLHbdH9" +
+		"VxpyauhdRe/4qzA7u1qxe/9KtFQIaiiv0UsV7JmCY7nwKC3dXIY2tkfHD2wTRhBW+kWbqRrVIuaE" +
+		"gHQ5sqb/nRs+9ToxXYuyumnn1wkc3loqfCup1MCDVaF1EtPiPWJO+GrAWjpBTWN76h3fu3HSmMgQ" +
+		"2mhsOw/hFKR7BS2L50LZvmzWxiOqIUDUqJ3+46xs52RtXn6hnXSpLCPUBuo8ds9dp6tn0EBLP3HX" +
+		"vJzhgd+Xmagp0ARnOMrZH5MkTIYf2+wM7cUn+SdrxP2BUJV8F4pAWU1aPCUaRI9t3AEqvOlTcJhj" +
+		"gDrRwW07G/bqkNi6hjkDFpvpgkya2tUJL/RApsvIEmg7n29kzCchFIadV4iQTPHGOzL6Vnt5C16R" +
+		"J2i87I+YYEOYmNBOIQpuaZ2U3Q1dC9eQ5S32CgMe9SxWN2bRdOWuvAXOOja+6W9j+Tid/NyQ7c+t" +
+		"XBRNZWV0xdnmB8/56Bc+El6PGOG+6R75YQv92Nn7RyqeReX7E+UHW/TDaDT+D948hpQ6qRkCIuPY" +
+		"LD+4kwtJDzbTDzbMtBH8/Url0hPRtaHPD6kZXXzqAeAW3fkXT1fXlnjRMmhYfgUdWmX7Su0r9fCV" +
+		"zu3UoH3ZS8cq0kVQu2gQNFSDtsd5q3HXlR2jZthlUpg445cMMFaQS13Q3DGGOqbLlLFh5x4DQlcT" +
+		"JdrzHF3qxEXQie8mQrYOgLu9PnDySghfwYEBobrxF9mc0mK1DKrGn/g9a6cwsTmyKLC2Q4g7HqQz" +
+		"StdklhDKVzrHdPj81sWG8xurC6fOjc9BY1/rJ7zSeb9v0HQkO3FZcHL+z4+Gp/OOMzikRiDYYjKp" +
+		"htHy5VwxXYvCGj7HoszhkC5HOqSkAb4IBLpUah05Nz51mnumU4OBx227F8iqQkxIMHBLbSRkNkh/" +
+		"a26iOeiEDiRGFof3qx3VHO15Z+AFsJ/vnUoH4zc5kj9tS9BWOY7ylwIZvjSQ3VY5TLmAAUJriSmW" +
+		"Su3M9tFy0B1yjFn68fKkXypPZ+YT8wOgVw316gxSY/cHQn2GkSCMkUBZsgPmGIvSXDOiRN3y/sBD" +
+		"ykaMxXzZSI3qrp0Yp2OefE98YdHBbGcyCQPL0x6dn7gYJgzTS+/7qVBVd5G+Tz5CCZS0IjM9xtHp" +
+		"KmLGxeRZdTCqHIOqj0HsgvWpAZLpDESFejzNZWjNBr02h7y2TBpP3Wq803hywxvuA5yYGZw2LThh" +
+		"xACEJS8VyWLRXCqREMsZKpk2WoRnicq2zrEKQC8YBvcNr+4TRoT2kS5ml2lH2PUmxvn3rBGfBiH2" +
+		"Seky7jIpTGyOqCa5ZLI7lHMhz3a28t9zHNvvOP7jV/qr/xq8R/D3K/0dkX819tr1tquKNuQDNzkI" +
+		"wzQ0rdJ1vB4IcdgZNSAfuHU0cwyEY3MYcXqsXjwuL76XfNlryRcPvcsOPYKa/mqg0VU0wCyQALuG" +
+		"Ws+KlZvLflZWSacjgO7ANEO00sqxJSNpX8fXsX0d2/9tA/bxv92qqAzbYTJuk5rjrywYFl5cxmYO" +
+		"5b9TTCK2lhwdzFSyA0w/CFXRDFAObuUZTFMzYrH2sOdAjXtoIF1MwhpxH5TYFwoT3dVyB5e8sjlZ" +
+		"Fp6B1MvIfGY2Y3c5MbodRUFDuj6lSnJQx2fM24+DmXppjwVRZN0OrVYtCG+xjD6/MNzKXtoxiUbZ" +
+		"Sy5H3D81RmKp0fkCZQpGvB76nIWyCfx8wLFpRqOt0OsCqho2LT3s24tC1SCZQgIBDURKF5nelYKq" +
+		"NjY+on1sQcuRo8QmKX0q6o91LQM1If9j01ocqIwOVMcW6mZiM2kGMWjbYxKM8b4lBzVpgJegAVqO" +
+		"pNZrOSYNNxlkchi+9YD9xsH6HUuLlMNrbPVdX4x2GG53yX2kut9Lp2iUY3PvUYMd4ZZMJkTqCrRB" +
+		"Az0lENDUd3SXO58fSCszm9b1Vr9hlwU1rY5W97zleMR+OZezrZwY08UkoqXFzeA8QJ0i7g5WnaE0" +
+		"c4vMTHr85WhBhWMAtGmXMLE5simy9GISXxZfFXMvE3BHHxqs84dPPrQZqXPgqfSVuYH4fRSjOslz" +
+		"ESNLcNkWxLFiNsuAxkJ0TdcJWZQUNweYuA9O7cX3VxQKVTXQ/frY6vZne0wyY44ONMX2iJNg7Mdm" +
+		"Ug3ljkdPiJfC2SmGPQYNJFPoUjhRtrBgMy7FzOkiII6h1PeW+8oakTS4bXeTsOpbTAYSHNH2YPP8" +
+		"a1JgobtUClgtpU43BqTSTcKAkSYmDDQxwdoepTuo6dAApRzMVndg1HT5m+cZEmJ0qcaAJoxAgODk" +
+		"kcya7ZmPrXUHODFkKyrQ9KqWGkhz3y4HM1XZjgUlLIpnohpSdNvOAfbcx9STuCJhYl1w5mP5d98Y" +
+		"vITAPMrLpEY2XtpNVLMOORxj9JdndwcOZKp4vFpKivumUBGGcpRJDSiBEsWSGInKD+AbM6uCcXPq" +
+		"lsbqUWpkRWqiHPanaKCLkgawQ6fC8BG/+yQYzxcFVD6/PlHNqPqTHSON6ZZuIL8eqPAHl2rOBwa3" +
+		"tIVDp2k+tUxbqCX2SemazGmBfotwiNhQznH2CfB41fJCszkjosbNZAI8Bbn1B6uwl9Fz+oKaVIsQ" +
+		"+42vhRFrD1wal7ogXeJukkM9WcsS2zxsgrxLci/lviCa5Vu2xh6evks9vBVdaaah9b/tB9wW/ozB" +
+		"3nRV00eUwDVLp3RNtrrHe6bY8s7dD+Vy7JOSWVGJeLfyB3WLRC56r8JAwsTFWqerZZTvtOruTlsN" +
+		"JmGVOeyogv2cpFPAq/xQQNUwl7PiU0xDOXuN1rRl39PZTAn4puKxpVcdnWSXlTVCC+MR0gU165hD" +
+		"JCxYKGCTekG/71W27iDB0fwG25GmCvJFqBusD5kdOmbreR0n9eO2IYRSEGoBx60gd3214tgOJDu2" +
+		"RIGxi0SPRKlXbfjB5yHxkxZO0zgevVRGVqU/TMJHYweO+LztSUwGg0ZAZfWFA4i5t6EliwiUbDDD" +
+		"Rq4m0dENxRWMJ4pmo7Q65I0C9i5iUg1hS5tJGo5zgjnVmB1o6mIyGFgMy+Zn5gZFbrJCI51p0w0M" +
+		"JcXsgNiKEwmAJkkmaYgpOahJk6A+gsZHWjlxOgIse4N6ElckOHIzjGdOSmOtD0LHk3zfEGbRAyXf" +
+		"GQaIStEgO6arJBgjnXjoZX5fZBAqeGdfuyd6JsuPjXq3rncvCGmu47akAUxa54d+W3dSmNgcmaXe" +
+		"1KBEJCbM+XHmblIj9GSX47ZTbMkBTRWxWJBxEZEjHGc0Y/ezikNw8khWTLH1BeHxzWZUsJPUok0v" +
+		"CY6W5+B0OZZJMxmppOAgEz2nkgI8nzGbhAGHXiw7BayvM1mPVO4rhoWnu1cbSskfyur3lg8lXVMt" +
+		"dWVNpD9ldegXdI8tJ3bQZB1UH6hRaR0V+WHM4YGY5b8z5kkNWCnMqWLiRDIn5sduzvN01t9UoaSH" +
+		"3bo/tuxX43leimdsXLEBk9v30hvZx5axuIm6NZytVcKAOL/+yxwWea5VCYN/4vLYsBSTO7p4x9jV" +
+		"Jb2i7LKyxbt8SPpe+G0eEDGlzYEmE+bS2uYAE7pT5xHeeQR3O7Z73SjUfqKRTjsgT5xM8znmlTwo" +
+		"jcOq1A/lI82qsNTziM3a0LhR6SbdwL6vaBmwKlYLK8ULaHuNr76gxo9teo1/bZUXGuNllygvv0rR" +
+		"J5VUVApYkbtKNYxgeNTtVk6TzaA7wLRvFFDbWDMuC8LcHyU7SF1wxoPOQCEO1cFcUOWqX3g9torh" +
+		"pd7QH4bENShpMTZHZsHzd72x71RdkcfNPchqshEo3Z8vwC0MF60B8K6v/xj1bRKiERUzxZYc4jbR" +
+		"F/ZMl5XDqrRFYUXvfGLR0FxUopg4rjsdEHHH+QAA058T07Aaj5tLRNGesYoNCE7mY/sv5q1aAovr" +
+		"4hCcPHImtKZgu3JFo9pyRuXCTY2+RmEIc9+SSTRK7PFQtz8FU+r61EAfFDLQtFhGHgIpcaBVHmb1" +
+		"KZ1izsygoZviZ7gJUidZ6mlzE7f7Kf2VcOPJ2VwgzZEHc30+NwpIGBum/QB9NBCKwHq+KNRb2wlT" +
+		"2qqnxhfOk1Np1FTq2oLGH6UKWvkoTboD3AoNUHGnrRZeStdiV9F2663inDaES7O7nOC2rlP2+t8Z" +
+		"m0lxmCZ2EQy9tUZc7ACW3Ug9zcgGsnS47qw1WgQ2opYfCpiRGVeusZd1DlDbY0smJRiZ34OHLW+g" +
+		"1fbAHEZBJzG1PbiySaKfX387M0Jr0s5X3NIkjdPsOrpEx+Jx7XTrdodkUDZJF+q4kwGwmDuWgAzN" +
+		"HE2aARLhVSlCOUhomX5Z8/YLbfiWRAEPfkMKxJMYCJV/Y55Sf+NLt2IobdcAI5NKCUbITBGupVNA" +
+		"PbmETJpGdJ+mO6vhsNb9zZWPhtNMe1gUkvIc0x7ni0LNTw2QEGNP49jTePw3Pc5b0bu2Tn1S3Ffm" +
+		"vqSOpBkhHXOFg+6YhkNxiLqFFcPCdeW2KAuztjyEG4Jtr+IQnDySiRoOUZXBgE5382EM7XJWk2Zo" +
+		"4gBT/KagyoxwpaEyXUY876Nhuz+Y5coFDBCVYk/cUmNLUt0TF+OkxGxswzuvIwYcuKIxlFWZ7kOz" +
+		"MmzC7mwRLvHb2atiUfphnYlmRmV4HwqAKHSejsc+ncLE5miVnXcQW4/f3ybpiGuD1kso2YGmGEzC" +
+		"gPCsNyscGBZeXKx8KCVPBWs+ffxvoVSTI23XyWIfz7+V56Rm+NdBaJNASR3F9v2R0JUGlf05iUab" +
+		"zRtOs+eAjxtMpMsPljW0DbD16Il+xxuAccK4rxz3T42jhVvaVHyGarqsHFZlTcVtmPfdgeYirGbe" +
+		"JpgbR1b2385sYdI7arru2R/4QectfSjpWlScxxatLSpYw5hFTNJwh1CtRl5DkLY0GYMDlQUv4G7R" +
+		"ewyWbI9BJlm8LaM4h1VZvVg507BzajxKjlbEUTIroP210qdg9aPzcRnsZpTK8tiRbODqPkR12x2s" +
+		"i9Wis2t369idK8EAOJ98ygIQnDySmZ1+v4vX8Hb53vGpWUClbCY7wDOxG4CKmuq3IHxQNEiKTjFi" +
+		"z4BpsY7WJ+YcJxYzT65lnpyAnNqb8eseh/+6xzF/r4OoXg3faXFSo56A3jode2M29sZnlgyGaczw" +
+		"fp5YT1KZLiOerHTeN3b0Xy0fI9PfqAHWYlSKgY5+f+OBcEsmE+3zmFdFHIJTM8J2/sXN+b/xOA+T" +
+		"MDB6KCP36yYI1RBgC7hRfn0n7LfrO3HVi0SjOj9klHDl/+tDFA1y5j3PSwfzq9QtmdQOcBX2SoAQ" +
+		"VbywaqxSDdqfdKuxnb9nfW4m29cRRlHjbwsalB8K/YmniY2sUpfXVaSLgL2nl1OyYeVSZesAj0eI" +
+		"a1kCTBfC8TULA0bYS1GHbFmDINUkzdZJHZsxgAlYnRyFArGWNOIJ7InpWhTbhKGmfzhdq7Z6Ahjv" +
+		"EQhRGWuL/POICaTe6tOSULJNmzcqC20n76gYmtVMEgys1oNxC2yyRxiwUdSp37aUHNSD8ykCTKxi" +
+		"txp23+09OZTt2+CNwvqLvxp74PalbKOfy75vCIdpf+DGBWFLC6bLFXdtx6Rp7HFfeU1qwwQ1mRQm" +
+		"ek4YKjRKT4USOC0hNFBCliQaVeh9IBWBspq0eJfloC8LjRvCRKEbEXR+oKJBUnQxGQwsxvPjpg8q" +
+		"1QFuO8ItBkIyyXJ3blLcN3aqgVUIfUJwsgRehX3WYbe7KIOx2GhkJepVLIGOhYIqhLy35MD9YwyP" +
+		"srOiZbdTrSkoq9sGdN+A7hvQ5wb0uQF92YDOm0CO3ITzoFC3JBeFqqee6CBhaBvFyEqrIU8NtNvI" +
+		"EwecPDV3efKwk9r2x3YeC6pZfVlzlXxkd1GaaYARDu/7psEtdpMNoO+aKV1dHGDaH/pOykSzX3pX" +
+		"3wjGIDolNoLxIbX/KqXYHw5xd4RfKrwfOnn/UMztVVL8YJb+epQsk8LEGY92u2+v+FfSJDi0vlUH" +
+		"mHrJJmH42Xp3UNMIxmbF0rRafM5c0nagq6ct7w88D+q8r9ymcgHvYpJxMUOWY3PQqKCBQGDrdCld" +
+		"kghCVWLBzNcoTJzxTA4uebIeBCnF73TqQtfkdK0auy5WA1VIMKgGrZNYHF5EBSwZqCwYwlRyBBks" +
+		"FlnOhTx6qUkJdTu2SYuxx/1DmfnTQF8YM0K16QbgY/4GsGNrrR21e+i7ghCmYk3cEea6ZXFwE7ZP" +
+		"TyGH5F5Mwqqh2kqsFLDhuY1t4X6ZksTJbDmcx4LMAycpAE6qzmFV2qJg06GZ3R38NK2KOAQn9/R8" +
+		"UEW1t+0Rbw7DNPLKestCRaBskMgDoJuKp4xU1LhQI6rUTAI/oTAxXaZ0EEoLkuSNEwJntjZvzaPL" +
+		"6lN1xD6J8aifHq35u6SnSTU8toDtw27INlfIbNkxlAmjOE4MwDLWwL8q2h4psTnltZ1tS47aDKVu" +
+		"D5Pq1fYHBTKeD3EL7uhLbr2eW3LqsmBYeDrzuDBFe0KeM2BFN+uvuBySO8roNgUgNUOe9sEXCQ3V" +
+		"HyWZtEpZ/vZcJYgVszN/xkRqCPrw+V8l9Tg1GKhDHQc6G9MwetVdHz2QiZjZ1l34Iq/xBaoUODZI" +
+		"YWJzRDGDYXtsOeiF5+SwKvVD+UizKpbtA70VwN0D5djyZJmMXGybIq7EAAlbF3kVQVqt2WJOjjkk" +
+		"+pw17gQYNNQ6YUaG6x6sv0iVbi/vr0q6PlQr8q4Pq0yCURAGiErBOAg2VeTUHaBRMd9PcaCpUEDt" +
+		"CFFA7JZXL3vdrBGpXZNxwhpKtc0zTteq2cZhglfxhgckDVpl7r+KK79aeeVX9Ze+VPKSoFa7FqzY" +
+		"A/XimrFjc8bGDF3nvU1PuQ1XHkPE7CAk9QgbU328kSbtoYE2FW5dCW9dSRubuBu0w+hXJoWJdcE2" +
+		"eSJzLUepr8ckddBRA7eopPn7SY5q/u+MXRzUhACnHbuZJHYzSfxmkuBm0hDxnnEHaSh4FtKIRoi4" +
+		"PUyaAdfYrUUm4v4cYDliz7Zm+5P3oKQ1rOITEKWuPf4Wk8M8cuybBjfOY0k8XqG1Y8Hm3GXBaf6V" +
+		"BcPCiwsq2rd9q68tLdico9MJI9z8p/lXhQ4sLdmWpKQfRF15S4vW+ofSpoZtZ20S7mYZSV15cbGN" +
+		"0o4W94XbMZXS5EMJH1pbtY8MeUHSx5VPdYj7glv6UNLlqqW82uJ1tQ+vocKv9a3JxLjgr6wcVmX1" +
+		"sprrE6eoYt9q29KCzTk6cVf3hzSZiApoyShSjle7dm8vV+mI9hFrHVmbYyhdJlkKvSto5EZ8xHBV" +
+		"trSqrX9qa0IrDjZUqbL+nIF09h/U4rEFXPP2x3a8HmPHR9Nd6iyoP/RT7gNKoLCqPZbLK1M4QAx1" +
+		"3YpFTddqkNXbYmjrVf5ieOmP8aErbYEHIi/NNu4nHqFwTNeiWGUwsvXINmCLcJ95b152XbU1LFKY" +
+		"2BxnNjb9HMx+CwoTm+NMB1tBmLHkR2KUrVkYrva+oUyMrz0JBdcOx/wS00vOLn+SBjAls43EOu38" +
+		"/d0yOgEJxoKwfR3f96/ju47/9nXcv457/Tri1xG3dmwU7esYjRr3YdhlBE8N5KJQj6eGtSC8iUma" +
+		"bzqbA81OtOit/6O2Vb+oVPnhVdpUGbfrpbQRPfZY9zP2D8WiShCj43XqMbZq7taOSXGfTIdQugNN" +
+		"yzcCVE2yjy4Q9391OnzHm9QF3bw/xDAdkyRM1n1uiiXU7wRaK91r0fcYJjMCP6My0cxly5NotPdy" +
+		"JodVwexw6msqr+Eyfn6obep1QZpZF6tIGbPz2I5PjZFc9nM0cy07fonxU2W0ib28ZMHFHPeVt/Sp" +
+		"pWvqhqMLhJUtt3NcPtDrZ8Mn8UxZ6NMeJjZHtuswjPiwxbF9Qe9fRnwqQoU6YKEs4n6FCv1S3USL" +
+		"PbZsJE6ldaK52euli8IoVCsErcV31GVJgtQF1V27VEB/CXfpFKpGDRTxqCEkDPFbU1rNstXmVWKe" +
+		"oL413iiofn+bVEMTvmbpCPMjvtgYiuKIaD0pxIDy7W3qGNp5HNjOLvUwqUm6rhLG8IM9pF9eHVLv" +
+		"AUDCwI0bMhhw1ANfRmZio//EjGMo/ER0lXDJVk0Og24GOoW2wzfK+GYWaBM05v2BsyygmSldBJyK" +
+		"B+qN7wEale1RaEd1q5ueigg05W6Shi4mg4HFoIZ3WxkysmhdIIzPLRSKEfEUDYKGakAuafzzpeeI" +
+		"l5Rj2h8jDBrohid8M2XIHwph9A/zCXqmjUmf0Y6Jn0OJepgl2e4uYX2hwwJgqgihMOwYx0hq/NZA" +
+		"McpJAbVjcRHUHdK0mR+u54ntmNgXjPuizJS2ZGuqLBgWXlKwjYZixXZxCE6WpHuCuJnEMQgUR03x" +
+		"1AD5PNGOTzbkM1Jnx01P667JesATiyGERsqQKOkJa3voa5VGNJaXSRj06/EGMOlGa1dL7AkJPkky" +
+		"FnMdm/N5LEhzpaCqW5TiVoMDYuJtqw404c1dIxrxEzMTabZzsCHN2Yx5NV2ku0lGlWxyi1VWbh/K" +
+		"P9qMdeiPSTS+JMgkGv1064yIl1lfbqp6j4AAE0M75tI85pKP64Yw/1QOkYZq9hlMmhOYNF4NKFvr" +
+		"K2uEBmrmkWCdX6MP0SBoyF/TmfhhRzLTkBghjuTDQhwpCtWhkZhL3HmiGXRBQkV8aRvFsObxv1Xp" +
+		"JtVoQ3HWls56M2YIPIUyqFI0SAiUoDssh1u561u5OlzhDosKHsS4waICqq4yQzKJP+ljjyjHjB9S" +
+		"NUiXozsylG5SDboZqILYjIaE+IpwSyb9Ase01j+UNrXLkDYIhr0Wlt5+RH8MfWH10XecVQRKmrnN" +
+		"yD9qwCcYjZojq2e8RPigb7qsHFZlTcXCORxlG41yvOGMDKDpdEnLvt1MmuEwScO8ZJsKopaLnxyR" +
+		"7NiSSZz0iH3BZoxusTxwCEUcglMzss1l7Y7zMAkDo5kbBrgcGdrULsf2jLVPotE2057cJ8YtTc53" +
+		"Z0vFuZXhNPtWmeJRXo+O4ze+t2xSY54aKGZp/MrHZESUHwpV9aIrF9a8WFX9izjACwD9bww6P8u2" +
+		"6b5ZrVdJDtPUNL1ubseJNmNQ5XQ4Wwac0Gabz2YOumwf1OOUHEw2QKeAynA/k0yikbILRaBEtzo7" +
+		"L7CUxMjGH+vS+DQOJIrGhEglc2bF/2qg7hVf14CkgWtpjlqNijaqnJXqmIAdhEEBt4Ei7wJF7rNa" +
+		"S6WAby1LjKbT83XtD4zGgHQ5cjNrf6QPgssI2hYCZwaGElZljUHhbQtvyRzZpgJH+1wNkcsUbUsJ" +
+		"33ZdGAlyf1QsAzivEXH/UDhauKH1T21NCsfXK/na4ofaXJcw0a2e+rKtfr2qPNBTpyKf6r++bdXa" +
+		"rITr7lBe+Ljtpxr+0f9xX4qjoeT/MWiam9xL79r/200eWPoghYnNkVmDtR823RX7luwthUUJH1pb" +
+		"NWbkasn/qOadTHpuaaZN9LI3OCfPCAkT3Xo5eGatm1xMSCB3ybOOktKG35/+0NI1dStJFdgf1tFB" +
+		"iH9E+TZ4W47kdK0aj9m2R3t0SDkhy3IcZ1469F7yLvEtK4dVaYtimzu010cOr7kjSg7n3heUsCgz" +
+		"i6FZitbruS8Zur46yMphVVYvL+DM3cHcz9zXeKSreCDcCEaWZgVZGZZ9kC2ZXEzNoAMkmGTMIfhE" +
+		"x2RExO2eS5OVw6qsXlYeNUbRvcreJ8li9WTVl8+oIIeCkEUVcy+8TGy4WYXnUUbIBU+S9Trho2lz" +
+		"ltSk2lsIyoykqtr80pqynRqI6vmNBRqVwcBiWNfvyHQ8NyrQp7pPp2BE7+bbrYm+S+1nlgXV9+4H" +
+		"AAjG8paaJ0mYbN6q2JkMGtKeMdhmD54QJqXL2RMxY/uqkVGY2Bzd90WBKCwyqgwG5sqFx/ZAWz3Y" +
+		"Vo/ZQg+8pUxAaTFI7vxK66KtcdfKzCrm71KPST4yQZUw+SMF8s1d6guXs1NZslgtnykkfKieN3V1" +
+		"/nNivRsQnDySqQba/jVWn7TdJDlIcGRCZXpGxl4U7nLBQbZg0qKA6TKFjurn93/avPvTPu79tHnn" +
+		"py33fRpfXyVImNgc6Zq3Q0wGg0Ywn4LldYBGlvt2cOpFdPMFuLLHX/b6iCvNuJyOmo6fwCAEJ9tg" +
+		"VZoR6/dikw/paa4lEglqzN1BgqM5Vl6SDLKJ2quWXE4e4a6ED62tmmdW8tC8I38YNMV/Z5n93BWP" +
+		"Eofg5JEspbJH1dmjiM2Y8Cq1T5Iw2dMNxbyrNDa4K17VT8tnCgkf6swbOpxxCacyGFgMU6jacIJu" +
+		"PCnzfMoz6RgHxSE4ZSd3szx5/hmyXkbwcpeSTW528oNyGUqd5PGEt5iEYfzKmIOVMdiiU3KQ4Dg9" +
+		"fYkXilEODtOXlxrtxT3XXvquPwHbxXsBgOBkR5crzTUnK6jLyyTiThPw1Yz69rTJRd+eLrNDugwb" +
+		"gfmzWrgot0WIxjWEZmsIjbfEGl4AhAwGTGLDl98189l+T9IL0unb/bosk0w2Qm5+XcNPiRIWm2U4" +
+		"PyVKhZlIm8B6cRUJQNPZuoPlzh9pWThdrnm5JwdThvC4XmKSZjsI8CJyx73dRfufaOt307KqpSA9" +
+		"5rsnD03rAiePJ+1r2JO2juYLZ9yz6GD8ZXiD4OSRTKSox2gv+6PkUOP2oaRVoyNq1TnR7ng/KPau" +
+		"gWQKjdNWHMs7f9l99U3q+Fe34S+qjIUfvNEc/3Km+9emub+/kinw9RBHi21fxzj+09dxfB1ZDjyk" +
+		"QsDqBpX2dZSvo2xZAwkQlaKp1GrgmZGydQ3gOMort/Gv33Tnj28f5abDoIoGqVeYCrb8uijm8ysO" +
+		"walOmn5OLE91HNCQw7DvW4vZQU2PbYRPDbQQ3DRRoWNx4c0TlZdKlKFSf8Fm4WZKguvQh0PYEkKu" +
+		"hgIvgOo6ey/80Y4SRIOEEFGSpJpsAHPmiD0I4kAIBTfbCcEpXY4Yq6g4We62lAwsdRJdGYfHdUh9" +
+		"UtwnWwpu/KDfJXu8yWsUJtYF2+SJXtls25XnVmVIhp2Cjg1Vift5mFTH+B1xQCruXktXzEuCQXXw" +
+		"OMJFwWrG9qBAJfx7CkW7UMC3f1V2cQhO5mT7yJe4HN2j1Ek0MkfLpUCcQ73fR33uZ0I4TPhtiBLH" +
+		"v7D7RtFGi0kDrRivp0vURHpNXXAhXdAbYtOBD1KCU53UDJlVwxhZeG5WaXlpw8XGD9APOqsYxN8V" +
+		"w8J15bYoC6N07IKkV7XFvqtUUtDgVra6EGIEFqkUrjND3MUsKUS6YGYxgFvB9zkhYUA4Nlb3W5Kd" +
+		"nYOEI0kVj0dN5RFTcoCJoX5rtyQWPz8ArCzBoDowHftbwud9C7LH+vWQy2GimgQn87hMMifUK51t" +
+		"3zLpaRJRSHHtBQ+1TQ6r0haFWeu2cWvQ9RI3yTbINgcbc92CZB0E9F9nVio2k3isxpj7DJrZ9eLS" +
+		"KC3WxdskysI3vspxqPPAjE/+QMZ90pacxR0x6hL7gktK7C5lh0uhdTvRZfv4llLcHba0YJoOVnDD" +
+		"i7Mlb2eFr3hTAdsxsS/YjOmKwxYQnJoR9lYWeF/6ozKERip/HZjuYk5X4uuak+nAF5scp9kLVNMd" +
+		"Xvylg+I/aFB4T7Tke0kU3OR7SSWGSeZFWZo4wFQlmVQDd01kDaNVj/mzOa0p+WMHBJpKNSnBiPWO" +
+		"PCcoaKs+NUALP63tnsKjNT/ZFEPiR+AmIwJnNr15PEJ8Bd1IjUWD/VELX8g2TRaEp97nhAwGFlMy" +
+		"+lrZS7+ShJI/NRyIi4EqR8+M98xVMs/A1lSgSRvCF54cm3E5V0zuTqjb4XBImMz2VKUZvR6TYBxD" +
+		"2bmllS2iPxzi7shYPoni6OZTJsHILzAY7QtuaVHoLdUkDPnck+Ayp2R9Gpu1eaUtyyTGlxQXbH3B" +
+		"uC/K9PHpIVVZMCxcV16SL8XZADIU7KCqP61EgGO79MeC90VJ8qHArz+EByGwLxj3RaF7yeLgprhP" +
+		"srF1aNatgB92nkdUhf3MbX7drOhzFeKgvvaOoXJ0uOeyEDxLySa3ZIQonDztKY0BFfchHM1cml3n" +
+		"mHYZn20StgtPckBqJHp2f1hX6Q86onefPDMDtrRgulwZrmX865m0YEVaZTXZCJToHDpcFc7Qis3Q" +
+		"SsDprPhMDYMZBoiCvJlB1uCmzxMS1BVzXr55XkpOzCjz/QwjCQvXlRd3z4RPJSoiE2ht17VKIxo5" +
+		"ZpPqgouDYekONLETk8xYT72ALvlnSxM0VkvAeafUh2STiORG8PtfpVQ8/0+ASUMEWrTWiW1kraDV" +
+		"e41/0SAgVKtuJB6iwzMe5aVntxf28Is7GGtV5YXT14t5vKRTaEJzGgqfci/2kDs++zZCWDm4Vf/k" +
+		"pSO7abXH0ZR4uFRbFqjL1y+hiENwqpOaoxNqW2d5iGkm6NlL1WeRHWFGGj3d11sMFKzdTX93GsAD" +
+		"ogY8na7QTSa3qI9uhKCRMdXhRKfyJ9EKrhgrTlSVZ6kab6f2jKrNwUOo8nttBdf3tex7UZkRxt2k" +
+		"NUGhYxMKmputgOhb4CNEI78e+iEWQjNCxq9H3CizGNwB89U3064ve7O84OvdKlRtaIjGlsCQVrt3" +
+		"BRvYat/Mo1LYZaizxYtZWSV83ajUfsdoCwhO0ybTz8kyuW/3Uichnp86NaJxuRSsdn+XZB6XScs+" +
+		"NjEZDOjczOfkPRLgyGIobYs7hap6iDfOX5tNXxtmr9r78H2BgtvQhd8XKPgUtYoGGXtxgA3Bbduf" +
+		"DjAxGgOLvYtf9KX6AsThMFBXdfRnTwp+3AR32koXxOrhoifC/sDH/AgSHFFlMNylUli/cLZ4Mev3" +
+		"yZXOqbRFs7ylcgY/cBo5UBtaSp7SFGNelIsAg4bxGyGUJBRU0fXZgezLLcU/3KJkpRHTtSh5xkG+" +
+		"sZAGCE4eadm+Ny8UuKVFcfdSJ9HI7MWr9Ubu+rE4FVDRPzq/C1l62Ss6FQmpUMAYYJ8yKUxsjqx6" +
+		"GXu6T6LDrp9UN3JjdTATvu7mGBZeXLwwVVr/UOhnad3X/abPPLt1fhmKEJzc0/PRZ2oVxKCc/bEg" +
+		"01DoG3gENWnQsZ2d29i5fd22TY/4zsNdxwOMBjgFnXj6ckj4n+j/+uDYCHm9cPKHVAkidWUJq2YZ" +
+		"UW2ulHPyQpjgT2VG6U+qTmaE3Lb8XNDMrIRVzUv2+vAEi4feyqmn3xOPK6msJhmtNw8J6XLk0Xja" +
+		"mfvEGVtv5RXcyRuiUjCOotftNeklYVGYHTT4R2bDw+u0D58U3iEsZ2vbc3NAFGtiVbHCPcn1ZT2N" +
+		"37Ydklur0EAsvT+s+P7w8jtPMmd/8Kv5joyn+NHHpIzErd17Sn+gQnoA8eViyOGsQ5E+kFnevM9O" +
+		"QGxFCEXDKAjvReokRkHkO4Xu1zGB0Xck8Zm/gg8l82NYmNf81Ux/f7cq+n3M19fxun8dY4bVvo7+" +
+		"dfT7+Jev4/w6znGZdu4R788B2P316uLE7blxZpJK2TpgFHnuT/wmIiE41UnN0ckSw3CNkIVqOLIZ" +
+		"71SbrJTNZAekCxK7+sRK9clFapUP2YIr9EHh+gr1CKl+p+1lshG6STXcTz27E9T00ukIpBpqKYdJ" +
+		"NbSkowbBTFjUdJxmVEZ41X9KS3GI39+YTQ7n8W7xiXeLz/FuMcWdEi5JKAJlNWnxlJZQ1R4RDmWY" +
+		"8a1nFWrS6esQlQLWSJ07524/E6l0N2kGyCQUUMf+gAcugiHV8HMeL5PD8Gd7yNjvX8f5TOeTYsSk" +
+		"TU8kkJpxugmfEQeOYvRd5BFKoGyQqGvaO0JGdzerm2gAHPd0dECejAj8KBRBTXr9pSJdBLbRmGie" +
+		"KW0PCthQeBLdTLyWqgKx8b5lBzf17SdfUFBlvC8KyQwxoTp19nom3d+px70kTCFUOVPHXYVV04W3" +
+		"YQjxwAsN5hFi2+MrRTw9NSzf2y7dsvveDhyryvym2qL8E2et0iOeNh2Uti5eISqWLIn+YpCirdwu" +
+		"ilebH4VWXN+g/cfAsrPPQ1eNka+tdpaujyJWY3FoTn8nSVi4rry4r0m5G4cSd53+L0pbtM4dvaif" +
+		"0W+ZKhc+purbEmcN46xgXOq3pPRU16wmvv7xr17qP6rlcdaXrgsMpW57tPZuWJ8EFutVXX82ycgL" +
+		"6fipJMUzyKRptLNaGlcPfPlGFaltRfOJfTti/lAYhU8+nKNPHToDUBEoq8lGMDme0LqDUW98yEJF" +
+		"o7xUooGPY7mYhybByTwuk5anGnSM1PPPSJt1sMsc7DIHu8y7mQqaOO8PvUFN0LigdxMg02WkUZoE" +
+		"ZwM7b2GAz3GP+uE4I+ttrl5TSQvDx38S3rgvSHe2CkCCI6th9+E4hNmy4OmLfyeW5c6qtyZVSDBw" +
+		"SzUXaQuJMQobhK5FShdZbU8NcChVmz3VJ4bDap9NOPWnI86qU6F6nJVCgoFbkO1xWvEgM7Imxwm3" +
+		"qtdCamv71ikkOVgMZBKKG666lE3qVSBIjJgMVWm7blTjvT4C9jZ4xuOpEb0SwYUILjxO/oQfJAzn" +
+		"QaHqQwOk0IUXFYhCAlwMnPZbemezS4GTy01ne3CaBpDguMYjhdY47gi3ZFIcmhE31JY9QYyNW3aw" +
+		"SCzaGenntYYy1zBda/1DaVNjVrCg7rxUUFD7UwO5U8AmKZ367fPJjOgUmL40/01LxXoRYIhCUSnN" +
+		"jBp7725PftjbCMbylwL96Vm3bhIOWlpCF1YLu0pDD27sv+w6jfVoVr41SdN81NQ3/egXITg1o1gm" +
+		"zfhY6JDvJmmo8EHJfPT19JfGT961O/lY82mPNZ98rPn0R5bPhu/8nNzB2pvmb4+eXOBQCf0u2WRT" +
+		"yJ0CaheKQEkzambPHhG25GhuOmFAJsgCZwB7bhFvU5/MFluFqZlDcGpGLDwmLz6mWWrkk3NGMGIV" +
+		"h0ATcsmRZeCJjQHlnID6xmyCybSmrL3tMgW3oBTfiyQzQqL2veT9oSs+Z0e7Y/o5BHccf7H6PI/z" +
+		"pBiOv+OFgfP3F/1gXNmhWX9/k1AESppRmd/f62uc5q85OFzL0HDZwHBJwifKr7xpMLDsW8VvohDH" +
+		"6HqVV9wgdgpU+apxCxQjdT2iPvBBUFN9jFAX91QMo/7rD4Zf/qATqJwrpmtRsPkXn4a6cPvusieh" +
+		"rnkP7/KnoC4+AzXknCxBk+BkHpdJbciBet/h6g8N4k6h5fXlbpIp0ia3vvIS8Ssrh1VZvVCHbnep" +
+		"Ll2Guv6WY8Pj6/krbxuC9pW32/i/SVAx0ubtVm4INX4Eu6QkJtXwKAhVqYJw7IS8hVgRDmXEfH9r" +
+		"IBWi9a+8jXL0kl9FpWgqUYu7Kj+bZv74ytvoMhqOiPitgWLS4FbHSdxJEKUl4FnWrM+E5G2MPxqm" +
+		"C7JCaL3iWzqj+4h+agb4LWeV2pvydogGOnZmfe9Nw1peDi8UeuAOgoHmcwjFS4c1J7gxHOtfBmpC" +
+		"flmHpCFHFrncSjepZvuI4USYu+yPbL3twzDyGdV+bemgkEBAmYNGopdooPjoj81kkqwxY5oPoS7c" +
+		"8FdJUrKDml4o4SWVAtZIHXVU2QGox0vrOuLqrkFsbRyxjn3BuLj0ZnyCSpJXuxzNuTRhfIsEj9Ix" +
+		"gcTbHabJpDBxeqP6FemfGmjZFbtfZTBoBCaqXhTIo98yyYylOsBUfiiYvy7wEtBaFcu8oHQR2M+r" +
+		"3tXOW/15bMmkGoZjU1vDGQngDaOKOASnZoRSmw6+KjUGF2mAUT/McCAbDUjAGU7Wn2Lt44wzEebh" +
+		"1rWOfX98Zasa5yUG6EDGfeW4f2qsq1vaVHqUVVvcfj/Lpb44/8rKYVVWL7QXtKUa5qJ3dvNH3j8x" +
+		"IOu3CcS8Z23frKo2R5dKgVpwogJSf+6Mbjujc2d03xkd+eklMiUbGNgXjPuiNOfZYFBlwbDwkoLV" +
+		"PKs1zSCr0VnZSmdlVpbUa+b1YkX0G9cQ2GJQc2O6jFjKqU9uQWpck42ii0NwakaoumFiWpxLAYvN" +
+		"CsPsxQAOPaazOWgBb3XSvT+WMjVUO2Ijxd0uB6gkIfUJwcnSsObAkhfckimlTtJ0VuZbCxuB/jcN" +
+		"dMHToH1l+b+h45ckuw5Ak0f09tJAUR3r7VIhAQJG3W38tMGQCU5oS9kqw0epAorBAA/wLZyuVbMs" +
+		"NP8umQJJ+OILCG6oUGeF+kNDZNmZ1+1MG4VQVkjtEoR0OTLdLq3hYRrndDkb4VOeC4dVaYuCqkOL" +
+		"S0w5J2uuTw1uWw4O6vFE3k+p+G7NwohnCU99X98gODWjxU8vlpRjFgeYEPYoJtXAr/IrHVvepa7c" +
+		"pkKf1yOmuK28fyhMga/BT7TCJli0DnKy961SsOag4TRU3W4JFOPWGKXuQ9F7lZDadYT3JkF3lUko" +
+		"AiU+qgxuJiekyzFP+89ojMkyuT6BVt5YVcj6UZsRjszsFoxTWqwsY6TFuCIcVYRjitiIIjaeECzu" +
+		"LQ7BKV2OVoKd6EAzy7eO9kTtu/ckezcpwQjNb5iuRbEyEr+lQ4Qt3kUc3KTTGWKfxGzjXb/K5KjW" +
+		"0qOPyNSSGPaFwsTmyHqBS155OpW6oJor7nEMKgjjyD7eHwhvpTpIcMS0Y1Gaa6gIOFmHpXo50mhZ" +
+		"eiK160NwWVIrmWKYj62XIA5qkmZSj7yyp621uC+4pUVBXyh7SSXzB+4/1P6PijxLpjBVf1aNXfND" +
+		"V4dwHTotFXuvIwuu649J6qjZcq6qICY12gel8npcXRwQmbZ2OLgJ4055VdHx5iW5PxQe8kPRVNbY" +
+		"xSDuThpZOuqq0B3i7qhu/czYzho0wGhVbbSq2ieqjhFVm6riEK58vM4pXZOzx0Aw07dl+o7ugT5T" +
+		"3xh86tuGnvqeA08dkw0kbThwGo+U1pNQBMoG6fE872BzRxb4SeYhS3ma1Eh02CGO0jrxp9Q08taV" +
+		"od5lQU2kAWqFSbhwxi2dleyoZGclOyvpvzKiSFeO1SolGC2RIyUm6CrucXPaSdp+Z91af0iO26rs" +
+		"HwryYtcBuAng612rkq6ptr7yTESXTGE5ZHo0MbCC9GcaSv5Q1qjKHK2ypa9V8ZqXXrfcjshd8WlQ" +
+		"F60wY3m1pIA2Pt2NbdztIsiwL+ieGO7Pvl4CmSoLhoXrym1RFrZK+V7tuB4aEHNxoMmKRdPp8tgI" +
+		"D8yO32P2bUftO45AD7oxpvzcolCo6VZqNqmGvRxSHdQkCSEUjcRB+vO94fPIA/W2YdbfyhGTmkI3" +
+		"6CddPxIcNIJH/Q+CjedeEoy3cpmEYd9ad5C64IwHBdmS1AVh/k5be0xCFds91uRAUy3naxKMD9tK" +
+		"EIyRxXDU+2nJRghD+GHW9tMwY/tpr+0ldZJFQ1l92kSb+03VHGtk81XZgtQFG1mSA02lHA4w4aMx" +
+		"TjCO389IURYFe2VWZtbjp1R7ANNVeGuoVewlU6iT7rC/I+av9tO/uDKO2x7zV4638a+TKxWBspps" +
+		"BEo9lOJtaLtoMGobd849o15exF33VdzxeQUF9XoIQrU9NdAS96ckihKk5JUbFcpKYekw4ijBw/Qk" +
+		"FDBjxZoQnDzSkqmhdD3M9KFWDYd1rOLH7++xId9YE1GpW/qt23e/bwhrMGmzPmqXEU0UvyqkUsCa" +
+		"hCJQVpMWT4mqj1nqmEVWCK3W/cFw314OjZRKf4gr523STcKiWPaq0R8fO55o+XzrpGDQvfzkSYx+" +
+		"bD/PSTRGMWmGfN+SrMwI/fUckm+aojkUqyta+tGPrcqk5hgbmstStscWZEE3x9oXNPOr9ElmxMHt" +
+		"6GZ0dUOaezxY3s9Ym9VDfWpimmz1K8cHfneX0B3i7jiyHU1/3KKGSSjY/w4e1ENqDodeasXjdiJa" +
+		"lVoCxTDmvRyvUl4Lqlk0eMVsElaEXSQ7TFN/LEhzFIf+mETjqFD+syFUW2aCrFFIAvdy0+l3HFXF" +
+		"rZWIWysqmspInYfsuKWCpuHNlfh6JaGAeRSjW1zfWx4VaRK/chyp9QwU+wv7VqUEpzqpGaIMRbXh" +
+		"zgdkMLAYOtudj9jrvA2rGtNGS1IQjvuTZyoNseUu2UEvDZTTtUvVnae9pv9gi36g/h3/WqO/mrc+" +
+		"KJnLVy638a8dq/BUUXhmKHZmKDdJuifsm1i5MAEecwIIAe1f0DOLZYX+OSTPgESm0jzxkQMF1Qfv" +
+		"/aw6RTZKl7E4DMdR8aCO+pWqzK9UZXyQBUL9NO+gJhg0wHpk0e8OQQyzJA0URzDu4lIM9VF+vnKJ" +
+		"TRAGCMwijdI1OXsMvuYxkWaIyCj06QHMJHoG2LSIa6QS24g/toAw7pDD9ZC88/mxVdGoiIZVmS5S" +
+		"F4fgZO7owsSSF5web1nQzaVOolHE5DDk8b/d6taQQ95urdSb5P6hqOu2b0FfcFXe5dW3vCPRHgNk" +
+		"t2x2q0feQojTHh7iRYU/224lhT9nw9NDqs3fpqDv3UTeatzyh9KoUd6r4MuZQ0t7eZTESvOnAAwP" +
+		"Kzwl0Z/5Hfx6yVZ9w1xD3q3Fe/Za9keSzmS9Sw6e7OyF7/0N7b3F5EtwJd+2hkTjAMc10eRGZazr" +
+		"VdZvURHNn7MZWIWfthzKOFTJ+5ZKRTH7qFbVMauwgvtjS9sH9wXTRaXexfNLgl9UXRgZppg9oqQk" +
+		"u+/GvRwUt61P2vKn0kw7W48zqf4e/JY+NfM9Yu9b+lBYa/sc/czoJTyATLEdM1T+CIRp1+I5fz8B" +
+		"BpbtH52fWqkfijnaF6YXrR0fWv/UPhJ2bk9usp+zyvYle9f7do+2c0vuMZ+LVuPtXCoLvdTLdX3Z" +
+		"y5s5jyvyuQ/Kyz7wp2qtZTZHrcUO5L1KiNiUGm18c25UuveSerWu7ygj9akvwlheZ+slsEJhi6hq" +
+		"EG10VixIim88sTU1+h320/iqzZGSGcprq53fBoFeZbdl7qG3vcZXJ68tHaRv+8O61ce7JNRTaRjw" +
+		"VavRiv7HwtYPdTu8ojVmnJkMsWXVuqPmOtpg2Rb5/havndgibcn4adlPZorjhU4lo4ftfmxL7rwt" +
+		"R4bTf2d8b4lj0NTg1trwZL7t3B8OzMcXdkqWt2Xy9vR/98eW5/Aif/d0Wn+Sv6OmoNi68AClwohX" +
+		"KtOfimb9ve36eKOi9ddvDEDfW7eYbndUS/6WasPjd9xtlxHpn7bjsMp+p/LDi+WhnKO87qrts++i" +
+		"s6P8XUV+zXbmJdP5GX/qdcucEeXH9rvVwFrpy/n4BcWhnZjKZXz3njs35qUF4+jZMg6ATvXkh35V" +
+		"047DvCO3zfql6p3fmKbOG1pD6aJD/Lmlf/Vmhvq5a9X0LdV2o+qjI7CdY+7lr/3wtupvn43EWv32" +
+		"ccmxtVPN9kV8VOLPGaKdKZLcDXCPv+QUcUN/UJfKzUq8mVfysd2zncYPnRfwBHXIcZM6qQFZqgIn" +
+		"FsqI9p8hVYW/xukM+3wSbHKDYsfznMfl9aZdyfpNLWxOPo95Ti63P3M0KLeEpyQuaHojic1vmtaq" +
+		"7Pv5WsaUoVffU+X72xvWTwUfKop7bXVModLKjYrENMmMXV+lyf0f9TPa9roa2pZXpuflLpfN5Yhw" +
+		"kDrOpLblVC2N1Nh8PB9XtiVPQvrH1ax9jTHLeKWzmRQnXIsArZQSGz7ZpUqaZ8FXSclPza9iA9Cr" +
+		"bvvYftqrrL37VXl+4qHzqiXMM9Srlm/MfLf0r97MEPsk33jVzGWsDM2z/uu8sZO/zrw0GF+DUwqx" +
+		"zJl/3XLAvK/KYpVdX6vb0qfWPlRrjip7ufMFKqpv/jwM1LNWdw0ix4z6jikt2pnD1MZNcTZzlSw/" +
+		"M2b9BQGrIn5WZOX4GdVcOWdE84si0yyLcR6ezdGXufrUkOXj6g9eZ9V4x6VV29Kmvyc1eLwVciZT" +
+		"In4XZYdWrGWabM22puERERxEjZ+JVbxXe3RiaLmJgfVT43S5ZlvR9IxMYhu0J+ub4kvlUTizATVD" +
+		"q2WxfuQ/SgDe7c75qrVV/V2dbftfsUY7O7mCZH2LdsbXRUVdWlauXeqCdNf1qAEFW6KrSrxMHHvT" +
+		"K6vTRNwCLbmduz7PxCY6X69S+4KW6Kx3b+Lzo27X8dr6A/fjSu5bXY+7vv11lP3hl15dsr6JN/Ah" +
+		"25z09UfMVtP+qPNJsoLzrsI4hmff1xuU3/OAU32M4OjLvcZ3NE/+GH4u+cx4ExqII/dsUk1qq74l" +
+		"l4PH4FvqDUnffnp7x5Ls9APuC6IPvmM9PcKGlHdZ58bvkrYemWXh3lVoJDbBzyN2cdBIv71d8hUl" +
+		"2QzyV2r5yqVgXavg0SiVDRJ+5alBVKenRo2ns4u2R8lhg9L1qUCFQ6ExHCleg+v4/z/hzUM0bD22" +
+		"RLFfhNid7D4vNZkUJjZHVLgePLb1h5LeSKRO/YEQ71kqjupMkrpyulYtr0qbyg+OukWzSMus4gYe" +
+		"2TL1DGuennlmXTOekIBC6yxsKQi3hI2krpyuVcursqRespW5DfovGty2u0lYb0kfyjOCccd1HQAm" +
+		"+ATsBsA0/f/Jet8sWV3f+/d5T+Q3lTsNW1JVvBvBD2D18Yz+LrJ3AnW+a3WTV0L4IyKiotWMsOOa" +
+		"PLfbJOI0fGmAdGjVxhbBQ2R+0CwX3vG0G5683+m3OzmFaZ1rOkj7JHac1mtMJhvgOkyqYbiNMviq" +
+		"IHZwn/fEbCrS7STYN9yd1rNWIjCmzo6uijgEJ/fERnDmjfWqhUtVVcKxm5vna3dXda1/wa9WQdLK" +
+		"FJqfYOEOQR3gLkm6SZjPLZg0gz5cKayKrI+lTdW6W4lDWvQLa4lK1yfIKqwlgTcRH31b2FKZkTrL" +
+		"sN83AO7eLK40akz/QFcCeBzhprC8KZgjh+z5JkLx/EvV3xIA4aRBgt9v7C//tZhcuFQJcjhfz61T" +
+		"qJo1UMRDS0gJTh6HHBV5ZaHFvzcNREM16FXPWGPWu0xiFOYvADN5352KR4lDcPJIVOmtH0yiZBwF" +
+		"D6tBt8q8UcCcNfu4m1Br5CJQo2H8Hf9bwKjyq4PML5WX1OH8i6cvv/0rlz/cNfpKKET70ieK11e+" +
+		"MBtWESjbV76+x/+39hqVrQMyhfp83yPU07g+LbdLX5VdHIKTOaGTEUsGRoodsqRocDGdeeouGaAR" +
+		"QTSoGsKg1Q1PQRggNAovHF5B7/Re+tlwCI3V0e6yS/PxZaJOMaJjY2sBhulHNAgaqgH5p6TBcnBe" +
+		"9qsKRo10m7SUqERKw+H41kCT4W6HCteRZhBu5l28ETKkbtrxbS7jmLyO72x+GtokUmm/Ccmk+XRx" +
+		"CE4eyRL8qtTRPUqdRGPcTW5pUmIVfKngorSpmdfVHJASr27nC79aGPcFF3PrK2tE+8E+AwzT2JC8" +
+		"x0Khpsy72kqjQN3EE1eekMNQmyAMEFxSOvDYYphEY6VA3hWTcZW3yWPLjkKEE1q/trSCRuH5JmQw" +
+		"qA7uY4AMhjbap+9bE5Nq3OuGB/6Oan7hhTsCXF/lauKgpkO2bpKGJ4WqWF8AqYYaNwqqOtATaDrc" +
+		"dkwjeyFpSyune2roB1OZya8VlyS2wBjqW7dQ1faSlBzUhEMF6/dUwBqpc0z1d+kuLv699J06vMAP" +
+		"ESiryUag1GQ3ViuoaF/5TiUjHMpxvhBKN0lzocAvCSy8fyjmTdm+ylf5f3sq+89XGa9tle0x/sdJ" +
+		"GyLdBN24sj1G8DP+JY9Q1ev4KttIPLpnwStbZavIFnL4VQTHliEFEismSGprMjxbHGEf//v2IybV" +
+		"qG79pYEaDtnSkO2rfH/p0o4x+E8a9nDp0Ajo4pCmDVv5LSHqxTGpO6ir8Pzh1AzVMcX2Y1Jjmrrq" +
+		"m1flWxCMhvrGN5GHHH4PfIVnUpg445GCXPLKwymOf4WuAZAfbCaOGvz3hRvZFBII80Tn+honzfme" +
+		"hDr9x7drSDOLghtwq3ZPzXN8y6Tp8LZ9Qm0i2nNwqQ4WrfKqTzQoKUyc8awyuOSVh1NCDski04yJ" +
+		"zD1FyxzkkcgaWPKC7lH0fT9XiMzUsrQMPTu8cTpwfLKCclrYYMoGaCw8mOmyYFi4Tbayuk0GhvJe" +
+		"4GqT0j3ZCi/IoOQn1P9dw8lmsU5psTJpEQ0UY+sUQ9X/XbKYTLdRNWidhKtfYzhedcv6csyi9A+l" +
+		"UROTwSBZFixLz7cGlgmaTcmLvyqLH492pRmVa8V0LwobYqgw1zePIKJbMQwZNuc+aTpzPzpvadU8" +
+		"sV4OrpplYEVJMKgO5u8J2RK2JfrMhmCW6WIVs4cqC6d7ap7wLZOmgw8V1BRjiJtmXpK0XSaFiXm1" +
+		"5z65ObKuJUkXh+BkjjxuWt/2JA4a20UXCzhtaTKr6MqSABgzzlxKXSZh63uVE7PoVfknzhpH76I7" +
+		"SHC0uiuXvDKPman2f9S26ovylpXTvWofFRrUt5gp2N8HSiCgfoMO7KWBmq6Oa0fKYGAxTFYvdjOl" +
+		"t0xK92TWqV9NKBB7tTWqUmoz4KORlMPjHbGLVAYDi0F1lLQ6NpbOkXQZR/etxryZbF/42dSSHba0" +
+		"YLpdkeCoBZI1B32otqUF1Zy0ESGDgcUwk3RZ6aBm+NakV+oU2mFI09bV/zr1zW1Sd7DIziLmE/NV" +
+		"SfeiMkmUYFAdPI7AdDc35YJnrUzLx/ETG9n9h0G2jPBRS+4LMvJZ4C5bjjskfktM6qfWVvXT9aai" +
+		"18eK6pxKpxjq6wu/o4Qfy9VwmPv2LNnklowQBTULBYxvoaBaKFSVjlCV4otCVwVR5+VSM9IHnxBQ" +
+		"0Q1Uti/96V39nQ+nYQzjX9/RpFTj2Lygt4JKCDytBr3DM1Y9a4jxAFJjcfSEMIJRiKbBgRbsOAvz" +
+		"MNMvn5VQDulSHUZEUVX7SCh1PLFHJgX3aQaYYYR3a3KbbF9Fgn78tIjtTcn6XrBMGl7XuwpF+yqP" +
+		"r/LQ/5Hy8fiWrZscsY9RmcdDsgnXpTo0kvZGJVqamDSDHrtG6Z6MtjENiBIqi6yWfdVbFUoUNosg" +
+		"a7avLQeTslC6J1uhcReKF37OdVXMw6xuoIxbcgjlcJYmzjpCkdPt6DnkPsmNXSaFiTOeDaJc6oLm" +
+		"Uq6FvFzvifhwOwTU9hoixaxFp7IFk+LENAM1EV79M1ATAukUplqF26uU7tBIVQzMLQaYTrfop5kN" +
+		"NGEf95S+ymPk1yUjlErZaMYP9pdHJzyTaBA0rAhh11DLe44aPfHrVUM2hCaG/voqr2P882AmjJhj" +
+		"BOWrvNpXiV8l/oz/wWn871umUJO+a+agplG3mB7jYbWDRuhpJ+LXZIbEvoyoc0wHcj5Ehcbor/9S" +
+		"quH+KjH/aKDFYC3BkCM6dwxxhPZVfrYzIlTl1kCT/Gz3MNVNg/ZVRm1TGIXjQEoYTFOIglB9tEkT" +
+		"j78U9Ikt5TAMV7HfgxiI4YagpqLBsdUnvpdSdM+lsU6UgwH5/irjgrfuL4e4L7ilqUhznoQM+LuX" +
+		"+oj/Hx2u9lOXxprsLQjbVzm+yvF9BdyRAskgeW4Ih48+WoRQVR3ywLjXkk2qAY1z2OdGSWjSI/I6" +
+		"h6CmrgFQR5QhdS8eOX5fzaSAzoJFus4dWKVNuyqI0DVLu0yG+a0HNqEZlWvFdC8Kt6B9lfxVNMO8" +
+		"l6fkCU2JN/snuvlW0DWBRZPx7JJ5Qs1+xZKXixXkK/r10JJljBRfxeZYWVszoylV6DGVMVTmVHRB" +
+		"lEEjIakWVI6tl7NI3FZlX5Sumn6jEkIaAdm0vYrkAdIRjngOnPiJoJJbwofJnNQHw2buRYOn5FvB" +
+		"vrpAHkZtHnSBrCsiSr7/aDBMWBhTymv8jx5UUDcd5Mq4YVzKOfCvaBA0VIOOWkO0rlKT/b2/yvlV" +
+		"zk1/B6Do7OXc5mWycVdsI9R5nIpAmW4CdqNipTS9dYAWe44cNLlkDfSt/gE0SqVokIy1mYQjC5T8" +
+		"kq12RrGIjHPqKbr7T8nujFAfa+uCW4R6CTogB5MNQNHFZDCwmLg7YHpx+l2102+pLUt9F57eJXt2" +
+		"b5lkxlIdYJLeNwc1jUC/9XnE3dEP00+9fRhGJTDfOTnDOfW4OnFBPgRsTw1dlmySzRJ5hJ46xz5L" +
+		"a1MO8+hsZ8noUQA1l9qvLJPS7axTN+e+ctw/NTanW2bWvJJwDW5NKAIlXHCvSqGLQ7odzY071lDN" +
+		"mKBASnByG/OudiOM+JYF070oecmw1Ekwlm8s4lg43a5p44/I0WzYQ2i0jnHnnI3GX5U0aCCIY6NI" +
+		"JpnkwM4BNKPuEPcF11TdnP/a0TEVjxKH4FSd3A0t2uNxHSYRRzuz55G3HHb8BQnA9NbE5ZBeuSnG" +
+		"M0JTD/VKHCgHdJOau+4UjOT1q1TcI1TRVOKiuY5K4bMvRR/dl7rlp1BsYSGLQ4dQxEQLeBNouPqW" +
+		"J6mxC8IAAZtut41RPg5xwFGBruKYblPEHGNxMJPJ4fw9/qNtsD6sGuGWTDaABMpqkhGoIx5w1e8R" +
+		"7OP/pedHSDW+pPW6TUoTl/guK4dVWb1QqGslf2pwjYFC1RGELWYKCQRkFfxepqI664NMFVArQqTk" +
+		"FTeBDpjjgtgTgi6xVKlqzFsyaQbtpUZmrHHBdDtavlFfVwJ0cbA4Noqhmr0kfaqC/+eWyxcmn3Vs" +
+		"z1N75nPL4YbUb5c7NWDcTbLPKVtkOxzcUYcgUHcwkw00i7Klf9R0fxg8qTgEpzpp+jlhxz03y+Pv" +
+		"ppK19jqzxhGvGQ5CFqMeURLCpiJ3ii05YDudmytOXSaFiTMeFSWXvLI5eUKxDjmUSeqHWziQatDY" +
+		"53ZElTFTbMlBz8LkNLEZdZkUJs54VuE5uyK41AXNXaVe2apQtSFRw7YeJV1fpeaNBylgSxNZCjQJ" +
+		"jqgIuCl1oUg3gcdUlqozcUDrpBmr/nH5CYJFbf1Ta4s60pV+5WCSUx5TRvz52jKFXpAYNaIEAjZo" +
+		"0IiqkaE69lcJJfe4LbyvvDq1/qF41B+HODP9c4N4M9sRle8v3DCP+4fCvuMGaYvGmFNC3Bdsk1tf" +
+		"eUT8LV+l7TFhLYBRmNgctZmMS155OpW64Gq+yaXtekm0KOp2WQ0ur8Dl5V+z+GuWfs3CtZc1PBlp" +
+		"+o3L0o7Soqr6nmRpZ5WbYpjbsh5EFQkGjL4pUHKX3PTtcccbaEeK89U+FPqVrXZ+yFLVUxf5GMV9" +
+		"8uLApGep3Jiu9w9VqFo3/XIo6a9MChPd8y83pcb9ZXLE6qsTGuLQ7HiKM4Qu0y3+zcixMT3qtUTX" +
+		"qUvHxKV3LHWCbF9lvPMnvcukYRzF4o7BCMcXe8ulp9ALJ+qrjn+FJkmvB6/WNZAAUSkQpRt0aV++" +
+		"hh/uPVy8YXv1rT4vcQhOHokMuv7eOmUD4OsWE8PCi4tlEANFmBa46bp5AzO17iDB0ZIOpqcOcgP+" +
+		"ULDwKtuPA0xXTF44mRG1dQc17azAbgXpZ2gdJEycVua9l0NMIrJGg3KaPCU4WkKy+xLuIcLGhgiR" +
+		"gu9BLvwR0RaFBYSCkHHFzRnZlFJNNgeUXrdfk5aqbr/ZAO5V1An10J7Y+VlVJf08WLn6Y9vFZDBo" +
+		"BOaun2ajRBTuvROkLjjjSTiCAJZfxO23QYx0lRVWqpM82rJIW/5xkOC4xiNRKr8mYbCjuj/QTx/l" +
+		"DzOwDvsof5jPsyCE/VncbF3jyXZ+1uLSnGr5zQbNoL8mwXhJmyBh4rRajlemyOaX84xT/xea9MUm" +
+		"fc02wyJiA41ljG1M2tCf05bxIMIx3YuSWeHEAzP5wJDmuJDsaE3orWn7NZ9fd/mlB3O/PeJGhHQK" +
+		"qDGLyWBgMZZUb9hABgP6vM2nlB+TiLKCjy3L9Za6soRV+8exQen7y0HCxBlt6UpAPN8/cFqt9IWi" +
+		"WZw8Sk87Sk87Sk8/Ss+t42LEEQ74DMCCEhbFEkNDimSlDTgsT+6lIZnD3GGn7TF8N99AgqM5lmj1" +
+		"4lA0JA1Xda+rWp/ES/+yYFh4cbG0OLeeF3O9bEg5Lz8SKjewWnNWa87qzVl5dh4yGOh8yTHdppjV" +
+		"02aKH5OWh49Q1QaoimJk218OEiY2R0sZOeQqIBrfAHUyI8a5imGjzsGicrBAsJcqDsHJI5moSUom" +
+		"FxPccKQ2Hqn6+54ObrJUr2KxnAkpSHCcjlZHfYgDWR08ivBXTMLwE2t3gOnYaneQ4GglHpt5Fpzi" +
+		"WrnqLpMWY3O01Kfk4LAamxEKP4su3DdK92QbXNtZZQuTPLehwMPeBVyYOdk839ESWDQP4UEzh5te" +
+		"VTieESWsyurkaWs8HU73jz6dUkVT9g3hj5hU87t0MRkMLIaZ/HJy+ovv4ZLUyaYzv/ypGiLj4vPl" +
+		"IMHRHfUXEwbh3DletKQ0Myv1O8+mvzxo8LgKUoJTndQMZ8ofs419NK5rr7+lfZX3Nv6TBqpimdeb" +
+		"9wPfetPvrRefto7YVw2/JWvwHWtwgP2spTyUoOsPAxY9zel3Ri5+ym/ROpT9FeUtK4dVqR9KW7VV" +
+		"0e1WzbLtnk9fIqMX1Zn8KSbN8PSHMm/9uXmTjD+++b6RavnP5MreqnyYtHrwRAhkis5Cepc86/br" +
+		"MhjQ/9ey8OuxyWFV2qJ4Gu3AhNXIg5oak8ZgMrhvDBbZxYGmpDPRQbjAU0oG5cdBps0zxixJif3m" +
+		"u9RshMn0wHEZN8mTD6U5mm8NkheUsCgz6dAs7W1yRt+I27dDCPbAHErs+DDEpxr+0dunbtnvW5XH" +
+		"lcitO0yH1i3tNXsdPiS5YFh4cfFs8IKWkYTJi8sVJsnCHy7MvWzdwUzWQrgOBnnacpySm3X4qYZ/" +
+		"9Pap/5/0GJGGIT9iEDsGTLVa2Ud8vMWsB+6zB+5LD9y9B/p3FaGU3zBJFvaUQ0HSIHvxY9qU8KF9" +
+		"OFoW88ty0N6SyrmyhFWbyVRlljxeQ6Gwooq7Fx5QobQJwcn8myeo26NPaoa/Dovnb3YyR2kLSVh4" +
+		"sc8sxPK1cVCJrte3yW/LiR9/J5sfksr25JlD2FHx+28kSyfWeeWw7yZAO194KXEqf+VTC5/qp6/n" +
+		"n/vravaEZxhaj4f1EFPCh9ZWzTOiys4vf3YbgYFh4TbZk/+RuscmH0r40Nqqren6pCXJR9ZLvaRa" +
+		"i/45S5MFw8KLy8znxMc+oXSd5TrKqswkHdPet9QHPR6ygDkOpltMycE8I681yPRM7I8PPHQF2YkI" +
+		"aOby62B5puIHx8PPjLw/pHSbdLfCLR6/aabwlCx1nl2mGv7R26duOarBBsBn3f7KpDBxxntCOxaf" +
+		"FfdbgW77nY62abz/ROovRawCJ8jEZvR0iAtbdONZ9rVdycGzudJMc3GnvfRyhuCm6uCRPv15+YTn" +
+		"ZSPFS/yYfC1jxcsHi9dFU8zhSk9ZOayKnZxM7avWFsXK+K/cJpnTf+VeIpHmxzpx2myGg7tqALNk" +
+		"s5wm7TyS+IvN5EawKYXehYGcXiw7yfYARDHJmLIFB5noWRTbJXhjVKH8OHiSeVJOflJOpQYHmlhJ" +
+		"r+OxZZvjDZxmPCVxlLAon17I+ShYNj1QH9EQuKE5crdmHw7yHA3myqip2Oh/2l7CFzmxJkbV1hzE" +
+		"8DZpdTxtJ5y2h+xGGdEdfXed5eSTxEUJH9qHo+dAleP6qT9L7eQ5/M4GNCXdU2XWdbNTDzAsvLh4" +
+		"TlX2mSDuC4WJzXGmi7lPms65f7hYSt7zmxxWZfWayUuwNyahdtn7yhJWbUnXZV57UkUJ/7s2fmVm" +
+		"aHpXT2UXh+DUjCxn3tIzkrBwm7y690mL+0eOvoWmzKy64SPawVZjsGGo6i00QjPyrOPJ2GJuV5oQ" +
+		"nJqRp7yyybzY4MdwO073b5yjNtlM0kvEpPsKK99EqoO7G6TkMFPaZGF+w/NDyzYDbvKHm9deWyi/" +
+		"C37YPWfVmnGVSWHijJ/pijhYdCl90uJZunvQwcezNsezFo8zycRlmcD/MbUPm4RP9SP2/lC8VjbX" +
+		"aUnknOTxQ2mGJytrdxn8K6ZL/cwk/6j/pJD2qXuBp81D5+1WUzwJRx27m+o4vatPR1rfHjZXbd2O" +
+		"8tb9MFc8PE/T2lQnegF9ux1mPvcab6msWbv4dEB5+noL93jMk4dr4VP99J252A0HJU+03HBQxVLz" +
+		"dvBkCR/ah9/Mocbd2qlebr1mC1/fba/xWz61fyLbh+q5X9/dOv51nsn7M5Q2lXvBmbqd9g7g2y6i" +
+		"cEeakJ2akSXu2x+TEpzM7Y+7vfQ9MqMPM51f895Zf9msvr9qWWim89m8IrOIhziYqYpDcPJIz5At" +
+		"0+3GQ8drPkqc+XW75OuX53DV7GD5X9UH/sHwvDhLuGxOcdmE4vLZxHtL1xwPXGuuyqQwccZ7RlF+" +
+		"HRD9K/NGIpmbrPf70e6/L0nHJAmTPS2VdE+VJcRsk1eghEXx9KoxhT4rIJi3PRgg07PaPQSlaXTX" +
+		"GrvdNPmtxZ1nn/orW0IXj0E/NQGphnPjtWfRSW/BvWPOeW1c8RHFx5I5iswJKGYJnBhcx9fovb/y" +
+		"hZOn3htHJ/5N4186wmFOusRAxWjc3zSMefxr0iwVYaN4xXMQcsvta6ze+POdSglDll+Ew77rQz4V" +
+		"Q5X8Vf489CpFxbBFPOSApAFb9EfnSX8wSfoTg5+ylMVkMKgO5u8Jtap/+hYTxYi4t136nSSPrO6n" +
+		"ZAptdKMwsTkiO7LW6D5K3SiH4cbl1M3rpb9f5W/J8nV+nf/f17l9ndt3uo4hLshdNAga4ufCnJoi" +
+		"DFQY4ldUJ8J8j/B1B6nHpAZsWw51W1kjIrKJ0rrKR9xN6upRY91aKBIMqkMzMmiHQXfwrFunG/PX" +
+		"0wKXUZxjad0ItqeYDAbVoRkZWFo609UcpVNANeeYn1iSsypwYbjpz8pNVLNmhqzGFowS9cfohkAT" +
+		"4KHQaamDfrwbsppsBEpLUPYfk0xTrMKKcNdyE32T+6bpm8y3CkJVRiBbRqhKShvFUJ8ax7cIFNQq" +
+		"Gmy5m6y3EeK/y20ShqBhRQiThli8bhCcPBLV94Xrp+3aZwkbxfB9fentgnMc2SN4XC6bgoZZAy0m" +
+		"ZroMid1AbN35NtJrk5Pvpwz5Ez0RsDmbS7I0/D1AQ8+LIacyC9MZ9cCPs4PKHwdpRH0Ld6LZuZGd" +
+		"lcS3xUl0sVK7ruxRotDT4rlFTVuRVaV7NW9kgzW+5xZbktvkiPlvOzaKoY5NSBjeEgeutIWYTcKQ" +
+		"x3uvk2DEx7lByaSdBFyVBcPCbTK2QBVamcicouUfOdoNzO7mPVPZjTS9pZpkBbg6jQivv38jZRlS" +
+		"M4G7PNg8CuotqISwyyTxb+asyhoF/5KirgpX5tvTrf+jtlVHOuSLCPr3LibV/qhym1RDPEq+AWdj" +
+		"KiLic6jlkAUXM+rIRmLHSrFtQRxoGn1cj4M0uoueK1P6lq1KXVCd2Z5JOgWskftRITh5JGuRoncs" +
+		"xbcsSGemDtg9KQbbQbYpqaBWMKqX1vhgHQ5U4JDei4M6HziPDom2PJjlEU1vGG6VkAZhL6dJGNRL" +
+		"P7bgoBGnHVCnfjNPoYvJYEBfa5jT2+WM3fxj9xSxexr7zSznJZ1eTpw8AhumD0NCvxEyca9sjV6t" +
+		"OTrfAFIc3sep48txSqWQQEAug+DxQpcAiNSVp8egUd287bKZbICXqDw2Cpg7z5okNeqnWSmDgcWg" +
+		"Ynl/1YKvOA+lshQFcVyie+xR7XrqyzjzZfwmqoGZSsZBkoNUCgkGbql0Z1PlQItWXZ53jfskxCSE" +
+		"yEzY4QF0QEcF0AShobrDdTzdSjIpTKwLLr4TWS7qFznhsd+MB92QmgYn/ZzRn1TCTveCuxQgTVgq" +
+		"d7NCM0KBZzzhjYBJtffqaTv3LRV0dPuZnJI/FEZprfpLML8C9Ulxn9yIJTuYqZqEIQoFVWyijjS5" +
+		"lyMeMilMbI5xn5ifk1tfmf4Q1UqraAQo7YrdAab7VRrKv1PMrPr9u1nmwOF7jv9Ngx25c4aN2cS5" +
+		"VZ5NQUUJbvdGoaoWckr9xsUEqRnWn0lm1FVZxNuBsXuK5yQahf5WGHbGKfUZa5pEo/5CYV15iUCb" +
+		"+wB28uP3ShS8ZbKwRRTbHK12xMikUgwY1TqFJi3YLSrVcLLNT/Wu8UczUtNdI8Q11LEZei1ZeV5R" +
+		"2QAlbQ5u0v5Vt136dpS0Mlz219VlUpg449E25NZXhlMQikBZTVo8ZXweDhxuoVg8SwqxbbrWiYo4" +
+		"0LH8MTktMwFYB5e6jQ+ZKTxT9AKeJZtEep3STZIw2dMMBd4/It0BJvwcpRONafvjIGaT5CDB0YpR" +
+		"bsfKM2F51u341GYk6bZWSneLRtbYSmb8Kw7BySO9QopszUP2uDldBzHEfdKWVm5TMexSJ7mxWh74" +
+		"/cwobVVZfLlyn7S7tTogVS5xc9gneWxwMJP+5NbAkzv3tH17vqTmLTHH81W3JguGhdtka78zyTNu" +
+		"E/cF6b7+nOO/Brq0e3/Nq4tPwz8uaJT/XRs8Edu26F2s7XxmpErs4tCM4j5pSyun2zX20saBpdmg" +
+		"wt+1E89l6nDor8gDsL/uajuDTJdayjlJ6sqLC/E+X8zmu8RkUtzCrR2oKXYchLsdgrsfgDsPpP2F" +
+		"kPG43OdKagV9GOaksQFnWZUwYDMDP0DveBMlGFQHS8iCAocoTQ1/eRYeLKyC5I5bRUqJQOfcX8KO" +
+		"CY4r/5UPJXxoH46sj+S5Z4239Kml2/RSLMeZHLlWhFAYmkQNH1vsJtUQtxcFVOG2xuPql6yIeJTI" +
+		"6XLFlW2NvHMzIFrDGyOC8zn9avqJSUvVc3L9wdGEOXDlDLj+6KKmk5Oa+jN+SIkSBlWxMF6B+o1s" +
+		"uEIGpAlwg2cIeoh7CD3iZv2PiBcm/tHbh+FeNMSUSkEVs4wjPuOWdYPL/sKtGhKPeSjYzSXg0lKh" +
+		"EVp3oIm+tiEFh0Ph8VeSmM48kklL8Oduh8OWHNXtf5d0kxKMmHRgvY3Un0dSjXsMW3KUSXCL5mc6" +
+		"cqylUzC+9BkDzxshzWrDWaPJTkFjgKgQMMZxWVmuTyXdrpKYOftLy/E0iZQYflrJHB5AFqdSN6Tj" +
+		"GlplNqgOzciABXPru9QqDvB5ScZnGXEs84afSh5WfutPqX35z0HnvvIS0WXlsCqrl1WOWsmLVuqH" +
+		"0j602zWv7R7PJAtaAjcmsSsYaOagDxQn+pji+r1qf9d6wiKfWvhUP/L6O7d46GnNenHssjIjLGPT" +
+		"LScOmvg9boNp0ieSqsyK92gnNLDnZXeiFN8yCUbWl0PxkMwl23WZYnOwssmIGE/uh6wxPGUSIhFe" +
+		"ddaWYzR3gx2mb8mXyZFG/3F6a5zLtv0F/bUhVCMCnuQVbsBTTDYDFG0I8zeqD4AJCbUpWpNEwThe" +
+		"05JopKV+3wSzN7PAEA+I/DTJ2qJxVKK3GM54XUxeP7VmKi+elQlvMWl5vO0mu7IBDgglPxigyaQw" +
+		"0dP9tc34kdskIn2ibkhzqd0Bpt9Sg4Oa1KNvCGERhHa4N4xArWPXdO6aLjWbpIHiWg5zqrJgWLiu" +
+		"vCRfs+Jm94hbpQDERxqYT3QzNyumJA6MO8QkDbaTuhVUKsWWHJpRl0lmhBxXcACrh46zmBe0flUe" +
+		"Iv2qLoMBJ1bK5m/1GbmM7ek6U++cqXe2Qt9f2H6FRseYbgI3r9uEvu+vcqUI4q0dEv00pWiAWz0q" +
+		"3aBemJJ1m3x3n/d2fIERANf60Gvb7R9NqONaEIBZl3FfOe6rNtOysJpjNyoNF97O8NZQm9Dm9R/T" +
+		"+P56RN7aNkQKHuz9VZ6SHeLuyFj7ZtiqpHuqra88E9EFBoQYOVWqAd+WBnSTUp2aG9NthIR5o6CK" +
+		"XApC2FqTGmVBNRedz/Qqbd19NfKCkbSlhbt8KEuCuCoL3sAdM3pSMwwyicYxQdzS5MXOvEo3GfdJ" +
+		"tidUa4chUpdEgTm6EntzLfpJeZIYWTr8oJ6zxZvAEU9iGjseQW7FGEkKE+uCi+9EryiYm1myedxH" +
+		"3Bf0hoBKL6sxkyFg4+ojiM7jnJ2uc+rSu81cOs8HKj0uL5GNAMnMbdTQY/facQxfeX9RSCNgjAO1" +
+		"TlRn3DIZAjtW6a9MChObI8q9eI8FK3QuTEK4QufivEFP7G+NeQuvRQFNScOYcL4DwITUnGC+U3lv" +
+		"Jofhd/xrrr/w/E0aaFzW4LsWXJwYNmcmQUv/ZlPh0F7ldFATizu33/bFYLTdzXnczccYtz3EuPeX" +
+		"7D8OagpIEjAk4YL9FkEIU0WoCqp061tJlGrn60BOMKJZb2vVe/wsCwRUPQ/d5eoUaj1fJYuDmsah" +
+		"a1INCPB0RaUafvHgXyUNVb7OvX+d4euUbfzvghBtRLpButCHsNhwbA/lR0T75eQlQptG5mo8x+bs" +
+		"LjRh3qIvm2hIOw4L2eyoGITq6MItSHV9jMWZlGp4SaZQVb31do946Uj5oxlq4oT4xHhklK9OoarW" +
+		"pyaE8K+J1azJqlktj4pMKloRLYBAL48h622EqFcpXeqCalb3zqK6lTRGFPnGPvz+Ro2+8TBpSFTj" +
+		"G3t23zLCEbnvW4gplQWF9hqdNNmPBpo1FgaqUBe25f6jd+VwLOkNEdn1BDWEFsr5JkEdeClLCE4e" +
+		"ybxtoS6x1El0jVt14BW6a7cz2uDKEZsVtmfRu/hGW1o5uc8lk5ohYpECrR6s44QtFQq4J4jcKeLu" +
+		"4OWwFwR2Aqx7FK57FK57FFv3KFz3KLbuUYJUvdohNCfNVVrfkkMj4U6jo1/WLBb51MKn2j5Uqwh0" +
+		"jYsbHgcZscC4zIVMA+7bt0ka9HKCEJw8koUqtr4gPJ5VxCE4IRLDMkFN/N0uJxjxO1tO0xi3gVfe" +
+		"kziM2KFpaYLqyY8G23cpCoj7sUhNkjRADHa2cF/zkYbYihHRS1TRAQ7Dm5wafGvuJ/LA+GxDs5wv" +
+		"vVNLgInZntDaq/w6qEkzwHUdJKzIv+JkoJIRzI/P3oUla/AWhAECNvq/MXaoTLcRTzbyi9b8ZUa/" +
+		"sVMMdaR/8kqeoGZdPitPrAsQbbT4zCVWh2H6z75Nb/iWBdO9KMP9RzRQjFma0VOaTFJjGYa0YXhJ" +
+		"jxFEjL4qh09K27NuCtIpJBBQb67Ek5SuPWrUAdeDdlWYytIg5o2S35Ea6nUcrIX+64iQl4WhUMQh" +
+		"ODUjFJK3xFF20K1Sr+AgNQLq/sKwB0BETBQoYOdTPSNzUqkeHDqzDY76sr8K2sXszChAu/AgROk6" +
+		"HNQkvW7fEfewqSUx7AuFiZ6Qbe2c7qmVvPKSRDtXftTIrQKpw1PX8EKqIe66LFoR1Yq5XWmbVCci" +
+		"BUNUMnbbAbGzkRTilhbmed7V25U0UT1+cnwIAQdDxscqDNR0oG8ddltZ8oGelrkDs+3AjGbNrMDY" +
+		"yEZCy2Y0Y2YL5huhLeKZzOjfUvtroN+KcGzGmodqjXup2S+KkyU41UmehHVrtl8b96ndFV1+7PBk" +
+		"q+Pnew1gx6oVwpYcLVaXhhjRaOclQ5q7rsVceUaUvCDMfFfDSI2X3neUfCV+OmZhOBzfdXMQJ0Ti" +
+		"F2Kc0j3ZGuLSjqXV4T0D4a0NLMgTvbkhRZ/QqAiUDRLtfo5/nNxOnNyG2EvNCzayuzHtKfWIuS9o" +
+		"niXHSWY0eauMLI1z7vPkXUclZK+Oamp62J6Y5J1YKjFkEJPDMDKsW3hLxv1S/e6bTYidw6q0RUGx" +
+		"rpX8qa2upX4oGrVvSUzCIPHNA0F5QnByRxa+o4urxF41bMYxyYJuJpw9epHKt3PJC25pUWbatyy4" +
+		"pPUjUNVrpr442dY7fCPkOYuLQ4SLQ8QWh0jd48lbk8bY4mI7ChQmNkfLoswdtBffH0S4X3xi44gB" +
+		"bNGmH7Y6RMszXHbmqvKsfPVicliVtiisnmklf2rTdWbH9MfZi/6iiyvAjFuXRmmxImHrteiaSKkP" +
+		"2btJN2zNSIJTdWqeIvqJm2oS5/x0LHlBvXm2aP1Ta4vqnLwI61GDARGLJEDlWpHJYoDkUxWjMLE5" +
+		"WrXnhNTZnHbL4zBp3dfYPA8v45A6yaNnYQds1yEmg0F1sO28PI9Bt5GZLNcr77N/UCNbL3/q/S5I" +
+		"Tf7adOyscd9qiNuCabLO4Gp8iphEmpekuDmhhIh0OJNUm2DWyAkhAFXERLNGO8OSYMx4gWqQbJSs" +
+		"RwkUcXfY0oLpXpTmzI4b9WdLPAF/3GQqrX8oFgV5bh2PeCYz4iV1Swume1GmT5TJ5sEnq8ZxYSsL" +
+		"PybiBI/2orBxiGix3AftJdXB4wxsB+ntCWmT42SvRb+TTIJDL1m25HgdC7oH3gaRGn/j06QZ8k+S" +
+		"BdX8H8/N//Heg0J1cB+DOAnb8x/mPuMppGC1mdQfTCeGxE7+4cHyY9MKLkgTfWQpuuxMcNgf26MW" +
+		"pDq2bKcm4O044zmEgTWzQ+ZljyrY6kO2PiE4WRoboRRxjHGwsANff4zWcgPfziUv2JzfsuDiPk/h" +
+		"R+wUplrt+BnjUziKHVefVbv6HDuPi1t2WdLLtuuyLDK/pTEx3YtitVmG53V0xvv2BsHJI1mKXqFy" +
+		"RdGqcW9MQ/pHb1DtytQwLLy4eGnzepRKqStbEk6RQOmePB26LBgWXlxmsdda7GVbl+SPSQmTkscy" +
+		"syR/LKuBvNFARZ3/d0WsdnZUjyY7dzUoTJzxzBpc8srTqdQFadaPMe/iykJh4nS2guIjcXbc4nGU" +
+		"PAm+HOmHlOBkJSn3BdNtihfAs0PTl7rQQo3llLyZ5KE50OG5UDNMDt721O7Jf2XlsCpLir9WR/4A" +
+		"nuF0mXmabflawqe+OEhYeNrvSbPgLLZBcDz5pbSFLeIPZNw3Hw2mlu5F765cK89E88gbijgEJ/e0" +
+		"ql5b8J10bWFCcKqTmqPTzMgGXGJzfsuC6V4UG+K0r/UtZpMSjJh/x4v4Ujlfspl5j3m2myvpnmo3" +
+		"tu6t2CfRmcKrdNVvk96srrgLK3rVb6voVb8Z2ziPuH6EAmrbkkkahCJQ0sws3xpw57xt37xtL7x9" +
+		"J7y9sd9LW78/mvottYlDuh1XB24ucZq7uXeTrAu/nUtEghE26RuFqpyGNJuGNE5Dmk9Dmk48WtFA" +
+		"Dc1uGQ7SyKY/3AvAJRaoT4r7yhwHqGte6ovO1LjyQuzbmSC4ccmMEY0PfINWObFjGU5zn4SLitaT" +
+		"UARKeCcrVrdbazgS9+377uKgZs2o8/Efn6t1PIvofBLR+aS625NqbKdtpm8lN7JHPKVRCQMK5TnO" +
+		"lunKXKVrWBecrszdlu5Kr5IooMbHZpfKvdrQCjKH2yQzq2V/ycGrnUWDfwklYYbjzAi6p61fB1Gc" +
+		"7K6tc3NFy1cVfbz3uJetT0Ic+nO3J+O9x0d5OjwlLFxXbqtyT4Wbqwp9EqN5hNgUtHdcBamkj4YX" +
+		"XxYBdQf4XDluJkd2v+NfBKEadPbLZ136TO4X/eeX/eUuXb7Ox9f5kJzj02T7Op9f52uTXrLJNuAp" +
+		"CKGUXbu0kRoTX7M1mkajPyZpSZFih7zUnPvWDoftWWrc/lHbp679yQwT6VUOk2qoW3mZhEFfNSWJ" +
+		"OCDy2Ha5etw/lC39o376uhZnJtpHHd0810Yueuv/qB/utytnkbh9au4Jrzs/ZUuOzQiXs8q6U5og" +
+		"DBC6nIjQQBDaaV/biLWFKC9ff/KSrAtysmL53vhz2qpRHBSSt0lpwXS7srTNNCzRecFGpuz3ObZI" +
+		"ajlKXkijf7/O1+h5Opy/cJPxFRM/32AkYeHVXhd3tAcVs/dXLWfcPzVu2bTIp3vr/6gf0UzaJcV9" +
+		"YusrN1fgPfpByXH/UOAUT6ufEo0Nq2AdF7OeoF8xlSA51JI/FPgte4tK6ytPL1SvtIKv60xuU4n7" +
+		"ylv61NKShTUklL/yoYQPrX5qn+k+NNuvqqKwzuSdCRG8EKKgxu1pti28O/VK8s0jLsnzoMDr747c" +
+		"qlREHcb77a/yHTeKBrlTqCrfQgE1xz8mYdHdWbLsOrUwYhQth5ik/Yi7AyvFI6dk3DskzMjO9TmT" +
+		"pzvptvIwF3ixZ5a85GPOkb56Xn7xiR9kB+As+uIs5VVy4ev7jnFfFPguPXQq6Z5q6yvPRHBhyBKL" +
+		"qN5OrO42akR9E7ILXj+BpTpI2zHdXPX+j5puN8TdUSes5Ekw9oLwuxzfkyRMZt2hMM0uKU0yYzmj" +
+		"hIXryqvT5HuSl2N5SJK9czumZq3fi+Rn1fXEixI+tPqptQ/1Q5uFm8roR8x+RJZuryo4ztp4XyJK" +
+		"WJX6obRFi/uH8k+Gs2Dvkt0/JDbYHf4rl7/z8aH3f3XmwicQjjRnCqom/JPgUPvWJ8V9cpvYJSyK" +
+		"V7Td/jr5qv6Vf/Xwr+HfFP9k6fXodio1tjYZZ3j7FueqI6O66fX5i8ffEHi/zrF9+acbVNpxzmcZ" +
+		"/vkGgppsAfCrLqtFFqVNbWR1pw3h3vncxBTEjm4HGtcyr1sdWtwptuSg1+zkNNESYOwlubH1SWbU" +
+		"BwkgbMlARhaKZy4Ha0vtNrbOOpSEJbCTt/SppdWz9Q+lTc28zNTHA6dzJnD904HJ/neJQ/s649cZ" +
+		"txxbi0dZsJG7SRjgUh6ldlmQkWkzSYMK3G6AVMPfvxvFUL/HRa3JYdjH/6ZBKNWkxmxZi923Kk23" +
+		"I+7bfWWFsdP1+ijue0nFpKb70WD7QyHBoBF013JnR6y7ViHJoZEoO4Wl6JamTxfPtcMS6YOzsvUo" +
+		"SHgkoQiUNJvjWbxoIOIRXicF1HuEGc2Zfduyb16em5NnXTCliHvpCFXRwEd5R5qrXhYYpXsyHPTB" +
+		"HmQw8Bjbj8bpXjXMWlRH/bBWO3KttsoG6fEjg/CMmWJEjyTyvaVgUo27IAwQh173DWIs3mg0kroy" +
+		"XFCm7PVq3UGjNE9Ga8KK0iqLqyylWh4K6XZErIYadI36u8wL4uOhbfF4cNv1X0qmwCJkx7Bwm8yk" +
+		"WB0WcetmCAy/kbdp4vOJ7Xg+zc5OzFs3Q7aXyXQbcQc+n7Dw7SAjCZMtG1U0zfjhJwmT0j3Z8k3S" +
+		"KVTFKnNI3r6GIsFI/U7JFKoi+InZJAyd26oL++Oz/caUBvQtuhwOqPqPaBA0rAibCobj5yImqREp" +
+		"0/ZA2FRwwEw+YqbxA5DBQU2igRoFhuukUPWBPB5cU64kwaA6mDNr8qDlWeNhkhkQPVqBydhhtXlS" +
+		"YoKELysDqkMzMmAeeiNThaWiq35YGJJp6GAJsZ9VIl7vA0PSgI+SkMx2m7SMyi/FrrMgRyb4tTx+" +
+		"ZwKLwnthjjBrqCNpwldPhuTCUEc6MUuMuKOjFpNqiGaJbjo10GyPU2qWNAkOyPM4k1AESkTjXSqA" +
+		"HszHqcfYodXP4397lCoOat76RqEqPgAd8y6VQs16Ts9Y7aeSVlQp71fD96wcNV6Lku0800KI0XzI" +
+		"v6UEB5hGvfNDNnxv0lEjtUT0RX6+kP01j8HEJAwYNH2UxBV15IU0n8vFzKrzsYDCjNF0PxogFsNB" +
+		"/mEeP3JrGT/RBFIwB7zeFfMPx7j8UxBC0TBjqTOBpg4LyszM1eqVtYHK/kI61J91xa/wG0hwZFpl" +
+		"eFZMpgBqQsDrCiMYe42nTAoTNV6L1E7NHs1CdCDLGMfy70skOUhwZN2U1fNGj72LiWEtWaSalGCE" +
+		"xIrqdTHU7u+LUPSI1i1A5c9Rqjioh+aOVczcrlN6F5MwcCbBrnjGjlAjmTWOt9Nc2LAngv9d+lal" +
+		"0TD+79p0GZJCN5lgEYQBAu7IuOp97ljHd2pNNoUuFIGSZlymGKWbjNzKM6porx/AxY0nhYnNEWmH" +
+		"3vbNp4/tiFVMaiQCzQMfalFBKwSzaiVWkxrRx2usZRKMghBKTBRYX6yImJIoqGaKofbxv2lwbt2k" +
+		"xmg76oc1RvidJAcBS6CsJukqlA/0D5KEydhAKvR2m74DoiAKgn6sMt1G7Mv9gYFapRq0phwBu42A" +
+		"He8Bq9ThquMDIENaTi/VI1ZQAGBBzTHy9KhvNkMyPjGDaD86QLSMtamtpTVAW3c82SMwAgJFsk/1" +
+		"y7+CSdYyENDltnn2W0/M76IPXIbUrN52Jn7jTDymHn90BPqDceaPjtp/UAguZXkl+/fv9vevStEH" +
+		"cARE6cIap/J1/nydP8+v86d/nT+/99eZvs604bYzpDhphYAN0MVkMLAYd8b0V0mnOvh9gxF+F5NI" +
+		"xEzkVZI+0JnM+B8d9Y0kLFxXXtytGqrQjtm5EY25bw5p0owlsSArAyIGCt3JSonA8nGmT/xZFUg1" +
+		"POOGrzw4Lua+4DT/lQXDwnXl1X9hVuiJd7/Spq86qwiUcL7oGLG3sIUROz5mhHzTT3FMI6V9KDou" +
+		"rLpQR0VjlsbUaKyYcQAmrDZS4XnM1K2wZrmd5ceszKnHx2NSM3zLJCsMLRqRkD04Wr+NiNXM86b1" +
+		"zYIwQOAzEEbwFjpWqhSdYqu6on1yc+UmwfLE2l4QUrNe+Qcha/HjZiT86TjYslXTquR18oyydUm0" +
+		"NYvp3LkKjODmdz+UiYxmSZ0ldSvJmlKBpqSXp2lj4/8Pne9/7HTthRA5tpdZmVE79BBtLGlIvgec" +
+		"7Fd4jeqkZmi5dLfF3SSPW8W/smBYuE32vPSmlAK3RZsR42KX7TIpwYhJBzYAo/Q8RWBMku5AU6ag" +
+		"CoFXRAgSHK2sB977SD4s95jRE3vsV5BJzTHmwgriid+Akk2ykFKsP/XCDsGK2mZxZ3Vr9e5VuBGe" +
+		"V3Ng8beKK8RuEobmbwFBSeKkNgQb7fe2d5MSjFj6jR+jSvahGAJNMSUHmvQhBMFMt0kYWAg2+Ob2" +
+		"3g/BBb0jzVcyycr711bSduNz+ASailQHmvBb6hOn+ZwEIy/3jWC0xkg81dzJTjT3wU6sAFN5PEzC" +
+		"cEo2CUMt5XCAieH+KiUtCHO3QxtEYzxQ8m/Fm7+OGv93QzgU2TQICMVkNQkfTlwA6XZkLARDjIMA" +
+		"qRPbNKfbkfsLCiZ8aX7fZaBQBEp6WI3arFHzGjV7v8/5NnajZXhVjhtgz8AKQLU73bub1fFbg2/N" +
+		"/VsQ6iMpApyQ6Xdse+yyIMwa7r1ulNfhoFH6RU8VgZJmViVv1m4ZZ0HJ8Szry+ofFnpw0CLBidcd" +
+		"gyLbJPusfzAEKphZvZJ1zRpBTf1VsDWXC4GMTbP4g2L+8DCK/nZN4rVIirgoHrJTaCX0yiThhjp+" +
+		"SoWv1KtA5PPFvWQ9Ph6tpOSgptxfFEMtQYMgJqtJxPIAAMCkoU4mCicTBfeHIBnNdJhdlFODE968" +
+		"RZCKji8FS58h1dA1AGLLCs8Fxc4Fxc4F5Y2IN+2/GiDRL11+MRMsv5gHYhgtv/gpayc13hoMvHRo" +
+		"v3itcPHRF6B1UroJPJovu2q4cD649BlWutSEJxND0ueJW3UE9deHPiqganhooLek03V8I0Rex7dU" +
+		"k/D9ZuYKNEEgOdOhuIMbddg2HZb6YMRhEYe4ZBYHHw8Ckd+hm3ucCOl4Mq/T8jot3Znob+2n923x" +
+		"0Y10ITOs0IOU4DRttRky2xzMhibLbHgsVYW0eEpL+YOQ/j9uVjd/E9lRzXVLFLgYA/VJcZ9sKXiP" +
+		"gXwb2bdVqMmkMNHTcS554WVNXUqbOLLojxaOEJs8JsQdEM0S3YS9YI+6Bqmhl71yPasp094nxX0y" +
+		"ffHjWOl6650xzZ8zhht3vo+vM8vF9XBGuDk3tJLj9nUW/cLbCCVQVpONQKmZ60MT/jhnweWyCszA" +
+		"HBvZ3XTIN7JYy1E6P2dirKOUPbq2HwAt+8+hvxlAkOC4xiPFCEd82LfWTapRx2EbdQu+ddX6gs34" +
+		"VrgOCrWPQA4NFNtw0laQzmsfI43v0lwKKO4UW3JINxEuFepQnrUcFEON4/+ZcQYDdQfNJeYdvzFg" +
+		"pMn0lnvcFoS5I/zGtavd2YdkdtioyEvHAdEs6N9KCYem82rvlk6bPOaOW3xG6Z6cPYZC45ogDBAa" +
+		"1ZhbK5mC0Xj1gtBI0xljtmK5FkIt8FZa+RENgoYVIewIb4SqoDV4zuKXMss4KZU0/reKkMNRsddL" +
+		"y/p5eijiEJwsCQYiPZuVJNsfl8GgEcxx37pJRMFT16FCbmlSuo2x7wybMYBh36qDmuIuFIFSZwQg" +
+		"MTJXfsbW2OKjOXAT4n5T2E3HRaHrzW9aTkYEcygIj1sSlgoPDaK9KFhlDOQEcxEDy7B/X7VMQnQX" +
+		"CrRi7FyTolgpWzMvNDB+CwhyS5MsDy5Ac2zOs9dAlQXDwksKr77ddQUXB/c1sNRam58NIbL/2ZiF" +
+		"/mqLTn9LSnqlDgmr3vyBhAGpE3Z34qdRjMLE5shykn8ShVzqguZO198yAVEMuSuSDc4pXR3DJ8mM" +
+		"YjIYVAf3MbByL6ugtmnJG0YtUgPibIIKdalP4cFmjAh8H5mgJt0J97bXO21HDAL14DoFMp1ywHJm" +
+		"Mqx7ijnuC8JBrFFANPYXfkp+KM/tYB2JzdgKeW4HynjqdQWBcSWb3JIRo7BOzEjCZDYpFHi/JNQt" +
+		"OZa8IDwO/dY6gSZu2yE17k7z6IEqC4aF22Sr0GFNdJSKdcnG1hi5HHFLC8J8IuTyfCJa7azlnM19" +
+		"wp+heCO3flfzaXdK23fcFyXJh4I0/KHOlRnBH+s07AvGfVHcnSVfuW39qlv/Vw3/6Eh4VemvLcuH" +
+		"gqh3zHf6OnVuc+jruyoCZYNEux98hYigcfKsG8cPZ404JFEgs4OLAJTgEek52v84NdhCueokCZMb" +
+		"MBeTMKDmJ2Zlx1nwAvCgq5lMbuHpQJ2zniozzpR4Ulny/ioUUFFnzPKyOqIJ7BKt2BVa8Qu04tdn" +
+		"SqgHKd2TWRdrSr0vWXQnYecghydKeYpGPJmvDqg5YmRXCQOc0bB8ylPsGY8CT3GG6Z5Kl5XDqiyp" +
+		"Oc5m3OaG1GjN6WbsjVV3hBFfxr9e4xRe4xRe0hS7pCkhCYXa4fXQQA0PDJIq1aDZMTfLRMecQiVp" +
+		"AIdkNtx/Jah3YogRHaCmUwOkP5lehwY98RX8omsp6AGlYkZRKm8jGyFBgit3u/avkf2JD/WVUzRQ" +
+		"8/PKFKrG/N92O6gJ09kTy+7KmaImwC0iSLWfG8JQDgdGQOimnSeSnZZKOgVU9CfeyyrneSPEG9xo" +
+		"j/PCE2uCmapJHJXnx29BmSoLhoXbZJZsn4BR6uIQnCxNnymWwvg8bjAGXoXuwLhyTeDuqrvgia4R" +
+		"jC+EAi3q0Vv36/wgjVRdK1phwNrcUp9jezhe6FU1Vi+rUAde2NQfJrArHP38EfpO1VGx5vkKz6K0" +
+		"RYv7h8IBwAzKNoJy4FpGqnq+7srZsrK62WVh9SvD6heHdV4fVv5sVdGfmJM6SR11g7rfI1ZO4gCf" +
+		"7Skmg4HFsJS+JQpE7FdKcUUmYAaSXU6LZYWP0Cp0k2wUYroXxVqpY7lEwWdAVNh44GzxkA9eIJFg" +
+		"xB1TAky4TlBpFqniQBNrj15f7WsIoCUS3omdqyfrVT1F3xJu37FlPKWdjPi6xe4Q2wdPj0kXa1u3" +
+		"2+SWJpnjzQpXPnsDIhbhGGS2r7Noq6LXNd0QrDnD706XJlelUPWlAfxwl7Dgx04KO2mLsKF7NK5z" +
+		"UCjZYUuO7mdNC26ktzik25HNC8UJAxKxL0iPajWonr8KBIKQSnMpwcltrKliyQtOj7csmO5FyUvm" +
+		"Vv3BpU6CEY+ho6zaTU7iYNEs5Too1K7twOMeX+ovrX9vT5Nw0jXnkMiuY2kWgaYSxMFMiT8rpEpu" +
+		"JW/ZSgk4wQCCUzNiK/ZQdpPNgL/pUJqlRJ/rUmNZqBmiXTgetP6sW7iseFMQ9cLvtA26eFFiiAbs" +
+		"r/vM+A2kgh9KUwFH/lqNEY05XPrLBInq0yTi0xXEASbcNRqy/jhIcLQ87D7wIG+EY5tbiltOKsu5" +
+		"4atDqtYYohUSW6/sda5YRUqQmhfkUeRa/9QsVe1yLAhz3qx8ft2ytF5OsU+Ul9ZPXeGlUDt6auec" +
+		"HRCcsHxuUZprTtZMZ91ysPZHbNtrPPuCNMvWSl4QZpsNkcLEGW+FzRmRM52sz2KUtt+pA5iL51K9" +
+		"f/xu2K92M1EJ+3X0yLERy4ndzut+Wu+6oGmIhrsyfeuFAg7fkvBM2NCtmqH+DnadpLFYGlZ46u76" +
+		"hpFMog8+eWRkg5Wr91TSwtOny4Jh4cUFLWZfqCt8AmOPX7ikF5KGSvFt0uxCT+6Fzsld97uyfd6S" +
+		"tflD5+tkgOrgPgaWJx4gqdQo9BXrJh1PiyHVcEahgFquWuMkNSJ4SQ0ONJVuUg0c6jtbE0OG/eqY" +
+		"Aovp3ggcSzreOy783JHKZrIDeO7qtk90B186ib94UXpxMn/ZRen10OAhFBqpl6NX4ho6IxpxCx4Q" +
+		"nDyS2SY8XLpwF+LibYiL9yEuu/OgK5dGiIa5OE+9bJYKQBJtU53x8Mi1w9UPVsyDrq4BXNDEdkfV" +
+		"bqNeujveUrWJfsc/etiv3ff49Zscv+xxQ6o3HHXZYZ/UgEgsFYcBIDGZTZN/l1nyrxdjP59ilO7J" +
+		"2WNU/KLKv1geocBMfnVJhM4XdK5wfp3n8XWe9Uv/tr3H3QcrU5MsPO1pEoeOqS5eawr38RwlOHmk" +
+		"1hV4HZMs3r5qtigaJb1Uk3ryBGqcfY/FaEsrp3tq070dE/uC6hFrFJMwNKEIlGbWn6DgwTcNNxRu" +
+		"K97VgVQ3LRubnNFamW2Fl9cgLZ6SmWV7PbduNDyZ9GkeT6T4QUgFUxQjNTYp4T4idoM2QheEAaJS" +
+		"NEgIvL1BgIkFo/F6T2IyGFQH9zFg4t+MEGn4638VP/+nl1S8dKpYXqlCjzOjRB+UcDP9PTT8xJwK" +
+		"CQbVwVzEIHokxldgfpIw/an8XboqWyjJvwvzoSLDAwcZIDh5pOVcdWcuGBb2QqG2yZa8tfhE88k+" +
+		"+k2atFrpDqU54pbIUGr8xHQvCnu6YMnDkHwm5agr9FyDswQxGQzYWop9kicwsPrK2U2WOqkZAmLe" +
+		"uwNNvOujeDWHNG2+VfGMu0xyI57hTV4iuqwcViXdq7amsY2iVvKnNl3L9cGWI41NTAYD95C5UU2q" +
+		"gVu4+2yQUmI1cFFECE7NyFKky5OU2bRlbdzy2bxltz1Rnjn6dkN5o1C/bh2c+bskk8OqtEWxWpXM" +
+		"Hw351Mw1LMVSkfChzoxCXFKWH5Pmz3UYQHhdtZU6aTHqZgf2lmA9JVi/CN4nwsb+HTbr3oEvTg3S" +
+		"cVKC7Fha7BgWbpMtU9l5H2MyvR4xW+IxgFgNTfmMaqvmebfuz85NlQXDwm2yJ+/6PfklAxjkQwkf" +
+		"Wv3U2of6oVkpPtdRtNHUuJnSZVKYOOOX/LzCym9ZGf0/xN2KmZeJpiZZ2O1e6lLSUpB3SGUv07qM" +
+		"kscTnjzYQRImexFPfPR2YJKlPCqMargp6RgWXlw81zZvL36o8C1jp/MsQKWvnO6pdVk5rMqn25K1" +
+		"bZ0cUUw2QrbTD7gviPzkOLtJCUaeow8wim9ZcKZHYXBjBxbrj4JGkD+YfgIkTLT6/Ym+m5Q12UOD" +
+		"7ZviW4IRXR/bd/Xe7Er40Nqq/ZOOW/fQH+QccheTwcBiPKkNUlhdC9kM9MaLI82Vwg4GIDOp1gbE" +
+		"5tx5fnDFk9hx/ZBaWVOp1WrIgeIRn1YbpTBxxlua+IfC3P6Y0x9z0VevIOlkL14B1f+5HWIShtH5" +
+		"48K3kW7BSzaXEoyY6wvvbwzILaLtXrH5tdFU2qLtK2/pU0v31IHXgbz+u8JTHIJTM2KlBlr7G5sP" +
+		"Z1WgMHHGc5eCkeFPzEHqc6td8r+6JkwbGi2xoyfr1SkeFNHeLnHtJtPWpVrqK7jUyGOr+AjuQOvA" +
+		"ILQWd/chwdpzoE0JjcOqtEXhZpqGQ+6QQJ/cer2YLa9IDjwTIwSnZmRZRnZ1hVc8V2ZEE5PBwGI8" +
+		"l+swyag/FJbmj6Wxg+HAYlQCIsuc6hnPCPY23pUmoHnzdVI0Sjvwy75fpzfZ1JqrUcLC035PYo2X" +
+		"u+BVSg36JNxIwmTzV0Vz1Oqd2/6zPWXBsHCbzPQnPzdVBXfch6y+LcbNFTbQuVUxGRxsZn1u1Yqq" +
+		"Xg7T3dNghyeRKXCNjNU6dkKe2kdk/1DSvahdPpTwoa2ZWL9fJwfr48dVS/eqMxc+BHSUsCprzCzJ" +
+		"Hwiumrl2v/p1hQWfzPvkAXhaZ1LoJJaCzoXOel7fyy0B0/qHQkdzYVOPT8+ZVEOVvdQwSRZm0VDo" +
+		"/Yy81+MsYdU8DdQl1eyGVfgbOx8KXMvTDxdjjUDAQ6HZcdDsIGh+BLTvu5xxI7KuILYulAW1Ze0V" +
+		"UiOpK7epEKM1v2KflJhdjd8yKUxsjlZlPrIyYjO50hbtLR/KLIwzQnvOZUSj1dWnOs3qLNmvFsi3" +
+		"s9fEFE8qwalO8uh4LNlHHw1U4zbPlpLcV/cPb0J9zzrW91IvKpznrnpbDG/5UDzKzbY59S110vRz" +
+		"8tqzPCum+3a9eHN0UFxpOuDzVooXPWIQk8HAJ3BQbsc+iacsKO5sYEXGwJNtK7WbZEG28AWIlAzp" +
+		"wRs8rVmLcBhsLYpJM+SnQ7odGcspX8O3NgZw2tOaTZSVmpFnd821b6bKgmHhunJblIUt3x5DfC7T" +
+		"o9YjB5gen3bPqfVCAfU6vC8q3kQzsTYXZ+Ltstl6u+aVnXGbylucLyvalHR/qHZibtdpF83EsPDi" +
+		"4jU41/Pioqpv33QG1nlZ2e06Eou9IGVSneRuLKhjBZhCEwc3sQJEM3drcuN0r5ptdZcau4OZDsq8" +
+		"8Xn8qllGrZu07fDr3243Kbr86SZhqDzKFGDqnALyoSnAo1hM54/fGFr843awwvE7MHX+EEzF772o" +
+		"MKe/kkyqx3uLyaQEI3q/sXx4QLLhX7GD5hW8cViVtiie43r1PrXVtdQPBVFW5nKqeeOM8v5U3J11" +
+		"macLxZIdZ8I1GSDKr0kJTnWSu1nmUX8JZ0C5JmCPvP3WNUjjf3EZog54cCS2lzDf/fs3islhiNup" +
+		"V8i4VOVlatwFD2YrL1bjrlnGHZ0q7uxKdtGq36eq/DaVymoSyX/cL4nJYECXZJn/JM/+x5YuVv8M" +
+		"sxJqo2crnKF4fooBn4UgwA/nHTvroOezLNZPGHYKFgnyyJe+/OtoZqs30POYNR/KTVCDVoNf7K3r" +
+		"h3pr1FOSXsdH/CZOxa+ODWH1HJQYVSm7mIQLVGzqgfod3NjDXN7MmO1y2NMIUronW/WgARlvuR/c" +
+		"9MN99VcKcORNZY3CWTYehT9/7MisT4TcitPqeSKPijMMAKYrbSbVgOFlCF0ub2RxtueArTvbJgzl" +
+		"JpihTTBbPLc0yVYOuHpPJS3sPkkmhYmIR6VsfOJbxCqrSTqyeRRoOrYft+oiEQKjVegYwvEjYmcV" +
+		"TrUAQowOfUWf/1CVBcPCSwrWqVS2DXsFrvmxSCE2W96AL8NVvI1c7SVkgsVANu7P1mxvtsYjpTXf" +
+		"qa3Bwuc1sb9E5ZvH25sH05BSJzXD5G4e63Eo1q8ESBa9NNbUZqRMChNnPNvtLZ0CUdzqN/fK2zb+" +
+		"HZM8ZVKY2BwtT7bTW0PUhLX4Kw98rMVR6ofSFo3ZUWMMxW/MWerKS4QlhCzjf7NnPSStYfnWQJ8r" +
+		"4Z0609grTbvJSRwsmhlhP6sMBnThdhT7EU6jLS1c76lIXRm5IHfmzZwtXx6j5dsqmuQwudlmkbf0" +
+		"qSVPgSxK28UpLgTrvqGjl10CZ6DAaaSjSDA5iTUmuiOhubQUzYr1yy5gyQtu6UNpizbz8afSprlb" +
+		"qZNgTFs8HCQ4WgaDzfPwneoKo+LbexEUbem95HalSVudDF/93Xg+r1u08Kl++lrNXC/5Qy/1H5XJ" +
+		"xYuRWYTM7GXJWpZsxaa1Zbe8O95fAlkiL8m+xgW0NEDzsGhmc1VbsaNcHdzfgKVpssDeFqyvhfjc" +
+		"kgP3gnKa2EhRnMq1ovtCIgr3HVUGg+rgPgaRLTCQdbv2bpJtS2zOb1kw3YvCcxBV27ILTwzLQ4Nt" +
+		"dgbjRkVMBoN0G1nWD7wJrMA6D7J+rYxElsAPXlDyWPF4y2geyI/1QHYFJ+9F/zu3ZdrkXz38a2j/" +
+		"GD7Lt37w+Bg/Hssg8fBBAhS39KHM9OzLRDw0WjRE4pmtStbVfrIBCK+o917vyX3BtNrpn8RkMLAY" +
+		"yzwyj2UhBbQkE81q2XDeQlqtM1vxWnepJcnKFjG9k/WVyLqn+Nz227HLgmm1w73UYwPo8meC1Imt" +
+		"O6fb0Ts1wksXJ8miwPVqYjK5xdNe3mcv7yDz0mS9MOEPXA2pT0SymBLzJHjmrb844Dwzr0CUohPP" +
+		"40Q20tTCp9o+VLb91Ev+V/9MUOo/6hoNxhpclXavcCptalbvoUiYWBec7lZTskcQpE2QMHFaPYM5" +
+		"uhgvTm9ZOd2rltdCeIFaXvE7dgcJjiwPXPLKuF+/qm3VF+UtK6fbtVInq/9/WBiiUoIRD36iO7Ju" +
+		"//lyJEPWbGptUZ1LneRGhbSdTRyCk0eyZFSLNUrSNy6ucF4jtEopPuxBqHNYlbYoVgo1bGKKj7g7" +
+		"2Oic4h8KHuYp/mFM0a93E5B9YcYlP2e+prgLK2afXQBqrB4Zh+QtyKQwsTky5SH9hacXZa6sKr6w" +
+		"qizrqsoR237FPpkDh7F7iclgYH5WavRHGIO5xLIcBWHudp1OhbG0dDEZDKqD+xhYkcXaE7SlyXA9" +
+		"OwWzPS3b07M9eWlcfD0ZyaL5aVNlbt7Z7bjmsjLcuSnHleyMQwwLLy5WEJSSp1LqyppEYzHgz8kU" +
+		"MrB+k5+vUvMkukCMHY6myeXKJneZZENutpeDDG3OPLU21YncnMEwdt3r+dqT4JokDy/f1KmpO6aX" +
+		"5YGSy4PZlUfVF9aMpK7cpvLhT7uKU4PtueWwTeSA5UpbtL/yoYQP7cORhZ6b7XFQmOjuffVlE5yb" +
+		"72wi3bGjT0kU+BC8YzOW4FQnebQViQcf5cQvlcqi3EAmrhPYPFKTufDZDbEZIvolu9lesputWRLF" +
+		"umCb7Oipbqv3S7pJ9niiJ+JcALSllZPlx+bCj4XG/UOZUX9kYUySzpj/Z+PiGfn6pWNYuE22ukMp" +
+		"eSoc7obCAZbImhYbm0HwsA8rOG7pQ7HqmZ4+1TUTq75rH74SFsU2AhozaVZ083ybmAwG1cF9DDxX" +
+		"f5ZaPtYYlfUrB1P7Jxlz40zdX1EkujNeT6z85Ey1r8nUctYo9jswrt3Oq73UBXmRNrV0L/qayK7T" +
+		"VLUZpitL/sgA+pVsdqmICZxNXHzS4hOWOVnBeh8krTb1UAhOzYhpVG9b3E3a1rRdMqcNRLju8eoY" +
+		"z32FTpkrdMpcoVOWFTplXZdTPtblsOc02a++UJjYHC0z5ZJXnk6lLkhzurtMChNnvOc9uB2T/8rK" +
+		"YVXqh9JWbVUsaw58dtO/NP0tcxIa/eQcXEGCo2Wh/JaV00xZ6oLNedK1ophiqaoXWGd5fPahWK62" +
+		"oBfMoriyFkDTS9qC0ZGjbxvTqS4LhoXbZKsPFO76Xr1EPyyAzdETzlm8MZx0I/o4BVh379vTL2OM" +
+		"1bcLrjA6d1L3fdTnLurziojI3tQ/ro/65/VRX66P+tzHfd3FVGzK132Hg9gWrixOjoSnmJzgUYSY" +
+		"TdJg9W58287Qo7e53W2udiu+WARUJ3m0N521RtlLcqApU1DV35tZEB0KP1c+qTnapAAKCyx/y+aQ" +
+		"JzVD5lq3vTtIcLR8lEt2LnXB5kziBYRCcPJIz/TiywPAkheE8/XtS4KnskZppa+AEE1/2S2si/ev" +
+		"3host+1sqaACLFBR2bdkCiua2Mgs6C3ZlniWt9Rvkzx7Eq0A/dTEAAgsllAZDCwvW8FHsvxMSfdU" +
+		"J1cHL8CA7f2OXoBvjH4/Oy3IY5lauhfFUjAz22GgLa2c7qlJmPyRmPm1YhKGsm9drrryjLAyy3Lj" +
+		"DcpbVk73qtmI8i4/YjIYVAf3MbDqKlmWidem79K6SU3wqwGL+k0IUc4vL1p+7ZLl1y5YftGsurqo" +
+		"/OHZ8g+Xmihg1PsTD/bePyPEEXZZn7nYY67ZX661t1zeVy58ScKOxytg7YtKOsxFMJdu6YVdeOHR" +
+		"6sWnqhceblzcp1e1Y0GpO2im+q+ObUsHQj1PEBoIwtZhA++vs8n54q//fyhtaup1BYSFIt/HJD21" +
+		"m2Lo0hZrrxojKUYJr+0X4bDF8a/Q+7bjbrM+0wBpXe/9hVAogklJcVt4Xxk7beqNCmXc8Lvnzq1/" +
+		"KIsbs3FvPUUYJY+1FLqDbv1Oqwp77g8lb+lu0j7U+Kn2T+0jssf9H3VL/8eQ7tX0Vz618Kl+ZP93" +
+		"1v57+9YBXpVQt2Nb2VI9JXuN9KdXYlu0WdvR12ZVqaV70aUtSusfyhJlSQ6Z+69kubhqalG9Jqd+" +
+		"8Nkx7ouyZnnie9DOZjfbLGDm3QqfL6jWX1K3c24K9db/1T8drKAlo4/m6d65Sq/ljPuHwqj2dfav" +
+		"s2/1iM8tTxp2qSVse7/TyiOiHFhNQWhf5/U9/gXfqVS4Vbav9W2Y+SrMpd+Xvr6jOiQo4/DLDhbD" +
+		"E89ki9AGMnJjv43+yqQw0T3Ra5UTE7WXSUxTwJYa31g0ao5OzHDourljQeiFdaAqJBDgyJWh4yeH" +
+		"2stkuo1wIsD3qMbwenFpwGW/5HQF/bKwikBJMx1Fb0aqHJcfV3jqZaJKbb3w5IqwK4wOrSPuJd+p" +
+		"UKhadaaoEs0r9RRdQ3M9Hhrg99cJTUlrhGfGF58OX49HpM7iH4+YKVCLB27IqoSOcJQ67M+IWRKg" +
+		"O8TdcSR45o1fpXBM96KwNFV1i7Q5xwTl0tnJpfOQC5OQ6+dnNGLaX5X35535M4mXtg92AnYBd4C2" +
+		"Skr4lUGCRtNXpyYXb6YO2Smo3hRQLU9dxAiJCHwfmQCThgfXZ13p1ACF4oU2ldqC6bTWTyeL0N+Y" +
+		"VgEzQvzuz5VOzbJtlaJBdjEZDCyG2TY7lkmIRjImYhImeC/f06cmk8LE5oiU47Rz6fnmOnRVtwpV" +
+		"9Qvul33B/fIvuF/8grv+9hV/+urCL1+pyGMld6ICP6Y7f2I2iYgRDlPeXwj1256EBpJAWbKDRVWT" +
+		"ZqCMFsNdlzlxGIBPTRkxwa1CP4s56Worp3vV2BPy3vX9YwIOEnBy7DIpTPT03eqmzM3EemdCcLJE" +
+		"HBqz/vyninoT1IU/jnvxt3EhUSU2jjWNdeqMwTXPQT3bmJ5t9M4+eJOYY+MHnxzhjJkZAa4/GqAG" +
+		"P6yBHur4Md4hOgWsLE4V3LmAVIO6slEZ51FMiGxG2aO7npsGomHSsAtFoGyQSH/GhFCt6Oz4QLoK" +
+		"kTqJLtgT/Hz6xddhL97wvvQVWH1d+6rfKeaRYd1fW2OjK0/waKmTmqMTMq9hG/tQe06Vb3QcgHoK" +
+		"ImgWJhJkgz1UeSWKFXkXHj1d1V5oNWpE3og3Xuya2RNJUOATOT65AU86sxb8eYGSqUgwqA7NiHBT" +
+		"WBZZKBCvZ0Bce1zVzn68/Lhq7Fs2idnz5HS7xkw6Zlq4E3tV9cbIWFOUy2QDoD6pxGySroUvyQPh" +
+		"reGJvX/iVFexpB+yA3B8VnwtHVLCpHQbswB+LntAE5PBQN98cUz3olgHGGr6h9O9ah+eHmMm1kO3" +
+		"h12xmk19UC1Wij3cujezsUzahRtdoA6gzvQXM7gsh4tZXJ5H7CY15uI7ZSCNe8tNYeW98W6UAjN6" +
+		"y12qQyNB6ntb2g10d7XXt/6aNwH2favdgabXFuskGLVczqZtKs2RmL9u6D9uOED3QLNPLV42VLcX" +
+		"5z0ARJ0xm4RB08aUtsy7OK7hPLhoKEeN2Et8l+LiO7SXvVJx6dsTI9QfQSPA436U0idJmMxqQ1Hv" +
+		"jnXUCmJyxIwc7BW8C2+tXHxn5er66R2OKKbAsQrHF5B53yZRg17nFRlZ869Rk2qqtr8okIs6YGt6" +
+		"F6FAlFQKi67TUs2FRXezsJ6dtewWD+1GSONfDdTx798kFIGSp+iB1aS5Umo+7/51jk+o4UOT/Myk" +
+		"fmTyfuL7UPfzuL/OO5WMsKmokWKHHN3mLrW+ZPs677odMZjckpG4TYsBjoRVNFCMGi0LwtyFQtVy" +
+		"bDluk/YF4eC/xDR5S6s23PqrZIqh/hn/o4C/29+/X//7+l/9+l//+l+/v/53beN//0EogbLegLFB" +
+		"Cm0IjQ11y88kCzZn7eJUuoPF8zeroPSVzEMnjIqSc9zS5OtYmf5xl3NFq0lMW9eL1g/NI5NMmsZx" +
+		"jC1sEWeSZ9xWZf9QzO/tVbxOCZO2NLkZJpkUJi7xfcFpxpraDy18qp++3H1TL3nqjNMUjwdCZIcr" +
+		"cwWNfR6xioOaXuVJoWpMCJE+WuYRjR7RGfAOH6GZKd0EHfL/d20/gjBAwMYMf9Q5zXnWh9ZMleBU" +
+		"J81oI8vtZvYpciBeONGpezIcN+lAOKY0ChqbA9aBENUzPwsF7H2DiA/8jLgpc5Ny943I3beC2CY7" +
+		"3g7cktyXTcn9Y1uGOtN22tB96zBlWTAs3CaznPqDEDFVkkkJTnWSu1nyKsltrRyysnWIWqPlxmpX" +
+		"NGyttrm1I2RZXeo3BzVjCavmyaA2U4LsPyt7xEz+0FWPq+JOS67Jatp1vRuJPyHxobnbhM7jkcrb" +
+		"s7Kt7DQUCqoUf4doGInbhnzRSXU6Bkkrq9ziCHvdYnZQjzeq+7YWePtWvlnjN08WCBrCGMRhmGRr" +
+		"6MQKrZPSTeCulq2pRTJCLZNfU1RIzEUJ2UhOTKM5ML4yaXWd3tXyqkxWrWxsj6SEEBkk9lRJuptl" +
+		"3OWB4KEruL1DqA7mLQbMZ9yzYYHs1sJeLRU/2+WU7slWyQob+z3StS2Mie2CMHeEKKR1S8JBBmBb" +
+		"YcrtiicrWeqCzXnNEPdRFm265Q1nj0WbkSQVlyAMELChkPiNeqoMBtWhGRkw2f4SCo3Qg3vc28sm" +
+		"JRhZih90pKj38iG1JeP+88CGjK87uKRvigdN2gxx/+EeGx9t2HKYZMaY3lZA63JOUgdNAF+bwBv2" +
+		"SShLYMGOHjKbnBZu3cBGqJSNyd0ltgNg2dumYNyJonfGAWbA3CvquBUxbYh6JEUcODF1hKhSYvGJ" +
+		"415MVnxCGboJ3Gp9RjwkNCqmQtNxA/MMnV00azJX2qJ1zC1XNTEDsSxYDxOctJHCxCW+LzjN3DDd" +
+		"wyfmhhF30gY0hGyP084I7Gk4VKKeZWPFSSzi5/QI2Hv1x4ZQxSeAum5ZhR/q1axSCmrS6I7Xip0Q" +
+		"ozVBwt43tmtnjbtVuNseZLe2U0b0U0bkKSPylBH/FFwFAGwf/NXgLxL//SvVZCNQjhSTmPYviira" +
+		"gQp6R9GNKxF9r8RuPrpNJYdtbDIWvg15HRQarWl1yjZ/AwisHaL0DW42eSPBCA+Ui8l56S8N8eNQ" +
+		"SjikAXDRCv7qk9X6VceXfzuFBMJwATWVEWEeGwGKu8OWgHTtFMwsemaRmSWhUDUGhOmG1J42SKP3" +
+		"vZQ8pGiwl6u2FdVHS9rlWaQ6IOJVzF0JxkMooCINEyDsdczvnGD83W6TaohbohhDmlGfZF66ZXtE" +
+		"QXpMDUkrN3dHI+2WFZ2YidblB5v5I53CdZE6abUyUyiNOI2wmK5ayV2uOkmN/7uk64PZhTVibMIo" +
+		"LmwVYaPYyykLqlmfLdctoN2CNUzQRWkq8y6EbtJcupgMBkzE7Ql8e8HJokt1gGln+bvtOODfmV5V" +
+		"WTAs3CZbybtvym7bsu+vWJSihmBbi7Nwuhctdmr7Vr9ZmcLS8b6pU3PU3zNdlTWK+VlNS2ylc9+4" +
+		"Qn+ue1l4RjCbY2OdDnHoUhdszjXuk5l+3KORBeltYi8nHVvJQRakR5ckuln/qP9G31NfinTV3H11" +
+		"zKf6EY2s2ouCaS/siUs17KiBj0fcINrLZLqNeKg/HkkoAiXN2EuPrgEi0boPtuyjm8vQBj03DQ4s" +
+		"gJio8ZuG33AUDYKGakdSmp4SpBq0Topui9OYbgK3ZyBMqNwi4Jq2TKHu5eoUqmrQUcduatQx+vkr" +
+		"qN34PaSvuo021dOF3hVVUSmaSpQcdV/EpMG+1eAAO9KytVXCnnCtRLwVatmCgyxYF2zOludgWBHi" +
+		"3KESxxBQHNVN7z/rVfIIvsuvSY3Ne9m6A0yhltMBJlQyPzBeAxCDvRkz9yXusgx5bD9SJzVDi8fb" +
+		"jnVDt4q59VKPSTD+bp2Z6KbqaTc21KYhpjFvnIhjw4Y2FtOQ/X+6m//b9KD+EQ2ChmqA8w8OsB87" +
+		"vn7m4YWfQoSEfiNEylHvA1OQ4xtd4dCLPcpGoGSib+slx7eucCjXp5LuD5VVOeQnZpNqiFruvBu3" +
+		"Ko2aBANabgrW5T/pFO2LnxSw7wbU7Tg1wOGuMhg0gjnqrwUAuslES+0mkUrDWgJFECfmVov29wPB" +
+		"a9MvE1bt6uOmhoYSKKvJRqBEXnmPgQLnw0Fs0byXSoFdQNJ6Z03GQSvbkJWDzbFyKAfFPNtDk0lh" +
+		"YnNkzUI5WFI5lkwz3pKDAqleIgjVpBnobsnYKfkpj5gxkjjD1WymQnDDnrZhT98w7tOs5+n8owHK" +
+		"wIGNhSRD0vGH3TlzN/0kuqPj559kjtjeHytJZ4m5YUqqUoIRkzTOJXNDc7dy0KccUh3MxVKx5bSa" +
+		"OPllDCiZJ7/Mk59KGEZ4jn8u0nNK92RW/cQKvbrp7OfUEk4t4ET+mAid0nDSOSND+ESeFk/rlScv" +
+		"WU7vnCdOPGdkCmYbs0A8v3y6YdOMk/U4JVEkN3AXnPo2tMpKAdWicW5Uiam7YTMG1LK/hKeYRdNI" +
+		"BGMei6PCWSM0BcYGfkRgQKkUUK8qJs1QroU0ufpUwUWiyqYQNLSH0crRjBgH9eEzBDa8CnNkhao2" +
+		"T6WztjKvf/iFRYWRpOEKoNm0vXHWrqeUhuGpcXTijK+9kCNPN36yaacG31I5dQCrD3ZtY3doJw/b" +
+		"xh3XTvirL3r6iO5bL5c+Raxbx9Stf2Na1PeXdJMSjJCdonppSkTLud1SJyEeBioaYpv7q/3gzfyF" +
+		"NX49YXU7RSlUB3MUwk3B6k2B+IJwt3sKzmFV2qJYDtRYGRdbcpBJzRHXesZ95biv2pKk35P/fhZG" +
+		"fXH+KyuHVVm9fDMyux/QXDzh9GOUihR1StRTzC41QoO+ZQqoyIx73HZ5517r7JDdWraj4XqSb05C" +
+		"nSWsWqNixmrSIlxmzOCcLaJj3Dak2ethvQRjTndvdx3ma8f4ojK5hUemLXI1at0w3UaL67C9MWt6" +
+		"c9I0ZDXZCJSoqV7Jv+GsA/hbkmnmYdvyxij+loyQftn8MjYGoFV8S4alIoSiIXb+m/v+zTyspFhS" +
+		"NAlDe1FIMKgO5iIGlp2tXwXzzDGuSX6/2U9/NZtfNO7vK+o1+y+bdSS89YjRqxs9PHBc4IDgkfBX" +
+		"zyN/C56pOjWgir8aICnTWuK/x9bVYT/7Vw1fVb6qbN+t1G8HCY6aity+8JuuGur9ViV4Y1JqP9k6" +
+		"gCsNJkpYlE+vRo5VJoWJM97SIeO+5QlwsmK8BIxCBCwHmNrtbFb9/O7EsPDiMjPnt3apeFIdCgXv" +
+		"DFMyuQrNNszvxk7tJqN5Q6jaOYwkLLzYWZ8glaKZfMVzQZgjs4l0txrgqB+QUA0e8cILdUgY/rta" +
+		"d5DgaI6DcQgsykwKOiI+uQbuJi2yW8YHn2cMLmc3ydhyrpFIrDdNIGFAiM266f54xHo4+I6cWnNV" +
+		"guOamA6l+ovyVbYnN/tpW5w0QGMntnGKz2xSghHzHmiNZ9xMIbSDspuM+yScK8VO0LLNjmadbJyS" +
+		"2c3m2VrWM7XwLC12hhY7O4ufmWVLSV9YXjAs3CZ/+FtprCY2Bu2ZrNels4xq/yjDgqqrN/fJgXY9" +
+		"2Odt9yAre3vTMDr+lUlh4oxnPhkvIzuFic1x9eWG6VF3wv1EFU9W8aT7ecpWHXA3f1GaaxIc16R0" +
+		"8LVkH5pHWuIULcd0m/TcCodqkITJHy7WJ6ExO37BaGEJqzZzUHXJoy4VrVtsW1p5iZAFw8KLi5Wi" +
+		"Jm1VNlt9XltdCA50x+Ffj81qZUe6H+b1KI0xSMm7Ekbh6/PXwj+0JcVEy7hyJFCwfMRHUGWkQvhr" +
+		"m/FrW9F2ycFBJjILZXhiBzUuMZlohxrUm8isSpbqYG6WN/dca3JYlsCw8OJi6VS5ibU7eLrap6t3" +
+		"LWXLrU3wVHY+9J+gM7a94grdOP4qWC5zBFaeSdcxWDXS5YetsUe41TK/6oz0UhTRHL1zdqUgYWJz" +
+		"ZDplq13vevFB8HQxT+eYmYX+wq6ThMnuLAfPoVd/lcrx3zisyurF5L/bj2QHCY5r/Ej3jUH1myPq" +
+		"N4fTbxtLvyUhRH/6tts7oJInNcdrxXQvCnv5t15TiD5CHyGPk2+9DyXfMQcKs2dG1P4yqYYSEwVq" +
+		"V6LVrkQUUUqnoEexDdM3EIasuhH6nTNKcTLfizWoZQv71vrK9DFV/cZrwRDIi6/1guARUzBpUTEF" +
+		"i9MSrh+hCJSI/rEEP9Zpv6+qj6cJjcR0nGx/X5W+t2UAc2M0CuUW9L4lBzMxQ5sF7uP//w3bvqU9" +
+		"djsKXetUroR+5hxWpS0KMzat5E+NronC8kmeLMGDJec+8yBbPJNmLxHDyq4zhn07Y1+nY26QDyV8" +
+		"aG3VLNsz9rn1poQPra3aP+mW4j+aAHdgFTwFY3BL0yhMnPGWpHWKaVAn7EWMorvmIEEoTG2QTCnx" +
+		"7CbpEm00U4R7fPPEZ2hms1lavX1AaJNwGaWKWa0M7HLB3XdAM1O6CRyBdjn7tidZ0MxscFBs/UNZ" +
+		"nBzfMindk5ei7Dp0cKkOiG4muOk8g+5+/rTfzXDa0sptUeo9Fbe/ZZIaX1t9ioPtEFWCk3uyEi8s" +
+		"JHdyVy7xdgUJZf8xSU/hkxYgver+QuE1ti55QQmLYgmhadoY4hsXX8S+IDxOoaAa2UdANNayb2nB" +
+		"dC/K4tNl5bAqqxdralrJrrELxDpHQ+OwKm1RLLfOKna70DRsxgZe5DLa2UsPE2mmieVz90bbh9Hq" +
+		"YtUYT9maQ7odrav/oOwfzlwHWFIlc1KpiyoprQ8m/GwLiWmTrrlU8Ou6PW2tLbf33SBhVdpU7gUt" +
+		"45jFZDCoDs3IwJJdTUw2Apu3PPlz32S7snflngpPlaosFCbWic2RlcDUZ7epz+5Tn51Tn72kJHuf" +
+		"JGGyuUIp+UNZUhNLXs6EUGXBsPCSwgsp1aS71iUSSY5vfzxCTSaFidPbsjgOsbYEhoU5Oze1TV6T" +
+		"zxTB9xi0WSNXZx5hKSp85Gc+fmNtKhI+1JkszmF7aN1hmvpM7HOwcpySmywYFm6Tp39My3adMcmk" +
+		"4Djdi+deZuZl5l2WrK8uk8LE5mi+KD7vMXmTD2WhMLE5xln1qS7Rlnvg8KbkKcASVm1NMvN6xOdV" +
+		"l7JUl5WDKfWY9JFiuX1JfU3k5dodzF1fBGnNh4apWrQd0UoSJntm2Q/bnIuN6cYSVm2mKT7ol/y/" +
+		"S+okCZPd/3/X6t1YJVqa7HXr8qGED+3D0TOlaq3XsILB8d8oCYsyM4nBq8ZbC6Tp/8yL+9OrzY/l" +
+		"rkr40D4cZxb+0Vyq9dr7yhJWbUlXr/3fdLOzUH/LqjKub3ufJGGy5963xfvgw+pVC5/qp69nM2ax" +
+		"k8LE5rj41j5pOtf+4YKU5YeCfsXmhqVgalhOnsoH0HJTmGsNFOJQHRppOtsEpFQOzxdPDZddDZbL" +
+		"LwcH0uukMJ/TXU56VA6QvGgZ0se0t3fVN9dpEpmJXd8Uff+jyl6FV68KjdTFITh5JDOzX3qbuKVF" +
+		"gXuc3cE4rMrqZflSK/lTW10La19aMynByW2W4xWk7bJgWDh/xOS+KG2yZxW7ScvFxj1QM1xSHJ4t" +
+		"z6/1bn1LdoU/tfCpfvoyQ07Pu109dHwMwKkZXmnTevlbzuQ5jZ1aM1WCU500o41uk1arcTYUK1Bf" +
+		"TxtUEFYKS895mO3HXqBeB4Wq1/HNdrtOsVGeGBZuk1kZKiUvCga3qyLkYTKA+5/YnPskNDS/O6NE" +
+		"N5tvgdz4lklIe+8+8SA2stkkGFgMChvmgDE42AAcfPQN/qw72HPn4A+eA8fl8B1rMAlDFdyOIdEY" +
+		"9To64LZU4E2egCXVkDBclbfbDNUcJFOgksHG60H0qBAoG68MSJC9cPcahoXbZGZHBZstIe6WGBgW" +
+		"bpMtsRwU3CEDmUbwXBHQSDMdLA9cLACCk0ea/0CvZOI9JpKEyeaf7O5T0FvzJTu+Ud6Zyu0gwdEy" +
+		"GMyRBgoy05/YmCRhsqccCrw5jwFIcDRXm8QE6VIPawpyWJXVy1K/JZVzkoTJHy6+Ga61qSq+sJ5C" +
+		"pThpFG5OD4GIuNmhxLvVIcJeMcEFyLSZd/XrVTDSNjv1GkpYFIxwruXnoq0xN7iV9JYFw8JtsufT" +
+		"a/zGxHEq4UNrq/ZPOtsYqqV+ajPp3hdcCtg/crQW0U+8GwQnj2Qav6kRMm7YqWwAPZGHogFSFjqX" +
+		"xCFaSStW0O3K9Z0mBKdmZDlc393kNpN03iJRZpocTIqT54Ir7YC3jyAlGNGr4r2jAb8UHvObKeEg" +
+		"zaUEJ7dZMtF81Nz0VQlINVxomAszmiGrSYunjNzuizOZ4NcDJNzqC/O6IPglQbhy2PLOrg3lduyT" +
+		"0Jz+lZyFw6q0RbHarCsagn76lFKdb0EYIJjo1pxkfxUKCQYWAU/YufTJ3tMawH4nIdLQzbVbSgyM" +
+		"2AGjTbBhpDBxxlvKyzdI3/0SeWiARA/U5YEdJXxiJ/q8Tn40gCOvVUQPFEkaYNwSv9Em8zabzJts" +
+		"stxiE7vBhsWXwrWXgoejcnxv9cdBgiO9lOlZuJSM6NbbwRIJ7vwDghOOZnAzsjTna2uYFjuHVWmL" +
+		"4mlwYgSYu58YwTyjQNFMMiZVKiUYMc1AS2LsqQDP7SkOwckjLSfeRAdIcFzjvSi7zW5PllQykd9E" +
+		"UaQXTziDtA30MweUOL+Kf+yArP5tjH6YfzlL+NA+/JjeVKvwe0sXjwhiWLhNZnoqJX8o8EMuTET/" +
+		"P9tyMbhozVWZFCbOeMvoTFgxQZIw+cMFKfHCCMF8i91dAA/Px5e+kSEUgbJBwvthM3OAOXW7L/3w" +
+		"Cftjay9sKknCZHNWhd54igUwX3+CBVZPHxMey2XYwy/AMBg9/EbCQ6q4DAbMdWAj4FAmhYkzfibS" +
+		"j6JPdJfugIkbmNHVTBVTt0FWULVaVxwNg7w4NWCsteUQD9zvffBm74N3eh92H/cRU6KwdojJfRcn" +
+		"pMq8c0oKE2e8pcl0NC97UmDIFFJNRnGTw02gwfPGUQyQMLE5miuKZGm8v//wm/sPPil82FPChz0h" +
+		"fPjTwYffzCfhKfBjrhZ48Oz08LPTY56dHvPs9FjOTuC3LJhuU0qd5M6EPxRiumfyZ8njDx9CDuSL" +
+		"+vIo+9VMSnByGytcUqAQgxkDZ67p+1wQDE2Co6WyFX6PgrOjymBgMe59UGzdwQuYN/ypvMXZD3Jq" +
+		"t7NX6tj6rNXhVqkOlsH0iq1PsmiT9qjZOazK6jUznMtOFo2ufj/KeNrvScyr4nSuUoLREslSqq/Z" +
+		"V/R2gnIb0hazScvTD7zKk8WjivwVBzeZm7SXSQlOdVJzdFrS2qorVWzYMEaC+BTej3FeIrqsHFZl" +
+		"9bIiqZW8aKV+KEhWuNHlL05Car0kUaCkyyaag+CBXn89xaXZIZPNEIG6WbguG8JGZrtCe/gF2oPX" +
+		"Z4+rfnNIBElYePGwZKpYy06NntnzykteeclrGWEvLGdWidgmFIGSZkvQfYPm0HHN5RQPXOw/eJk/" +
+		"ZDVp8ZTIUf/RbZ/Wa5/eaZ/ss0+t5nNLQhEo2QxPvBU4ZNwomQHuOj+xLnkIMTCPGrh048k1y0++" +
+		"PEmQ4GhJOg+D59b7ZlINYtedT8FF51Oy1G3BLguGhdtklkOl5EV5M3mnQKo/CE8KNT43dY17DBM0" +
+		"Jh5CkU2aPXeHLU20SqgmwZEVjdYBB0HSn09ZnvN1zcnNFewj9qFnxKuzBAmOVlpjbxrEkcRwmuuk" +
+		"afTtMMWjojhqZTLzrVsQk8GgEVgh3hp5+q2Rp98aec5bI8CSF5we2K1VOsXjSpPQPFU6/bsdhcYW" +
+		"3610O1M+cTvmWbFuWKXFcOXws/I20oDTpLjF3PlE71nLL4XH/GZKc9ClyPZAR6W92UPldvS1M1Rl" +
+		"wbDwTMwzlSrJM7L4Lg7BySNn0qVU7wfKb8+g1EltIoqsz9itMHJYlbYoVio1Lfi1fSPEq4wv/e7a" +
+		"ExST5T2V8KG1Vfsnnecf01zc9tq+kYa+fCvk5e96DIJHe1HQg2eO12anjReemw0RKGcEHyS/+EqI" +
+		"yi1NcqM4BCeP9Pw6hTn1GaPOUYMqFIEScVatgtOaymDQCPS5VOBpgj1K8OcIA7o4BKdm5H5qOb6v" +
+		"2niMQlkoTOR4u2gzuUxk5rbYKPpao+hLjeJcaaQn05j3rfJRtnNYlbYolpBayZ8aXUs97cHXooVP" +
+		"9dPXMzadWQeRqsDkD1yEARabpZ93BJybK6RSd5kUJlorT21JMdHKctmjLWqfCrItvkzV+SOiLYpl" +
+		"BxtfJwJIcHQvf50IzFSntY+ShMme8PSW0OfvkyRMdu9u1W/dK6/ojdW6b0jry2b460Qxd3l6fyCH" +
+		"VWmLYumpcRO71BMnPef/E7l0QVokrNrMWVV61sKnDc5hVVYvz0A1L/5tLfG2OQ/Q3JUXX6/l23vY" +
+		"G5faAM+iLVn4vVQoTBaf80AzJXxoH47MrrVLTAaDRqBPtxkoKUxsjqsvt9B4cdLzyn/omP9Zr/zP" +
+		"u+R/fsj+Nw/Y/9hJ/4tPhDgNKVSHxca8lJnw6TY1lLiLyWBgMXQF0Zgp8HSfZHGWRfaUqPcVnmIy" +
+		"GFgMfa+3cMQ1DAu3yR/+aKOfmEMSh+DUjJAojf/te0oJRnTY+AZZ2iKiziYm3dAIlqYLRTDJR02D" +
+		"q0kzUHpibEKai6rmnIqEV1OmYlFvcUi3o8figoHYF4z7okz3jjz0l/UMJExsjlb3Pwgx2Qc0km/W" +
+		"n2Vr/jC7P2yVP9Yqf5j5H8sZVbnpf7tZ3WSr2aQEI/oMpJdNIga6DAbmZOme3I8KwakZLX7cNow9" +
+		"yQa45MPbIF4WG6Z7UfKMg3zzrAC6jboDksf5BYlkq7wUaGEmkSmxn+OuZeDmWoryoKCV4i0mg0F1" +
+		"cB8DbmZ8vrpJpnu++hIJf17PAtzUbEOefLGTlBarNRTuqdvbFPbmhK8xSDH/78KtG0MJq9IW5TMJ" +
+		"TyAp/u+yT4eAUQvm6fl5XjMfHFr2uG8+7LOne8nfTEs8YqM1sjWotWfZAoUYzBh1Lftml6/+UYb5" +
+		"SYb5QYblcwzLxxjStXfrJJd9W4WEZkeAhMf4xwX8gZtMx2YDP0nCZCba/Ixw2C2pw29JHbwldehX" +
+		"kFRQtfgcKMRgxsD1RHjSwZ52HljAduDZ9hBsJ0P2Jao3kblUrwAefR9brRHPfA3dbEnYXQbdJi0X" +
+		"3oQBSHBc45G47y+TyE42vltOChNnPPORYMPAYUuhAOk25K4fzKz4HP/AQoUhLLfUKeiZ+oxBkuNb" +
+		"qoMER/MbbJ421XXWiGizXlKYOOOZHe67DCEO1aGRpjMtEet/SWFiXu1eIWh95XRPbTpZQehH/gLO" +
+		"8nrNQKb1UR7v2/Blm8M+6T/RzKxo943/g5D2P3TkKfLgLTaVGlOCJJMSjOg9EF4pUIiBueAR16Gv" +
+		"BFgdXVmj+spLRJeVw6qsXlYerutV4oadYbpN4UnU+TMme2zn+gzDsPDiYiWzol2YX/fBofC4LVZl" +
+		"vup7cEn/4Uv6j7mk/7Al/UexoX5QMsko2pn6zYJ4eh1AyUxVu/ymt2FYeHFhomu56T2V1U+rsb4M" +
+		"9PEmEGageTuEIlAikg8H8+YHk2Fz7oM0GQ5WP0/MkwSpESn/vsu1ooRVWWMsF5fc4uU9KwwGWZ44" +
+		"gQGCk0cyC3nSy3ykmqSrJfEEpdvYS6V/cFiVtigfGVjF9UZzll/P8XdLJpH4lzn+Wnq9X5yzaHtn" +
+		"PT/n4m9gGIaF22RmUpYFN7lc3KUKwYlTJCjNyHJ4W3lvL+3tZb1nSe9Zzts7pXKpk2D8zRTIUEvH" +
+		"E5XMF5J5EHLhlC2RsgMSR2O+eLYCSHCk12Vnqzy2x+vnyogq+36dFqUKTqNEd7kdkPngarJ6Elu0" +
+		"oqyJT8kUcDptRc8g9eDLRyolGNHJXkgqFepzW96oXdU2dVkwLLy4WO5Pc3ADnPjCO2AWF23HAC2R" +
+		"vRR/jn/9qKEKjNmkMLE5IoOTH1VWmCa4RUTg81anf4bwnN8gPO0DhOcWKwWPs4HmXqU6mLdnUPUj" +
+		"9SAxgtfMy0deQzp0QXzrFLD3yjHDMCy8uFgloJT8ocDvLRSBkmZLeiP0mt4ewYObhGR6lX3iq3ZD" +
+		"IFexx8Inv9V24gHDEJYx8CZaOvzSNLE6WB6eK4oXFJus2MTrbBJ9vC6sCl2yza6A09hZA+se3jtP" +
+		"z7Pul03ppsKoXvCYxNDNNS6MIrqdjgzb5OuD071qnINAf8uCi59//eN8lV740x+LIuFD5Ta6jpQV" +
+		"90MAwckjmQpX3Sev4k5edZ92MXcmrIVUaT0gcWGkgnUu42aKgWdkfrZ7kn8+R5H+N4X53O6CLpuE" +
+		"CzhIEhZePCyZKl5J6WIyGFhjDzbw1LabU9w3k82gy6QwccZbNnMpvPN0KnVBmi075oVGsaYopz1c" +
+		"MQwLt8nmXzsFR2NbAuu/EE+sDo00cyj8RpQtlOWjNnvQZr8dD6KDpW5xHkRQUKR9BY4kYeHF7tk0" +
+		"Xx8wFfWr8sJ8lCQLMzWU6d2i32dzQ5IPbY1DqVUaexLI9+qibukfQ/vQ3/KPukRLmOzVbnZQcRHF" +
+		"6YsoTl9Ecc5FFOdcRHHWjyayRRSG6V4UOwgqHhaoDAYWYyXwViIgODUj9+O9HpIs/OHChGxZP73X" +
+		"eXqvdnqv/MAkwExi0gye7C+zKvgsH8AGMlUWB2mO7MvG6XbNsi78+h2JSXdpC0lYeLF7HnwEd84H" +
+		"cMS6YJs80XvrfEB3ro/nptIWjfuNP6tldEhYFM/siZ82Ef4SDqS5lof7lQe9LHfrFMX6RPEuoYTW" +
+		"1KiONdwqzRC3PEmN1zdudAO2vCCegJg2vf3QnNqMdGqvSRIWXjxY9SvYVA4UJjbH1dfKD8ss7rIf" +
+		"Z3OE+WmLz4F6IFypiclg0AhWULJjfdBbJqV7sh3cV91fnBYQw8KLi+Xe58OI01c2gszmm7kMdhfb" +
+		"yRrJTzKXtRUbyr7/oVKCUzVa3JpClMMkDVVMBgOe7p3d08CyjQ2rVkjNERvlLGHV1tTc5PFjnVsi" +
+		"iMlgUB3M2criFlbt0/ym+PxUt33z2r9XsXyxYv1AxfwuRS1ofJXBwGLofWWE2fXhoP+bvSrRNheI" +
+		"6CMcw+WVZMGwcJuMnNuO27sqxWmJRBK/z9r2SygCZTXZCJTMBJcwTWbF7Wqm+dVM49VMk63uLwcJ" +
+		"E+uC01UmWk42vrb5zL4Jc5LgYO54z7BJShQWkVij4zvx0Hf2CLdKcPJIywgNjB7vk4gBOIWR0j2Z" +
+		"w8GcZQw6Zg52yDSXldcJTarf43Gmy9scJDik29ELrW8xsNIrH16TLLrEOglGeNGje/XxKx6E4OTb" +
+		"1Ls1nP3ghjS9Hdd4z63ZPbcmv9g4vfXWXtspJoOBxTDFa6u4wUOSMPnDBeniSWG1e9mLfUqWAksA" +
+		"2uvCLycahYkznmmwjLnxne0h2bFAzTEufBt1B0z0weZI6SVdm8nkQJ9LTJpB3xPjhwiG8C5k3KhI" +
+		"cEDX8QWG9pmCFlP0zVK8HfskpkYh+JURwU/tSWNfi83uIxg2Z3NgfXhGar6uehAnYM0fBg/iw4yB" +
+		"pTqYCRKZm2KJ8dCmFWRauKcLd3NJ7obxHZBuRzu+hsLWJdJsjy3sCwX+eQKFamDeVp5NTEjNsDuw" +
+		"ErwpDQhOlqDPDNk0IMTX0ytdOwUzqZ4SF56Nq7Gbv9Tf5kv9hqwT3yBp5aq7OAQnDpHG6V41b9Gr" +
+		"2nZftgiy6RrvVjh62FLvhtvaDQu9mx7pXJmock6nqCZZ2O0SnOqkZshaE2eypc5z4SPwLQvOFPRN" +
+		"yaSV7Oep005UZ/Rbn+BSF2wL32QxGQzMy/LGVEklopJkH7So9JVZ85KDSZmU+4LN2Moq7ApnyU0c" +
+		"3BSXX3FZDPdUkyw87W9Z8MPu+6Jut0lWt9ptLUX10sr37fEwKcGIrgPhutVu0rz8KBlIry4mg4Gd" +
+		"c4zd08BzufgQ2LA54wf3FgVRLISdc8gkdWLrzreRtZCXGrFSAiBhYnOcrj7OWfeefXu5hzrH5LlG" +
+		"q60rtFov+49JllnsGYQivWb/N14i3rKyRZjRsq1SHdzHwErkS4oACRPrgs15TTatfRKNkp/9JflD" +
+		"kfChelbUmRJfj1m+HbN+OYZc8sozoTUMOd2r5nvAPj0zyHPNz5UZXcrhQNO185vlzmFVVi/P0DSL" +
+		"pB3qxWU0ILPdJi2TO4nJYNAI9Lm+sTIE0JzYjGD3bXuN37JyWJW2KJYmdYotL9SITJ/6dEfUIRSB" +
+		"kmbz8+9VGSLef4mG6NbbwXKo/IgCKUxsju5rL4gaNmdsVOWHnUgSJnse89tOU2lTI77ldrBs3jJr" +
+		"/RaMxlfb7Q0j57Aqq5elbssntE0r9UMZyfoWE4UEg+pgLsi149e0hT8P4j8O0m1A6D4czF8Lsd8K" +
+		"6ViC1/l7Hp1L8botxetb4j0tUpjYHFdfbJzz4vSe2WAK0PHy0xDonKRm6JnxhoZCdTA/Kx73N/p2" +
+		"YUEqzhR2mrBzhJ8gOn/lrvuv2vX5u3YdM57u05zOWU73NxaU3jIp3ZM5bnHdULeTX/czWedY0l8x" +
+		"/5hk1EDEFduUaCttlNyE6U6P8/0ZMqvoimYXkQH9sMkxbxSJkuZLTMJQhSJQmu7xh1Vs3lLrnNJ1" +
+		"m9F1XMR0fKC1l5IohGZr7lLQ3Jjsd5vsd5/sd072e7n2l0kJTh5H94rRRmUwaITp001y5t394wJK" +
+		"cRqXNGztOn9isNsHBexcPU/VyynZz8id9/VUipN58R7fgG7Svfr0YomCtfsACRNntKfJ/gNDU4Eb" +
+		"rvG7/d7L+jk55yUCRwLXxdq7wEO+vSUHp0nu4LGeFlebpOnnZNUvCP37Ds44LmoJFPhQZa/lwY4M" +
+		"ChObo2eOr6cAPLr3map72xd7ydkwLNwmL/4cIYgSVmWN+UxiO6As7zz3WuqYmfaVmYUJXmMaSlgU" +
+		"LwFayav2lk8t3Z/6WgD5Heu1In2QkGfS3jjyXDVTWD8hNrIEA3HwuOpgJmZ/4f2PbsXdp1AESkTz" +
+		"btqV16/kqibBqZFuk57I3HEoXHzF9bI3XC97wfXy91uv81USbxc5S1i1fxw1vb3FgtdwuSySTWtL" +
+		"Id/jf/nalnOjIiaDgcUwg+04KehynDNGfXEz/W130N9+B52U7smWABI/3i36i8Aj9Fourzrxh4JV" +
+		"VpOMsGJmzomjzCAUy6Lwy30qgwGuOg1ZTf5g3wDLHic+ldWhGQHqN4Uty3UOq9IWxbKnVvKnRlfm" +
+		"ZdnwnP+eH9t6z49tvZePbSl7fPDo7hC3tHK6XXNkqigmzWBFUG+WVbNdW5uYDAaWbbNslvsl0JJM" +
+		"dKuX5U3UZvNwc7yZ+8xkNnCHsz2uIKkxyi+FBIPqYC7MKeIG/xun/Den4kNazpyUv21O/uYT6rdN" +
+		"zd8RDcOfsxyymmwESk/A7VbAk2vjvnJzxciiu5VlI44ivD5+c+w9f3HsvfzemPGSwvLB6VulVe1t" +
+		"vxhk2IwJQhEoaV6HW6gSJrrT7eB1IJSdI+J7vtlkqMnLj1AESpotg9Qp6GAXwST0yYIL4ve8aW5o" +
+		"L4pN/V40NsrUVlc2+tTCp/rp67W65rb5vfo379W/7V792+7VA8zd8rTmUxVDHUc1XyjwZkZ05e+R" +
+		"/tpF2K9fcdkPlP7i91V/7VdVf/1nVX/5c6q/+CLGr30R49e+iPHrX8T4FaxfVdkIFhUQBgePgGd8" +
+		"vkzSJT493/h8qRceNA/Btvv1B82/fM78i9nhL6eGv5wX/tqk8BfP2H/tCfuvP1//5dP131J/KMzj" +
+		"xz1+eOoaCIkHFb9YFzNEHrJiGqGyEZhJjb3TCS+w/C3ZRKBskJritZ2thLhP2pKzNMe/smBYeHFZ" +
+		"Mry/6ks2DYaD5HgdQ5bWt+6gUe0yoXlJLzXuDltaMN2uxC2vrClRxHVsPe6TLBm05tjcu8QAHH4x" +
+		"S+vaZo5NOfYIKAh3qaUtSDezcZLriOi/5RCTwzDyKgGNU0IJkkMt+UNRt+MblQRsyZGxl0tkO4Lr" +
+		"e6vfJofh1rJvPQiHqBSMM9GlToIRe/bur4Mi7g5bWjDdVNoXLhBxdRi/x/+WAkW9B+jdySEqhXri" +
+		"zmT8/i6ZQs3lkcbxPnDou2gQNKwIYdfwpQEiXjRJ6ypHHeP+wi0YLknUnzmIuHs/RI9SnZAO10wq" +
+		"NYMfS1g3JkZ0e22/Dmoq+0u6gwRH5qAMz963rzr2dAwBj00jrk3tV2pjCEkoAmWDZLzGau2DVDy5" +
+		"iLgZFoP9giUex8fwFIQB4ixJJtEDgpk/x6aHcdtIHIJTM6L3QH1y7pjuReEesA0b/BANqoba7uNx" +
+		"VsSzrMgHWRELGlQEygZp8XVjOrXDm85J9C0NBSFUCnpDMC+tBPYaP+keH7q3Bj23XnIcgEbENzZU" +
+		"qAvbTe/S2fvvKtEspHRPZqMMrVI2JtZLFIIl8Wdg/k69QjsMugOdLCV+0HzQzKBAUMNXt43CxBnv" +
+		"JWIWYKQO2o2fMeicfkCitPKOraLDkdRYKkI2kEK6HS1tQfuOQLPAzkUe3HMpaTAsozUO0SBoqEa4" +
+		"HTxGDsaZedhy0EDxqYH6ZOzlzL2cn8/YTcJg7jihj9mibEHqgmbuJmmIP6Jgb4c7Ip4hDvP8xJmK" +
+		"gLjfUsfmjrvGuE8ccxOEAQI2VLF0DTQKr7WqaCrNBfuidNsVpc89UbTi5/jfqp6V44mlhpAaKxpo" +
+		"Wl3oFLnISWWDC+YjEauc4qlVOdH3z/J4UGhcaV1MBgOLYTYnYpjPiU3i4qh4nkkoAiWik8drXdXY" +
+		"tl8KVTvaXuUwaOM23aqmheHiDZducV7VRl7S8lpOhXr8aKAVaT847wypW95+otak/fCHCZQ0+Q80" +
+		"jS29F5Nq/t+lJWExEOSwa7Ohqbquu1KB0QKkU6fJOKNDxxDQr8WmGf1Fnftf1rn/HdHv8a9FvHEv" +
+		"aEhU/b3xzozSDamqaKDO2qB6pTLC7y3/TGpECQ5mKlufROOjlkwrLTx+SDAihF9nzp3ld0vJ3vXG" +
+		"4fqOUjeTMCD6wnGtcthvbYhbt/L44qsE9uqAyvY1Xw0om1rDt7hsgKQLDYwkTLaEqsCb3+QzgjEK" +
+		"BdQfHSXLFuqVs9QFEc/wVX4daNIWJNDUpTrA9LvdJmnQyx+Cm/pL3bRlDmzVgbwO5nRw+w7VsgaK" +
+		"6lWRpgo1pKlMA611hHC1unpVOW4QaBrie/xjT2CmqgLtxzmrylslMvkWDTQNHGNG+IRQW8EPyRMk" +
+		"ONJNGZ4dYdxN0qwnaEJw8kjLCBuiwdU6hVQDN6WbgLEGnW3/GWvONGr/+cYVqXW+/UcqBVpkkEmk" +
+		"kE5hCdjQg9wFSR5oDwBMkfljaBngVbNsoGlOZS9f+kynaOfEgi4VTWVBqMoIhpN8X/uPSTXm/pTs" +
+		"oKYR6Dm/8NNpxb6bVjAHKM9LEOo2PS8kweeGINNtxE2ISQPNjnMW/HZy4XLR4h/nJU1bdTems46c" +
+		"RAPFpMG3Xp4QYNcssbhKxXfikyfVmskf3pYuKUXmwUsk0BKJNG7AEZTSUU6TMOCDFgSYRqgfATIJ" +
+		"Q0a4i8lgUB2akQEqcGwZhwoA5ysqeq5z7iu3qfyVlcOqrF5rcWrXHnaghx2nBkh9otYn63wypbZK" +
+		"DgXhULT3oneWx5e9LjVEpUAUMigPTF7xAlUpj15Ok2r40QBZ+EFaeHCWnygUMDNT3Zvl0ABJMRoX" +
+		"jsblkN7FASa9OIGEITIND91y2KFbOJiX49i6OCAKodYOyThiFx4SxTq6zfQGqK7edKavuZpnt9Za" +
+		"Vu+p0k3CMMJTNAgaVoSwa4itO7lx3J/nqHfjjd8BqosG2NQm31cw2Qjt5YCEclhSqJ1p2dhNfvGK" +
+		"/CD0GIBGomaNNWs6fyxNT0ANSwxUNjhj1l2wst0a2dq49XodJtUw8hrZ9k0DbmlHdfsGpQtFoKQZ" +
+		"RdkCpzJfdiXSrSAzzeR5aWmxNTx4clTf8qzb+6qyMiIqQig9cqwzVLMGrGOXTCHVoHVSuglstN7N" +
+		"CRnZdvVfiSyHqNE4YQ6xmTS7XhcoMWs9ieDccT0FYYCA7YVwY+Rrx7zmwn1tldnktKCCisxGKqUl" +
+		"t8miYVi4TfacHLR1rucLP+BnJGGyu2b8th+wkUy8CpLQO7HTo/X0Vx7K1RdsYGG0JJPbPcmckkE1" +
+		"aYbGPH3785wt2zsBCnqQXjkkZm9bkAPFdZqkARfiRmpEEWxdFmJtipq07htKhFNnmu7ealdHNBQ9" +
+		"utADvfRiL8UrfPoCn5hMt5FFPSlxAiSFic3RfNXy5wtv7uK1XZ7jeYLXCyu+vatXEb96av/F/P0X" +
+		"F2yQasBP8qk0XVvmN2Ak+7Wf6Cu/AfqNc7o+BSq/2hF+sU5gSBaMLvCLvFGzX0bxourXrpz0Ckev" +
+		"2EaISQKAEVoPXMwNgaVySvdXPY+verav2r5q/6pdvur1Pf63XijakHp1cOGKQoU2uVGY2By1uso4" +
+		"1hwlrEr9UNqifWZgMSZvleaDKdyA9jIpwcnj3L+9kEHSzQj6Gguk+qpVUtpUqinuenBf3xEPQK7v" +
+		"iMpHxuuOv5hjgrHGnUJVDUbBuJTWqfzFFrENxqbuP23bfxxgukxo5rY678KvEqvAjEERMjKKtQvW" +
+		"Ha8wuuMVRIMEtiikYQr+BAhBT53k4TvSa/WxIuWy1SjXfKFITxDXuCV24cb49XjonTfIdBvBDa2A" +
+		"e+YX75mrRHTyeKQb1mF4arvivrYKqQatk5K5sGK4/X0969Yp1DqCmDXgyf/CTATrii7OQi6+Zwep" +
+		"eeu0RB824EHDpSfYC+dWPHHg0wYVQx096fjeEGqa43uDy/G9qQe61IG3YlXSbG6e17gYxvd4xtL2" +
+		"LXcHM3VxCE4eyQzndhtOj7csqBt9HHp+hwwGjcAsdUo+hO6M49CEpSJEKr4QMuAouGc9uUFRcWqA" +
+		"RjnZKCcb5bRGOVG3E9bLBLxGMDYv8+xFUPPGs7gRjHprCFINHDGyjRhZNFB8aqCoh3/WGbsKNWZJ" +
+		"FFQrBdRInYcsX1q/ctYa6L1lFeqtgbp1puuWDoV1TcXqs/anCEJV4vaiUBXvlkAGA4tBVeo2NqCJ" +
+		"BiNKB12OuPhpMBWIogeSYtxtPxpoRJNOoaoq6Jy4u3vNG7vALpPCxObIkrovyVHW2asSPVFNa67W" +
+		"bWRsnkESikBZTTYCpSfggdg6PyFiJGGyOZ+8Dsbk6Gpav1GVvn1vz81hRHZbkzCoPkoNpnHEB6Tb" +
+		"kRujWeO64erc5m7bzGuBq4+ib/lqX237/sYqhAXVLBWhKkkQqlI6wu0pDsGpGY2iFOWqDoxVUTeE" +
+		"Q9nH//7aasyTRva71FC6Vm9/yUHRIDuFqj8a7Kn0l5LWaf8ZpylKuKFeKmHQUCuz101Pfk5bmgw3" +
+		"5Fr13ALQhh841oTJpDBxxsctrZzuqaFaNSZ5yqTmqOP8qlji1rc8qRlGcYRjiSlu+4KIL23Le1/Q" +
+		"N+jS5h48tkUf9EOI6aj0oAZZIVpXmYTie3tOasRAWU1aRL0JNLAUqK8LoSqxHRCdIu4ObN2hqC80" +
+		"blwox9bK/mIGrvVPLe6f+kj62GqkkEBADQeph2jwvLYaJsnC9IeCJD8ip5m1qg9hXR/aQg8BaxUf" +
+		"wt37EN25j0fSiwcnjRsPlkwOw8j8uWmwszeR0k3uAI0SDb51tB8EJy0F/fKJyjxx+D+fEXHPaHZs" +
+		"z/OpCcsINNm11WJyGF7xG+FQ4sgrJg2+C1qQJHXlNhWUQ8Xt3QEmGxoiul5Mj9heDgIny4qjQ0yl" +
+		"UkA905ZlEowa6riBT3upfJVCSmgYUOuGP2LEHR11NbNKVbW26pW27aCQQEBNB7Uv+6rNkLZr07Jr" +
+		"ySwn2Y5OGzILsOonzOqCNEcKqOz2CrRoXlq66J1ZA40WhnuS+rMgzc9Y0yQaEx4BGiP/dmzZQRcK" +
+		"QRFaTykna9FOqa3klZmb168tFfwtnvVvYX74CAegm0y3kabEKWoIqmzW+N4oquldHIJTM+K+jPz2" +
+		"TNtSYisntkMqvxRSDVon2X5F9fCOatvSgayOkvXKdqKQNRGcTKFGtW0IVdHS+nf5YxI16/vIsi4I" +
+		"M+rfUd3OyvZIPbohi8nmYKSb0U+xXGBvr+1H6oIw465z0/scbUvjKpxHwtW5I+xzXhMtvpcat/yh" +
+		"LFFMLBSBkh6s5xtDpko7Gt+cGyk0gvtbXd7wpSc24s3NejOZJSoIh/HYatRz9Lie1FBz4PWkgrqJ" +
+		"BmzWQxCOTTri37B1B40obaOA+t6qSTWcW6aAmoQiUFaTFk/JOp02tB5X3aLJYdCvpWCDDNW897na" +
+		"3lQJE93pdkBZg4+S2QsWLd2fOpuGFov04kr24kqeWaPzDOpMctmYCDbrYVKjNKuwJYpG+YvTRQ6Y" +
+		"K6lE3yHWBZszqxM4rxqQfxzMZGktYdpanyRh4bry4u4FDYX28scktjrsm15ETYSZBTBvy/ZVniZh" +
+		"iHTg2JCDDQU5WOmpdJPNwOoMhBnjeQ4YzHM4t1PqJAmTLWtVkPqM7h0XYzcJA0Nd40aqxyRE/8b9" +
+		"5SBh4oy2Cmh30aww1cp2yGZUhq2izs9HLTpTys8aN5Xq+bxiZscbKA7IMfaNw5xhc76OBd1syexw" +
+		"iLqowyA4NSNuDY8J7Yoa16TGx1fbzvFvJ9cBjXJs3BgtVOdo4YOFrpdTUSlgfcUqDjBFurAXnaf1" +
+		"opPz6/McVTtrOV93lwXbZJ34n2po146zDUmNPFqrtmXdt3aYbARkQeKpue7l2EzCsZyv7Rkn6lBV" +
+		"A3adShhKjruDZafnm4oTS9VMdCCtPxqbo1CoseQnhaptO7caMS+YikbxU8hOWphOldtruykkENCo" +
+		"g5pKLa0h2B51W4hRSGoa9ldr3F94Y3jIUdKw9A3brnJLk9JNbodBd2hK6rG/JJlUs2gQNNQBQj+U" +
+		"0GVSmNgcUTFaIk/x+M0MymBAF0sS7fjpERdsPUJjdGZ/IiF1hmMVClhr3E1uaVKiM5tAwRL8FYfg" +
+		"5JFWh/bYdq+lKW1qpcZ0r6opUYKT+VvcPXO/8VJa01P7EHU7KTRVu47NpBquypZVCE4eybyv6q2r" +
+		"L1BrmrsijFuLjbhDDvXS4efCXZprF8xqAIyvFFQhIs3srNeONrl2G2Qu3si5drVL/anbpa55Q4gi" +
+		"M+doV9aNvTLOYirp0e3cpKheHLyvWs4SHDSKF+yXXZxjtnp1QW547qowYt/svZyz2jT1bZf5A7hF" +
+		"b17zq4ziJnMzgzaIz27fW86bSbjkTqGqFotCkT02EdNdznbfHP3fsVQKVaEVNNS7VOwVhebQSbYZ" +
+		"ha359kx0CwpDtb3Rl99v5vhmhm/L730jRFa/4/+7YIz+1ZV6lGoIlyb61Tx/H7yU+31oC/++Sm3i" +
+		"oDHM9YgpmVS7BrdUiqGOmRdmXX/io2IvktRYzhfmJaRpxAjhPCJGfbhJN5t8tMe+fWvwvdXgAPu3" +
+		"0KINBWidhBYHPgG3Cm2AffuuOiPft28NNSfk+3iUFByYWlmjVcWseNf57r7placKqr/b0wGmgFAo" +
+		"mSuzTIIQkZJY9SSZEVC5mcm2MvlGJssv6RhEQFQqp0kWkLAWiwgv+JjHKckko1k05jwDLAem17Y9" +
+		"NDiQiT59o2wApjlOhIznVBjkLpbojBDQNNQtxNULpJ5cHMPCbTJzHgqf9H1o7lmuFdNtCq06Rd23" +
+		"nFlOZkbZsshWENTGPZI7QibrTNa5L/EkjdAcLI7V6L7Hc7diusXNKAq1n/LUu5BGEiYzCyiNWLdd" +
+		"FoT5SptJMagO6qNqxdFaeVRVZFV3QSXrLlbLumN7686trHu0Ta+4NbhvFcllr+V3EnywIVXmzjTm" +
+		"ZplKd4gHQiZ+mOsDsdwH1Vq+Lhf90CQ4McnjNmmZcW9V31leI/21kA0oKOFEyHx5TFQ/Juo8Jqod" +
+		"E/V0C3TzdU+Y36yi1kZL6y96KKCavaTyxDyOCsyatrO/dPbXIb/rFvOHIuFDtZR12q1YoDtMeBVU" +
+		"sLun1gLfITEITnVSc3RCHpK3GotDM2p9EoyCUBtK9DS1i073VWBeu+vF4S48gsUOQPuBYiUVJ9pK" +
+		"5TC8xv8WJD+qXEG++O32CcGpTmqOTijwJcdG0R3iPon1pdYc/8qCYeHFxYugr/mxZofV67BElqD+" +
+		"LSY1JiarLgjGdlBYjYkWS9lVIMf4V+8xEBrpfFXJcfvQ9k/NXFUmOaKkSTSeFDj8lFjrJCd8xsK3" +
+		"tr+OLfW/DhgnjFt3RRv+KAjRRkcpf8UhONVJ08+J1TguFo/p3P7K23kSLuYBgjH2v5ImwTiWuFPS" +
+		"8JdCTP+bZJIaCwZ4lek28ig8+phsEY07lmh9kSo7QCmJQu8zGjVDVpg4zfckM47+FVaukqmGzb3E" +
+		"XB6ChxiLYj54BuRoZix6XdgiuIdKST8xTLLoY6vHgvqUz7XphJudizKjuizo5th6lfav6vF4tORo" +
+		"ZpP4UGP91CxSXzwD3phtG9IjW0pOdl5XM/GNFyBWxaIkGJiFTfe7IRzmOP45KqgccbxDB9kAeh9+" +
+		"QfYwVVufpO5H7Ft1UNOYTKVt0mLssnJYldULtXdN5wYeqmNrpZqUYMR0A4dX4gcxSNEIbTBWXGko" +
+		"gbKabABkVx6PtP06ME6FHheF1xx+JQOgA0Rk6QWtXdjYeum247JtL3oVUXgNUcqpS1oIdGABuKQo" +
+		"ekLH2yaQ1SSjzV13VTkFYYCAjS71+2Kr1P1FIcGgOjQjA0svCPko3xFusrt1n0ZaxooIqSsznif9" +
+		"wt/Ud6KRScwbwqqTEbKMTOds3pltq6C9u9TsaeFzoscB1NTRNN2apls7dG+Hvr/UcuVQJU2CsSJE" +
+		"6oubcNk2XPUpJoOBxTD/qzogpiNkAnaBy7rAZV3g6j4QOtNDhfbv34QQmf1y/C2/qL32/Lrp2mqD" +
+		"4FQnTT8n5FQ3hlhCQmqdmKY1reabQIlMD4RWmcMrc3hljlmZwytzzMrwMrr6ZXPF1a/esBwhz5Ik" +
+		"RglFoKwmLZ7yJds5icbI1FbeyYGi8skHoDpYXHSbN8w5MznRMlYIzywkGNF1Ve4WTXYHcbO4zQpW" +
+		"bN053Y68BKPyNDy3sKB7IN1vomCZv75T0NmGpGe2Kvxmr8FvnqX+ZnUU9AjZuFNlw8kS0EgsBZQs" +
+		"IWIF4f4yKcGpGbEywNadma9wA8XSBqbMFGbPniJbFxDJ+rOhE83sEpdwhmbWD3DVD2WN8pJg/EX4" +
+		"vaU0iVEB3zpYmBGs9q9t/q9v/K/vCfm1kn6p66tHTjTqBsZvvRgeH1ZK4hCc6qTm6MRy4jeTMuZA" +
+		"qMvWncLE5mgZHCeFuZ0zht4mXtvvJAmTPUV7YXJQI3JlDmenYKLTdkg8u3noymtDmWTxcw+7gqi3" +
+		"ZKmT1Fi0nPLADSUF3HCr5YkQCvY/3sMGsGKF/aGUp5iEoW8USKpLHvZarvwUh+BUJzVHpzjjrTMp" +
+		"sw5Xxnh/fSPEnWiF6tBI0W2W1WU3pQcxI3g/HhSW6PHwRI8HB7ZB1q+vx8Mk4g6Ery09nHAjyF6a" +
+		"cDIjK+8nmgs977Ked3nPu9jz8HsF1wezbsdJici8v0xKcPK4uCCLyBylrjOJyWDQCOZ8Xqk03CyE" +
+		"crUF02r3Fusxi9QF3YxbJIZh4cXFisaz0f363hCq/8VlTwNGmkuNAXPwK3AOfunAi519cYp/2Ywe" +
+		"byFRBgMmSO6jBj1KLh4kQ1aTaFhFhxvALOxZweXPCi4cWlc6OwXztVFhUKkO5szrUUMxxSQHEKBM" +
+		"ChOnK0s6NMDtAZXqwb46uqpUg9ZJTHnodmr/uzhfUSnBiJ42h7n0rvBV9Qi6KtaWAhohoY8poo8Z" +
+		"ptXOPnbVSivvCV/1zQrXt1W4viPTvlX0/SUlT9KMek9i8vvq3TgYmBfL+ctbUwoo56/elLr7SygC" +
+		"ZYPUlLKNfx3GVDSVsQaTMOD6iUBTzTJgL1vrDhr32KpUBzOxvEcqpTogUtcfyIZvERlo1LMw2VP7" +
+		"PKTG8AkmQU3qkLbcTcKKjUuoUmKFEvNFZqn9xDzg0GDLFLjbxenbEIiIyIt90GZwsuFzaQZjL8t2" +
+		"lCt3B8Qy5AHkiK04NJlekqtQI1d+E2AqtZtUg9ZJL5RVSDCoDs3IgBUHocJ1fyX9KN/CcGeOlmam" +
+		"0GgEu16LEGB6SUoONOmlOQGmuP+YzGyWFoOYhKFkCqwgMLwNk4Met2SPxwY0rqM0sqxV6mZ3OHa6" +
+		"ddyTI8BNw98tJZM01GCSho7mH+/EQcAuEkzCUGq3qxZqI8H3touOO/Jdan3p4fo9BknZx///Gz54" +
+		"xqiiqeS7TrJLEIpASTM2bOdvIjjF1j8U9U5XEJPBwGKYUboso0Fv9S85UHBhqnG6nY3EXKU6WIrX" +
+		"5hmxtKJfGgDglSlHS6Syyn5DdooNa8ucV3tvU5HJ9ySOBkMTk8HAUrCOg0qe5NFvmZTuydz1Q3Nz" +
+		"8dI1SgOtsq2kNIrtmEozFCPNxerDD4s4WVpVmqEEI26Qb4TOBFRA1RCPhSGZIbAv6J72HXVXZcGw" +
+		"8JKC1dCJhIpAidbCfSuVzSTKrp6Sz5mBmmoEQRsyoN0CjvTAIWDIdBO4hwIP6cCxQGUHoKhgWxc2" +
+		"7m+AevO19ibhqTFP5ch+PN97d7a8ovXvEN3EybjoD91DBMpq0uIpWcXL+gOpGb5lUron29Zfe6+f" +
+		"yJxTuSZoShn/QYPvKZvCvjVxgIkuUilgjdR5rhVbly02iklIZkhu4bAuQZeqQcKAUKtHb/P90QDF" +
+		"/bD4H/r8qHZogHrynC92z0bkSKzrkayyR/LaHnRSJY/g1ECvzERO5Hoy11PdNDdUpNpKYEFr6fov" +
+		"FUhoC7iU1EMnfioCJc3wez62K3WHEfdkJ3yu/U4VCUZMbH3xWeWJQ4YUJs54JgKXvDJGiFXVZJcg" +
+		"DBDM4EKeF9T4zHJVhwaKpTqoKQvCAFEpGAfBDNsRd5OcNsR2lOe4Xbqy1E+trWrcV+0jyjLUhRcr" +
+		"b2nRWv9Q2tQ0vQ6WHCc5RNroGP9ieFQ5IlIoxxA4bST8eBEAKZKfF9Ic4pPYOJBkjgPg2I0xS1YS" +
+		"I3b2ZGeJ5OeJxDNFwqvlCktjLlpbVS3soUEUigbZXibTbWTlP9IK6XZ0B7yZhJkprp9VaCSHkZT0" +
+		"YRwkIkrH9JYUJs54T3x1k4jUsP+ValINWAQLCYMKfqfPgL1xfptv4ekOOjm7JoWJMx6VtF/Hd1IH" +
+		"3Zv81r2BRkQNtpyvLS2ImsWtxgC4euFCj1VDFt9V3jIJxj3WPcmC08yJxRH38v+T9b7brrq+9+/z" +
+		"fSPnumxJW75F8APYbvfVn0HmTKDv3xhrkVdC+CMioqJNJU+iR8l6esrPVS31V3PfxtdTZY9hq3ad" +
+		"sccgfeMF0x4fMW9pEhvblTY14DOVthDrvpfcXyn+aGgnyz1zw7LN5Qybszg6II/ywCv8UKypi7fy" +
+		"IXXna4BDqzI3/Kh8IVS5mHxg0r+l/8dgyQrgfyfWTDvCbL9vOBiW9o64QTW4JHaO9hVBg+LVJQKS" +
+		"9Iof61zYI3I7torz09DLwVFzjx/9sJUTUnxF3t78qqDlLsGyFtlLinkbcPiFuKEmy+MfLZV5NsOP" +
+		"q1Oi+RU1LmgglQI29MwcMJKoRISG0vDTlIZ9UNS9lnnrSXhCG2IaNLXWPiO2VCpbg4x9c6BJBQI7" +
+		"DZO2tDLm74va/6O2H/2f/EcN/9H/484mMUO6pgZP5Mds+LzdKF2Ts8dAzB8Zn9pFTuLgyeKNuVmN" +
+		"Is96pHRNnolsIg9cyvOTo/K/2cSxWxvZa2uGntIbBXuz2c7CBWBuJ/fQOXfHueyKc/NNPb1FTxzx" +
+		"gMVmm6LZdOt/CsGpGbFi+OnWs62MPHv0LKJlGvcf2tLK7F5T7f9R24/erx91tus0yX/U8B/9N0Nr" +
+		"azOkpQB6ZkkOMHEszl13yKFbdGBsPMaqZ+trqiRxMlsXh+C0xHKnQHFCTYElLzg92j6xLzg9Pl50" +
+		"qZM0Pm4jbFEVHTCOLp0CxninGKfyibCe21P89LWqSKvzvONMvAVJkjCZGwilGepjA/nf+D8lUTRI" +
+		"9DVAcKqTpp8TSvnfiZ/vW1B3wP9Om+qQJEz2pDYJUuT+dA6rsnotyX0fLhpcY6ZgPjFPokc/Ub8S" +
+		"N5Mjpm7PFIsDTIdQUH1RxDtB7eokeQsTglMzQv2r5BCP2HH8m3aBmR6deQC7csVDYJXaM6tkSwQ7" +
+		"rA8NcJ2iy/GlPgUh7msCNAJ7r8Z1HFi05qpMChNnPLfLusAARNLngRCKXf6RYCynS2Yxh9a6o2Y7" +
+		"fq3OKUxsjqzKzurbZV4tvWTtGZVFHRhTVeKiVlHj2CGrdcbqva3OnsYHXKLPigVPaqV+2Mofa2Qd" +
+		"L+oHrpjq1Y83DSd8Q16QUONdTM7RkGryqGCAi0egOHpW/CaKK+7C1vpE6V0mMaEVNIs3d9ziqZ+I" +
+		"QRBgJtzgrp+CcJf7y26prmqjXiwPIM1D8KKy4TsX0v53xrueLzN67q9BXXjfXOUw9PGvn6WnVCMC" +
+		"rJMhwPS/c6sySY2aRWez9y4mECmVAipaSCUMTIWZ+5A8lxuaVzVpBkrLUNXzQDiUj+7ED6agH8l4" +
+		"+9loMfbXyhZhRjdEmdRfC5r5UlkRbslkop1XviQkqrRIoDRnG9U+HL0+Nn597BwxwIocnmNPfXFt" +
+		"j1t0X034lWpGuCH1N49gGP6O//W8+vfnrPpX89KXL+Uv7jf+5d3Gv3iy8tduOf5F1n/Rk/9GOmFu" +
+		"8Jfz6r/zltPf5YbTXxuH/h6lU6i9Y7hQCYN0CqgstJdMAfN5JOmT1IhZ7V+fvf61yevf6097/Gmv" +
+		"P+1lb3m+/C1PpXQRsK0vvOX50uPmxe/vvex7ewOSmAwGjeA+atDoIAipIIL1CFaNwFoEr0RgVgFH" +
+		"02sL5UuhnysxkrBwm2zJ9XvNACu0fL1YfJwZZIlREWjKj44QGeHH2RTURxnvsr5s4evL170q2TY9" +
+		"n0yqS11fGzZMd81L8S0I9SOlToyiRe+FERhTKahSnIdJGFizt1XszTZ/e/1sF7/Vjt2cEsLSTTbC" +
+		"16RUp2Ze33QZWe4psY1T2VWor6KeoVTEu0HbnbpbNT3WBL9sSTDAYrgJ+60kpoIvPeWx3X8Q9dz1" +
+		"K8gGi80qv0v6oXRNnj4QVgUr0w1HKSYlONVJzXBJAZv+SI4BTBrm/09bMeMzay98fea15edriw4S" +
+		"HJmxMhK8EULplk0/6dgvis4+SVS3QxAGCGuiY2mi46eJDknshkqtGy4OTAaV9a0BoRqrINzYD/SN" +
+		"1mMS62NK/VHaolnmqjGCSV8lBakrt6l4OiqMort5UnytvnGjeEEykzdCFvx2c6PQ1TQvfcn1xZdc" +
+		"VWaT0yJ1UjO0DA/JZjM3d8J+qEcUk4zxxBFnkHpg99TDdlg9TLz4gsqirFHMqtPH3m19zXdbgfgA" +
+		"LxXN4CMIA0SGqBR0gWA+H9sp34RQFa35NXBkKP9f0FAHONkeGlaEEihN11Jsvc4Ly3ResvUXhZgM" +
+		"BuZgKZTMCPkRikBJM5Pctik15iaSTapB03FB5Ev04KCLYFE9QPeuSL5UHAhDeTog4YFvNhjR+BJ8" +
+		"r3MyI9AjAOlyZL8QOdhzSTAiI7avVNdZRWtfqZ4Ni0N8t3h+dXOQxse3XvUSaHpRQE0bhapvSRSq" +
+		"Jt396AopbXeTOCyBLNu4mUIwT2xTSo9Yxai9Jgn9PTvonenssFAyHx4JktBlErtMYpdJ1mXyluNz" +
+		"ywtqvO44B1mwtZVlasxQWa31Jt0kDOMncylhwHXtAO0Oyl8NUCTnWjpBf+kM/TXaJW69nUPebiXp" +
+		"5YfjcMHZK0oy4TqyGwS/qiFYO1R8MESSR6fPo08HTIIGsMtFm+DFB99KN0rX5OlMoXGxYwoH0Bjt" +
+		"U3x6ozJtz9eCdGKRCjSp2DXATCLicyCQ08KkO96CAVyAaF5sFpuKRF3gq8IMGp81uJUsDrCH+DQJ" +
+		"AxyQPc47kSedaGmeSUwGA4thqZmfVn5FjlfRhquYfX9kc7bicpzQCOZyUZgB8e1I+BbvK8Lj0IBr" +
+		"cY0a8Iw89TsvEcz3SGULDojHykYCTZK7A0xjPlwnwYizeuRkJdo8JdoUJR5QbcoVj2+V+3uShMmW" +
+		"RJVGxCpUR5gv2/zLtl6HrIi5S9SpSeTUZMhqktEsCXOVWBHNnlmtXyrQAaIjpF/nUmVHOllyPwir" +
+		"xbQk+uB7MiP6FtMkGr9bbH1BmrUbqL3bJUHsL9kmqGO3HY10nbXuNpR0H0q61bgj748G8PrY8frx" +
+		"w5Uzl/jhwfoZWtkSQvUuXFk9ALEdoSq6+wt3fcGCJkhGM62CDl3l/rbJFS8iiqYNvBxXaJ2EJMGv" +
+		"yEvQS4siGrxKzQ4SHFmosqYSs6TtLg6I2nntSoKRIccYEo29Wm5AmHsVcVDT88wUOCYHaQTyLBqg" +
+		"1rzU0hdBX7ja0teufTbrE1mfwwJowuU4gCYVhwbbi5ehhoh7RE6DDaeZRRx2VJDgYG9SO8KccKID" +
+		"SJhYF5yuVkTi2WtcF1EEymrS4iktKdS+JW2148veoMNHqYK3HowakJbEHQtiHARz1wGiVPwuBQEO" +
+		"/VZxhJba7zjVkhg/bi8/ZeWwKquXF3Yv+3/Y3OrZWJX7OYGxlrNkk9Pi+SlagmxQKS33B+6ykOiF" +
+		"tdpGlrcddyC6RtswLxczzlI7ngkbSZjsvomD4sAL0g75ahFtnDQk/Cjp+lGXNL0ck2j84nKHRKPm" +
+		"oPXrPGSHZEMqspbdDmBU6EyB4v9DridP2QAJjkx/2ml8EO6Z8MA9uVknd8tph8ppLcQ9joIw8y4f" +
+		"SRSu4wYSiSls/P6IWSBo/mpwi/8gOb4rSHA055uN/F8s+CUEJ4+0FLyNCEAs3bGtX2uqrzfUd16A" +
+		"ly9b4otTVPni8y4ERD3xo0sTYeYJxW60li9PJ18/m3ytQDtAvv9n70i+yhc3BFVytla+O440bpKu" +
+		"AoJE8iPKXSbRaGcdEIz4vgsBJobdxz7jJcLq1uOOLMcWHWPzKu6i1e3I2i/QqlVCmFAdGgn5VVy3" +
+		"6+OoEQYKJvjiN1iU0kVgG1b54jjHI6xXjfKmQLmRa66V4KEnioqL5yFgTYmC6XgVq4Di/RJlkJXP" +
+		"i5QacccUr1u/7HVrQHVoJMtH34N94eeBVFB9U/D2nuLTyBJnbs9HKAJlMjktloZHYY24h1Sx+ujF" +
+		"n9Nvr1rOQCEGM0ZdzxtC/jS78UVCt5+vKb/4cvILLye/6vlEiPPSABZwPhGPrT8zbvd3fZNLxYjW" +
+		"D1m9Ts75TpvinR6LMeBkd1MpwYjO1gX5jiykXQlNxV3cXB08zoBZZw0wfPvYjYFbX9wbISO7ReqQ" +
+		"dmo3PnlL6+TNA/72WHvpSH12DmcAdehy6SZ0jAYqEVGYhqO3hRjkVd7O54IeLcHIyuYJ4bS5GV8o" +
+		"hbxjHu4sYdWWBHdOue3VU4BZ4Dh6yqU1vNB9eBl76eF18bC7sMQGsv3R1Xn6JJGgJuQIqYYrIcT0" +
+		"a9Aw38e/Xv3EOxYEaI+L9/dtu0w2gASTMFCXTOG65aOULjITVYjWVUahQKyl1GEt4kmjCkTj2QGA" +
+		"GTB7NA2B3gUhlbObhAEnAQJMGo4giAZb3U3Cqr+/AEkDDhsjGs+aQXf6380fvm+0MQARfEvUyIxZ" +
+		"HIKTR6K1gqRC0LPmkALJU6IRElbZkgJU+3kvR5p1dkKg6Uh2fTK0LofDIWHy6sGUvZa3LBgWXlw8" +
+		"5TceMilMbI7m2+t2f0+SMPnHBSm/esVCcFNyMNNFiFiF7Khm5ssji62XUDLbj4efPAXhMGKVcZRa" +
+		"Tai19Y1C1Y8gDBCwIbdx7uYFHi/uVDSV5jK050sDdcRPaA+JmP7H5/JDWiSvaAfhfosSjjdQY0o9" +
+		"4p6vjisx661D8C0wcp2kDvuGUJWsgZ7Wh0wUEgyqQyMx77wsWKMmk8JET/dvpkTd85ZwpCiJERN0" +
+		"fn2czBT46oIRou3oJ8HIKnADrPrSKaDqR/fyXRalr5yuqfmmuuZ5SHAymyW8bKMXCZ9SKTaTZhfK" +
+		"aDJvk5qjucFylNYdJDhauYPhOcKEXZ6w9hQyGFSHZmSA3JLkTmHOuIhWShdBE73KiXVtjmHhxYVZ" +
+		"x/tG0cWhgfDa+KCSTYqBxbSo9NZAO1XCkguVqCWWXAzJExYGofS2T0g5wozvKxFg0vRJA+aOVzMg" +
+		"O8ByTwg1TdFADerFBunMpVtqaxA0Y9e0H/jwNqYCHmo5ogp2e1PJLG2P/bWyReDJuOIoZWzpHrdM" +
+		"0VSmrZrk+wGmXIaJJBT04VpEPjTxZybzkYmSOnNtafSlpSSPpL/+pFTcj63He1FC1odlfXjWIFTt" +
+		"YDEHqngk2SPu6KjCnbXjJ7dUtk7oJU8yr7+Q+GVc4DJQrGpzXcJEt3rqy2qduNjGkWOm/ipzi9oH" +
+		"9zPhZ1ZJ576gOXRxCE4eyeLO5JUmukepk2i8b61PkjDZs7vjsykD+5aF7QMFx/BUdING2RiK8l2q" +
+		"y3QZVYPWSchUj//Md+gJTQnhFyEVTaE3iKJ9j1KBu16rn5+44B3AGR0JLojSzc5PsTSoID7AFXGH" +
+		"M/LeJp/A2QM4lV5gEpPdZMmTPLKKLOhmgpejG8SKNqv8AAmOcTHDEy8kEuzwNu1yThPVIeICSO8U" +
+		"+H2CmN80si3er5LEASZWQQfdzHkOrlcjfoRABaz05eLNiCY5+aWsQWcziQpSx+VHPlvskVRi0CnO" +
+		"Mf5fJVNIIKCoQSMBZ9O4E6UCVnoNZURox8O4U/XUUXHWQD/kOFRTidmk2sv9XkyqoW4vFbqjtSj9" +
+		"1wpjW/jTTipN18OhNRtZWhtNwP0yBH401bGR/dSkiqUZhpFf1+7Q+a0LQgOpuJedQlXRIGioBmw0" +
+		"kqAu3bK3OJvo9hNjlMpgYDF0Pm18Im1p8nAdI7GuOo1/sXgm/j04tQFolD9Lin+xUBuyGfTXJBhh" +
+		"oBLFZH85wDQa8B/7/z8EQcOq4XDiuQtfJ1cRKKvJRqDUBO9t/HdByJmuo/wo9Udpi8asul8ev9HY" +
+		"b3z3QwWtEEgggRelRmFic6SvdIRSTapLzAhVSdK3RCjZQePwXhbklialyzi23VBT9PuLAt+PdbRY" +
+		"CQbVweMMokfiaAKmywgHCvlJ0m+uGlm0JvlqcNtScND4LyrzRWm846/AXL+s29f0ocXxfys3CjUF" +
+		"DbDvI29fv2PQX9yChJuG8NJCIwrQbvzWRUMj1N2LpUPvyLwSz41vneHaO88qu8kG0JMpwZL4CfWN" +
+		"me877hrsyAUTwbdN+962Guatt63fvGs9JOu981u8Sihktx8hfNuN7DfuYw8BD1W0STJ+fOUd87hD" +
+		"2ifBhZuQseiAgKjEMnBuGpJVytmqlLPXhOeqN5a/YK3d2wrsuOZ/R93Gg9uIkwvktDAbnnLeds55" +
+		"R2whv8BPkDCxLjhdZSLzqR0h03Y3q6cG6gEdQzikBCOm4cA+gLVSSJejNQ9u10Kq4SMIA0SlYBwE" +
+		"S+EPiQEQ87ko4KJLNt5YofE+Nw0UUxi3+eul/EYogbKahCsmJgOgJ4T37XBghIr8RojssqXWec24" +
+		"QHxfSHkx3RWwzSqDQXVwHwNkyC28/k/vsEFKcKqTmuGSAudZsELStc6QEoyYImHF8/vinVACTVlM" +
+		"qmGsgZc+ScJk5gdFvf0r3o5qxvflIGHgN3iN3KhZpu2mgd4GT/aTaEl/pClpA9lyUZXZ5LQwwR33" +
+		"whObMFkDKrQOcN90QaIzJ7Rl2jQWT5GSvVaStic+SU6Ao4ZRq/MWhAFCY6wkfSdDBaITF0UoqafG" +
+		"74zGWAqJWHOHM26bQkpwmrbqbkw3UKfUQABi8E4NAA2knw7GQ4/E3wNL9nNehEQPZNS/senWa/Mc" +
+		"YWsvhRe+rZ74dXaV1WQDMFe059HwTC4xB4yDKqvJRqBkeq1rF4QBQj06k/aXtVV/ebN0y882DhvU" +
+		"LYsuNTuky1G9zuerS50kYeHVXhd3/ljLj7pEsxpQ1P4RhAHC3pyYTC/aqjlaVp/pcUFCtejYXibT" +
+		"ZWTHw3cEar2Q74VyLmZ6MZcLwxG+uZ5k+ycU+nlco+aY0oJmpkR3VGidlC4CKybbP7UEDYLLarIB" +
+		"UD18wE5FoHzZMTaV/8TNpC8/DodGadGqyhshssCX2VRiA7hOIPHbLkPadsgb4kDIhDw6RI5oFmsM" +
+		"OdgYcnhjyGH584ehjdI1eTqXj9RJNFLgCY6RhIXryou7l/3FQx9F2ejOGlyYhjnCD1vUWUy3nDpj" +
+		"kfYjFIHStxFdWfSBjAq42EMZJfPoJtXAAVR82AS1bhj/yYJh4TaZpQzFWvfQHM7+oqDL2VGRrwbI" +
+		"78u474iJd0EYICoF4yCQAAMknxAnPvSFbATdDnvgm/gcN/mD3IQHoylqBkEQVgrEiAl9UmkEI7NF" +
+		"Chb2fJmQYFAN3IUp+ZxLwarEXRN3DbROO4vYrZDdunzcMWtJvPxIvPxIdvmhwEQ8d8bdEyPUGiAq" +
+		"P/Eiu1EDeix1LKczghGp3qxqfltds7V+Zm5vLe/QAEvMCA3Ew5IE4zuX7gATPsNIoAlNwJOryrhi" +
+		"687WBHYCVkDFOIhEnLL1omSEuop5QMdvhDgiXr8WDKkGLYrn3Ojn3DjPudHOuQqaGFvW2WG69RK2" +
+		"h53TfHV34qQ6jQvoVDhnLPhlA8hpQQrFZnABLErtooGiWvU9HxXimeCFn1Q4Myw2MyzaP/T3pxJ+" +
+		"WyqBD0EYIBDBNIeZOcYX+20apXQRuMNsgdwARKlVz92Fs6TCSVGxSZH2naJjUekvvSAmpMvRCsAI" +
+		"hV5WOAEqti/KeX9RSDCoDs3IIHqkbdN5f82stPTz+aJglk/3wLBddCGZCg42xNadrfZDuQhq+Gpw" +
+		"L9v9NQn148JNAkyowhelfFkCR2i8be2vWqfyPXS9BQGmEZ6YZ5yBU2oFzerUj+emU6O1rFPTnU8N" +
+		"nnqxRIAdXWzIapIRqNSA9nJIlyNrqYuu0okTy8kzy8lzyWknk5Ni1wBHkEoJRuZxsysIRYC+5ggJ" +
+		"Qyo1OMC0M6udme9xAhrHRmj8MELSH/dI/G2PZMtWE37ZI+lDsHTCpKPvqdnVAyESVUtUkahyg6pt" +
+		"jr5Fl05sBxqSL7kBULNmNwoHagI160Fy8i0VAPx5t2TA8NZ/hMhm17PMzkvZnRepu12k7nZtuuMc" +
+		"tOtEeYRw43rRAXYdMLlNhXm4wiit2wCrTEpH+QtQvWLfAFBSHbtk3yqUjmpjWT7ktLDUiqX4CpWS" +
+		"eeFmu4L5JmZvVaqdv8juSDPFFz/F5WjRzOY6cFG6b9hWDI07LyB3u4DceQG52wXkgPMwqTF+N8xx" +
+		"mHViu/Ml5iHRYmK/yLzba8w7XmMeYtRNdE/yRdYhmc7WFSixEez11R2vrw6BLODXmUVnDrhSHGCp" +
+		"tAVieEo2qYa0/VUhCAMEouSigIqcVGrZsd5fFBIMGoHO9Y2QDm83q18XhFBeCIWLMQZHi6LQYrlJ" +
+		"saPH6XLQnQtBd1sCumPx516U+ZEQgDZt0Q+C7DpG7Tp074U5vIUX5I70wUXGoEpB1QSXlEy2iI5j" +
+		"3JBmrcCbNXtbxWylyl5sS97qyGO72OsWILrYwV7K/UUhwcAi6Fv0ZLsXnQWooCumaADUpYxd4NCM" +
+		"mA8+CqtgVcaUYS/1ubWbjvuTNUJ3uZdkk0EQ8+VkcMepU0WgpBlN5qdSJU2Lc+p+KuM8qRJez2cS" +
+		"k8GgOjQjA+aJ3n4+uY36uzsjVEUz7sDOetnYr2SpdPTP2g8yx/psQ3tGn8gbHtJABgOLoevjRKhm" +
+		"tWBikO2uZNa7KnmLCSEio6WPSWPfgvAW+0KMChB45T/jzqUKfc6S+WWbbF+2yZttx1s38tAg1O1Z" +
+		"8oKaCea4mbf9IBkRzWK5ctKrgHbMdndQob0c3M8mOxn3GbPeRRyhXqcR1FQFYYCA7YGQxgfL0p2d" +
+		"OYpnvas+Qla4ejNU7MaKZqgJITNL5p7c3fZJtUF1ILKApie2bL8kCagOzciA2fH3lAAo6t8/NqX+" +
+		"sFKW7Y1QAmU12QDRLMxB+PUiBTax2H4HWDSjVEMuOMr9somQLscGgvgnFIGSZqTVex2Z9zoy73VA" +
+		"Tou7Ik+775FxlyPHIAi1CrjRMYRuqT4wHSGyw48YQzZANEv0FMyeB3C0AzjaARz9AMYT2CG0bCwr" +
+		"hlT7oYEgDBCVAg7IkMcBL/VVdpNw4AEV/YCyC/qMC/qsF/Q5ahR6WrSeFq1bRe9WEdnqCz/Z3vcB" +
+		"VAdzYRK8AJTHVXm26+wBqFmxt3aU2sshXY7sakXf6sl6ZZ7LUwMMe3bJnfVcnnUVTMYCmFxwroaU" +
+		"YER/nsGzXqVn/kq0ymqS0dEsVl1esmdc2g+htSuI7Obf3R9fvVCwLcIwVEpHxD+hCJSIZa8vOloV" +
+		"bkRF/pUVxOBVbLvqW5JJCU51UjNcUsDWETJZZ6JuSbolQN3Vm2gb3X2ju210nxuN06C+G5LxZkge" +
+		"1+W58NdTCRIcWaJyI+W3A02xBgeaeO3uSDMW1hjJynXlxd2rUDbPHS9h5vINeMXPqBHL4QATS3rY" +
+		"Zj58ex741IoRjXh6aAQjm/hrLfz1dv1aHXETNPOFzsyXOIcst5hkwbBwm2wZHal8HSQ4rvFIxvBF" +
+		"J3yxgGDeDd8ZyPpmZ90n0XjG7gDT6CfnTQO9m5ft90ezvtmX9YcoR6gfJyQIY5CAQ/dpQ7f9WmXm" +
+		"m3jZXrcD0CVNnwtSI9TGMYdzy2xzy2z3YDInmdkmmdlmlNlnlBkzymwzyqy3ZcqfVsZJueCcXHhq" +
+		"hWwKGh4a6DZDCsxIhJFKZesAFKKk+R3QGn7BYJC+aVm2MYSVTZNX5FaZpI742/jXPWH3SSHHthS9" +
+		"S1R4j0il6VoNu2U0wPxZL9w3Krca9eHOgP+d0idp7Aju4/+uGd+jf6ZXOYlDM2Jcoohtd+qT4n1y" +
+		"M+wyyY32Cgw1mRQmTm9s750faVZAJBbGKUQxE1yK3Esue7z/ap7F/LmdRVnjWl+5uUKXY+uvSYw+" +
+		"Sordcn1rgC16S6dokJeYhIGbqP1ypAibBqrqwDpEptBq8ulrCforOiUU3AMgNNI/cQhOHskcyq4Z" +
+		"DjO+k1semwbDMgbk8uCXiBVScoADB/Dy6HzKUh5dMoUEg+rQSCj/gTtaBdO3IRrKfAlviZUH9+9D" +
+		"b2iVB+9nlUfHaxgDdLZCQNxw5e0Kv1sxIDGCmejtixKfWShUJg20dA7kJer2xgqHKlql/ytnzSYl" +
+		"ONVJ7oZsFIdtFIFX/FUEygYJ56SjSMIHGFXq7k7qrKdZv4sBcEttJGYUzBLpEz1ZnE6Ro4qS+6tF" +
+		"6ylYUEpQB2QiSCZ7ppBq0JpTJ3HptXNzviaxTkPpi0t3Fx9HoMmkMNHT/Zv5eV29crnE4ACThvEe" +
+		"u0kvzRV3kWDEQgaWOsk9y7liuhbFKjNUhzPoZqF2MfDlgsJHyirRqjFEivk+DVUJE5vh5WBVDp41" +
+		"qxW8RoGClU/lf2eUhf/JymFVVi8raWgogoajsVsp4VSSYt9ilUmNWOOCXkX8WGJJ3mRFA1SmsKCC" +
+		"cV2lJisaIujxLg5qOv3UmE6cGVUirgsFVbb46R3jw7Np+jAMEPl+EbpJ+GOPfnjgfZAxlhLZD2kV" +
+		"PEVSkS4C22C/VanFQU2iwa3gE7tATSZhg9QVCGUXzGFUwpOfHym71IafCHSUsCisH7VGlu4wTTOh" +
+		"vbtYdvshQiN44zslBJi+rDCehQ94bd3BTKI9Zs/bfjs5U3Gl/yiaIhe+zkkcLnn867iLH9wqeesb" +
+		"BdWYNwc1PTXAtRMBdqyyJ8D0wBELgIkhzoAAM1VpKwoUW+/pOM1ozay9JWfpFOqAjw4WXWtd9Kf1" +
+		"dgeN4I9LDcBIBUjTxs6WEZTHze5vFG24goG/cLAvCF4IhSJQVpONQIlNAGjRpb8aVsc5NmfzjoyP" +
+		"VvRIeYz/FzrCkN1kvE/a0sJdfpTwo7VVQ8FTLdlUDlHgywimspcqk6YRNSo1PlbitJSaeuMKhTel" +
+		"fGWDLWLAGoZy1C0Xk5psBPgSqgpV+ZogwU1tITEeudYQA0UynT1CN6oKlqIRYIenuJ8WiGxR8/p8" +
+		"6bSmFnuTDqjpqiQKteORoEqkxQ9JD2kF1PKlkEDQ5RlG6Zq8pEHrDYJFvbQIPfw5F8WdHBXq1qNQ" +
+		"BErmYxvSTR/ayA6AZ9nlHP//35c/PVR4OV3sKhrrOGwZh6/iKFjEMcTI8XxroJakwWPKdBlxW8+U" +
+		"VkiXozvgc6AEmDTUef6ZAx51GDGK94McaRbapJq0CN1/J38cj8A4q2EOs4o5mMwey+99OUpYFM9X" +
+		"NaYwgR+od6SZX+RwVPOhwb1kk7CiLB6Fpx2FJ4/C84CXVlk96l0oAmWDZIIabG+DEEtnFMLjhb9l" +
+		"PSQ6EeYCp80EznqcC7AcDRFsXAbgCDMsKJGpGkvSIfzsL7vYO8flWeuTpK6crlXLq9Km8mWHmZpF" +
+		"VnwYgGyZeUa8wDJ0z7wXrxMuss7+OravA02z4KXQr7Q+SerK6Vq1vCpL6iVbWbbnIzlWh2Eaq+7i" +
+		"M09ajDrqfaJ0ihE1NgCDmd6ILbgHa0PWd2SkmzaSXjfZsslh5HuTxzb+74LwXvWnQRwbOEA8uOR0" +
+		"MuP1VqiCEA4dI43oVimomtCv4RmZ8Yx9Eo1YKG0kYbJusSnmXbxa/I2ogZcKvW01JOuEo3QAjpWD" +
+		"V9pD6tFBSJejOQZBGCB0iCUwnuJbqtY+RG56YBFhvHR7bM+X9B4HvTXLXYMdGWMlF2QDMPWuqm5d" +
+		"DqI54S15yGDQCEyGKdSx5SjJJFzeCJnSmlaBDhCZHlmqSUZYElU1sgrCAIGi7dx/4FcJVMQbDSZ0" +
+		"t1TmaA81jw1NWVlLPik+/EnxYa9hDUhiMhhUh2ZkMJOxIEZAs03wGumsAvK1fd8rN1MADIWC2bfZ" +
+		"CI3VarYJeDH/2GrH/lW3tiMsId4nYT44NDh1RuIF32PTDLsghOUlFFB5SNgPHx4bjjpcnamsJqeF" +
+		"rdA7G5LXb8fWz7SZ1JhPzBRI/c0ITWO6LzqNVuBC3MWoa8Toc/URyoZX98kXqZrkqWxRzLkSkC2A" +
+		"Jgjkga2UjZ+FMVqt0iZbbhUzXSNZ+MeFSd22x9xBGnPXAPtE/Pa6Y5vcF5xmuwn2oy7RsmBYeHFh" +
+		"1ewOO8jje5y+ndHM1CIeFHx+AL47zGznralVa67GPh3datXGYgGjGW1kSS/fHpwLAPQq5wSr1Rzj" +
+		"9bv0B79Jf9j36Adwuzi0KDBBch8YEPbtnmSSG/HZkMnpWjVz6+IQnDzSCuz41IwR49lI3Zqo181k" +
+		"IpRd3I2K+ZZ2L4f8KD9R8b5qrHw90WX57RyjMLE5WuX9+zmTF6ePrJwu10pdUBNoKXJ/UaAoub+8" +
+		"55Cn/ZrE2sj9ldgDiOlalDzj7MbLoiAKRQV8CNmIUUwQ3DeahSexQSzRvlkPRO3C3GHG9DDhsW3G" +
+		"nYdJGvQOGsFMunJ2kEYlmQ/GXGv9R2lTQxr4plvMYZLUldtUuE1QzI6LioH3F49Boqx2qT9KWzTP" +
+		"VjXG4FUEIxotR8vM8/Es7DSRMAiOhfBSJ7mRCVD1HGzws433DfeN5gbn0Pk148mIUFPdEW69/Cvb" +
+		"wjha6h7v+CaPsSzYwCq+GmB7v6jTl1X6skY6Y3ltz3zuDmqSKhRU2SlI2llfUvWxCcHi8QTleMXM" +
+		"wYhE418KzViHE73vfuAlioNz5sgZe7QZe7zjCIk+c+dbFgdOFfF+JjEJg7pjZ0TuiyG/cptkRvVF" +
+		"BXSaHLHgZ0iWglkzxhFd2HZwYdth69gOX8d22Dq2A2vUDqxRO+KzdAq16vw/opPjg9ZH5Aw32gQ3" +
+		"vn2D2eOiTlGjHngx4bOEhAZChtalY0p4MGtELwoMCwA1ZQ303Z4hEwWaHx+bPGIOSUwGA4vhJii1" +
+		"bojK4DbOETOScy24UbomZ4+h6BRQrRAvInNaSIJXNpNU6ZM80uAySYPVFioH2Jjto1CuYM4Ihq/m" +
+		"VHn+BzRQokCD1c2apFrjVjZuRVLZKGiEiJ1CggOT8+6/krVrjd3kuaVJmGe60n+UeP9RlwwwRaOS" +
+		"FnYfTLFiLfeXdFkQZk2ipXExAUFj4c8t67zGBmDANm59Kpej9RiW1R9sSQWY2EDYpG4lubljr/WO" +
+		"0Ragvh8NBuL7CSp4hjG0WAkGZkFnBbVumC4j1jzZKzNKiIV3RzRrnOwyLc3rtLRcqCW/Ukt+qabf" +
+		"Gjj4sYEhHyKdVMpCFu/JNB+RTAE166MAgtSJrTtj+ySHUh3QPcAkQX/De9oqAmU12QiUrBrG4cRB" +
+		"Fgv2VFDtFMwvs80UFpvllq3NlC7SjEUcMjXFo5XUWPR1C0gY0F+KdYxiHaP43i66ahhSglMzYv8p" +
+		"/skt8kyt1T3rU0wGg0ag82ld55xd51y6zmldp8SEEGcYrodRWU3SD0mxREYFbwsaqlnPdkW/L3Pg" +
+		"XR8VuHmn5FGHlCOJK7WV/KNYWrv1R0b6FO3SomTUPD8FP7mjGCiryUagjBbDlh7E47PwtfUBl4pW" +
+		"qklm3Lh8GNgccPezZP+6JxkHhLMeM/pU4+ADjcOeZRx4jHHoM+URMpZThmKvPilZjfny04Hnz0Og" +
+		"gISQWdgeLNiDaL2Sb5hlkBjFJFkqziGGjMagDaDJMsd+Q4tZe3lrsa3qhvtdAJ5jio7jlgRjuSes" +
+		"dcsmEdER0ruzeWq35qndm4fP5wgsjBegALPRveFbro5Sf5TVy3NWzWLYY0F4Mcg06yNDE/Oax0Lt" +
+		"7P5U8CEQYJnZ+oEw2K5Xp8J66FbphthUofgvRRKz2/k4gCRhMrex+1OCgfCFZt6shg8u3XZL993C" +
+		"Ze7HXOZ+2EvyB9a7H+VsWzLZAEIBtSNEqaelPrU2dYuZQgIBDoPgQbUjhO2bKJjqmyyV/jzvUfXU" +
+		"WnlqrViqAMlo89dzKT4/r4IXjQNl4118VaqDpWAWvKWoEj8uOxkRyJBZiSXT5sHT84MPz48anwgx" +
+		"KNb54Xzn1qfCfVLtEyODYEAZMT/5EHhyc+U9yYxWlA+1isx6jrpgK4KHTOXpdkgsF1JkWtywAwSn" +
+		"Oqk5OnkR7zTzZq265dItk+55dE+IHYBZbOUstMb+j4J59H9SHczFfZkZVw0TNLIgAjVDR6/W06t3" +
+		"9Wp9/bwLRaBEw553exIxsFKajt1wcoZVTwhNeCE937Y/zqCBojb/iUdY5y6wIYtzLzmqHKnPrMEb" +
+		"Ifb/ad91OfAZwCHUVyMxozkrb90C0uVotakZIerA1bYH1toe/ETBYZ8oOM4Kc0fIyG6R2oJn3zTM" +
+		"8W0SZvSBOX1aZk8+eTq73lIZ9byeaWvNQVuHA8K12xmEpHFY1fy/P+1/4//cbgh1qCAEpzqpOTpp" +
+		"MQNhQbJQS3ZgBIQuNIWU6tS6YbqM0OyDEwXrlLxMnTD8j5eJQxZkueue/N/JL+0RJDhaBjnYNlWh" +
+		"CJTM0p4sKlZK01ltzw7X6v87NxfMDtNxhSjViek5R1dCqbB3L7pTdOaHDkLwOGbHE9r/zu2L8E3B" +
+		"xN+3VIdG8iRvWlToMzIVEgyqg7lEt9km2RuoINsMe1KmZBZ6JQorJ1meyctJnjjRsrO9Qema7EXK" +
+		"U8QhOFlWg+nIT0UrutSXdxzd1XL6J9XB4wysjHR/mZTg1IwWN92AeEOoDjhaor4rPySMeq/vf2fE" +
+		"C0yE4NSMmPVAJMidgt6Z/Shm6z12slFCRjg0Ig+NyO4frdfHulOYw84OEe07JEB3Z/oddcL6O4Jl" +
+		"UcUrX7EwbxCzstpXr2plVdEdoh130Y676MddnMdd9OMu6nFXY93ur+1PG7n0P61vNw30RSqV1WQD" +
+		"aHICTFzODbInp9RkUphYF2yTJ1ohSSgCpc7HSV0WbMZiDpXSYrh5yfNGnTX6ft96cYDpjRBF81lI" +
+		"x+q1vuENIUg1PB4I4Y43+yAZzfR6U6Vvyk9ByE8+OaLKT1tONDBA4CdgjehmotcTlX4+ku8FvAkI" +
+		"yVyePBc4pssUZBWZJLLNnuZuu/yZN11vD+oOyWxdHIKTJe2WXZ61RJwmjwEhKsET5YDEaIxzXa86" +
+		"Oi86ul1zDEjuAIGMKr5gZ8QoCr1dTzCTPoQzUuNbEAYI+6DRZHrpNJJAEwSrmLZ7j10WbODn7ubn" +
+		"7mZYUOrs+KDF2BytGLGm4tGQzAdNaodCeiNkZm86v7k9yav9tg6QcBSggZJVLc0GMaabZZC4vxUY" +
+		"B/HdandIl6PG8qDGgoO+7TFvKnepJiU4TVtthqwAMV2mwOFAyDwOCeIwTc3I8jpY0uHZeIzq+S4U" +
+		"VF8UEgyqQXPoJOY1qORJcEQGYUslC7EGh2UQpi4rr47SFsWKDLjCHHS7TNIgYtIM1SQNnot1lhzK" +
+		"4+Gg5yuyRZ/dgaYjHjLJjCXmviDNJnpMaUGYtWvnfxuFGo8XwiuVe7nrI9hFjT/aiRQ4Bg/29IPH" +
+		"05G41QePrMN6ukZXXrcPOmvrk9S5okNU6xDV+kHlnWkSE/K3IEB2YFfvKFWnUn2roeyQJxMKQpTB" +
+		"fFnvygJtr/i6rIH1jMjguf0Th+BUJzVHJ6uWyTdCprKxpb5t897cuLdvWprQTdKAO2fdvlBDsEhE" +
+		"2RZXa8vqTemVqigTCecJFENR5eiKlxMg4WkDbO1JTAYDuqTp4yMOh7Paz8MkDB8/YOtHKAKlZwRk" +
+		"Ahbz8UiLwo5s7FO2N5t1kxZV1w3EeaTPeQY3uAsexoLOJgsitdi+6cszZdXY2EqtGy4OF+QuuU+S" +
+		"MJkVhcJUzBM/0WrEOBMxsJ6Nk0QQbmyZhnvB0MS8eJvX2OxfyzHeKXDRrYhSra7ceUNu9TKU4FQn" +
+		"tYnuueRj9y5WzZMQmlfJXrSanK6pxS3/KEtyIMLT6nwKRTP5Pzf1vhDjq5ikwYTplsZT9FI5iikr" +
+		"nTwznn5qPP3cePrJ8Zxnx3OeHgcmz8W6GY4hHkI8VOxIUecLURcKudjCl7kMbTiEWP60rveVR/jY" +
+		"Wp+UrsksWLYQJRhUA4+y5NHSxpmQghGsit6LGKEdhobwfkMkhJYk0dpfJq0uiq07p8vRK5L0M4Zd" +
+		"th0h1t4ZwWfnRu7MlxMp2fYHv4k8eYmwCu5Wn91rs88K7O5WS0qTJCxcV/6N+Unu5Q/lJ4Z2ExgX" +
+		"SDRqOyBzwf6RhJBGjnbig53MsU5s4BZJO24hOTKeYrzZIHXlJYJ4UWA+QULcgZBVOiSbnBaryIEJ" +
+		"tOCr/SpZ7cNdkpi8v7b2qzTT8AMwkz3CAPU9vC3QpCy18sJ+0JPAytreszaoHMyVsGnP0razxoln" +
+		"WxlV0GtD/NhXl5R0Pibao3eeumRnL96tw6Ev7LhP3AWjktgAJPkek0kJRkw7EF4F4bNuB8awqbRF" +
+		"i/dFucCMxylaci8VAzh28XGrfL13sjoeY2YYJlW00xG2YLIR7Pc/FgVRj82zBsKcNvxChSPMe9lN" +
+		"msGT70vF9CWVviDNQhEoq0mLp2TjKvCDQKsGL4b8rUFHmks2qYYqhUJ/ZvFOxeK4TnvyGuHe/Tpk" +
+		"wbBwm8wTs2nYlBrR86vdNFPy67VFa67KpDCxLrj4TrQSrYtWFJCZMvPQx4/EDGkHa2XZunwVEoYS" +
+		"g0luGlaldqmdS5C6TtWlv2ZTGiOWYckmYcAMUPwc8ZFQqkxSr68GHAK//DoaqbUFxRVm97XRUoFj" +
+		"/ZfJzWc46NcbO7/aCElzNAvO54BOSheBbRjtfuH4IQvBd34mN1OqA01HzA40UZyHSRq0RFRFr665" +
+		"blZlNjktUic1Q9ZxoNnMDZtmty0jPjY2pG0hrt6jvlmtIlDSbAn96geosfrT7ypM7WbAzBbkhwVV" +
+		"WTAs3CazVFSKdUnSe3GAKSG8xdpfCzLyVvRg4ZpflZUS7WJziWgfJrcbDjEhvjMhV5F2/Hp21x8w" +
+		"zn2SG7tMChNnPAucP5Fs/LGUkVKPQ7pjQRYkzdEs1n+5OKDPtQHdlgYM0GUYBMZRwP5GeDufDojn" +
+		"MZTtGMp2DGU/dLIdJvlNF+SJSLZE9o1Bc+HlAMjID9e4dhnDiOmMymBgMcz18NY/Zusfs/WPpfWP" +
+		"pfWN1Um3i7cchjQdW243HADpcmTseZiEQUNdu9jti6eAehmZCzLGHJ3z8zLmajiO9ItpI9yCOASn" +
+		"ZsRc7u9b5Zs1i9JMw9dKJy8RnoHl7reCnekdzco+UGz4LHP4BOKKs9xn5myxQZ62cZvfB456Upg4" +
+		"4z2jo3QHRvPeAolGCr9zXzDuDoHTEEn9dIHqENxE+/63EjYx+BFW9PFmL88oFGrFXuPUofj1fOFv" +
+		"zg5QP72nUdja3C22R/CwwfcJWiXpSaGwNXRFQrcPXnX/4lX3L1Z1+2RVn9+s6iWVTKHqjudWKsdz" +
+		"q5XdQYJTneTRrOVA2A6ETHZYvB4amALyzkrJsm+YSRjSp1JQpfjieQVJwmSWAcW9qxjxblrJfAu9" +
+		"l8x9nG0XZ+7h7Ds4W678tu8gM6mr3mIrBb2m4DleKQkhlAMhkhdri4K20MjjzmNcAfbndpiEwe6X" +
+		"kmDE2ysEmA5emBX+ZBOBkagFp+vFfigWxJrZHN4+mzPAckzmnSxDa3fWkr+TYQQvXiFiMlhYYpVH" +
+		"LbkvCF+/W2lI89uueJ0ZYQd99RuKnGuWGllUNB11xe7HdXmpSLzTlfc1ii0BUEK3qH6Do3BVQMfH" +
+		"ZYeAB0N7ZmDYjMt7Eo2db9xNXiJYip5b8OGUjgct5cSV0pCNBv8s5FBQ5dPuavGpTNGJRvkIn82Q" +
+		"GKXiqwH32HfDQ1UA4lECZ9z6Zn+v/GVvQnCqk6afE7Ko20MoAiU8HhaP5SKE4FQnNUenOOO5J8Ez" +
+		"z0uBhxWAmzuV5hp+zXFRlqi8oIRVqT/KmsQqAm2JWZK0WbNHqbxq+tHT9V8Ld/iPbRoW/x/HSd4C" +
+		"3CjfIN+YuSFzI2wDWGFvkqVB1m2eW9z1t4b7jyLhR52poFtKfhtUGbYcKMTA0ma2QeZOzE9hU+Sn" +
+		"eEtwpg1wE/dCtl6YvRcOGhOjH6W5VidNo5NX7WmTV+ewKm1RftNgElv1TFE3/ginEdNxyK/2oxaD" +
+		"ENM3iqfskxgnz3iftKWV0zW16S4TW1/QzUwVKeYXD6hKmNgMLwerfS/2Eu6itFWzmp/crBOxX4Q3" +
+		"qdUKUAVFfFn818rhwIbYi7FeCwwMuLVfucwQUB3MJbrNBiJbUKhkbtcf+61DFZbjbql3z3H3xPw9" +
+		"SsewcJs8/WHE8SbS71udZHEJiykM3WwjpbFFmLSfa10Uj4oN1czP/nKQvKCEVak/Sls02xZoM4aE" +
+		"lyyN0jXZjnLBxh19LhxwLa4KMzp62a87hkPLojVW144zaU3f7lGyKmIqpJKTMmUJTnXSal0ysI23" +
+		"yZRSnAm9S/l1pOKOr/ZAQSN8EbIc6+fyxYahu8sXuyduCAWCI1L0Azb6VXON+F30AZ1CqkEzE3ZE" +
+		"vPtZJ9756JEEIz7mPUAosEZNqTskszEVtyViIhHfJvj5BPA8OqfWVtVysbVfJPOxpJ6I0pPZbQEg" +
+		"YhmHd+sAtkUD+6Tk1upgeUS32e5e7vP4OyC9LsUitwOhUATKRkknS32kH6KXJzukOlicJ6ChC543" +
+		"GYaF68qr/8KeH6r2EYpMCVc+B67lJhQN0u9YO68RODEU9K3yNhEo4Wr7E88t/aGlP7GcTyTn40h7" +
+		"FlkL1fzUBdhGYpHMwScVJT9t/kA0T3SHkp94dqYkRrzkMWSajvWsVS8qazleYrIR2AC2S+1LqSRW" +
+		"ib+IBbI+5heDFV9R7fjNkV5xRcFFN/Ucx/KWFsQtj6n1X60tarpckUlh4nRmXc4gySQi7VLh9EuF" +
+		"068Kzjn9R0PxR0IVPOKQ7oBkaN+TDohlFJ95DGAG1/2VcU4+bxpgFfbJ3yMCtE7SNCfeX1F5QeLe" +
+		"NUDqREtY+MQHyJ106u8X9fOmyv1eKCQYWATL1X12amzgtSlA/fD7CkPSnUOwyuaABzHOiIhMGnnr" +
+		"/OQKihOrMYYw9ZxgrvNkffKLGQBsveWIeDDnZfp7LZ2/zTIkE9vXR5SQfDwVYRn2gAS/wjKEeqSf" +
+		"OSpUCROboXtbLXbuz52L3hWqQzMysGQHQqbhsamAytuNNPwk7RBatFYPEXoj/8R3CVVWkw3ADBTQ" +
+		"EBkxHSETdvPrGH4GCEH9D3lsd2uaQ6xllMzhMsnMDsmBu5uIGhwxUEg1wAYfMfCzx2Tz94SIKpVC" +
+		"1cp1OwrIqHK5zgDb7RV9pz6lZAeN4fIXf42NNG3V3bhptkhm5Hjpzr+w6y/s5Usf7Fzc0xd+Ehcy" +
+		"XUas2MVR8rLPjRhxhKTmroR/YjIYWIyVejYKxOxRKAIlHXc0+XWw+APFnVvTHX7q2qwRpgsSNR9U" +
+		"IVpXqTvsvI3/LeHptVFT/N+5qax3LWqQbCbh0vFjNefttjWuOJysLjrC2mh63m4Vy7SNRJ3uW5cn" +
+		"7wS6prW74x3E83Yvu/7iq/woFnVs974gzbnxy3mLkq4flY0zDD1mboArW/pR3bN6cWAJq8ZNNbXU" +
+		"X+0nFyhnwmnVkWb/zsKiYANC/Og03ChMbI6syGB2Dmc4lT3i9ZLzFk6hCJRwOZmJhMgtAWnsY9tj" +
+		"ijJZ6/ao9p7aeXtWvT9KUEd8gBKSXkSPBpw6vBHU9H8ayL2blGDEnBRLdvzIgumaSuyuIOsSs0nm" +
+		"WnhtDoTX+dy6TAoTZ7wlUmZdzjwrQ9YECVNyQnDySGaWpFMwplsxKcrZxXbj1OAXd9YXFCbOeM9o" +
+		"9/omfTMd0tKw+QZVg+bA0nd8ecvR08xC2PL7Vp/mvG9Vp2VGdcE2GSj1KQ7BKd+n1R1Zqv52h0Nw" +
+		"Mr8WdVIweUbMDNg0e7zPb0oOtc2Y5oeW8kcWTNei2LCzx05hKveRUjViLXKpOxqs1FvshkGqQyPF" +
+		"zP1uHFZl9WLepmFTSjWxmiyT7GmwsUcq3SQMRfLmIMGRCZXhWdnTjloeou23pf/q9OQPfziqGV8Q" +
+		"kAXVjOBe400mhYl1wcV3Ims6+OiT2BiutKkB5/hDpPl/J08uRHSHJvUTretS6SubG40shEN/s4G/" +
+		"6b0Yt5mTrd1wpV9TcZwe3OQ432+iJpPCxLpgmzxxZsjCWjdp+ejKTjH2BI0bWmKCzDja+Cn48+Yf" +
+		"8j1v9iKYkRvxyhYYn4+enK5F6/KjhB+trZpV0NSS/6Mu3h9ZeS3w45kuOSypI9b1O4aFFxevjCol" +
+		"/yj0w5MEkkya0edOtGv7RYHXuYvJYGAxVodzn8fGWR+2ly4sCjbSVF0km6SBb7sS+ySLDybFiSV3" +
+		"rEQaUB/nUxY0c92ybCzybzeJ2NiTOAQnj7RS6JXQ6XviCa8n/ogjENuAXd3tDKEAl8IxW0GCo5Uy" +
+		"GJ42w1yml+vcElzyyjOh0csaDkiz10tpS5PV4aw3ii07xC4rN1eM4kZQw0ey1Y5Is59FFT+yYLoW" +
+		"xc6PVI27SQlGbJCB6qVPSFWo+k9q+dPO+10kmJyExIoN0FyKkz47cEyXKdgUQ3fHZhlO91InwTnm" +
+		"e3eQOrFNc7ocs9WOOWGxhoJtiOVc8Jraeb+ft0h5IurkT4cZXo59El33m0mWdO43K+rcdV/rxdz9" +
+		"hZfA9KbViTVqJ5b4nva77Kf/LLuSpn2bnoQiUCI6eTyvDnQBznmvpWkCdrK7vQsGYiLrdnhscdpv" +
+		"kCqwKQOCKJVSGz604TYK0DxFT7ZaIz0N4zDHSl4VPP0MlGBQHRoJ1SKZETIyA3xCEmAxkfuI3BdM" +
+		"bmfW8S+FnVgGW5Z/Lcu/5l7ws2FGYWJzXH0tz7o9t4WaoTj0/sPugRmAIcxnE5PBwGKs9JMH23Or" +
+		"CO9bFlLZ+iQJk5GYSgNaPHa5QmNyd8ej+kFHOvdJNFKMtuevP0NKcKpG0b7dYWoS5/x0LHnB5vyR" +
+		"BdO1KOy/qg6wb2sriEmN0WL0MiPOT4koJ3FgfpFf7Thjx7ccCIiDrpvJcwC/S3vy1oDdF8DVzDte" +
+		"2zv+aWd6bAi7mGwKqKpKGM5KIYEQ7wb5ScINkUEq3xogAZ7mq2wmO0A3K9kCWCXN8A1NY5NkCqkG" +
+		"SJ84dgywHFJEobwBkuhwMeO+ZYrNZDPoMsmMkOyYCii6V9a9V6981943uua+jQbad5vpK6XL6J9M" +
+		"ChPdk1P0wUzTKdAnSfBnDlIpXOeiwMn0r5Y7xrkh8ck7RRRoLrFTmL0jCavB8V2hOpiLZWI1Lbm5" +
+		"u9WmZI4mexnBoYFGHfz4s1G6JrPF9dgfNcm3rb9MikNwqpOaoxNqoAibLimBpCFQUE1dZdJFqARE" +
+		"8WcyjWA8azbJnOybckDzat1BTWETCqhyf5ukoVIgW/vcmRI8cIWlEgb9yDQkDFihQWBB7Lc56H7P" +
+		"+E4CJCIeCb8eZqRGLdk/S3fm19YpVNW668qHM2PBDKRGcnjPXDIzQAUbDAuPVaKRbMGxPaA5c9aq" +
+		"1tjEpEbjR/0g1YDgXnHBQ4IRe44X9Lm9tiAONOGuhoKWh++eQsKFz8nPjLFmCG5E37yyHFoylqJB" +
+		"qkHvy+q/bvAhdbuNHoDZzKq2H71Ty/nEQDaV8KO1VUNtpqrH9SH1RmF1uKHVB+lxeEi9b/VZFrSM" +
+		"768NtwKdw6rUH+Unzap4xe4x8WnBVNL1o7IjqIHXfWBzK/uBtb+rZm4lh/M+G8L0j/xHX7JTS6n/" +
+		"UZmhWKvIs5iplufSuFDxKOWQOmaKW1rZi4KaXMus1COd0yed3j7g9BMzW+eZTqZ/1q21Ba3NXrgu" +
+		"I61Ww/h84VpuKhbFBwOH1LgfuBRwDqvSFsXbHdpspJh5g8FZfjU/IFy/fvX+H7Wt+iw3272KodhO" +
+		"jfzFWDKj7bWzyelaNbp5O4wVZdJXNg8xj6LLMRwtvuwONGXrInnjepFFSdePaknKZ5skEy3+3KXa" +
+		"FGXqzGvuwmUHLrtv3Xm/u+7gqcGQCUxoHbv8auFX/fX1cky3kvxWsnNYlbYoMw+7d+z8k91h+6jh" +
+		"CpMUJs74mSemdKQwsTlOX941Hdi3Oqk5dlnQzUsLU/mJOv+jWZ/gSySO7jZvLa4qo/uG9xgnM+Js" +
+		"UifROC59JoWJM963f96FGkpsMilMbI4zoT0udZ5OpS64mtkI/NQNEUcfR9OYeRTrSfdAJXjytV57" +
+		"+BSZa7rO40gIeeZVkODoXrxdiveBVPCBvOGWVqVeq2YNRV3CongJ/lR/sI32+FSXysbS4pYXole8" +
+		"T+v9x9xlwbDw4uJ18EU/i2J+nthqFK1VeY12HNwBdpV24Kv7kLwiPuwb/KQ6qRkuqTlOH/jU/oAm" +
+		"JoMDy7VBbQ5p64Bm7C72dpRryKVa0w+wViXPCAkTpzXaRvpaaOOSnUtdEGl9JD3mKHrMEfRYRs9j" +
+		"GTnrhhDPEdEDq+zbHXeCnJsrF0lMJrMUk+qswcij3vkMHRCcmhHqNeeLy2xxmRCu00H9deskk9RB" +
+		"dXlEA/7ozInvOp/2VWf87DV/9fqs9oSg4sZhffyZT0hUBoNGYB0efFPNSBb+cUE6RkvsJqfF3AWX" +
+		"1RU3LytvQNaHxauGNmL7YAGWr78awD456IKEyocJ1d9DOb05eUhWrhMcYG0Fxx3Ds8pg0AjMZC9n" +
+		"7g48WqFIcFydNYO87WJSDcfWXEpwchtz4A/7EBCbTpfTYpmkc6blxSEgODWjxY+L/VxRnypbMqmr" +
+		"dA37gvH+o1ij08B8eOlOkjCZdYBC72Oe4RctXb+67bsqjLiglieOX6UukxitC/4JMtEqQp7OSn37" +
+		"SyHBwCKY0h7jVX94Vz8SU+K1bP2gnh+5kMVHOP4PKtWBKbE1n/ixPjYwOXAnGLtzMrI0LIzz9QFW" +
+		"avyU6jAi2300rt6ejfIfwzVVrU57xREeWAelUoIRSlBsgBxMyqQ6yd08KRp+QBMHPAVTxthuCN94" +
+		"N2u8/xjPFbUjad/hUcPjzg4cfnQYct6tpprEWILRkk4zwsPk4ciHtR2Lek+uHeRElIvXT5uI2rL1" +
+		"85/g7UpCcxoV+UjqQlEpW//TvttNA70q+No3vL/67esvX7/92tu3X3vP9uuv2X43BE/E45PSX/+k" +
+		"NMltlga3NQaYRdWYEaqSUvmalGBE/4Hu1beYVtaIXYOIEJYDITLjQmgAmoILoQckfenziztvQ1xD" +
+		"aPXyGyGywSWASmTCT5kA3JQuAgagr/38+xc/+j4EPDKzzRaN2FZMLRltpRtzcGMOqzmqWwNCKDtC" +
+		"ePIV8wGI5ScASahw1Q/9ffVJ7AhvJz9JtCht0ZglvoWgkrEW0eN7c9A4LUffZPM3+r96g/qL29Nf" +
+		"3pz+8i701+5Bf3EH+rt1hJ1eXarJabEUnfXlgjS9PfPdLnx9iYBUF4X5tT/81acRikua4YWfFh/i" +
+		"W2qYBGNHeEOPIjFKaGLG3TLGTzUBGjOwsvqByfJAE69Y+4JuLsckM+ojNSMaR3PotfwXF+9fkQOh" +
+		"VJM0sxoK6XJkrAl+1/wr0hHe8BtMjs2YW0qk+V5qBolLCU51UjO0euEdcAVza6zDS7a6Iv2imDSD" +
+		"9nuRzu3rO/ciiF48kvGmKr4I/+XPHauslCg9pRcbACQLMz23ICVrTn3768shG5LJvGsrqtfREbJP" +
+		"i34tWEWgbJBIqD+HPEKplI0G3ej4sO3DbyV/oybiOB9t3Nafo/rqN7O+/CTW176A9cV3r75x12CX" +
+		"ahJWDkQAFLojhl0UABOPQf4K7td+BRfgMbUZGbCMjETykhomwYm+8HvKpGqSDh4DHb0kHoIwQGgc" +
+		"D5JYE0JE1uTmBqEbjWw4dg5ZKZvJDmBqDJrR3/5V5F43bMZJH89M9ghcoE22CDGrVAePM2BNPpIo" +
+		"kMo+SK2kvv80YJjEZDBoBCQreI79LYWhOtrPGX7xc4bfooMaP33ztU/ffPHpm28p7UXBeLZksd/+" +
+		"5BhdasAjOyN41YDfbbXxewi9L2aEN9OgobZVNFTuGmhCnpnsB/W+5zgO9AHgddvwm2WEZhTvf9p1" +
+		"33bNkjAi7+V4YaEhsU+K98nDN6XtFu8OW5eVw6q0RdE6Tq1k0x7/jz4zwVtZ5GvSzCyJg3meNwc3" +
+		"TaBbeeq1o9E0YnvT8UJoGlZxDVLXz9hj136LuG0FikbIg4Rrz2u/lUQR7w5bWtD9UDUlW25MTSaF" +
+		"ic0RDTNYbbv0Gu+TtrRyulwTd1fbsfWXcCuMzX/o9Ff8JwuGhevKq//CrO9QkDm/jEqi60u/WnXp" +
+		"06lzn4TYXnaTm9WZ7HXu+gznGg2Zt2d5bsnolEnqsh1NTNKAXDMC3cZ8f9VRU70IM8331Y/eFoOs" +
+		"HFZl9UKjQNOLxMkJxZlHOXD4kcLE5rj6WvXKgVTqpT/Kii5n3Fd2py6TwsQZz6LCPKJzqGUXB3WV" +
+		"+oyT2u7EZpb61K18nJJMqnsJCKHkazfJRhrooFkU24flwNGZi+3M8onoBH270wRarew/WW+0XLm/" +
+		"EApzHRQn/ZMFw8J15dV/YbadH3f556ijRv8hjldM0QFpDId5zN/EZDCwGJSmH3+88OnHIUZJbQt7" +
+		"zA4jppUDYftjy9ZV8Ggz3NKPkq6p+pFhuqwcVqUtCmo5NC/oJ2dsKZfPX/zeIKQZ4v1P/9O3P327" +
+		"/eFviw0Rpf2xXxQbspxJXkrXCKVm/bn/ieqeNoRQBOF2mvwLuKfSXwvSO0Dohb8T40wcpeQFx42l" +
+		"H231I3eKcjhYDOV3q6gqNzaVGEyqSykIUcVS6FcKYitCVTQ401YpuzgEJ3PqzEix5AXdo9RJw3h/" +
+		"4avkC9K8S5e6IMzXfashboNjR5guSL28UDprdoj9cobnWwOtvq7LhtCkeqcFsnWA5frGtun6bIhA" +
+		"WU02AqUlgKpFFw3UoNl37HCV6TKyIsfR5kPUMj4B45YnmT8l6thxz3BQsiz5+9CGLGpsSjhKEpNq" +
+		"HMHjIXow6ZcJVNYtJgeYRn5jW/ViTkWlaCrREs8Uty5JC3mOiPjcEKqSNLhh/wBgx5WFkxmzOMCk" +
+		"q58NYBKke6LbAqapTmqOTqh0TCbZhDFhsZwTjKVSMH+8hwCCxxHlLpPMeIgDTAyPmB1gGpefDmrK" +
+		"HSHKzFblrHV6iwb6pUAD2LOG43eyKGEuZzdJw0eqA0zQqWiIMt96Edu3dNfg3IcQDW64zTVR/SR3" +
+		"CgkE2DWMGuhlkoHa3xpgQZBTuiazPycc3gkVTqxxekehqJRm1qM9cWPSG2bNN2lwK5dJjcNRj3sx" +
+		"Q0aUl5jzkI1A2V4qrYKpfCmQMcNXMckMrxdHZ0UkvWjRETZhcE0nQy1vNP/OM9a+udCoreIQA9CE" +
+		"rgVQ062cNWZZUM1q0KrtaPfdmnzXLd+x3Tu9tXV2VHcvf+ND+95+aIBsDqTDB14JbkFm/OQrCBbT" +
+		"S6aAWcMRDHPeMA6pVONtq2PLc5CdQs2hlBoHPDWQVEw2QO4m45YWTJcpdGSTkWC0B1fgJA5sOChO" +
+		"dETNn+yZ+gAeIlAiAUen/ESVC6OLm+GGULPWBnhjRFWpcTheMo6XzOMlv3F6UgkD89Vuo08WVFQK" +
+		"dPZBJi9IbESOmUIzUZ+mHuMg/2dzBmgyKUysC7bJE5m3cbLsdUTKfcOD84F1g2CMfj6pb8f41xOS" +
+		"DszopIck7FgAYitCOrCHsoMe9Gm9xomXwrfU3UHjmDSW+6ayItQ4VoDFWfaHdAqozEE/t0XZCN2k" +
+		"GkYwcqrbvrUtbXpur1vuktKKjXy69aTxhiFRpRoC+moN7Kv4hI1K9vAaVBcNgoZqfkqngPUpPIMN" +
+		"Uo/4eFBARey+3Sm2sO1E9GCl6aapsgYYgFVKcGpGTKOdshYNgB2hKocG25linoSYkimgali3Z8kO" +
+		"MKHsGl1Wymay/7EfDez6m4EaUlWntiUKqFFDHf+rJsbwV3mIVe7x0X53AuyoA+ZBtbPkHh1QJubX" +
+		"1abXFU2hA1rTpI0HQbODoKGXtrcGiONOaW/+5NxEuO6RX61cFYGqYZNEgQybcOwbpB5dEAYIjF2A" +
+		"dDlyMxp/VtApXZOnT6WgChFpZku1Hpk4ekJWrXNa1LS5hvM4HVKMCJTAArRlOzagM6ZLDbJvCUPj" +
+		"qtK5ujvK7JZZj0JRKc2ste7d/JNQBMpqshFMck5nSLPlUwoFMypFqkMjTefY+iSLhhyNdY5/TLQx" +
+		"Yp+cKg5ZTTYAcgXovjhxSj51ENdDQye3Z5dMgbz4mA8EN2TOw+FE7z+t95+9jJvGuCCbSrqmKtOv" +
+		"nJOHx0dqphgR38DjX6F1UroILPMbdBT4Zjpn883DPO5B/OVU76+dQv/q1v1V/79opb9ar79Rg/t2" +
+		"MwlrkLpjhzjPCC0GI9bfiOb6G+3ynARvxkHBhYdKNWA2/3fXXfC3ZP1elpE0x9YXdLPmdWyXVIdG" +
+		"0mLHhtza8afLNv5v25OiDXnXyyFIGF4I0XKCH4ygpIM5avYEmM6DQscYI4tT+dZAcHQZIUZDTccr" +
+		"SQLsGu4ajBbnR5SH3LcuDnBj2KU6wKQ/HdFlO0qnULu6VVwSEmAPtRwOMKH0yk2sHEVlq3q9DilO" +
+		"TYIjK4zOrbJ1ACP+T/RHCCciG529QMKAUEtVbIIwQCQKxlUKqhAskisLB+GWG4Fb1+btNipKWuse" +
+		"dzEJ6wi1/9xfUShgy6LfNlXEIGGEtWyLek0lOcctL+j+RW8vKI/fSHWiQ6G4bTf05qHc63b3IlW7" +
+		"JvcF431RzN8HuVVL16LL6tr6j7JEWZLjVW6yMnw07PoIyGCYxg4OAWWEMXsUfICTMrkFQ6QEtHoY" +
+		"LON/ZCDY97JrANNuNvXMGmxPMVkdEB9pYQ/WU4RIvhDe8PskC2u0BjgkhednBRbNY1T6C6FQBEpG" +
+		"mnfpW3KQumBzjm1s47vHLiZH5EiX5L617iB1weaMwsC0ln0/sz3X+X8si1OXlcOq1B/lJ82qeOkl" +
+		"P6RKvst/1PAfvf3qlv4p6EdJ9OtYBs3oeE2SsHBdeXGXJlOJ95V5NJien4vS+o+yZLggUuv95dic" +
+		"O0vBi2tSf5S2aFbiPr0WFz7hNUZZy0ENBWZ9Lntf0Dft0Ie0Sq+SZVKYWBdskyfG+8T8nNz6ytPf" +
+		"C+xl0tJiqtM9bZcDTTVmaxCyRZS72KlJ1f2YjlDg2TCzHaSPTZ3CxBkf7wt6y6nKovRDSwZI2F8l" +
+		"O9B0HeIwTd8arZZTRfRHb1Hh0J9a/9XoGps4BCePtKryZa+J8OBvBk+cZkv5FyEz/8us/zJauxwG" +
+		"r8SBi3dGuUiNEq2XYMfFBgGms2KcZzNn6X+67DecHVUOv10qTq/7oYFWCvcZVWwmd8l95S39aula" +
+		"9WYKxidFt3WZNJN13uOjdlZZ2SK8fmgJ3uQcsnWTbjjL+cPpWjUvzrJKVjM+phtEA9WCsG7JgXkW" +
+		"vqbqvNq9pFIxQxukd+2MwsS6YJs80apbYEKIje92BjJkJPPvzLxbzt3ysmvBgdVq67cVBkOc2iFG" +
+		"Cb7KXjEJJfQ7JyCk1cq2GBoTZ069sq6xUtkpJBBQz0HVXDSJziyzegWh8PyCMMMguVuNjNNPjNUq" +
+		"SKWw3Oq0tE56lLKipbSf6vrR0vWrLyVxo4KwL5DCxLpgmzxxZpFKzAuaixe/lAtpSWOOPcJUMgXU" +
+		"GpNJGDQUjgQAmKBDeZSkW4DTXeapLmeUgSJ4Wsx2msssUsemjJEpH9iggxuDQBAGCGxds5Ej47om" +
+		"o9MOoRVrEd2TQ3fmuJ1t0M6NXbGh/MYKaOKOpQ6E4AQPPl0zStdkqxN7RJf6KuW9IGJfCJnyBSMr" +
+		"igY9WTuOZtlHsryMYhnjZT7ZcTlk5pO97BBBOKxHDAiRMz69rpK5/e+MaTM5DHW7xW6ShquLA0wv" +
+		"qf3foBtOPfUuuUu2kdHVaypZHxKtmnriE1Bdqmazb0+MCSR1wUVK3aMNP4rJIV2OzajLpDBxxqOx" +
+		"yCWvPJ1KXdDMli5SLhPIVU3XapC2aBZz0miVtfoxqSpas9mw1qaopFavbncxGQwagdWt2730vgFj" +
+		"NkmvwyyHmzilINH4719xgElar9yvxulaNbhF7rc6H/BBgS+uJ1Uy5hHvDp4llsQamafFXbapQEuT" +
+		"xSQT9OI1JuPYcHXxW9LABR2ilooJJqhPaob/ZFKYOOOttuxXzP/ioimpTRBiO3zgqxj4Kke82iM6" +
+		"RGuSOKsxDAu3ySjYlJJ/FPXrGvBYa90mhCSW13321zrL7VZQ55EDMFONd5n8VwmVxGzFrr2b5RLv" +
+		"SRzMhEVXwscNYo8bBrB7gOqk5mh0mWRj4FHFkHvhOOXcpiKT6c69YrcK7MnGAIgytgpXiY2tNZL2" +
+		"DQc/fwyM0DopXQTmrz8C1qXjmry/OGnpL5tadF6h97ohvF93PJwdyksCJrLkkldmOrtkNqS5/eGV" +
+		"2t9+w1kNALtW4y9WVkHCjlrhwu5vP7dkUrfsL74aAxCTwYCJsGmvLemzIZWjzV5biqHsMYhy0oHq" +
+		"tWUNBKG65TdCCZB6U5OQLkc08cue4g9IbrJHYMbT2cQTr+yuikZ1De4vCgkG1aEZGTCjrwZI8TXb" +
+		"8Blbh5fYKJ/ll5spBCx1n6gjnWtpVWYKJS1KKyaPjlCVWBFCaTtEp4h3gkZrJFbHGlihuyAMEHBv" +
+		"kj7ArMFdKB6l9tdkfe+yv2TezCVfRiyyhJJilwXhO07IW15wmtle60mc2kwLW5XdZCNYsUpbWjld" +
+		"U7OyTWFyK6GyPe2E8hKeTwjBySPRSUTPUi8pDb8VNnFLU2l95eaKJq3bISc3w9gqb3qjEsWp9Ulm" +
+		"RBpBuN3K2Rds5Ac7c5WN0Teme5TqwEweteygmCF3g/KgpN6LyZn8zGY9D/P7RnSsioz3LZnkNu88" +
+		"MuoecdPupV/HuvK2/1ejn63QnbxEVE+Uz3uSzfyOtNnRMzVL51Kc+tYXjPcfZVZeDUykom1njQZn" +
+		"m4SsmyCEEiEO9lcFNfUNoSpfDRS11Ov/CxApQaL9r//vM2Tcdj0tveL9jVCySQlOdVIzRCc3dLOB" +
+		"JWmd4JIefBtUWRso3t8cz0lCLhRUG7KSh4bYDPkgK/lIvQgNkvUc9wpH34pPhliba9SAKvQHxyhx" +
+		"ljL0aAVtKWSCMvJzrGcst5WbK/8XnyszIjIv088lh3PNYXxiVSZfIIg3wo0Vz2z+/Ob+spNpxIky" +
+		"ZjR45j7O3MPZ9m+2HZEzd2C2/Y0drCN+1B/MVgkjXCvmkQpRqpPHRqbyCRv5STILvPAEjNCc+mtB" +
+		"mqM49NckGjU75daTmNSPnpI1ErO6Vxn/6oaF5q+i9SvYUWWc6u6AvxTwwpXaq9SMEC2g0DqJW11q" +
+		"prhUlPP5crjpDGxRmmtSJ7bu/Niq/CgzBZdaUkuzOKuJjjTl1ACVwKoWSBwEhulaFMtgqOk/nK5V" +
+		"Wz0BDPXNEwLuYVGZ8f214DBXXd+nIpSdJIGAja1Y+/eqW3tR0KOhCQHmIgaWutlRqa/wQ9zQxtXG" +
+		"LoXqYN7RbdzrlT+dBdK3NoGW4oKkb5fsYOXYIqDJ6XKN2XRIZC5szCpycPZWMYcc4l6qLGjmsssk" +
+		"GHMJcSLKRIuKtahYi4q3qFiLireotJfVSAnR44RVI6oXH52CLajU3JguI/amwWmiSbihi0TrGBEP" +
+		"dwiNxLpFf7SjjNiPUARKmpmmYHeVzWpbNq/twHQZWW0L91DZUEUd6GvB+18KlvUNfoIQyn6LlCVd" +
+		"mGWo0jy+tOg4HU7YslBQfVKwbLx9B4IHlgURglOdNP2cLBMMIyox11Q8iF+EWONjhDy+eLnGiEaW" +
+		"+rUyv1aOjt616IynnhrsCHE5Y79GqwQndN7TOuiJPXbaKWgQIli7k3U7gwY48vlDrgM0TvH5xPTj" +
+		"fD4x2zi1KU9eFY+f59AQOezc2+d+y1tMkxpQHw0vSLOJO44dQ5rx6pgRjAdClnpYqYfG4tEL5K2k" +
+		"vrI73NN2rLxElDP8KB4lbp8FYPedfCwTVvYIVm8gzhGG6TLFre3FrrFoM7LrIvZFWaL0N5apIV+o" +
+		"aLIRfNGSX67aBlQHc0F1v2zs71Y7BRNxibLS8BiFXTrKXpx7X7iRMoT2yKvqq5CQWzLSqCbp8afH" +
+		"Pz1udUPY/vR4ixtCgUh/9PDXWX1E/Tkpj6w7p+QqLBo1tUl65LZFvE0Y+TahymqyESg9gXZ/Qroc" +
+		"MRBGbat4f48u2gsy7+X+dtDoEej2j1AzCfowRIVGBtREJQ0XxVdnxY4aKxoEDfHCKwGxu754Q4AJ" +
+		"4Rc3eYzMeJlUQ0TGEZ5RaGxa4Yh68UQQMXSoUFUjcfczyvjHGAjZFKqu1iRIXXDGK41aiD5CUKE2" +
+		"da+Igv8IxmsLUX/deQiUru8wxKemeErFbsRKMywDHaFkkxKc6qRmiDwVzWZujXk98NtyytpIzxeX" +
+		"MQ+q5ZBJNFJ8t+CgpirNpfqm8a97PWFXaj9GL0bPYb9B/2XvTZoMH/FQeEIiGdNZQk+jWtcAjjyY" +
+		"dBCN+00qhQQC4gexLyrqkOBoaSGrUCSTZlepsche3viG1cTmzHL5ZgEhXY7Z7ZE5JHbjXax6CuaG" +
+		"pHqWh4Sdr5IawYgMWRuG9y3JJBqlfqQuOM1W//baqkyCA5+TGIWJM94y0I/XDPhXsFUxIETB+N1s" +
+		"Bb1ROyBR2sYzKx7He8EZi5AuR/MvOv7H/dhyNNn6pOE06pTx/W2D4NSMUDJO3hHn67+T4KcZAB9F" +
+		"jwYk0g6NiZ99+klBPZ+mJqEIlIhOHs+txrTZFPQZXu1Gu9blLQkVEgzcUs2Feb/NgjrwxJN5nsl2" +
+		"nsH9i5itUC91FpvNM/YTBq0rlxzFfBRNoFFNEgWytZd3lOCxx/5yUJMmxyGfrX1HMcv3Bf5jQDKE" +
+		"up4OUg34KELUSh7jXydtESt4VTTITkH1L4V5owMMQJX48l3Ub41DBMpqshEomUC1hgY+Ghv4aDih" +
+		"Hc0a+sBTQ0gY1AFn9yECJfPtBQX1clAMtW5BKKDusZ0OatJ4za0Kev+QWq2KVT9DslpV0grpcnQH" +
+		"hnrhS0iXo7mh1q2dQjGso8y+ZYTxTnnuAPW4vxAKtZFzfwnCAFEpGiQECuwR2Uc+TTYKE5ujJSGn" +
+		"a2oFuXwsk4/n8fEsPjOHjyfR7oLLvyHGZbvUlRsUCKbHCk9Ijdj1+j72vZxNQa3oMfZ2WPS3w2Jn" +
+		"H+LrYLGzGlaOjsYdj57HZ9ZGAM9//64/Pf/p+Xj/6eVPL1vQIIrJppJhLyVNgvFCqDVRaPuA1hEi" +
+		"I/R2yAaIZmEZCkzEzFrfl5T7mnq+WbhqlhPFqNptu9+LSZw8JjdTFMrzuWUHCRPrgtMVtQQP6/2+" +
+		"9c2kGlrMFEMN26gQ34AoYxJOESirSYunREH6ykQZaeS+HRTDQ9ML7hVBqhWpBJfKkGqv25NC1RGM" +
+		"mW15PEQoYNPw/NPL8H9uGmhZ+q5QefKBPgFHfsHXQgo/D1KeelpUESjhxY3SOmgFMc8smGgWzjSH" +
+		"7BQWzd4xCA8dgRfBDF0UzBlmDFvljSBomBGi3+KJTNH3yQqu59CuuhlJ6nZb6CLp3UKjZtgd3LGL" +
+		"Q3CyBNwwYBnVSkmDWyn9NUn90VqJX0EhIIbZJGh63QWphlPySDA2dt9e2/ftIMERWYCbUi8UWsi4" +
+		"MVNwX6bwtozeZttMwqlcFHpdpIQIhr1kmaTG+9YpVNVoxDzKaJq4Kpp98Rc5jGGuetuMoIk06LFT" +
+		"DDWP/y0h5OtcwAugu05rmWWru0l1083PPGUS6F8Rwq7hUwMk4cGhU8CSn6dQBErLUT9mp5IJdCvx" +
+		"OlXBLK5wElcyrje5UrVkvAEFqe7YCNYGQUwUSe6d72su+mUaFt4qQ3IRWslNp92QwaARWJORTRn/" +
+		"7z88gZR02ypk+WtSkxX068JOXNJbd1dJO67ijeCrocbjeCo4WRSeJ3DUlP7a7i+ZxKgb7mw6mp05" +
+		"YWUToHWS7t3SX9xBpb8OvKLuaGa9lWREI0cfXNSUwuO96NlaRaCEv7VBh9qimByGY/xv/xDCgm8l" +
+		"Qaoh2G+gLKwRIghVefCN1XJEDXSJHF6CKfgBbUocFP5KTDnQEY93Lt2kGtg8x45pgEoYCgy56Eyv" +
+		"HMXf1ZrcphLvK2/pV0vXosuSDHZfEDM5eYyCXi0UXC0UXi0Uzv0hYUhCEShppqNqDedZlTDoDTRI" +
+		"GAo99G5T0Zc+C174LKMCVZuk3l/2caXJDYoESuoov95ftgf1YKyyhVId1BeLICElGDE9MV2mlDrJ" +
+		"kytkDbZQTKIqh4RiUoKTx7EgXc+tAl2pckV3qQeKRJsMESgbJDOo3IxqX+0BqlNF5tUzr5Z5tRsQ" +
+		"3Bqsq4dEJq0g1GgtQQ/qileYIhCR4wLBAaYSmzhMU3tJSr8aI5kjNCyXJ6TL0aqMOxuQwaA6uI8B" +
+		"m6rjh4R7qRrqUKcZ4q3uISoFrRBI3tp5UAxz/8NXVgt+199/1B/Qt6oXXouC6dTUm2lRHC/CPyy4" +
+		"nUyPRAdVRQMtWnaEOjp02RGvIeukTG+cE+yyCeCWSk+2Gq+k+DHmXs77limayhdCzgLP+wvrIYzo" +
+		"xDgJlDTjjHLaWlmldBG4u087qgEz+slY3FI0ovHAp4scaeZPsy5sEZzTGNKshT1fCFH750uyyWmR" +
+		"OqkZst4DzWZu2NznK4pJeniaxLKtGXRtUTlxpjp5qjo1P+xH6+gnFrOr7Cbj3agZaP5136z99OeS" +
+		"cl8QrlkukzSMl/L7gjBr2NANGo6JIWlm3TQZ+uFpXUrTf8e/LgRVoaYbTt8q1aDpvpIQmsZLGxIc" +
+		"WR6AJoiKkImr+Wn1v3imCClOjBIKVZmOjfe1rqd9/5vhm+mb8XPZTjBGoYDK8FHSG/SKhwMj9y0T" +
+		"hHBIwcn4mxt+iNAQHsfWXyY1EzwQgVTDCP7KHrchtZv8jXcblRQvwDJ9MK31H6VNDWkywmEfhuvm" +
+		"Qk3awrxRMiz1T6/bTcY7AZOmUQbdGWLA4TsyQ1QKrbiSyQsS6ks2k2KQCMWm/K4hqdYQEpFvhPhI" +
+		"mBGjWJ83K/S2erwtFy5Hr3gyp+KMfRKMMPjv91NJ4kSbnrkHlGqSGajQygRBSAWPKY1WK6voDzAr" +
+		"FiepoGpCuxpIjKwzqsLeaEwnK8GaMkTbAtCWVtaBbFX7f9S26umaGiLOu5gMBtXBfQxYuYe+rQTJ" +
+		"5gHWBZvzkkqNTwlxy5PcKDnLyjPCSDfgidJ9eq5Y9ukHbcbAPyaEt7T9k7pymwrriltLKqtJerkH" +
+		"9IzQel7MTJjFdKEjc8qWU7accipbmMRYdo6Y2TNiZkQ7OENZlDXKsj2amAwGjWA+HWGpJnUC6Jgu" +
+		"U2hV8X9yt3fFTFE/7Yr7fasm1WHnyF83rBdSmUxOC6s0kN5mORDS9ZBqEn6H+yUxGQyqQzMy8GQF" +
+		"5yjHsHCb7P6wIdR7ZZBqyDigMo+ZrDM7SBjGe6cOUie27szxDPxPVg6rUn+Utmqrwoqrhj2KrpQb" +
+		"R1EFCY6WoM3h03gmLHXBaTbqCT8hotpd4kfqys2UHCbJytaS1PqPsqX/qBwIfyz9/7W0/5jSNQ2+" +
+		"1Xe8D65Yco95LVB/83piWLiu3BZl4VlIjUdf0NvZtLaoZB+diTSL7UWZe1GWvSi+V8SKFM/zYTk+" +
+		"7E61KWnB6Tz3y0PwGUtjL+/hNXvEp/3w7dRl5bAqa6qZW8yxe5q/DjPp35nw70ymK9OMli0b2lIj" +
+		"U9vUJSxcV16cfgoy+9lkUpg442eys81KKNPpyU81TJ4RdjxxIY6jhFVZY7w8aCX/aqtrqT8Ko+Lc" +
+		"4REfnSD2SWmxWrLmA4SiDWOuhV+1/ahea9Utzkr0Th5nH49zly7TJFfStahL8pJ/c/CidGWy45Lc" +
+		"vn7g6sI2bvN3qYl0YIXS1mdnxCNnozCxOXr90tJr09pl+WsKjmaOnY+PVi38qr++syzqJf9XtwTl" +
+		"vkaf99lLoFyL0le2ptzjc9aOSvjR2qp51aha0Xvk73dP7SfKa7xb99l9j+yxL/tCtbSwb9HQZt1s" +
+		"7mW8ZDYrWZ51/r7VapLwq7ZVvX6Umdu5VvPsa67UmqvTzYs6Z1ues47+8+M/Gh3LXTYfbcpukrHH" +
+		"do8Piz22at+lmeq1KH1l2/1HXPfiEatMChObo9cbv/o/cc0m8cM6rvymstwKL5oHzsEW7D61T5rN" +
+		"r9pSIn4Xf3JdeclrVgR3cYFNJoWJM35Jtg5xptGxyd9zSyv7dAiq+ekdE6Pdd83QDgmL4uXy3srA" +
+		"89b6aNJl8z/4bINjuhalGbfu1VGly4+iflqvI5RSHWCXf2LSDLr+x8iMuqDbaEuT4XDYLrQNPXgd" +
+		"cdh1hG+0PcWp28HwZU/e8KLTCO9bdmgg5Ns40W+4dwvAVLTZLVt/B2qANtm5bxSNknPnc3mLnNo/" +
+		"WTAsPJPaCfTDi96PX9B+5hXsx65hP7gc+9jV2IDqYC6Wym4YKurPqE00MyU+UW7kRnEITh7pxbQe" +
+		"JUxshpcDXb8JIby/rPvXqv5N7qe6pteLNXzlN8rkcv5wulaN+w46Y5JQQg8IUaBsgdFYsANoBPfZ" +
+		"dTm0EaMhGJZskp1Y2eqK5+mE4FQnTT+nuCAr0k5uVEfoZXXm2i1RtyQ9NnFgHEcVEo0Umj+zvbFN" +
+		"bmySm7WIxiJOzMQbgiJ4r08hA2DXh9uQasBiXEg1vDXAzaohq0nE8h6V6D09SSm6lODkNjrvWy9B" +
+		"Jmn8zu3bbQN328LdN3GXHLj7iOg/e0nFJGJLpYB6pnIulK7J1jv1u3Eq8M05Yp+UzCrBoDrgrhNZ" +
+		"JlveYsBNycgjMC+8nAFoDp3Eku0ljeoX3bzgHkI4v3JeItxZ8zlisFORtGNrzUHCxLrg4jGRmTK5" +
+		"xfQkJtXw1VDL1bEj6rEecaRGXTiDBS4jFMRxd+OTOjXy11sIZuINfMN0TaXLymFVltTdSqGmDRK3" +
+		"/krSZcFmPB0wgYg31Ptm0wbc4eB9iyHQKZTEyO5ek2lfanCbi7GHkrfkYCYxSUPnV7/IF0lMapTO" +
+		"yuP6ibVVo8vh0hLVjWNDHH13cxAjvNlZdRVOtfvaQ6Ie/E04QOukZHHs0X63m690VXunq9o7XNVf" +
+		"4qpY5qOiS51EI7LGN78JiLnuSRzUxPtjKtWAImcDgdI1GW4VIRQNH3LvmwNMKO7BDXngDKvS4im5" +
+		"XY9yZwGq4nfgICUY0ff5ZC7PdN1lbMKPgqiS/Vsiq7alVdeN09NDfCPdOFJwH8sQ5hu3Nd00TYIH" +
+		"Ny/ZVqTEnqzQnPprQTPrlJTn75jKDZNcEo3P6KTlapJ9q1t20PhdrD+AYMTO4z30uO/02C1+Z6+0" +
+		"++YAxFnv3Au/x1ijTpxj5nNMpVp8safq6pf1JUZIdSwaKB4abJVT+nggTCYCZaPsJs3w16S54Mdm" +
+		"J4aFFxduod3Djwe26SiBAjv9KBieD1b3YH6Y+tvLHtWvd3CpE49+3V8OMH1xhQpQU5VdTKqB92Gj" +
+		"34aN8y4ssGRDTYAtxYeCh7S9xJ3fuUM7O9e5Hy+TW5rEQduU7kpeMV2mSDBi3QY2wCdWB+4J4+mh" +
+		"9OFv99X4EROIiBtFMumd7GMfHyT+87MFVFkwLDwT88pGlcSMtHnK/bXp+FDuLxGTI6F2ihKCnqLK" +
+		"M5VwdVlQfeJ7o1BVhwQumVZ5mdR3/R3FFQKqV1Iqh0nGqNjxTRyCm7DXDGE+EKIG9pCr4OmTthnG" +
+		"pIKCCh0L+rZKRjMlK1IO3gUgqVEPXKx3qIUrIys+kVWxrLPaus46V3NWLuccsu0mYSjtwMewJmuE" +
+		"bqeOoAXbyVtr9kjTn2b6g8xy3rag63gcGziJyWDANb+L4r4TXqX0H0XCr1r/o/4m9WpRX2Nnvrpw" +
+		"dzLa67RLAKyZUoHkuNmoMhhUB/cxsFyw407u/JM7/7Sdf3KXntYJzkaPZh6tyXZO+quIzcCW6Y3w" +
+		"gvvc5asBStNlRtXWGVVfU1S5lMg+IYG19LVoC+hxcvLS5MQQddpdu9Pv2J28W4f1CVyBoN8TGCGc" +
+		"ud7gtMUGp829Ts69Tpt7nTb3On3uNQhP3UmMhjgT72cD+yTdj2d4CkWgbJDMXGMRJwktCFA3VJus" +
+		"R/75wBxLpdo1Gz17n8wTh9jJI+xERXSU4IcnVFaapZv0KPRrUjNk7jvedKz28YqKj1fUM/OMDAhO" +
+		"Hkn37De7z3x/yUpwxc0QlcGgOriPgWX7RuiqxjeGyIkXbaddy51o08bqti5isjlgEHRmhH6HiZAu" +
+		"R56ZT76DBnCLFeelcxVmxWdOhviWytcDFq31VZ1lqM70KnS8PPuLtVNIl6OlXDLBxHS48C6ZfSG4" +
+		"Xji6Lx7el1biYltdthGX1nz8bVwS2aQ3oRjq8ae3M+uXSiHbn97H0X3exv+mQdJQjTfdepWjyFN/" +
+		"cP28iQZBQ9zlOW9wlKqXOQRGcL0bMWZHXL2ZcpL1BruCWSA01NZUoapu+HlTtlJO1OBE+WP44ejD" +
+		"wUeF68iBw5GNRhhTzkfXQH3xDUgViIKjflxpHPjnE+s6IdWoA83JV+R0XDj5OGBUFYsTzxQPhCM6" +
+		"adX38X8D4jwJqcswFavJRjD5FQkL0owK8AsJkBojjY8AiaP6+rb/aS/329f18GW9U29tnfY+/4lb" +
+		"XHo7/9zP1ClMPcu54ujJ59iCvGkw4nKoG4WqokHQELs6209AgnCsnPY21jnfxjqzNgtWauH2xJnl" +
+		"PChUfbYuWoN4R6hWNE/OkigkGFSHRprOuFBThERL5ayVGagXVSeupc6DXxtTUB99C+LEyw9nvW2Z" +
+		"QgIB9hgosEfqLWYxCY+C8P7a6lNWDqtSf5S2aquCDay38tiyAx3+T7oDTYf++JwRjRR0pqudn4l9" +
+		"ku6+qpXVLlvDiS9uGqlRYBGqDw2wiQ9W+oEYze6Jdnt6uz3xnshZ32JC3XfRexQEmPRONKQatOTM" +
+		"78MaIWar5cxhQZhvnNA4wox3twgwYRPQeyu7b2WnVEnDQQGVG5FtK7K+3QtJA+vqNcVPwhNgOvTe" +
+		"CwEmhvgqjBGMfLxgpMZDcmeHPGI/A0ij/nfijRkjNVZ0oYo5joLaO3LWT09+VrTYLPf3ghIWxXyG" +
+		"bC8NwG8N1Fd/ivZsLQlFoKQZDd6afm8JshmMph7V7oLP3ihUteqI2AtOKPbVfkIjac5Y5KFCpzHY" +
+		"K7iSOnuPCOHa9bzYTw0G/h3/+vKVCjWNin83vF8OGQyqg/sYaAnfLeYRgvG66td+ufzrP12uZD4N" +
+		"4hpCWR3GRnz1G0wj1Hxkw0n0i08vfUU0CAhRhIIWgbPUEJeKrCFiOhN1psG5dwALEBQv/xiRHv1l" +
+		"EoaPUOy4BzjZ4lXmHif01yQatW71jRC1qraVFVtZD4RD0fvd38h9E23fRNsR0XcEv831japgyjAk" +
+		"mkmhdVKmD/w1H74QOAAiIUQ2WQNBCAuyxSjzxQnkiy/fqAiUNLPIbPKNMInJYNAI7snTo+IFCdVq" +
+		"4pnC3KSbVENNCOFd0adVMprJa6KuhaCtMDFWWU02QDSLtSsnz19MmL94g+iL1tSXV1VIMGgEZuS/" +
+		"iABEVvigyTcix87adNbGXlH7zk97fG1REgCJrWLjdw2+Zfzrsk8VTaV+suBbOLEgNFIeuRTVcF8D" +
+		"chiOP/26l5Ip2h8+lr/GyeDSE8HFbzFB3g1an2Re5+4wTIdogF+uJCB3MHyCho/tLg6IYMiVto40" +
+		"SzfpBm1Vx2m2Mr+4Z2tUF2yTJ67pumRyQR4vXbEyZMkmNal+G/Hi7bZrvkZ9+Y2361gWl6kiwQBp" +
+		"H/TizjhMoCHZ4kfRwN+nntymEu8rb+lXS9fUgfaY47J3pi+9+37VLWPnA7a0YLpMEXf9J5PCxBmP" +
+		"TRpc2nbWlduqnP/RPIviMSy+U6i5aND+nH/OW/zfGftwn5guU64/ZwhSEbY/58Ne/HIy4/Vn3PU7" +
+		"nylKhRheT37o7RzX5+frz/nemiBsf873mSSJyfbnTHepCLcuDsGpGY32MSwZqNuQ9IbimfKmgWg4" +
+		"8tQxDmLE9/EvNZZh7HFHeYB0GZ77pAYsQ9QN4b3kJvWDXxP7fywNJv1mvBLOQhPpsG81ZqbfS5Ca" +
+		"wYxuJcf7gtqlqJ45LMgEn1iSjHLwmJ0yGDQC2tAeaju1P2Or95skhE1FHWFMo3SjaHCqS90QPgUS" +
+		"RklpcximuL0RDiVtZ6cY6vEHd/t4n26IKhSBskEyXrUukk32158zbzesWHNKF7jTmERFjXqTc2Ib" +
+		"fL/jowDAw1/Cm3qSVZkxlqjsewlsYOjjTDBjjxStfvd7OfOSJbVrauZXJURXztbLTuWdy1gfxi25" +
+		"/+/coqUKgQvoB5eaSdr1rTHCRzJfjodWe7Qkn19CtaT1l/TRJfP2eOB54ET4PB4xRSvhUbeoEAMM" +
+		"KYrvhBSf2axvWFL5emzS90QHdb7CMfkyhsd+i8+Tm2EMn/0W8TuSZ95y3Lkzc9ynzX1z5nOKwe07" +
+		"C1WF9h7v8bDN03OBNcjBVcGKglUyC6OM4xAuY1Slyt3byjQdUFQt9gP+VI+6OpcPsD5PcwJqQZV9" +
+		"pO6FVW+vbV8JfnrpN2TbYrJ8WpNq9WjNd1NrmDcqnju3gojset/u7N+92/uDqmAxFdBy6XiT9SM/" +
+		"iuWExTHqePaXZLaz8Tbj+ObFmXXx53LUfpaNsiWiE1HQp8TgPmC16xcVKXEmAw+4bWi225a2fJdJ" +
+		"i7E5otTbdnRW87ZVhNXUaj5Vk8lmnY94Afu0dskK4z3Q/FyQvmNsyK0rpygPg49nQeVyReqCzdmy" +
+		"z4FiGuCknf4WN4wYt8gwoIYR6aKni0yXxPrKeKhp4+It8UdHJqKKadx2oKRnubun3osYcGIN8kSk" +
+		"LqlTIG1JHKRv+rXyIatWd1wCmKRr8yYu+rLAgDMHk/T6QtbNLFW2tzW2nmQWJdFFv7p22q0wg+DU" +
+		"jFiBs7JVFSQ4rvFI1HvJDhIczXPw8LzjBzAG5KxTOFLrhukiQR4ID6bCMhMl5FdNb/Tomy6jhqK/" +
+		"D3Lmu2yNfiAUIrlxwLpLlb1kjt6LRsfat5gnrdYepS2axrzo/cK7zCTWYGADpCT5aQ7ZdxoUN3u6" +
+		"Q2rx/MYAJvzqxn8NmLtlu+sMin1mD8V8uvm0Lg7VCfvmJTwU7i8tZNMtjFtSUe/nfudp/B4/MZnk" +
+		"KKSMAtMWICL6/D1t7TA5LdzqgY3QcJU2FEG4ZZNm37JUp9YNU5zmFH8iON5Cu4wWqyWsXkaVabOs" +
+		"4F22t0l6l+1tm1J0OjqA+vNpTs+nOz3p01/iEJw80v3PwOiz2yTlXrAD8HlHAP31FvqQ+rU5xiY7" +
+		"DPB1QYB13fHZv+p9x7ULGl/sApfsIHVi686erHgLl30/s16Kf1jCIbltnuuR5rEzNcvowDcqyX6m" +
+		"hmY/PQrdx8+hVHn52eVH94x9TqtK2WNbvKnSOd9ls5bOd6l50mrlPOhesn+H4kf7jbWUj7hg3Z3t" +
+		"lVzVnpKjZZfnjs3/O31qSc1i2j2WPKNMvaZ6tpV/Y3zn5Sb36o2eWwxLESXFsMT12M91uxcD5lC/" +
+		"Ni/RvgkB7dwX3Gf2fbNfboYqbXItKc1tNfVa1MVVX6GxOn4kr1V21SvsluSG6gV/Yr6vbD3IFKYp" +
+		"HCxKQTHlkDqPh1LDsmH1TeG6ZVoxupRaZweoVdJMWsutzJ01rwjnFeC96GNSg2lqRlba2V8muRUf" +
+		"HvMf7wEfsdNz4SzvXmPH1b0jUtfSmkkJTm5jPrV8g0V/2Sfq2ezSBQtEKTVt57XavfuF2n1+6O3M" +
+		"9zPZh6ahnKz8lInCddblrBhK9SqZw8zZ/5w5bPuGkd16f9jO/ENakSD6IYdJYWJzRGlB7n7poCxh" +
+		"Ep3juO84fRLPWEEeYvWQh10bBXn4JZMyrSna/guybzZEBtntN1Kh6FHZrfaLzi3L0fNWhPWQPDei" +
+		"Itzu+IHEVQk/2o5Z/zT8pqztR/3RrPZ1u3sWfWbf13hL+LS61N2BcTdJKcqiXMQYHLzFhmKuo48C" +
+		"71vFyy4/Gv24ZmDhsCqrl5eiM6MFZVX2La+auGZzCVNmdmXrk7z8svUfF32e5Jqj75v7WavvNirm" +
+		"dbp5Mc3sg4xXIQ8JP+rsBKE8HZpRZqvJftjNHNdmUv0h7q3LrxZ+1fajerVML/m/uiX4q28jLxxW" +
+		"ZfWamQ7trPKr0fVhGTxkJU88FHNNpVTHL6kUbvfj9KO+Pk46Prc6W4YKs3sWk+IWL/bpjf2sW5jU" +
+		"Jp7exqZ5JK9mnWfEt/MQeW05TJIfTteqcRAPUhMuuZQuB4uM4uCmLJPCxBn/nDSNi/lwsOjLY/ct" +
+		"e647Zgiuesl7/DE2x5lNadw/Wbb+WpDOWT+z+JLwo87demw2Mh2b791jq32SG9tC4nw5eK2O7VpL" +
+		"uJaOc3ibH7Y/jjib4uCD5lWZ/ka+Bwd50rTWYO7boxz24EHVOq405Tn1Es77PGqr98w6E9XZQety" +
+		"xFc5qjS7TR+k4vcoJ67mNtkzaLI5WLzcq/AlvR/DZWpKk5acUvIEf73gV6x9QfN4+YHvq+2nNp3W" +
+		"BOYfg0yy6PjMk7zw+Jz7ciju/c9d3rN+76V+JZlD3x4+yrW+5RXnRMHUa1Fn0dT+G9sWdXKXBcPC" +
+		"s0e7uqZaeCm5FM/iDFF+lDaVa0FP3be3TMoT64Jt8sQ1i7mh/bWwD9GD3FjeRl8HRp5+tjq737UJ" +
+		"Uj9bOpeT3lBlwcVL2mSvIX6Ujshm+OKWihIeVYKt/b8vSfuCEhZl5jw0FqlvljmFiRZfagoLmrnG" +
+		"LgvWlVefhWf5XJBApTAn/R2wsGC6XLG0Ldb53IBqkpXborgTs+1y7+7fxaezfT2hdKnmv06mPtG7" +
+		"L84L7KbjB/bwdW/mFp92+RzGYc77biGm06zjNGUnHrxSOeBIZberhNiWKWxsd33X0LVk86PY5iXj" +
+		"UGrU63irZnue0T13Ox/EdnhNWivpYzzSnbNibXmyM7Sz3sgfu4TSqU65n7sP9Jz02Iyn2HFVdmnz" +
+		"YZDNOct5M4MSdljdvB2q8GGYPiY1OS3iNhZY7VfSzxxwtJ1pw9HBZ+2E4NSMmIU/b3ekh1aOg5Bs" +
+		"vD8lW+0vk9PiXv3lt0mhXUYwNYHATVaF1kn0bTODBot1Ynu8JPeS9TujE7f0oyCrwCU5imgDCaft" +
+		"FdlvW60b21f24ndvnJHNeKUTOwgXCVabef3wc/Eg2Xus5PvJ30gcCs9EksNpx7GMu3N3+dXgnfwn" +
+		"KYfWdcLSLIf+OlvceEtf8seuoeV/55ZMSjDChvzvjHw8MvBT7ltaGU720A8AW2v8nv+Zpb/ifSV4" +
+		"fLBjPpJdt734kaxv+kyEy19+O3TgXfQrjLYRi3796OlXs1jbuYNY+793nqfk72Ejn2G6FsWq+ZeL" +
+		"OgYlu4s4uHjuh939ntds8nfe/ZW/x1mf7F2PjXv74Q94H8vz3ccWK4U4tE5yF1busUW8jzQxXYvi" +
+		"bqnbfbTHtscUt7ogziKPrb1mU0+NcTjCABIcLdPBKKq/yu6ZUEEen1I9Agw7ZgoP2fANuYEwiDUQ" +
+		"CO5iE8iH6HUOEtQ55XhgCaHBYrPcOk/Oj8i7to/oqzMe86T0iB2hNWPsFN3i2avGFzE1pP2vwPEv" +
+		"o9P2fP4itiXpJ7HsPE41ycK0L7VPo+1Qji+meaTIj8EsjLTF+vujJBOuM0XBfMbHmEep0sQGH2oZ" +
+		"+JTeZwS1i1r8LFHx8xNjZdVn4ayHX/kE+NloPByy2cLgM+N85ZyuVUPF7ebEo/JE+agi/8ShGbEW" +
+		"Vf532sn6UaPkCYvNnAfz8Z8rrS+adRColyOMWAmgkpn7SgBFerG65R8ap56Rw+PjTLOn+paeeL79" +
+		"wN3lh91dfvjd5QfvLj/O6l36uUV7kEls3Zlb8bTH00/JXKw4sCdx2Pmc88n5+7NY+4BaN7Q8C5rl" +
+		"OeZpNsZAQQZ1uwu21hD+dbPHSIZmD5Zw58fZfjVz6zPbvmbbZY6PT3tp+MzPc6vM+cRpW6V6ndFi" +
+		"OIV6+sTppYvBNPalH8UmZhOuo5lArRvmJ6lRXiqPw9yPw92PAzMiJW7BYNh8ocXLFlW9tupeSqtV" +
+		"FrvVon5s5v7aOte8vOzx5AA754BZRXLrU0nXZKuCarRXZsjCRNhMkg4rQIn51C2ZQ4t+FT0VuMVs" +
+		"zw/HICgm3dAITI1v3aL7vPQFAUoJTs2IaQo7uwI2uHhXfxXUA5fKL3syN8CSlzccaxOTwcBi6HvW" +
+		"iqGQhLzPqoVezyhZZ3rxjz4A30cbKUpK+vrsYCwVUtkULqx+AKgpSB6DLg9MU7XgGKJfkMUQKz6h" +
+		"gmJ8/fvpF3jxgQvCOAZ1k4zhB5sN+2WYnJAUKufo/gGhibHti4bU+/b0p1GuLDF80rRojD02TvDi" +
+		"fshcwzi0YPakd3NmDHSLLbXj4mow91xcHv+TrQK+GDLmu/6CFg+AmH2RasyPdAqf/MbM72N/xDVG" +
+		"vLabL8qhZjHx/2Fsb47dPu1imvn9H59YRvsCrCE3Kbe+pTTJC1ZNwsJ15TaVn5zMXk/f7OZV0J/w" +
+		"emIMNi1aga5eVDnCKs7+OTV3rDa2mWI10gspWeLqefw/GrP5eNN+7MKCR0HJ7JsDukMj/fPDpejL" +
+		"FQbByT09W7WMV4shUIUxfcF4M7BSokS1NvmrAqWpnbeIccfKtsA3CieyyMVovlmd1bTFoAr0sDpi" +
+		"83A4fzTAZ4lAH3FQP36R1MkSQvknP0r40dqqsXCoacnDvTyrmdC3D8pI9X9n6xRzCJzaNTUJZDW+" +
+		"ZT9GwjeWjL4jH0grNIvhqYI4zReBe3Gsf2XRA7FtpHRNNt9schjSdhMcfmm7C4WpcOC6kbRhQWba" +
+		"wsVl7GnDSS/5uR7UjCzlF+dkQLocuQVJOCVLstVsclqYzUC6t5dJ82osVjZbFptk+4jlwnL8qi7F" +
+		"u+RGHDdFQHbnyzYwvoV7gdi68wv336HYlgzlIrgN75YQJDiynsr0jFYTVtzWoaeIgu0qSb99AyEG" +
+		"zK7gYShXEfsi4rmG2JYQp4InxiqDQXZYbJZwsCc1sMhZtw+Tfrz1yme2XuH5MxVe2aUTH2c0at0w" +
+		"XUbWmOd4A/vM+xZExdtEg0SOWHmwb3nzda27r8Pc5ypMuxrxJ6YK1W2eG3LnuieF7vnyZveASGpv" +
+		"Ctctp/ZGVnbE7LJZKYKZwG5fAh4Y7BS7y+73QPhM9//V2lSZqN4jjj1DbJrUaNlqkTEHusXWLN/I" +
+		"OcgeW/cF3q5oPrFHuzu18xbGXoKNd3tJdsoclU8mMXjuZa592n0N2O7daGc32s/GSXbedtubmRfr" +
+		"eetn3dKkdE1mn8lyl9a2it5kGpjztCz1Iybd0AjYPyRkUnr0W6xQNNW8NVtu/8fpLpzKTd+Zy92Z" +
+		"bsscptyWN0nKrdeTEz/ndK0at6/c7+eBFh8/5Z4t0fi9LtxhNUTyg4d2OeyWY6nPzeYSpcZnxCSo" +
+		"1P4qoYzZwLHd3xQSCGiWY8Nowof9h7+Z5ZPjY0t+v/3Yxj7iKefYavAGcuVSpXU57WWXY+s1FlxH" +
+		"HBsa7hDLBD/JNqD6Ojblg9PeQ6rPew+pmNcAGunkMApuns4f2eiPO0O4zu2P3P6YEWY62OjBtQWH" +
+		"nVmPZIPNWEhgW59ka+gdhulaFO5qVZltOk08mdtptwoHwv3kmuqj+Pt4A+2ux1GSPVTDUoY6CUfp" +
+		"oQvV6A2Ge5W7BLuSWTRUu0qI9+W1Qjck+dEY939nmPmOUc8ekB9Vjq3+oDVFlUMXaVp7+DWTzsWz" +
+		"vQ23aFbaZ16QHTXme+RDg6EsEXMpx1HLfeaONR0f+VGYd3ngd3m29B/V4+MsgQpjfI334MM2v+Bd" +
+		"vZnmiHPLij+B9QtDhUzgXaeBvJdwnLfZEU6/uTce6NioPa4P/FJWrwzmKLao16JKWBRsxKLRNUo3" +
+		"KdWpwYgn2CqDQSMgQ7vUxc8dUE6LOemPF5y5LpfjVbZAsVguyERh77I4c5ep3p0wIC0f818V9kw3" +
+		"XK6wdnIvz3ElZwmhMkssNrdzwdBrcPz42toq2Hwb/6o/6xiE8m1BbRUf+KroPVncfazyjM2mwlWe" +
+		"fge9yks23lOai7erJNncyB8RX5gbmuI83qElmWhWLrA1pH1MqdzfJ1pVdvEzapXdn5o4M/kxn+gO" +
+		"JfOmyhgiqm0Dl1PZzZDf9VXjrn409LNzXZdRVGmxecb+2L9KO4qdc6eSrh/Vu0Zj8vlagSrRd/t3" +
+		"q2FBbq9eo+Cwd7Y84yEUzDAeUilRnF5Va1RJicJ1llBwU6WWfeMLqPiapbqdnDorIM/TL3oqHuw3" +
+		"fH/IIDg1I5TUtodQMN1DKqVm3TCTaJvdJWpbkomdWWOdvSl2i6JtPbaH/qZhTP8xTDVKcL6sWv20" +
+		"MaZxktG2j6ViaL66ALDdN65YaP4+fRvz+jMZl2IYcWP0/qNwkGn3uu3cQGJYuE1m+ffqFxDtXuVr" +
+		"clrcUb5MHg+rYT0PfGNxVdL1o3LfNoyQze7hN3/fexA9qg+1bR0Y2zoOQrE8OoXrnmdHnvYGRLMZ" +
+		"esOPiRgwT9nZMYmtO/sGyG7OWcXT7782eVZ5ei3SI7bXpHRN9sxyp8DrXWgb2HpP4hCcmhGr/9r4" +
+		"BiwpTGyO7muDIvEiYlNe22HJD7G4D+Nkwzt8pDCxOVoxBbG429/igy0yBhprX3LrU7FGUe0CcQ/P" +
+		"NUTtbc8flXCVaYhUu61maHk7KLhh2d+5HdggazJpTnVmgAeXrdzt9KOoKeyFRK4AbSVFW+YxRnP3" +
+		"58Dexoe0lyVXzU4lrfDNXn6bBFKqU/PIdBlZYx320jAJHsd8g9qY9ruNGUBU4JB73+6crreDvfLw" +
+		"DTz4trX/chmx6a0PvWXS7HzZbAraup2RfHlq67LxsSOxded0OdrGdc6rWo82STCkdzkoDi++2M4e" +
+		"ZIkKdrb+sBiledXZJ3rdzM+m55hC2ejR60nPU6/f5krZdt74W7AL/0S0RWF5/gtq6UfrlpAjwHm/" +
+		"S2vs6q6gBc64XBVBSzLRrMwxJastK3BItQ+WtPM45rux1BhTYaqHrUVr57LWayosr/l6JrL5mWJ+" +
+		"y/2J9uVyyPYVsZU97eu3S9q1+498qHZs/k2VRbMz4NW62LPCvt25fqH73YSuzwWIO2V+JnGYpmaE" +
+		"mnS73u7Wifo21zX0rVm2PKz7cu3QJS9kFbBnRv6a5xhK7QA2voxZi9fyjYceeVj1aAfVII/jAdVj" +
+		"gEUQBghEafvE3W6xElt39kx2YcYcegC08WTViwYJfM4GMG6m0P2LZ/C9bn51Oue088fBz2w/bDqd" +
+		"VLW4j7uJG6NJLu3V34A4Mz/HDzyFolJiyzkZXb/DrVp/mWTPmp/hnoq1WD2xuNS+5nlmfE+QclrY" +
+		"nl8+p+ucH9rX35y0lNPurHJZ1dmwsFIlPPr8ApDxsH82Nsln6bifrbK4j8REgRixM+qgprLOB1RQ" +
+		"6MmLvU/h/ZcvXx79Wmf/jrKrQeukdBHYZt+t1s3vnlCzDODLen83fzP3Oz//MhB1+jL0xzJfSfey" +
+		"i5Ol1UuZ76vwxxoWtjpFSTzOiK07rz5av2iXQqS0WN05Bwpzxbqbb8w2TnxjM8EcGmvQrHi7iv0u" +
+		"17DfYs1V/EpYXxfw4xgafGuGsCazr9M7tu5sSeZKGj8uvpzAfHnP6IvT7dfPtt95sv3yXPut/Nqn" +
+		"ItfjXWjS4XeVt1AEygaJ+H/66to/Wzb8L1oB//RVtuPPedz0AkHFsNzwbS1IcdJUQHjZyhzHYb6/" +
+		"9MwPKcEIjorqxV516Mvoo78eYetCESgrZYNEilC3Rzc5YiQHhEIJP6zpOx615OGuy9BMBoNGQJKX" +
+		"bJ8tOTSlMUIfr5gShVoLrCXpNJRgMcwMSzWHxAdWjCQsvNrr4u55tP5jHy31Fhl7LW05UAyHFB+d" +
+		"QgIBuZDMSaU+zjzscebhjzMPPs488Mztz3mU/OdE39GfgdLwvjUh+XvHU5NVw8/e/egef3aH5sRK" +
+		"H/Y+5CCNpV2vGY9DJ3RH3fTohwwGjYAUVbZKARfhJYGS+uLFJMh0GeHoBQMsRwWY9B3oo5bNBG4W" +
+		"OyK7woL0VT0VqIq9r6c0PPT/vqWxUXqhO8IGQVe8v6dCI2IQCqipZJNq6Pr+OWQwsBhm2bdYabLj" +
+		"p/WtdpOWsPYlkgk6cq+y7QAdkAjqc9anmAwGFsMMv4scUXhhToWqsh9lyFc9W3dAIrC66WMjFVCx" +
+		"Y7uuwjjw85vn0c+aKcR05nRWbTRMtlSki6B2PSHV7R7TEDme+x/8fICGQpEuQqVsHaC9VsGWF0ER" +
+		"h+DUjLRqA/1DqYvSpjayvr9iphgxsv058WsXKu5D6GCkQj36C/WW/qqbSTekP9h52HP1Kfl+QXaK" +
+		"BL39sX1ZtRg80lTRKK8mEyOxXpBdTAYDenTmmq3FotZ3kG5jeUqOemuoapvcJ7Y+qRFHeQ1Z9Hjf" +
+		"6mib9udsfpXIWWPbngxH7MBRs4Y1ys3WJ7e5NrlJWmS6jCy2apC3XRxg13AEL/V54RShsneZRBdt" +
+		"jgFDh3L+4dzWZrb4QWOIphLD0rgSRcjGHCiBspqkJzOvB/Trz9klt5hMtgE1IsyicpTSR0uOls0r" +
+		"62q7qZpfFKMLko9rgKilstkkGDAhDpCO00UvR9woRrz29hHic4pGjNK87HQ7IF2QzAwHyedMG8JK" +
+		"QWP7c/4taNrPn892H/8bftsfdEF2igS9C0WghLt2dEDJDhIm1gWb85psdPmFp5PSHQeaU5g445nd" +
+		"3Q64hc3JskCqmBJzBYWJM565gkteWZ000K9DD1mSmEREOV2my0iPrMHnToGyT3xCFDQ8ntut5OCw" +
+		"PcVZphlpwEhVoxigAs8xXn10WTKFUCaTyRzUv7ITDOgmNeYUhPDnGlGlCqF7MmYN1Baz2Z7Jet2i" +
+		"pMtVkBpGvfApUcqRSFs36ZoA7Avn5krR4/dH+4mUVdOShKWIl2KHQhK0QuIPljghEmFHqDskCTtM" +
+		"ks7YM8S8eZ2mOoaVX4smiLbNEQ2vEi0SA0J0WsA0NSNWQLHkBemBvpr0l21Ucg+m2IRC1SQXhaql" +
+		"IsTuAyAHfG3XoIG6mAwGFsMKnl6/c1bvRDImwplJqVJQhWBOH0EYICzRB14f89Jx8LPtpTQxGQwa" +
+		"Ac77oQGiD7PFKibV/Rjh2IK8hajHcg5bosD+Vfonk8LE5ogCBqtN3RGtLws5qCmmtJmEob0oJBhY" +
+		"BHMGwdgjY7UfZAzUmaPxWG3RXg4SJtYF2+SJzEENHWOVymE4RIOgoRq0Nx/WHQ92x8O7I1v90H53" +
+		"lGonVSriEJzqpObo5PmhBx/FnL5bZW6jBmOtezE5PEau1ZcsgJM4NCPG4fAFMC53kzR0unCDSIi0" +
+		"D12CGzyp60PISYuxOWIzyczeovuWHMw0I7EBaJ05MleMcJUjWt1j7ibVwP5XvQNW74F1dkFtbFsc" +
+		"D9LcEcCn3XmI6lY3ufeywx18Kale9tK1Z7S2JQp0uUHq0jXQToHvww6pm9Q6+xgmGiMGgCbtbMMz" +
+		"yCemJBMxik9lJDpTRyiBsppkNHNLWsaprYdVrCoZq415+/ORsTljayuFBII6KrU/H5H/2y4KVQ8N" +
+		"FKsGmk6YSusig55bRqgGuY1roLogzAhxl9bJjDiZOepA55o5dXEITh6JWgFLdvxooidSsBbPKBSz" +
+		"rq6YB6Xm+pIdZ3TSZdQdktriPYnJBkD/k5j+8Ca53SIfcvjErAFiMmN0o9OmQUWolqCBYhqnB0nl" +
+		"Hg8JsmAj9zjxUgi17DIJsWelUBWZfqRTiGTSyEInd8LPgCokSLZ5DhoIRe+bQ1OK2P2cYUoOpVIg" +
+		"XkP0t2w9Lnufy+x1WfxDMlSQKxaZGQQnS9Mtm+oVlorDIz8F4+ygcWFrgA2McIpV9i0bCaghp7Ij" +
+		"1NGOgMS0dA1QrR4TqwpajM2RtQWX7Iw2I9KdrhX1HA8/k0xqhuyNg1P534kDbWr9V2uLqltCy1nF" +
+		"ZDDwmIY+5pyuVcMwqbrVGb+O4eT+flkj+RSEQ7GXq53SRe6AHDaT6nXTYEsUftanJpPCxOaIag5G" +
+		"QTc9GQzZ464geTOJmKjnDQJMetqFZDalscK30gXVUdegJ3dIuI4LC5Nwic8aF5Kw8GJn1QP2V30y" +
+		"DBCVokFCMElErD7NWZ7gAe/egHiYR2iAi8JywjbEFveY8NOMQ+2eX8dEZ5DlgXbZ9YXgSDxT1AOm" +
+		"7nhJVymxHns0wZ6x63d69bV96/OGDazdIXM7h1S7/gLQgHpOKQbq0jaEPSZxYpWxk7k/uCvaT4s1" +
+		"bzKlOsmdPdllkk3ZXFpeOsOT2gpCKGgB3W9dbnVzEKfk1GVBJJS/FNT1NqJBuhwt1tye3vo9PrVG" +
+		"evId8zFpHI+ajUbNxyKQ1qMdUinUp0miULVrsCUK2LQxW4/6g8tOiItPMWmGuKVJujGNg2frPKED" +
+		"kAAT3QEXBcZ/JQENh5Gu318INYneXTNJg1+2L8oaJYsyyutFA93EXhBbUFuUiq3n7FL321/raX/t" +
+		"AgB0LqSb/VeTYh/rF18+r8efT/zzidv4t9Mrj3tejcRtvHNgsg1IGijmgHAoN9FAsW6vrR6TaNR5" +
+		"lEI3mWg5XiXLgm2ynqQWhVFdTAYDi9GNA5U8yaKLyergUfUyUtNZ87k7DNN9qwh1xCcwAo1ulK7J" +
+		"OK0NTYWm1Hrf5SlVe6EjPDJmIPEuVZ5G5TIJJw1fV2tF77vEO7b8HnFhHu9Wo1mftTaxNY7PkzVC" +
+		"z3Mq/NxJTSaFic2RFegRjYU29ntmoHKuiGpxH/B+Wrz3c0smWdKJ1cBE9T7zRjHUICnex4EZgxSE" +
+		"9621cdD8ao1qyfoxQLZakBJiu0+imxYfpLiX9VVD+lF0vU9hFCbOeM9JR9443NVRXeSrAQxfqRTN" +
+		"5Cseig+8yD0RHshbJQxH0YttIxhH+JQWd23lZ2SICTGoO0yTNEedSDpPZ92dT73Qic/MtgcEJ49E" +
+		"bYGtL6ge2nmevNlC0Pzj+B/BGxm8ubU6cCWdb0W+IjOgaqj5p+XcHPkaEqEBLgrkrPfJRvgUk9Wh" +
+		"GRFiNhmnqZyODhcAujCZxGyliPlGDTWH0dx5i8/KNs13XFrFHCIeqxiFic0Rm5PD3Hrn6VTqgmbG" +
+		"JbVjuhaFTayHQ5bnVk2qE4qXS28/EtRUNNDN4l2+aHf5Yu56xx9SDVdCOJSSNNgQogEKWrJgTgEZ" +
+		"DCwG21/swsrIokt1gAmX/wrdpG560XtZKuAZM8VdUiorWzy6NsmMlBZlEUWTmtm1NbKsjIgR6lRq" +
+		"hGhYTKli3Z6FQqCPFqw6QFYMWvUZM8WWHCwGZ5JBsOisC1INOOiqzR784fPEZqzxHNlVJlqEokHy" +
+		"QglYCGdzsGTzVFZP22FK3QGuSKtPmj+xbRpoF2nofI19r23oJk07VLtL3UwmB82y3aOmx91Vlahz" +
+		"01VdDngIZMp0QLWdFycjSAgtAhWGZ/TZWuMo0CLma41bgF4+BKeZ5IuE3OwJauSVRWyxI9zyBEbY" +
+		"oWNIM3PqlhGOJZX0UAErbX1DqAo6xBBztrFozVWZFCbWBRffiayY9bfG2YPWoW+iYUKINu1rTbqV" +
+		"3L3g7gX0mX9n9t1y10tUSDXglj1kMLAY5hG91MifaFkYR9WiaeIq6Cu9xvHmGZOvV+3QJDiZ+2WS" +
+		"pVcM5CrpU5LJeJ9km9oxBvTz4I04x7Dw4sKCqJS8KHqS+Wwa3ME2ff3M2z7GPO4/vAkU9QEI5bk7" +
+		"wEdDPV4/EXOmIbU1P3hmNKRlaW31sab6oKU+3lCfeGwcZccRee8OEhzNVbnklbe0arH1/6gz40F/" +
+		"JSPUZyQErfRf0Z71b/zrnQUV7c+n3LcbwiQmada37VflUkwI452ydYdGsiNiUTxKHIKTR2pL8Au9" +
+		"KhHjuVmTOKbLFXP9iIOa4oM9zjEsvLiwcColm6K7zzFdi4KOUMJ7QzgUXbIwQii6K4peyBVcyQ2B" +
+		"h8ZG6ZqcPWbfYgLBgPpFzSFwf+nK4yEsUl3TpuFwGDkk3o8C6FFA/CcLhoXbZOSc7voj+4BiEn6a" +
+		"CTCy4VL0XYUn+yrGfdIFGxklJ7lYFNYAFMR3DfTkCwnrhsr0XfQJmBEiNTxvvq0nGmtI6LtQwNPu" +
+		"DTqma1G4R5K+oBNl4XRNbruzWUXqJAmTuZ1UPKWw/mOB5SxJlcvQ6nn49MYVr2cXiqHusSPUCuw8" +
+		"+Q4YsSWUQiGBAAeltpOGr99cL8vNdWMWbrfaC24VFrtVWLzp2D5aR9QJO5A7D5VmJXkwn/cXQgmU" +
+		"1WQjUCLZeX/p29xOYWJzhO93/KvDVxLC4fJl7OALne/ShcGQ1aTFmzyrA03I6GKMnNzfoB7v5Pbn" +
+		"0/58Tj3MbPikJpPCxOmtJZxJL6lUiEPrJL2zaNQM2z7JjbjTTr6MZp1Uk0lhYl2wTZ44q5o0Z39Y" +
+		"xzLXh3fUkkxU66FTD5vyQDaCds8zfTaEI/5z/tOHpYT256PDzPfPd7vdYqdof77b/a2BVIjWVUbq" +
+		"0Q3a1QcVhFAQXn++Wxj/QSC0LHyCACJQNkjGayzitDy9STOEhk9BqErUAMmiWkbw0GQPJBsfSKAI" +
+		"lNWkxVMio0fXAN7dbMNlkBb/1MinZvTU4QzSdM34ibHti0Pju6HKT6YdsWaol0p6P2N7mUyXERv6" +
+		"+UxCEShpZmqKkhFKNdkAKjToW0wmhyE+NFBMGmgREe0V2VyRBTFN1gDY7qU7SHBkAuPpDPriXYqJ" +
+		"atYOFlu/bTlMYpT+Bp0TjSlmmUSjCvVljTq2pnNrrHKdX52aiOgqbQIyZZ7600SDPoIwQFSKBgmB" +
+		"Ut6iQdBwPDunTJcR9/JbMkL6ZkufEashbWzRFApCoaZCNAgaqgGJ0luD7VbOPqkBv9vlABMywGCQ" +
+		"3ti09Eb3TW/L0vLynMpHqgNMCK0EKyBpsN2YY9puF+SLAuZb2arWhFuUpFMgXi4xGQwQ80jlK3VB" +
+		"mK08bkEqBwXTl2PGWDOnclB+Kcz5685feBzbIXWShMnmqgq8EeqGZ7RkZkPW0wTq2/8hRIb9n1ST" +
+		"jDY3lLIf5/7nu+XxHzSQSiGmT0ttJCQmmTGdrS9oZkgNRQPNV6uRMWBlDlhDVpMWT8kSKd4aIBG6" +
+		"XmbXy29zUU3bLOcN4U1MTmhGAGTGQ21I3SQEKI+jQu4lU9Be8oxBub14Th2DRtZmOWKPFEMdRdZb" +
+		"EopAaebaCJQopd5G5n2SxmpanB9rkEyBKGTIPVJ5ZqyBoxhAiOUmDmYquwNMGqqfvMrZFgoTmyML" +
+		"pGX00/pg+pdswaRG4wvpBmrC/KJyeqHXFP7+8ner+l2MIcv9bRIRpQaTatDN2G+pFDbUbqJSwI0F" +
+		"7S/BG2sLW6E7t2nHaVk/Zz6Ex5f8lDrJ3FWBB8KuyXV/oTtVdiaV6oO9eGiwRe2S47Wbl0lEIzHH" +
+		"pnokHHQAeGhY0aftLW9SXNH9mFdl51W4HCyWSdHnKvucbXCNpZrUCATjuRulGrRK/VWeJmHlnu+2" +
+		"63vcdaNQHdRlBENt2y0O8dJgw1EF0OjXbWsxT6KRZw+SGb/JASb9tdtJMApSSqWgteJVVWchIzrS" +
+		"nduE5bhD4kypEp51e5qkAUciACb8+qETjP28mYRB2yj/f5r/oQG6rcp0GbHzNt0nrWHCp1KCEWvb" +
+		"ONXDNnRMgFtneNvaW/rK8EU2nbOc1n2a0/oyz2ldErcKRKOdOVt3wRgK/GzgQNayV0kmh0+/vxCy" +
+		"e/T7izNJEp1CeTrQJPSSatIiKLFNgHQ5cpv4TW2FfXtLXbA5Ly6ZIAR8UWciE3VOYQxp/mLoI6kR" +
+		"bqKfRTVgxC3WMMmMPDIMZVVY0amtiZyrDkFdqi6hX7AZnxjsnS2i4npLkQ3IeUmX+sDckMTIB45Z" +
+		"khn1nf2JNL9Kkkk0RmsgHpiDspftG5wiJ4jgyyjLJIsuvKzrUsdb8JNWq+c8FKbcJZW8oJljSpNo" +
+		"PGopjwUlLIpnrhpTmHhJmGTGGGSSGQ+MTYY0d16hdfxKpwFjvy+RtKCZi2+lJtEMOH70nkwEygbJ" +
+		"7dCS9crqIzxwAbBD1/WNA5LkZ38t2MjdJA2YQOC65MN+9uH092Oz3491AoDu+Y9FaYge9GH/+Vjv" +
+		"4WXeZyT4O/41/796YP/VlH+R8i9T/rWUf5Hy73eRah+NaFINI2/9v6GLqBwx15hoVQczIUOSGtMW" +
+		"A+RFIdWBHjzlXwk7mQF6zGX95eJk9OI77yRu0r9SELY/X/nzlf8vjGDUWf6/qoaxe2V7ayCZQoJB" +
+		"dWgkrZTwMlgwtg4x2lK2t34b0EDgkixNwuYPulSgmgR4Jw2A/UURpTq1bmip9eeOvrJp9Vl5qydq" +
+		"eZRMkZjmKLleBDVoAbi9NwAFVm53jci1siK4bSQ2SxZOk8XnycKdLrbLB+hicycmQBz4YpomiQLF" +
+		"NkkzBumaoI36S6pJnkMWhalc9yR3nb1P9gjxDJa0drPVVVkwLNwmL8n3zZPu4mg3e1bN03OYXRQJ" +
+		"P+qSvQ220Cb0Go8f5ZDwqy+ZqEHT4oj4wPeD1v0w3w+2EGOM3Ma/nuDldqPpvu0UDbJ1k+6h1+xy" +
+		"e4ho3KMUlTFv1aQ6xFaCOKgplSeFqjtnZ0YwxsZbZpOR+tCBH1INCGIXk8MwticEE1UF6h449xC9" +
+		"ASz604gqYItCQRVpcKGopzwZDppGgolKodEhUudxJSxYgn4esy4Ifx7OzD2MQ0OY5K1B2C6T6vKW" +
+		"HEzKpDrJ3Swfycj9janIAOSZcUY2QpyGWQPkn6NQVMpmsgNQToa5mV9zh6bFZQ0PDeBysLIHSjiY" +
+		"8mAJh2XAfi68f6bvyY1QM9QkqsP5ExPFMI55oeiNd4nPF8Jbjdj/zozW7Y1PTL0H0N4pLB4XmqRm" +
+		"sRhBlTiGgp+TzJikrZiuRVnS6jeV6o+yRs2szar5aAIdRyPu5ahENWuIYrIBULidcQcVCqjtD77G" +
+		"JiaDgcWgHkntgSeghM6ZUIHEbpmC+aqGuzoy7v4OkTRAuoQ7PQT1TlEoqOanSb3F5ohobk9KDI9q" +
+		"CYAwj41PesZPmOCqrCYbgZI5dA3gjR2UeL0h9iu6oEbpvWgqiDLXoY1NyPcXQi1Rj3Qe5nY441jW" +
+		"bcwjx2ME2oxV9JJdqnxLehhgxNe93/pNvwM3SCrbUfEiZJNSJ5lXnmdRqrJgWHhJgUqrop82IcMD" +
+		"Uz/CMI34ftPnhpBqxNU9JAyjvp07WNuuYzd0tnnv2OLOBu64/wjZ/nxfWsQLP3ICWU0yWhMSaFKR" +
+		"BOFNnx4ZNcPM6ABRKRgPwax3DXa47vqiNIDR+6VCrfWh4Qc+WvC4LbFv9y2l+P/o8JIPyu+5wAPm" +
+		"9sJHgKjENhXm1CyHVpiHTmtfmNe+RCuAX1RX+ZS6g3Y6igYhuQwG1aEZGWDDRZBDum21lu/KzZQm" +
+		"k2i8v7ZYF6SZBVu5ifefHekWxaQZrC7U7fniZET8E4pASXNkgf9wglBKFwE9F4hi/mlUeiNEZvqg" +
+		"6yVpR0gju4ekHbEHQsYeFntorO6ffNc6ZtuVGVFz31ZBaM/vlE12yNsF+ShVJtEpZsjyoKTO6LWk" +
+		"XiD1+35KHvWN/TUJ/ahW7pFatQJaG1wyvAR17a3jqCPB2NEgHABeov1oZBHvL4QsOD4eCDVBfGBi" +
+		"PiBJryIrNygqtGvjTucQsDFtKrsK3fNReT+kmpRgRP8dE6FX3BnqS4BOsV9TScZCX9W1AeL/T9W5" +
+		"ZTvLMlv43o787XIFVmQHwQH4ZpnW71FzzsJ8F1Y9FEeRoBw0hSkXZlWUKKsklWeUCtLKnSsP1B5S" +
+		"2kMkhVBj5ovzpktSakXlKeWS8iBwHxAPTDNu6ciYdxcwIgc30M11n1DW/9jL+u09XMugnA89Lhrd" +
+		"19JZHrW2N+ZAvhz0klzfrlW+vr49g76iS0K/k1qmZLCW0zM9b+rCIttRa75JvlLvVMJNMl6cdZ1I" +
+		"s9pS85bEOukvSnp23tygb0t3uARTy0cp9pfcsusy9NmOQbetzWCegF8pNp0+cnQdHNqE7uSgVBB3" +
+		"RMqf1d2aMZjYyY/1mCCTQnF6xynKkw+bTjIWKY+qn9WI/sMaUR25kZczFm91gz1tGhHToQKZlJRc" +
+		"Xf7cTeMkT+5tcJrGvt0Uwxd/hZiF0oS+4SXFYJvMHnIW3uuJLl204Rdt+EUb86INv2hjXrQPxCsh" +
+		"yufzs5bnTZ0Y5RnlZtRqh032bRWzfVvFdN9WMd+31dVEwL/WOlh8v9FUFLfm+FtruKkTn23FHavm" +
+		"OJdsvxz5+o9T17jmqIebmuNtc7XmeFO4sX3hV9gbdb76tvWkfN185+bPXpW3Adxm6u4nvc8bba0H" +
+		"JYtSjxhdd0FzLYPKgY0pW619k1IKalcGCokc1GtX9c7Vu+XqvbDS48Wby/8T5cnGpgeKqp6jNjdn" +
+		"SmbFbrIymV4pdd7nAcFCnV6GE2WwCrvQpKzpJxsA8E/+qGJz6EOULwEvQOIoIWmQAM2ZdUNFGvQx" +
+		"GSIEUgkR30hAbiEWSnfF5roLugDlT9yYkYLC9UeLsXwhPfvR0IslnUF4RlcIUN+UzJXrgNTyxv/c" +
+		"gpQtHrhTGBulOTDKTxrdJw3vk4/v/ePgALh5bn5qnEpKmkJKvxGCkyHplzVlsZ94gkxcDkva05i4" +
+		"azFp1yJ0c90F0sl9+MCSfGejATLZBiXCY4UHCk4I7NOEUiHm3kqgwuxS5nwlCENUUg6PdbjuDuhs" +
+		"HNsX3iGYSw7xh1NaExlEsWJTM3GMcijKb2pxAr18A9tEmbEUmnLIvCKZDT+HmQEkvFh6psdK9irW" +
+		"5+vfegjGMxX/EeitvwN6z/8CEnkEjrr0Wf77o/zfn+QHv9f3BJrqW8qdjHX4eWbaYUUhVf1ex6xS" +
+		"894PCHjvnIiExrnxC4LQfYPOUSpId2oPR6clXOx4RMpA1aE2Shk3tzIJvhjPJ8FUws/6dN0Jub4L" +
+		"qWH5yym2b+63Q3i2MoFZhwfG8u2buxy8jJyCSpxmTppjtr8SXnOeIJO3sfLVxgqnxaFRoSWw1kvw" +
+		"VqPprVTC/9mcSPtCeue13xCFHnf3cu7e5Iiepta/U2EvWLwbLLOzM5rJ1fexlviFbp7a8yPSfKQj" +
+		"TpCJq0CphKaMmyci6X25o8ybelpHmbESIZBpYPnaScaz/U6I4cb2hXdQrwFjWTmvmEo4Dyl6cMYu" +
+		"lYCrh9Nl+vHpF4gUxc2bg5Dm3dtp3Gcr1VZ2AyYOB0r2DN9AO7N9Mpmn0nh6OL+UTy7WWzdyDtf0" +
+		"kTzWMsFNupE60jzS4RoGNWlv0coZ14dPFUXn6Cf48iA5SgVpentr5ZXl34wYMBXdQotnVLyTLMVP" +
+		"prCNFW/cxeu+zJovs70XNcQKAaTAYyh1vpz8V2rcHHji6jk0AQ54eqP2yXDSJ94UbuwT77Bu4w6+" +
+		"VEZTdY/mFT7YTEzgd8e+G8+hiU+h+hmq88btkGVsWG5LLXLOUECTWg4hEinHerg+YrixfeEMOTMd" +
+		"GIEmld9L78vsSfWDc+nBHuhTjxDro93QQY+GeWunGG5mMnIwNH2f5+Wa5kx3g6QJRcKdzfYwljgB" +
+		"3rxDcVUkaVFEY+fUuY+NminrBtY3vMjqgMDyOSBYId3vsdhFmDrumTRYk1gnwHcotzFzG/dzhEXC" +
+		"jj2T2hKWtBUPul2CTs3i4PGW841pbKGt7wlfNsx8iz2ecZnk/pHxI2WgalLT7WnMzUHOXShNtfFu" +
+		"MLatfoGnYcxwqUjRA/MOafiuTyf39i2gQD78a2UyzWXJ9LUmmca3jmHStClluPmopafc4d3DmF2C" +
+		"P/SOkR6p7zfJqHhqHoPLq6a9EMPTmTU4TP6LEAHSAnKzQ/rYwdvXR1uNAM3Bwn44Knstb6yR1zUs" +
+		"7/qDnhjKnZ0ahST0IWLxiE+CpYQV58q15moYf+ITv7XK/a1QGNKI+nC8BEoaSVjS1ljqy44IUUya" +
+		"zRoJGwVWAmvGU0DNvxsXAJ0YgOXkv6KabjauqfkXvti+Fm+S0RS2xZjkyNGISex8ydIhTGo39YmT" +
+		"WI4dr2YojmqY1IfjKzqpSna+wVH3H4h1SMGPl2/XZdt/IFFZeyy/Nb9uMi/zwEpq5QNv9ZdcALxW" +
+		"onzdrJLMt16cZhguY070wPgOHpEmvHPpwNgvCNZF0YuTtbC0L4SAAT+5Wu0AhB9eumrDhcfrpg58" +
+		"rA3TJhNvM89gsjzwF1uTZPSEH3e67HuNzqm7w4jtC2+zZ8lzwCQJlIJHvmIDypdAdV45voB2twKn" +
+		"O4jS12Ug0CunB6sp1+FaPpyvqjWwsdewq2BH5HTQREY4MFAW0CS5xTBBJi91n8Xtd3m759g9y87T" +
+		"5qBQwLCchhLE9oW3v5//GwMGAb2RLovHyIr3C0GPX/r8upeSQydR5cgQLG3GWKbW/Lw349U6f7A1" +
+		"66ddNfwXDNEdDIUDfxTj4+G4pGrag9s6aj3sMGcLECu63hZ+an1NoH9kCCbcvNk0Dk9NK2HtRKy+" +
+		"eb02DT4FCn5gOyy30Jvc8XThRCMCoECvZf4HICAw8mvd8MWXGzu5qfN2pNlfnpgoM/eSOEVZVQWv" +
+		"WQUvz5UbzCp3m3sz8ibUXtyrY4BZF4H8anvQU69jOXXhOY2nG1WfptnQiXHiDOY3GncoSObNmcDA" +
+		"Hu6+R4D5+hB4xAmKotTwqERNjyOvj3gTjXyjRkCT5BbjuMmN9ZjgJlZ21wedvpgBxmwOQ68iGSqd" +
+		"4cmchxSd3CwogAkPAPhTydgm9eHorZpTfabl/Jfi+yYZOZXNboPvwNW2c41FgIC78to9p13XmDW1" +
+		"667UdmSJc+e2ZGqWpCkR010grRtl833IFXsCTCofQL5tjHfxfTEnGNkcO6995zMfQCl1dl7NW47p" +
+		"GCa1m2YwPhcAPREuItfG2uDrTKbdn2WBJ7sJ7RMn9AkKoT5p7hs3zP+hfN18h9FmhYr/An3jDmd7" +
+		"hZZ3PdFMzhywqmbA556T7cEUnOh1Ts4CQjdqnoh6IiTxD1X6tgOBOVZCP3Hsy5vjVx+7znGrxqxt" +
+		"RXcJBSdf+23+3m/zF3/bfPO3+au/bb776wTjAeE950T6HUyUQ/jm43f/J1QHmiB7j1JwsrD8o2EH" +
+		"uwSckDWJDKJe1NIkbIuMaCq6Dg4ewGOwJiKqLT5euJUZMAYfQZo/fxgoIis0FojHJhWDQxcoeIG7" +
+		"D0oGU0M1yNF1cGgTupPDjAbDYO7DcwfEdmMfk/M1ke2XDgMt/zRf/2m+ANTmClDzJaA2F3yaVny0" +
+		"e6tpzafJk/OHzScQ/Z9l3xqIN03WNZ+taz5dB0ixTbptfTgqpRcLQLt/i8KpE6X0yuFEJouIujG0" +
+		"NCSbFIMo5BalgrTMKsv40vShHGgoFZ61PAMeA526Y7r9Fb9yHrbp7WVonGctT/4qCPmaqOtay3OL" +
+		"/EDLzfn6dt0hZ8Ye4PaCQpXghdtWT17oE1EsOF5MahfLdSGFi/HH8kZnxuHmxL68T/TZJ7vos1NE" +
+		"Klx1aHcjbO/X8rf8PZIdEP9MmOlfgkjLXyy1QB5b/Yk39YlpvfGx/MVWn209tm++gH+UMUh3aquq" +
+		"v7T8JRwm+vJnRbGS1Lb8tfgL0Ze/Edu+/P1b/qyAKN8/RPlb/iwJpMFEkAKSsOAIzwgew3wQh5EU" +
+		"i9EYDxEZU1EV98pxN6lPO0/qE+2n88V9uZbrf2vOy7Uu1/rYBmUM1FYFgC5lj4Wi6MSHysmwb7VS" +
+		"WsSXHS+k+XoxyZfZdzv22KRicJiW1kWMte+ymIClvCAQtSjtgsSP5eJt6dJN6LI7D77ha2J9Rte0" +
+		"rm13TQNPynQfsX2hvCO96er419pJMO5nfsUJMCFKAbb6Xq6127vHrs1uxjdK/Wah3xkCfgWCviU2" +
+		"KXopNBJ/9+UKyxWXK652bBDdZIPA2MWBdnS8hPKcIFN10wVVKGOg9ghlRihUkBSDMsXm4Cb5W8rP" +
+		"J4RZrEnyDYZL7yeYrm8p2es7Noc+RJsa1Zejy3Wnk/omQgdGdH1Bw3VAMLtD0a0d2dWPOE22fqh2" +
+		"AeBnERHQSmK6U27p3+oaho7EOeF78SysldlYclIX6gJamJEgzMcqxcqegh0GMQfKKI3EBQpgCs0y" +
+		"leVKhx3RBE7WFF2MaWdSl6v+2PFTKc32YyLETBmDdHMtb6RiYO7naiJBPCBhPduQMqf9dPB/+CYz" +
+		"Je2QTDC/IBAqW0OuxY6A/NEP1GbpnHb8L0Bay6rn/2i3p/WrnkjsxJ2Xug8CmokB+wAnS/iEW9hj" +
+		"/nX4hxKeEGOjtDu4Q74msu2Zw9TbjgyBM3qr2vDrb8tleQ18KuDcjfCJ2Ou05nc+HitlN/WCwI/t" +
+		"1NfhDK7lOs2LnfGpzvh80cNi5ggxUjCw1Pedyey7krGXKa7zsONIUapT45OzTm67XDM3a0q41LjQ" +
+		"n+WzFssBqksNaNTOZy3X8lkPO6xpQjWpDm3pmka4vnzimiHqkGqXgJ71nDpfTswMfzsDCccJYRjs" +
+		"MEjjGVMfN5mx2JHGJgVTQZxifGxXk4LziDmVCWZqFQJRWo004bxiq3QhdWTMtTvpfDnpDNjJUis4" +
+		"zRZwrBB9+aTnZ31KPWNwQp5AC5Mfm8mcanFt5vKAeCFa4UDJAH4RAvi7lhDz8pE/s8GvjAqBnklu" +
+		"Flfjhg86KpOXyZJWKTMedqAxJDYGqOlmfLUP68IURBmowSQ0GOvZPqk9apGy3xTJPCw81hypYOrL" +
+		"J9eBxprruCj78qkhrQ+pNQvMvv+kKGXOAk9+H/hTrb4q2goe1D78otWn4qHmU6sdL77mOcmCzf9J" +
+		"vzFf7ujjJg8Mzx2CKe/MqO4IcWzXiBOmKdkZWQuszdoCR/yf82HD3TShL593injb7HOF+KjL53rW" +
+		"EaU6taV17Wd7Xsv/DwCvf82/XHsMAA==",
+	"newsroom.dic": "" +
+		"H4sIAAAAAAAC/0zOsW7rMAyF4f28zl3uatMu4iBpXctNZkFma7W0CIhyCr99oU4d+OMDp/PvP9pm" +
+		"OqGVWDafQO3gQDSAurkB6Yd9swhIV2UD7blEQ0eEzuccE3pqqOaG/jpW+qIJTyqmG06Ncxjmu8O5" +
+		"J5w1mWnC5aXBxYscuO4swhnPvv5HleM3hcOaYsDr7hdM41AvvSsmTmYsnvMfPqIIY4rhi0sxuMHB" +
+		"hZUTh+KXo3qLS8Gc9dMnw1sdeVPNK7Ph7gvnh0Qu+BkA3s7cwAkBAAA=",
+}
@@ -0,0 +1,69 @@
+//go:build ignore
+// +build ignore
+
+// gen.go bundles the dictionaries into dictionaries.go, so that the binary
+// can check spelling wherever it's run. Run it with go generate after
+// changing a dictionary.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	paths := []string{}
+	for _, pattern := range []string{"dictionaries/*.dic", "dictionaries/*.aff"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			log.Fatal(err)
+		}
+		paths = append(paths, matches...)
+	}
+	sort.Strings(paths)
+
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package spell")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// bundledFiles holds the gzipped, base64-encoded files in dictionaries.")
+	fmt.Fprintln(out, "var bundledFiles = map[string]string{")
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		compressed := &bytes.Buffer{}
+		w, _ := gzip.NewWriterLevel(compressed, gzip.BestCompression)
+		w.Write(b)
+		if err := w.Close(); err != nil {
+			log.Fatal(err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+		fmt.Fprintf(out, "\t%q: \"\" +\n", filepath.Base(path))
+		for len(encoded) > 0 {
+			n := 76
+			if n > len(encoded) {
+				n = len(encoded)
+			}
+			sep := " +"
+			if n == len(encoded) {
+				sep = ","
+			}
+			fmt.Fprintf(out, "\t\t%q%s\n", encoded[:n], sep)
+			encoded = encoded[n:]
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	if err := ioutil.WriteFile("dictionaries.go", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,342 @@
+// Package spell checks spelling against Hunspell-style dictionaries, without
+// any outside service. An English word list and the newsroom's own words are
+// bundled into the binary; editors add more words to a dictionary file.
+package spell
+
+//go:generate go run gen.go
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Dictionary is a set of correctly spelled words. It's safe to use from
+// multiple goroutines.
+type Dictionary struct {
+	m     sync.RWMutex
+	words map[string]bool
+	// try is the letters to try when making suggestions, most common first.
+	try string
+	// path is the file Add saves words to, if any.
+	path string
+}
+
+// New returns an empty dictionary.
+func New() *Dictionary {
+	return &Dictionary{
+		words: map[string]bool{},
+		try:   "esianrtolcdugmphbyfvkwz",
+	}
+}
+
+// Load adds the words of a Hunspell dictionary to d. aff may be nil if the
+// words don't use affixes. Only the prefix and suffix rules of the affix file
+// are supported, which is enough for word lists.
+func (d *Dictionary) Load(dic, aff io.Reader) error {
+	affixes := &affixFile{flag: flagChar}
+	if aff != nil {
+		var err error
+		affixes, err = parseAffixes(aff)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	if affixes.try != "" {
+		d.try = strings.ToLower(affixes.try)
+	}
+	scanner := bufio.NewScanner(dic)
+	first := true
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if first {
+			first = false
+			// the first line of a .dic file is the number of words
+			if _, err := fmt.Sscanf(line, "%d", new(int)); err == nil {
+				continue
+			}
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// morphological fields follow a tab
+		if i := strings.IndexAny(line, "\t "); i != -1 {
+			line = line[:i]
+		}
+		word, flags := line, ""
+		if i := strings.Index(line, "/"); i > 0 {
+			word, flags = line[:i], line[i+1:]
+		}
+		for _, w := range affixes.expand(word, flags) {
+			d.words[w] = true
+		}
+	}
+	return scanner.Err()
+}
+
+// LoadFile adds the words in the Hunspell dictionary at path, and its affix
+// file, named with .aff instead of .dic, if there is one.
+func (d *Dictionary) LoadFile(path string) error {
+	dic, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dic.Close()
+	var aff io.Reader
+	if f, err := os.Open(strings.TrimSuffix(path, ".dic") + ".aff"); err == nil {
+		defer f.Close()
+		aff = f
+	}
+	if err := d.Load(dic, aff); err != nil {
+		return fmt.Errorf("unable to load dictionary %s: %v", path, err)
+	}
+	return nil
+}
+
+// Open loads the words in the file at path, if it exists, and saves words
+// added to d there.
+func (d *Dictionary) Open(path string) error {
+	f, err := os.Open(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to open dictionary: %v", err)
+	}
+	if err == nil {
+		defer f.Close()
+		if err := d.Load(f, nil); err != nil {
+			return fmt.Errorf("unable to load dictionary %s: %v", path, err)
+		}
+	}
+	d.m.Lock()
+	d.path = path
+	d.m.Unlock()
+	return nil
+}
+
+// Add adds a word to d, saving it to the file given to Open.
+func (d *Dictionary) Add(word string) error {
+	word = strings.TrimSpace(word)
+	if word == "" || strings.ContainsAny(word, " \t\n/") {
+		return fmt.Errorf("%q isn't a word", word)
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.words[word] {
+		return nil
+	}
+	if d.path == "" {
+		return fmt.Errorf("no dictionary file to add %q to", word)
+	}
+	f, err := os.OpenFile(d.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to save %q: %v", word, err)
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, word); err != nil {
+		return fmt.Errorf("unable to save %q: %v", word, err)
+	}
+	d.words[word] = true
+	return nil
+}
+
+// Words returns every word in d, sorted.
+func (d *Dictionary) Words() []string {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	words := make([]string, 0, len(d.words))
+	for w := range d.words {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// Checker checks words against several dictionaries.
+type Checker struct {
+	dictionaries []*Dictionary
+}
+
+func NewChecker(dictionaries ...*Dictionary) *Checker {
+	return &Checker{dictionaries: dictionaries}
+}
+
+func (c *Checker) has(word string) bool {
+	for _, d := range c.dictionaries {
+		d.m.RLock()
+		ok := d.words[word]
+		d.m.RUnlock()
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Correct reports whether word is spelled correctly. Lowercase dictionary
+// words match in any case, as at the start of a sentence or in a headline
+// set in capitals, but capitalized ones, like names, must keep their capitals.
+func (c *Checker) Correct(word string) bool {
+	word = strings.Replace(word, "’", "'", -1)
+	for _, suffix := range []string{"'s", "'"} {
+		if strings.HasSuffix(word, suffix) && len(word) > len(suffix) {
+			if c.Correct(strings.TrimSuffix(word, suffix)) {
+				return true
+			}
+		}
+	}
+	if c.has(word) || c.has(strings.ToLower(word)) && !hasInnerCapital(word) {
+		return true
+	}
+	if isUpper(word) {
+		// "RENSSELAER" is "Rensselaer" set in capitals
+		return c.has(title(strings.ToLower(word)))
+	}
+	return false
+}
+
+// hasInnerCapital reports whether word has a capital letter after the first,
+// without being all capitals, as in "iPhone" or "McDonald".
+func hasInnerCapital(word string) bool {
+	if isUpper(word) {
+		return false
+	}
+	for i, r := range word {
+		if i > 0 && unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpper(word string) bool {
+	return strings.ToUpper(word) == word && strings.ToLower(word) != word
+}
+
+// Suggest returns up to n correctly spelled words that are one or two edits
+// away from word, closest first.
+func (c *Checker) Suggest(word string, n int) []string {
+	word = strings.Replace(word, "’", "'", -1)
+	if strings.HasSuffix(word, "'s") && len(word) > 2 {
+		suggestions := c.Suggest(strings.TrimSuffix(word, "'s"), n)
+		for i := range suggestions {
+			suggestions[i] += "'s"
+		}
+		return suggestions
+	}
+
+	try := "esianrtolcdugmphbyfvkwz"
+	if len(c.dictionaries) > 0 {
+		try = c.dictionaries[0].try
+	}
+	seen := map[string]bool{}
+	suggestions := []string{}
+	add := func(candidates []string) {
+		for _, s := range candidates {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			switch {
+			case c.has(s):
+				suggestions = append(suggestions, matchCase(word, s))
+			case c.has(title(s)):
+				suggestions = append(suggestions, matchCase(word, title(s)))
+			}
+		}
+	}
+
+	first := edits(strings.ToLower(word), try)
+	add(first)
+	// two edits away is a lot of words, so only look for short ones
+	if len(suggestions) == 0 && utf8.RuneCountInString(word) <= 8 {
+		for _, e := range first {
+			add(edits(e, try))
+			if len(suggestions) >= n {
+				break
+			}
+		}
+	}
+	if len(suggestions) > n {
+		suggestions = suggestions[:n]
+	}
+	return suggestions
+}
+
+func title(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+// edits returns every string one deletion, transposition, replacement or
+// insertion away from word.
+func edits(word, letters string) []string {
+	runes := []rune(word)
+	out := []string{}
+	for i := range runes {
+		out = append(out, string(runes[:i])+string(runes[i+1:]))
+		if i+1 < len(runes) {
+			out = append(out, string(runes[:i])+string(runes[i+1])+string(runes[i])+string(runes[i+2:]))
+		}
+	}
+	for i := 0; i <= len(runes); i++ {
+		for _, l := range letters {
+			if i < len(runes) {
+				out = append(out, string(runes[:i])+string(l)+string(runes[i+1:]))
+			}
+			out = append(out, string(runes[:i])+string(l)+string(runes[i:]))
+		}
+	}
+	return out
+}
+
+// matchCase capitalizes suggestion like word.
+func matchCase(word, suggestion string) string {
+	switch {
+	case isUpper(word) && utf8.RuneCountInString(word) > 1:
+		return strings.ToUpper(suggestion)
+	case word != "" && unicode.IsUpper([]rune(word)[0]):
+		return title(suggestion)
+	}
+	return suggestion
+}
+
+// Distance is the number of single-letter insertions, deletions and
+// replacements it takes to turn a into b.
+func Distance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		cur[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(y)]
+}
+
+func min(a int, rest ...int) int {
+	for _, b := range rest {
+		if b < a {
+			a = b
+		}
+	}
+	return a
+}
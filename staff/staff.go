@@ -0,0 +1,202 @@
+// Package staff is the directory of people who write for the paper: how
+// their bylines are spelled, their current titles, and their WordPress users.
+package staff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thepoly/uploader/spell"
+	"github.com/thepoly/uploader/wordpress"
+)
+
+// Member is someone on staff, as stored in the directory file.
+type Member struct {
+	// Name is their byline.
+	Name string `json:"name"`
+	// Title is their current title, as it goes under their byline.
+	Title string `json:"title"`
+	// WPUserID is their WordPress user, or zero if they don't have one.
+	WPUserID int `json:"wpUserID,omitempty"`
+	// Aliases are other ways their name is written, like nicknames.
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Directory is the staff directory.
+type Directory struct {
+	Members []Member
+	byName  map[string]int
+}
+
+// New returns a directory of members.
+func New(members []Member) *Directory {
+	d := &Directory{Members: members, byName: map[string]int{}}
+	for i, m := range members {
+		d.byName[normalize(m.Name)] = i
+		for _, alias := range m.Aliases {
+			d.byName[normalize(alias)] = i
+		}
+	}
+	return d
+}
+
+// Load reads a JSON list of members from path.
+func Load(path string) (*Directory, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read staff directory: %v", err)
+	}
+	members := []Member{}
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, fmt.Errorf("unable to parse staff directory %s: %v", path, err)
+	}
+	return New(members), nil
+}
+
+// Save writes the directory to path.
+func (d *Directory) Save(path string) error {
+	b, err := json.MarshalIndent(d.Members, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("unable to save staff directory: %v", err)
+	}
+	return nil
+}
+
+var spacePattern = regexp.MustCompile(`\s+`)
+
+func normalize(name string) string {
+	name = strings.Replace(name, "\u00a0", " ", -1)
+	return strings.ToLower(spacePattern.ReplaceAllString(strings.TrimSpace(name), " "))
+}
+
+// Find returns the member with the given name or alias, ignoring case and
+// spacing, or nil if there isn't one.
+func (d *Directory) Find(name string) *Member {
+	i, ok := d.byName[normalize(name)]
+	if !ok {
+		return nil
+	}
+	return &d.Members[i]
+}
+
+// Similar returns up to n names in the directory that look like name, most
+// alike first, e.g. for a misspelled byline.
+func (d *Directory) Similar(name string, n int) []string {
+	name = normalize(name)
+	type match struct {
+		name     string
+		distance int
+	}
+	matches := []match{}
+	for _, m := range d.Members {
+		dist := spell.Distance(name, normalize(m.Name))
+		// allow about one mistake every four letters
+		if dist <= len(name)/4+1 {
+			matches = append(matches, match{m.Name, dist})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].distance < matches[j].distance })
+	names := []string{}
+	for i := 0; i < len(matches) && i < n; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
+
+var bylineSeparator = regexp.MustCompile(`\s*,\s*(?:and\s+)?|\s+(?:and|&)\s+`)
+
+// Bylines splits an author name like "Jane Doe, John Roe and Jim Poe" into
+// each person's name.
+func Bylines(authorName string) []string {
+	names := []string{}
+	for _, name := range bylineSeparator.Split(strings.TrimSpace(authorName), -1) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// WPUserIDs returns the WordPress user of each byline in authorName that has
+// one, in order.
+func (d *Directory) WPUserIDs(authorName string) []int {
+	ids := []int{}
+	for _, name := range Bylines(authorName) {
+		if m := d.Find(name); m != nil && m.WPUserID != 0 {
+			ids = append(ids, m.WPUserID)
+		}
+	}
+	return ids
+}
+
+type wpUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Sync updates the directory from the users of the WordPress site client
+// logs in to. Members are matched to users by ID, then by name; users who
+// aren't in the directory yet are added without a title. It returns the
+// names of the members added.
+func (d *Directory) Sync(client *wordpress.Client) ([]string, error) {
+	users := []wpUser{}
+	for page := 1; ; page++ {
+		batch := []wpUser{}
+		err := client.Do(wordpress.Request{
+			Method: "GET",
+			Path:   "/users",
+			Query: url.Values{
+				"per_page": {"100"},
+				"page":     {strconv.Itoa(page)},
+				"context":  {"edit"},
+			},
+		}, &batch)
+		// WordPress answers a page past the end with an error
+		if apiErr, ok := err.(*wordpress.Error); ok && apiErr.StatusCode == http.StatusBadRequest && page > 1 {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list WordPress users: %v", err)
+		}
+		users = append(users, batch...)
+		if len(batch) < 100 {
+			break
+		}
+	}
+
+	added := []string{}
+	for _, u := range users {
+		i := -1
+		for j, m := range d.Members {
+			if m.WPUserID == u.ID {
+				i = j
+				break
+			}
+		}
+		if i == -1 {
+			if j, ok := d.byName[normalize(u.Name)]; ok && d.Members[j].WPUserID == 0 {
+				i = j
+			}
+		}
+		if i == -1 {
+			d.Members = append(d.Members, Member{Name: u.Name, WPUserID: u.ID})
+			added = append(added, u.Name)
+			continue
+		}
+		// bylines are kept as they are, since WordPress names are often
+		// usernames or nicknames
+		d.Members[i].WPUserID = u.ID
+	}
+	*d = *New(d.Members)
+	return added, nil
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/thepoly/uploader/styles"
 )
 
 type Snippet struct {
@@ -14,6 +16,8 @@ type Snippet struct {
 	LastModified time.Time `json:"lastModified"`
 	idmlStories  []IDMLStory
 	idmlLinks    []IDMLLink
+	// styles are the styles the snippet defines, to match its text by.
+	styles *styles.Sheet
 	// cache for caching results of expensive method calls
 	m     sync.Mutex
 	cache map[string]interface{}
@@ -29,8 +33,9 @@ type IDMLParagraphStyleRange struct {
 }
 
 type IDMLCharacterStyleRange struct {
-	Content   []string
-	FontStyle string `xml:",attr"`
+	Content               []string
+	AppliedCharacterStyle string `xml:",attr"`
+	FontStyle             string `xml:",attr"`
 }
 
 // func (s *Snippet) CreateWPPost() WPPost {
@@ -53,6 +58,7 @@ func NewSnippet() Snippet {
 	return Snippet{
 		idmlStories: []IDMLStory{},
 		idmlLinks:   []IDMLLink{},
+		styles:      styles.New(),
 		cache:       make(map[string]interface{}),
 	}
 }
@@ -70,6 +76,7 @@ func (s *Snippet) cacheSet(key string, val interface{}) {
 	s.m.Unlock()
 }
 
+// ParseFile reads the stories, links and styles in a snippet or InCopy file.
 func (s *Snippet) ParseFile(f io.Reader) {
 	decoder := xml.NewDecoder(f)
 	for {
@@ -88,6 +95,10 @@ func (s *Snippet) ParseFile(f io.Reader) {
 				idmlLink := IDMLLink{}
 				decoder.DecodeElement(&idmlLink, &se)
 				s.idmlLinks = append(s.idmlLinks, idmlLink)
+			default:
+				if styles.IsRoot(se.Name.Local) {
+					s.styles.Decode(decoder, &se)
+				}
 			}
 		}
 	}
@@ -100,7 +111,7 @@ func (s *Snippet) AuthorName() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Author" {
+			if s.styles.Is(style, "ParagraphStyle/Author") {
 				res := paragraph.IDMLCharacterStyleRanges[0].Content[0]
 				s.cacheSet("AuthorName", res)
 				return res
@@ -117,17 +128,19 @@ func (s *Snippet) AuthorTitle() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Author Job" {
+			if s.styles.Is(style, "ParagraphStyle/Author Job") {
 				authorTitle := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
-					// look for regular because author title line is italicized by default
-					if characterRange.FontStyle == "Regular" {
+					// the author title line is italicized by default, so
+					// text that isn't is what's emphasized
+					emphasized := s.emphasized(paragraph, characterRange, true)
+					if emphasized {
 						authorTitle += "<i>"
 					}
 					for _, content := range characterRange.Content {
 						authorTitle += content
 					}
-					if characterRange.FontStyle == "Regular" {
+					if emphasized {
 						authorTitle += "</i>"
 					}
 				}
@@ -146,7 +159,7 @@ func (s *Snippet) Kicker() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Kicker" {
+			if s.styles.Is(style, "ParagraphStyle/Kicker") {
 				res := paragraph.IDMLCharacterStyleRanges[0].Content[0]
 				s.cacheSet("Kicker", res)
 				return res
@@ -156,46 +169,58 @@ func (s *Snippet) Kicker() string {
 	return ""
 }
 
+// BodyText is the text of every paragraph in a body text style, or one
+// based on it, like a drop cap style for the first paragraph. Italicized
+// text is marked with <em>.
 func (s *Snippet) BodyText() string {
 	if val, ok := s.cache["BodyText"]; ok {
 		return val.(string)
 	}
+	bodyText := ""
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Body Text" {
-				bodyText := "<p>"
-				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
-					if characterRange.FontStyle == "Italic" {
-						bodyText += "<i>"
-					}
-					for _, content := range characterRange.Content {
-						for _, char := range content {
-							if char == '\t' {
-								bodyText += "</p><p>"
-							} else {
-								bodyText += string(char)
-							}
+			if !s.styles.Is(style, "ParagraphStyle/Body Text") {
+				continue
+			}
+			bodyText += "<p>"
+			for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
+				emphasized := s.emphasized(paragraph, characterRange, false)
+				if emphasized {
+					bodyText += "<em>"
+				}
+				for _, content := range characterRange.Content {
+					for _, char := range content {
+						if char == '\t' {
+							bodyText += "</p><p>"
+						} else {
+							bodyText += string(char)
 						}
 					}
-					if characterRange.FontStyle == "Italic" {
-						bodyText += "</i>"
-					}
 				}
-				bodyText += "</p>"
-				s.cache["BodyText"] = bodyText
-				return bodyText
+				if emphasized {
+					bodyText += "</em>"
+				}
 			}
+			bodyText += "</p>"
 		}
 	}
-	return ""
+	s.cache["BodyText"] = bodyText
+	return bodyText
+}
+
+// emphasized reports whether a character range should be italicized, by
+// its own font style or that of its character style.
+func (s *Snippet) emphasized(paragraph IDMLParagraphStyleRange, characterRange IDMLCharacterStyleRange, italicByDefault bool) bool {
+	return s.styles.Emphasized(paragraph.AppliedParagraphStyle, characterRange.AppliedCharacterStyle,
+		characterRange.FontStyle, italicByDefault)
 }
 
 func (s *Snippet) Headline() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if strings.Contains(style, "Headline") {
+			if s.styles.Is(style, "ParagraphStyle/Headline") || strings.Contains(style, "Headline") {
 				headline := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
@@ -213,7 +238,7 @@ func (s *Snippet) PhotoByline() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Photo Byline" {
+			if s.styles.Is(style, "ParagraphStyle/Photo Byline") {
 				photoByline := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
@@ -231,7 +256,7 @@ func (s *Snippet) PhotoCaption() string {
 	for _, story := range s.idmlStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Caption" {
+			if s.styles.Is(style, "ParagraphStyle/Caption") {
 				caption := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
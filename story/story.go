@@ -1,23 +1,19 @@
 package story
 
 import (
-	"context"
-	"encoding/json"
+	"bytes"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
-	"net/url"
-	"os"
-	"os/user"
-	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
-	"google.golang.org/api/drive/v3"
+	"github.com/thepoly/uploader/blocks"
+	"github.com/thepoly/uploader/fingerprint"
+	"github.com/thepoly/uploader/gdrive"
+	"github.com/thepoly/uploader/schedule"
+	"github.com/thepoly/uploader/seo"
+	"github.com/thepoly/uploader/upload"
+	"github.com/thepoly/uploader/validate"
 )
 
 type Story struct {
@@ -28,6 +24,14 @@ type Story struct {
 	AuthorTitle string   `json:"authorTitle"`
 	BodyText    string   `json:"bodyText"`
 	Subdeck     string   `json:"subdeck"`
+	// Slug and Excerpt override the ones generated for the post, if set.
+	Slug    string `json:"slug"`
+	Excerpt string `json:"excerpt"`
+	// Status and PublishAt override the configured schedule, if set.
+	// PublishAt is a time like "2018-03-14 09:00" in the configured time
+	// zone, or RFC 3339.
+	Status    string `json:"status"`
+	PublishAt string `json:"publishAt"`
 }
 
 type IDMLLink struct {
@@ -35,35 +39,16 @@ type IDMLLink struct {
 }
 
 type Manager struct {
-	driveClient      *drive.Service
+	driveClient      *gdrive.Client
+	teamDriveID      string
 	m                sync.Mutex
 	availableStories []*Story
 }
 
-func NewManager() (*Manager, error) {
-	ctx := context.Background()
-	b, err := ioutil.ReadFile("client_secret.json")
-	if err != nil {
-		log.Fatalf("Unable to read client secret file: %v", err)
-		return nil, err
-	}
-
-	// If modifying these scopes, delete your previously saved credentials
-	// at ~/.credentials/drive-go-quickstart.json
-	config, err := google.ConfigFromJSON(b, drive.DriveReadonlyScope)
-	if err != nil {
-		log.Fatalf("Unable to parse client secret file to config: %v", err)
-		return nil, err
-	}
-	client := getClient(ctx, config)
-
-	srv, err := drive.New(client)
-	if err != nil {
-		log.Fatalf("Unable to retrieve drive Client %v", err)
-		return nil, err
-	}
+func NewManager(client *gdrive.Client, teamDriveID string) (*Manager, error) {
 	m := &Manager{
-		driveClient: srv,
+		driveClient: client,
+		teamDriveID: teamDriveID,
 	}
 
 	go m.updater()
@@ -71,78 +56,6 @@ func NewManager() (*Manager, error) {
 	return m, nil
 }
 
-// getClient uses a Context and Config to retrieve a Token
-// then generate a Client. It returns the generated Client.
-func getClient(ctx context.Context, config *oauth2.Config) *http.Client {
-	cacheFile, err := tokenCacheFile()
-	if err != nil {
-		log.Fatalf("Unable to get path to cached credential file. %v", err)
-	}
-	tok, err := tokenFromFile(cacheFile)
-	if err != nil {
-		tok = getTokenFromWeb(config)
-		saveToken(cacheFile, tok)
-	}
-	return config.Client(ctx, tok)
-}
-
-// getTokenFromWeb uses Config to request a Token.
-// It returns the retrieved Token.
-func getTokenFromWeb(config *oauth2.Config) *oauth2.Token {
-	authURL := config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
-	fmt.Printf("Go to the following link in your browser then type the "+
-		"authorization code: \n%v\n", authURL)
-
-	var code string
-	if _, err := fmt.Scan(&code); err != nil {
-		log.Fatalf("Unable to read authorization code %v", err)
-	}
-
-	tok, err := config.Exchange(oauth2.NoContext, code)
-	if err != nil {
-		log.Fatalf("Unable to retrieve token from web %v", err)
-	}
-	return tok
-}
-
-// tokenCacheFile generates credential file path/filename.
-// It returns the generated credential path/filename.
-func tokenCacheFile() (string, error) {
-	usr, err := user.Current()
-	if err != nil {
-		return "", err
-	}
-	tokenCacheDir := filepath.Join(usr.HomeDir, ".credentials")
-	os.MkdirAll(tokenCacheDir, 0700)
-	return filepath.Join(tokenCacheDir,
-		url.QueryEscape("drive-go-quickstart.json")), err
-}
-
-// tokenFromFile retrieves a Token from a given file path.
-// It returns the retrieved Token and any read error encountered.
-func tokenFromFile(file string) (*oauth2.Token, error) {
-	f, err := os.Open(file)
-	if err != nil {
-		return nil, err
-	}
-	t := &oauth2.Token{}
-	err = json.NewDecoder(f).Decode(t)
-	defer f.Close()
-	return t, err
-}
-
-// saveToken uses a file path to create a file and store the
-// token in it.
-func saveToken(file string, token *oauth2.Token) {
-	fmt.Printf("Saving credential file to: %s\n", file)
-	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		log.Fatalf("Unable to cache oauth token: %v", err)
-	}
-	defer f.Close()
-	json.NewEncoder(f).Encode(token)
-}
-
 func (m *Manager) updater() {
 	m.update()
 	for range time.Tick(time.Second * 10) {
@@ -153,13 +66,21 @@ func (m *Manager) updater() {
 func (m *Manager) update() {
 	var q string
 	when := time.Now().Add(time.Hour * 24 * -1).Format(time.RFC3339)
-	q = fmt.Sprintf("name contains '.idms' and mimeType = 'text/xml' and modifiedTime >= '%s'", when)
+	// snippets and InCopy files; Drive calls InCopy files either kind of XML
+	q = fmt.Sprintf("(name contains '.idms' or name contains '.icml') and "+
+		"(mimeType = 'text/xml' or mimeType = 'application/xml') and modifiedTime >= '%s'", when)
+
+	srv, err := m.driveClient.Service()
+	if err != nil {
+		log.Printf("Unable to connect to Drive: %v", err)
+		return
+	}
 
 	snippets := []*Snippet{}
-	r, err := m.driveClient.Files.List().PageSize(10).Q(q).
+	r, err := srv.Files.List().PageSize(10).Q(q).
 		Fields("nextPageToken, files(id, name, modifiedTime, mimeType)").
 		SupportsTeamDrives(true).IncludeTeamDriveItems(true).
-		TeamDriveId("0ACukZyn2MrvEUk9PVA").Corpora("teamDrive").Do()
+		TeamDriveId(m.teamDriveID).Corpora("teamDrive").Do()
 	if err != nil {
 		log.Printf("Unable to retrieve files: %v", err)
 		return
@@ -171,15 +92,17 @@ func (m *Manager) update() {
 		snippet.DriveID = i.Id
 		modifiedTime, err := time.Parse(time.RFC3339, i.ModifiedTime)
 		if err != nil {
-			log.Fatal(err)
+			log.Printf("Unable to read modified time of %s: %v", i.Name, err)
+			continue
 		}
 		snippet.LastModified = modifiedTime
-		resp, err := m.driveClient.Files.Get(i.Id).Download()
+		data, err := m.driveClient.Download(i.Id)
 		if err != nil {
-			log.Fatal(err)
+			log.Printf("Unable to download %s: %v", i.Name, err)
+			continue
 		}
 
-		snippet.ParseFile(resp.Body)
+		snippet.ParseFile(bytes.NewReader(data))
 		snippets = append(snippets, &snippet)
 	}
 
@@ -201,6 +124,19 @@ func (m *Manager) update() {
 	m.m.Unlock()
 }
 
+// GetStory returns the available story whose snippet has the given Drive ID,
+// or nil if there isn't one.
+func (m *Manager) GetStory(driveID string) *Story {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, story := range m.availableStories {
+		if story.Snippet.DriveID == driveID {
+			return story
+		}
+	}
+	return nil
+}
+
 func (m *Manager) GetStories() []*Story {
 	stories := []*Story{}
 	m.m.Lock()
@@ -211,63 +147,87 @@ func (m *Manager) GetStories() []*Story {
 	return stories
 }
 
-func (s *Story) ValidationErrors() []string {
-	validationErrors := []string{}
+// PostSchedule returns when s's post goes up, given the configured default.
+func (s *Story) PostSchedule(c schedule.Config, now time.Time) (schedule.Schedule, error) {
+	return schedule.New(c, s.Status, s.PublishAt, now)
+}
 
-	if s.Headline == "" {
-		validationErrors = append(validationErrors, "No headline.")
-	}
-	if strings.Contains(s.Headline, "  ") {
-		validationErrors = append(validationErrors, "Headline contains two consecutive spaces.")
+// ValidationStory returns s in the form validation rules check. The photo
+// isn't checked, since the server doesn't load photos.
+func (s *Story) ValidationStory() *validate.Story {
+	return &validate.Story{
+		Kicker:      s.Kicker,
+		Headline:    s.Headline,
+		Subdeck:     s.Subdeck,
+		AuthorName:  s.AuthorName,
+		AuthorTitle: s.AuthorTitle,
+		BodyText:    s.BodyText,
+		Photo:       validate.PhotoUnknown,
+		Slug:        s.PostSlug(),
+		Excerpt:     s.PostExcerpt(),
 	}
+}
 
-	if s.AuthorName == "" {
-		validationErrors = append(validationErrors, "No author name.")
-	}
-	if strings.Contains(s.AuthorName, "  ") {
-		validationErrors = append(validationErrors, "Author name contains two consecutive spaces.")
+// PostSlug is the slug the post will have.
+func (s *Story) PostSlug() string {
+	if s.Slug != "" {
+		return s.Slug
 	}
+	return seo.Slug(s.Headline)
+}
 
-	if s.Kicker == "" {
-		validationErrors = append(validationErrors, "No kicker.")
-	}
-	if strings.Contains(s.Kicker, "  ") {
-		validationErrors = append(validationErrors, "Kicker contains two consecutive spaces.")
-	}
-	if strings.ToUpper(s.Kicker) == "EDITORIAL NOTEBOOKS" {
-		validationErrors = append(validationErrors, "Kicker contains plural \"notebooks.\"")
+// PostExcerpt is the excerpt the post will have.
+func (s *Story) PostExcerpt() string {
+	if s.Excerpt != "" {
+		return s.Excerpt
 	}
+	return seo.Excerpt(s.Subdeck, s.BodyText)
+}
 
-	if s.AuthorTitle == "" {
-		validationErrors = append(validationErrors, "No author title.")
-	}
-	if strings.Contains(s.AuthorTitle, "  ") {
-		validationErrors = append(validationErrors, "Author title contains two consecutive spaces.")
+// ApplyFixes replaces the text of s with the text of fixed, as returned by
+// validate.Validator.Fix.
+func (s *Story) ApplyFixes(fixed *validate.Story) {
+	s.Kicker = fixed.Kicker
+	s.Headline = fixed.Headline
+	s.Subdeck = fixed.Subdeck
+	s.AuthorName = fixed.AuthorName
+	s.AuthorTitle = fixed.AuthorTitle
+	s.BodyText = fixed.BodyText
+	// only keep the excerpt if it isn't what would be generated anyway, so
+	// it keeps following the story
+	s.Excerpt = ""
+	if fixed.Excerpt != s.PostExcerpt() {
+		s.Excerpt = fixed.Excerpt
 	}
+}
 
-	if s.BodyText == "" {
-		validationErrors = append(validationErrors, "No body text.")
-	} else if len(s.BodyText) < 100 {
-		msg := fmt.Sprintf("Body text extremely short (%d characters).", len(s.BodyText))
-		validationErrors = append(validationErrors, msg)
+// WPPost returns the post s makes, with its text sanitized and its content
+// in format, and its author and categories looked up with v. Its status and
+// date aren't set.
+func (s *Story) WPPost(v *validate.Validator, format string) upload.WPPost {
+	post := upload.WPPost{}
+	post.Title = validate.Policy(validate.FieldHeadline).Clean(s.Headline)
+	post.Content = blocks.Convert(validate.Policy(validate.FieldBodyText).Clean(s.BodyText), format)
+	post.Excerpt = validate.Policy(validate.FieldExcerpt).Clean(s.PostExcerpt())
+	post.Slug = s.PostSlug()
+	post.Meta.AuthorName = validate.Policy(validate.FieldAuthorName).Clean(s.AuthorName)
+	post.Meta.AuthorTitle = validate.Policy(validate.FieldAuthorTitle).Clean(s.AuthorTitle)
+	post.Meta.Kicker = validate.Policy(validate.FieldKicker).Clean(s.Kicker)
+	post.Meta.Fingerprint = fingerprint.Of(s.Headline, s.AuthorName, s.BodyText)
+	// the Drive ID stays with the file as it's edited, unlike its name,
+	// which recurring stories share from week to week
+	if s.Snippet != nil {
+		post.Meta.SourceID = s.Snippet.DriveID
+	}
+	if dir := v.Staff(); dir != nil {
+		if ids := dir.WPUserIDs(s.AuthorName); len(ids) > 0 {
+			post.Author = ids[0]
+			post.Meta.AuthorIDs = ids
+		}
 	}
-
-	// photo := s.Photo()
-	// photoByline := s.PhotoByline()
-	// photoCaption := s.PhotoCaption()
-	// if photoByline != "" && len(photo) == 0 {
-	// 	validationErrors = append(validationErrors, "Photo byline without photo.")
-	// }
-	// if photoCaption != "" && len(photo) == 0 {
-	// 	validationErrors = append(validationErrors, "Photo caption without photo.")
-	// }
-	//
-	// if strings.Contains(photoByline, "  ") {
-	// 	validationErrors = append(validationErrors, "Photo byline contains two consecutive spaces.")
-	// }
-	// if strings.Contains(photoCaption, "  ") {
-	// 	validationErrors = append(validationErrors, "Photo caption contains two consecutive spaces.")
-	// }
-
-	return validationErrors
+	if k := v.Kickers().Find(s.Kicker); k != nil {
+		post.Categories = k.Categories
+		post.Tags = k.Tags
+	}
+	return post
 }
@@ -0,0 +1,180 @@
+// Package styles reads the paragraph and character styles defined in
+// InDesign files, so that stories can be read by what their styles are based
+// on rather than by exact style names. A "Body Text Dropcap" style based on
+// "Body Text" is body text, and so is "Body Text" in a "News" style group.
+package styles
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+)
+
+// Style is a paragraph or character style.
+type Style struct {
+	// Self is how text refers to the style, like "ParagraphStyle/Body Text".
+	Self string
+	// Name is the style's name, with the groups it's in, like
+	// "News:Body Text".
+	Name string
+	// BasedOn is the Self of the style this one inherits from, if any.
+	BasedOn string
+	// FontStyle is the style's own font style, like "Italic", if it sets
+	// one.
+	FontStyle string
+}
+
+// Sheet is the styles of a document. An empty or nil sheet still matches
+// styles by name, so files without style definitions are read as before.
+type Sheet struct {
+	styles map[string]*Style
+}
+
+// New returns an empty sheet.
+func New() *Sheet {
+	return &Sheet{styles: make(map[string]*Style)}
+}
+
+type xmlStyle struct {
+	Self      string `xml:",attr"`
+	Name      string `xml:",attr"`
+	FontStyle string `xml:",attr"`
+	BasedOn   string `xml:"Properties>BasedOn"`
+}
+
+type xmlGroup struct {
+	ParagraphStyles []xmlStyle `xml:"ParagraphStyle"`
+	CharacterStyles []xmlStyle `xml:"CharacterStyle"`
+	ParagraphGroups []xmlGroup `xml:"ParagraphStyleGroup"`
+	CharacterGroups []xmlGroup `xml:"CharacterStyleGroup"`
+}
+
+// IsRoot reports whether an element holds style definitions: a
+// RootParagraphStyleGroup or RootCharacterStyleGroup, which are at the top
+// of snippets and InCopy files and in Resources/Styles.xml in packages.
+func IsRoot(name string) bool {
+	return name == "RootParagraphStyleGroup" || name == "RootCharacterStyleGroup"
+}
+
+// Decode adds the styles in the root style group that starts with start,
+// and in the groups nested in it, to s.
+func (s *Sheet) Decode(d *xml.Decoder, start *xml.StartElement) error {
+	root := xmlGroup{}
+	if err := d.DecodeElement(&root, start); err != nil {
+		return err
+	}
+	s.add(root)
+	return nil
+}
+
+func (s *Sheet) add(g xmlGroup) {
+	for _, list := range [][]xmlStyle{g.ParagraphStyles, g.CharacterStyles} {
+		for _, x := range list {
+			basedOn := x.BasedOn
+			// styles not based on anything say they're based on
+			// "$ID/[No paragraph style]" and the like
+			if strings.HasPrefix(basedOn, "$ID/") {
+				basedOn = ""
+			}
+			s.styles[x.Self] = &Style{
+				Self:      x.Self,
+				Name:      x.Name,
+				BasedOn:   basedOn,
+				FontStyle: x.FontStyle,
+			}
+		}
+	}
+	for _, groups := range [][]xmlGroup{g.ParagraphGroups, g.CharacterGroups} {
+		for _, group := range groups {
+			s.add(group)
+		}
+	}
+}
+
+// Is reports whether the applied style is the style target, like
+// "ParagraphStyle/Body Text", or is based on it directly or through other
+// styles. Styles match by their name without the groups they're in, so a
+// style in a group matches one of the same name outside it.
+func (s *Sheet) Is(applied, target string) bool {
+	want := shortName(target)
+	for _, style := range s.lineage(applied) {
+		if style.Self == target || shortName(style.Self) == want ||
+			style.Name != "" && shortName(style.Name) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// FontStyle returns the font style of the applied style, inherited from the
+// styles it's based on if it doesn't set one itself, or "" if none of them
+// do.
+func (s *Sheet) FontStyle(applied string) string {
+	for _, style := range s.lineage(applied) {
+		if style.FontStyle != "" {
+			return style.FontStyle
+		}
+	}
+	return ""
+}
+
+// lineage returns the applied style followed by the styles it's based on,
+// nearest first. A style that isn't defined is returned with only its Self.
+func (s *Sheet) lineage(applied string) []*Style {
+	lineage := []*Style{}
+	seen := map[string]bool{}
+	for self := applied; self != "" && !seen[self]; {
+		seen[self] = true
+		var style *Style
+		if s != nil {
+			style = s.styles[self]
+		}
+		if style == nil {
+			lineage = append(lineage, &Style{Self: self})
+			break
+		}
+		lineage = append(lineage, style)
+		self = style.BasedOn
+	}
+	return lineage
+}
+
+// shortName returns the name of a style without its kind or groups, so
+// "ParagraphStyle/News%3aBody Text" and "News:Body Text" are "Body Text".
+func shortName(name string) string {
+	if i := strings.Index(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// Emphasized reports whether text stands out from the rest of its paragraph
+// by being italic when the paragraph isn't, or not italic when it is. Its
+// font style is fontStyle, if the text sets one itself, or else that of its
+// character style. Paragraphs whose style doesn't set a font style are
+// italic if italicByDefault.
+func (s *Sheet) Emphasized(paragraphStyle, characterStyle, fontStyle string, italicByDefault bool) bool {
+	paragraphItalic := italicByDefault
+	if paragraph := s.FontStyle(paragraphStyle); paragraph != "" {
+		paragraphItalic = Italic(paragraph)
+	}
+	if fontStyle == "" {
+		fontStyle = s.FontStyle(characterStyle)
+	}
+	if fontStyle == "" {
+		return false
+	}
+	return Italic(fontStyle) != paragraphItalic
+}
+
+// Italic reports whether a font style, like "Bold Italic", is italic.
+func Italic(fontStyle string) bool {
+	fontStyle = strings.ToLower(fontStyle)
+	return strings.Contains(fontStyle, "italic") || strings.Contains(fontStyle, "oblique")
+}
@@ -0,0 +1,165 @@
+package styles
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+const document = `<Document>
+	<RootCharacterStyleGroup Self="u1">
+		<CharacterStyle Self="CharacterStyle/$ID/[No character style]" Name="$ID/[No character style]" />
+		<CharacterStyle Self="CharacterStyle/Emphasis" Name="Emphasis" FontStyle="Italic" />
+		<CharacterStyle Self="CharacterStyle/Roman" Name="Roman" FontStyle="Regular" />
+		<CharacterStyleGroup Self="u2" Name="Text">
+			<CharacterStyle Self="CharacterStyle/Text%3aTitle" Name="Text:Title">
+				<Properties><BasedOn type="object">CharacterStyle/Emphasis</BasedOn></Properties>
+			</CharacterStyle>
+		</CharacterStyleGroup>
+	</RootCharacterStyleGroup>
+	<RootParagraphStyleGroup Self="u3">
+		<ParagraphStyle Self="ParagraphStyle/$ID/[No paragraph style]" Name="$ID/[No paragraph style]" />
+		<ParagraphStyle Self="ParagraphStyle/Body Text" Name="Body Text">
+			<Properties><BasedOn type="string">$ID/[No paragraph style]</BasedOn></Properties>
+		</ParagraphStyle>
+		<ParagraphStyle Self="ParagraphStyle/Body Text Dropcap" Name="Body Text Dropcap">
+			<Properties><BasedOn type="object">ParagraphStyle/Body Text</BasedOn></Properties>
+		</ParagraphStyle>
+		<ParagraphStyle Self="ParagraphStyle/Author Job" Name="Author Job" FontStyle="Italic" />
+		<ParagraphStyleGroup Self="u4" Name="News">
+			<ParagraphStyle Self="ParagraphStyle/News%3aBody Text" Name="News:Body Text" />
+			<ParagraphStyleGroup Self="u5" Name="Features">
+				<ParagraphStyle Self="ParagraphStyle/News%3aFeatures%3aLede" Name="News:Features:Lede">
+					<Properties><BasedOn type="object">ParagraphStyle/Body Text Dropcap</BasedOn></Properties>
+				</ParagraphStyle>
+			</ParagraphStyleGroup>
+		</ParagraphStyleGroup>
+		<ParagraphStyle Self="ParagraphStyle/Loop A" Name="Loop A">
+			<Properties><BasedOn type="object">ParagraphStyle/Loop B</BasedOn></Properties>
+		</ParagraphStyle>
+		<ParagraphStyle Self="ParagraphStyle/Loop B" Name="Loop B">
+			<Properties><BasedOn type="object">ParagraphStyle/Loop A</BasedOn></Properties>
+		</ParagraphStyle>
+	</RootParagraphStyleGroup>
+</Document>`
+
+// load reads the styles in doc the way the story parsers do.
+func load(t *testing.T, doc string) *Sheet {
+	sheet := New()
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		if se, ok := token.(xml.StartElement); ok && IsRoot(se.Name.Local) {
+			if err := sheet.Decode(decoder, &se); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return sheet
+}
+
+func TestIs(t *testing.T) {
+	sheet := load(t, document)
+	tests := []struct {
+		applied, target string
+		want            bool
+	}{
+		{"ParagraphStyle/Body Text", "ParagraphStyle/Body Text", true},
+		{"ParagraphStyle/Body Text Dropcap", "ParagraphStyle/Body Text", true},
+		{"ParagraphStyle/News%3aBody Text", "ParagraphStyle/Body Text", true},
+		{"ParagraphStyle/News%3aFeatures%3aLede", "ParagraphStyle/Body Text", true},
+		{"ParagraphStyle/News%3aFeatures%3aLede", "ParagraphStyle/Body Text Dropcap", true},
+		{"ParagraphStyle/Body Text", "ParagraphStyle/Body Text Dropcap", false},
+		{"ParagraphStyle/Author Job", "ParagraphStyle/Body Text", false},
+		{"ParagraphStyle/Author Job", "ParagraphStyle/Author", false},
+		{"ParagraphStyle/$ID/[No paragraph style]", "ParagraphStyle/Body Text", false},
+		// styles that aren't defined still match by name
+		{"ParagraphStyle/Kicker", "ParagraphStyle/Kicker", true},
+		{"ParagraphStyle/Sports%3aKicker", "ParagraphStyle/Kicker", true},
+		{"ParagraphStyle/Kicker", "ParagraphStyle/Headline", false},
+		// and a loop of styles based on each other ends
+		{"ParagraphStyle/Loop A", "ParagraphStyle/Body Text", false},
+		{"ParagraphStyle/Loop A", "ParagraphStyle/Loop B", true},
+		{"", "ParagraphStyle/Body Text", false},
+	}
+	for _, test := range tests {
+		if got := sheet.Is(test.applied, test.target); got != test.want {
+			t.Errorf("Is(%q, %q) = %v, want %v", test.applied, test.target, got, test.want)
+		}
+	}
+	// a sheet without definitions, or none at all, matches by name alone
+	for _, empty := range []*Sheet{New(), nil} {
+		if !empty.Is("ParagraphStyle/News%3aBody Text", "ParagraphStyle/Body Text") ||
+			empty.Is("ParagraphStyle/Body Text Dropcap", "ParagraphStyle/Body Text") {
+			t.Errorf("sheet %v matches wrongly", empty)
+		}
+	}
+}
+
+func TestFontStyle(t *testing.T) {
+	sheet := load(t, document)
+	tests := []struct {
+		applied string
+		want    string
+	}{
+		{"CharacterStyle/Emphasis", "Italic"},
+		{"CharacterStyle/Text%3aTitle", "Italic"},
+		{"CharacterStyle/$ID/[No character style]", ""},
+		{"CharacterStyle/Missing", ""},
+		{"ParagraphStyle/Author Job", "Italic"},
+		{"ParagraphStyle/Loop A", ""},
+	}
+	for _, test := range tests {
+		if got := sheet.FontStyle(test.applied); got != test.want {
+			t.Errorf("FontStyle(%q) = %q, want %q", test.applied, got, test.want)
+		}
+	}
+}
+
+func TestEmphasized(t *testing.T) {
+	sheet := load(t, document)
+	const none = "CharacterStyle/$ID/[No character style]"
+	tests := []struct {
+		paragraph, character, fontStyle string
+		italicByDefault                 bool
+		want                            bool
+	}{
+		{"ParagraphStyle/Body Text", none, "", false, false},
+		{"ParagraphStyle/Body Text", none, "Italic", false, true},
+		{"ParagraphStyle/Body Text", none, "Bold Italic", false, true},
+		{"ParagraphStyle/Body Text", none, "Bold", false, false},
+		{"ParagraphStyle/Body Text", "CharacterStyle/Emphasis", "", false, true},
+		{"ParagraphStyle/Body Text", "CharacterStyle/Text%3aTitle", "", false, true},
+		// the text's own font style wins over its character style's
+		{"ParagraphStyle/Body Text", "CharacterStyle/Emphasis", "Regular", false, false},
+		// in an italic paragraph, roman text stands out
+		{"ParagraphStyle/Author Job", none, "", false, false},
+		{"ParagraphStyle/Author Job", "CharacterStyle/Roman", "", false, true},
+		{"ParagraphStyle/Author Job", "CharacterStyle/Emphasis", "", false, false},
+		// paragraphs without a font style are italic if italicByDefault
+		{"ParagraphStyle/Author", none, "Regular", true, true},
+		{"ParagraphStyle/Author", none, "Italic", true, false},
+		{"ParagraphStyle/Author", none, "", true, false},
+	}
+	for _, test := range tests {
+		got := sheet.Emphasized(test.paragraph, test.character, test.fontStyle, test.italicByDefault)
+		if got != test.want {
+			t.Errorf("Emphasized(%q, %q, %q, %v) = %v, want %v", test.paragraph, test.character,
+				test.fontStyle, test.italicByDefault, got, test.want)
+		}
+	}
+}
+
+func TestItalic(t *testing.T) {
+	for style, want := range map[string]bool{
+		"Italic": true, "Bold Italic": true, "Oblique": true, "italic": true,
+		"Regular": false, "Bold": false, "": false,
+	} {
+		if got := Italic(style); got != want {
+			t.Errorf("Italic(%q) = %v, want %v", style, got, want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Package typography cleans up the mechanical problems in story text:
+// straight quotes, hyphens used as dashes, non-breaking spaces, doubled
+// spaces and stray line breaks. Text may contain HTML; tags are left alone.
+package typography
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	leftDoubleQuote  = '“'
+	rightDoubleQuote = '”'
+	leftSingleQuote  = '‘'
+	rightSingleQuote = '’'
+	emDash           = "—"
+	nbsp             = "\u00a0"
+)
+
+// EachText calls f on each run of text in s between HTML tags and returns s
+// with the runs replaced by what f returns. prev is the last rune of the
+// text before the run (ignoring tags), or 0 at the start.
+func EachText(s string, f func(prev rune, text string) string) string {
+	out := ""
+	prev := rune(0)
+	for len(s) > 0 {
+		if s[0] == '<' {
+			end := strings.Index(s, ">")
+			if end == -1 {
+				end = len(s) - 1
+			}
+			out += s[:end+1]
+			s = s[end+1:]
+			continue
+		}
+		end := strings.Index(s, "<")
+		if end == -1 {
+			end = len(s)
+		}
+		out += f(prev, s[:end])
+		if r, _ := utf8.DecodeLastRuneInString(s[:end]); r != utf8.RuneError {
+			prev = r
+		}
+		s = s[end:]
+	}
+	return out
+}
+
+// OutsideTags reports the byte offsets of each match of pattern in s that
+// isn't inside an HTML tag.
+func OutsideTags(s string, pattern *regexp.Regexp) [][]int {
+	tags := tagPattern.FindAllStringIndex(s, -1)
+	matches := [][]int{}
+	for _, m := range pattern.FindAllStringIndex(s, -1) {
+		inTag := false
+		for _, t := range tags {
+			if m[0] < t[1] && m[1] > t[0] {
+				inTag = true
+				break
+			}
+		}
+		if !inTag {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+var nbspPattern = regexp.MustCompile(`&nbsp;|&#160;|` + nbsp)
+
+// NBSP replaces non-breaking spaces, as the web editor inserts them, with
+// ordinary spaces.
+func NBSP(s string) string {
+	return nbspPattern.ReplaceAllString(s, " ")
+}
+
+// NBSPPattern matches non-breaking spaces.
+func NBSPPattern() *regexp.Regexp { return nbspPattern }
+
+var spacesPattern = regexp.MustCompile(`  +`)
+
+// CollapseSpaces replaces runs of spaces with a single space.
+func CollapseSpaces(s string) string {
+	return EachText(s, func(prev rune, text string) string {
+		return spacesPattern.ReplaceAllString(text, " ")
+	})
+}
+
+var lineBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>`)
+
+// StripLineBreaks removes <br> tags.
+func StripLineBreaks(s string) string {
+	return lineBreakPattern.ReplaceAllString(s, "")
+}
+
+// LineBreakPattern matches <br> tags.
+func LineBreakPattern() *regexp.Regexp { return lineBreakPattern }
+
+var (
+	leadingSpacePattern  = regexp.MustCompile(`(?i)^\s+|(<p[^>]*>)\s+`)
+	trailingSpacePattern = regexp.MustCompile(`(?i)\s+$|\s+(</p>)`)
+	trimSpacePattern     = regexp.MustCompile(`(?i)^\s+|\s+$|<p[^>]*>\s+|\s+</p>`)
+)
+
+// TrimSpace removes whitespace from the start and end of s, and of each
+// paragraph in it.
+func TrimSpace(s string) string {
+	s = leadingSpacePattern.ReplaceAllString(s, "$1")
+	return trailingSpacePattern.ReplaceAllString(s, "$1")
+}
+
+// TrimSpacePattern matches what TrimSpace removes, along with any paragraph
+// tags next to it.
+func TrimSpacePattern() *regexp.Regexp { return trimSpacePattern }
+
+var (
+	straightQuotePattern = regexp.MustCompile(`["']`)
+	// a hyphen standing alone between words, or two or three of them together
+	dashPattern = regexp.MustCompile(` +-{1,3} +|-{2,3}`)
+)
+
+// StraightQuotePattern matches straight quotes.
+func StraightQuotePattern() *regexp.Regexp { return straightQuotePattern }
+
+// DashPattern matches hyphens being used as dashes.
+func DashPattern() *regexp.Regexp { return dashPattern }
+
+// Dashes replaces hyphens used as dashes with em dashes, spaced as AP style
+// spaces them: "word — word".
+func Dashes(s string) string {
+	return EachText(s, func(prev rune, text string) string {
+		return dashPattern.ReplaceAllString(text, " "+emDash+" ")
+	})
+}
+
+// SmartQuotes replaces straight quotes with curly ones, deciding which way
+// each faces from the character before it.
+func SmartQuotes(s string) string {
+	return EachText(s, func(prev rune, text string) string {
+		out := make([]rune, 0, len(text))
+		for _, r := range text {
+			switch r {
+			case '"':
+				if opens(prev) {
+					r = leftDoubleQuote
+				} else {
+					r = rightDoubleQuote
+				}
+			case '\'':
+				// apostrophes are right single quotes, like closing quotes
+				if opens(prev) {
+					r = leftSingleQuote
+				} else {
+					r = rightSingleQuote
+				}
+			}
+			out = append(out, r)
+			prev = r
+		}
+		return string(out)
+	})
+}
+
+// opens reports whether a quote after prev starts a quotation.
+func opens(prev rune) bool {
+	switch {
+	case prev == 0, unicode.IsSpace(prev):
+		return true
+	case strings.ContainsRune("([{—–-/", prev):
+		return true
+	case prev == leftDoubleQuote || prev == leftSingleQuote:
+		return true
+	}
+	return false
+}
+
+// Normalize applies every fix in this package that's safe for s. Line
+// breaks are only removed if singleLine is set.
+func Normalize(s string, singleLine bool) string {
+	s = NBSP(s)
+	if singleLine {
+		s = StripLineBreaks(s)
+	}
+	s = SmartQuotes(s)
+	s = Dashes(s)
+	s = CollapseSpaces(s)
+	return TrimSpace(s)
+}
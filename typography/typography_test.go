@@ -0,0 +1,62 @@
+package typography
+
+import "testing"
+
+func TestFixes(t *testing.T) {
+	tests := []struct {
+		name string
+		fix  func(string) string
+		in   string
+		want string
+	}{
+		{"nbsp entity", NBSP, "a&nbsp;b", "a b"},
+		{"nbsp numeric", NBSP, "a&#160;b", "a b"},
+		{"nbsp rune", NBSP, "a b", "a b"},
+
+		{"double quotes", SmartQuotes, `He said "hi."`, "He said “hi.”"},
+		{"single quotes", SmartQuotes, `'Tis 'fine'`, "‘Tis ‘fine’"},
+		{"apostrophe", SmartQuotes, `it's`, "it’s"},
+		{"quote after dash", SmartQuotes, `word—"quote"`, "word—“quote”"},
+		{"nested quotes", SmartQuotes, `"'Hi,' he said"`, "“‘Hi,’ he said”"},
+		{"quote after tag", SmartQuotes, `<p>"Hi"</p>`, "<p>“Hi”</p>"},
+		{"quote across tag", SmartQuotes, `<i>Hi</i>"`, "<i>Hi</i>”"},
+		{"attribute quotes", SmartQuotes, `<a href="x">"y"</a>`, `<a href="x">“y”</a>`},
+
+		{"spaced hyphen", Dashes, "one - two", "one — two"},
+		{"double hyphen", Dashes, "one--two", "one — two"},
+		{"spaced double hyphen", Dashes, "one -- two", "one — two"},
+		{"triple hyphen", Dashes, "one---two", "one — two"},
+		{"compound word", Dashes, "well-known", "well-known"},
+		{"comment in tag", Dashes, "<!-- x -->a", "<!-- x -->a"},
+
+		{"leading and trailing", TrimSpace, "  a b  ", "a b"},
+		{"paragraphs", TrimSpace, "<p> a </p><p class=\"x\">\tb\n</p>", "<p>a</p><p class=\"x\">b</p>"},
+		{"inner spaces kept", TrimSpace, "a  b", "a  b"},
+
+		{"collapse", CollapseSpaces, "a   b  c", "a b c"},
+		{"collapse tag", CollapseSpaces, `<a  href="x">a  b</a>`, `<a  href="x">a b</a>`},
+
+		{"br", StripLineBreaks, "a<br>b<BR/>c<br />d", "abcd"},
+	}
+	for _, test := range tests {
+		if got := test.fix(test.in); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in         string
+		singleLine bool
+		want       string
+	}{
+		{" \"Hi\"&nbsp; there - you<br>", true, "“Hi” there — you"},
+		{"<p> It's  here<br>now </p>", false, "<p>It’s here<br>now</p>"},
+	}
+	for _, test := range tests {
+		if got := Normalize(test.in, test.singleLine); got != test.want {
+			t.Errorf("Normalize(%q, %v) = %q, want %q", test.in, test.singleLine, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package upload
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/thepoly/uploader/config"
+	"github.com/thepoly/uploader/gdrive"
+	"github.com/thepoly/uploader/links"
+	"github.com/thepoly/uploader/schedule"
+	"github.com/thepoly/uploader/seo"
+	"github.com/thepoly/uploader/validate"
+	"github.com/thepoly/uploader/wordpress"
+)
+
+// Options change what Upload does.
+type Options struct {
+	// Fix applies automatic fixes before validating.
+	Fix bool
+	// Slug and Excerpt override the generated ones, if set. They're only
+	// used when uploading one story.
+	Slug    string
+	Excerpt string
+	// Schedule is when the posts go up.
+	Schedule schedule.Schedule
+	// Format is the format of post content; see package blocks.
+	Format string
+	// Concurrency is how many stories are posted at once.
+	Concurrency int
+	// OnDuplicate is what to do with stories that were already posted:
+	// Ask, Skip or Update.
+	OnDuplicate string
+	// DryRun prints the requests that would be sent instead of posting.
+	DryRun bool
+	// Preview writes an HTML preview of each post instead of posting.
+	Preview bool
+}
+
+// Status is what happened to a story in an upload.
+type Status int
+
+const (
+	// Ready stories passed validation and haven't been posted yet.
+	Ready Status = iota
+	Unreadable
+	Invalid
+	Posted
+	Updated
+	Duplicate
+	Failed
+)
+
+var statusNames = map[Status]string{
+	Ready:      "ready",
+	Unreadable: "unreadable",
+	Invalid:    "invalid",
+	Posted:     "posted",
+	Updated:    "updated",
+	Duplicate:  "skipped (duplicate)",
+	Failed:     "failed",
+}
+
+func (s Status) String() string {
+	return statusNames[s]
+}
+
+// Result is what happened to one story in an upload. A file that can't be
+// read has a result without a story.
+type Result struct {
+	Path     string
+	Story    *Story
+	Findings []validate.Finding
+	Status   Status
+	// Link is the post, or for duplicates the post that already exists.
+	Link string
+	Err  error
+	// live is the post to update instead of creating one, if any.
+	live *WPPostReturned
+}
+
+// Upload posts the stories in paths. Every file is read and each story in
+// it validated before anything is posted; then the valid ones are posted at
+// once, and what happened to each story is printed. It returns an error if
+// any story failed.
+func Upload(cfg *config.Config, driveClient *gdrive.Client, paths []string, opts Options) error {
+	validator, err := validate.New(cfg.Validation)
+	if err != nil {
+		return err
+	}
+	resolver, err := NewResolver(driveClient, cfg.LinkRoots)
+	if err != nil {
+		return err
+	}
+
+	results := []*Result{}
+	for _, path := range paths {
+		results = append(results, prepare(validator, resolver, path, opts)...)
+	}
+	ready := 0
+	for _, r := range results {
+		if r.Status == Ready {
+			ready++
+		}
+	}
+
+	fmt.Println()
+	if len(results) == 1 && ready == 1 {
+		results[0].Story.Print()
+	} else {
+		printSummary(results)
+	}
+	fmt.Println()
+
+	if opts.DryRun || opts.Preview {
+		return try(cfg, results, opts)
+	}
+	if ready > 0 {
+		client, err := NewClient(cfg)
+		if err != nil {
+			return err
+		}
+		color.Cyan("Uploading %d %s...", ready, plural(ready, "story", "stories"))
+		publishAll(client, results, opts)
+		fmt.Println()
+	}
+	printReport(results)
+
+	failed := 0
+	for _, r := range results {
+		switch r.Status {
+		case Failed, Invalid, Unreadable:
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d %s failed", failed, len(results), plural(len(results), "story", "stories"))
+	}
+	return nil
+}
+
+// try shows what posting the ready stories in results would do, without
+// posting them.
+func try(cfg *config.Config, results []*Result, opts Options) error {
+	for _, r := range results {
+		if r.Status != Ready {
+			continue
+		}
+		if opts.DryRun {
+			color.Cyan(r.Label())
+			if err := r.Story.PrintDryRun(cfg.APIRoot); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		if opts.Preview {
+			path := PreviewPath(r.Path, r.Story)
+			if err := writePreviewFile(r.Story, path); err != nil {
+				return fmt.Errorf("unable to write preview: %v", err)
+			}
+			color.Green("Preview of %s written to %s", r.Label(), path)
+		}
+	}
+	color.Yellow("Nothing was posted.")
+	return nil
+}
+
+// Label names the story the result is for; see Label.
+func (r *Result) Label() string {
+	return Label(r.Path, r.Story)
+}
+
+// prepare reads and validates the stories in path. Slug and Excerpt options
+// are only used if path has a single story in it.
+func prepare(validator *validate.Validator, resolver *links.Resolver, path string, opts Options) []*Result {
+	c := color.New(color.FgCyan)
+	c.Printf("Reading \"%s\"...", path)
+	stories, err := OpenStories(path)
+	if err != nil {
+		fmt.Println()
+		color.Red("%v", err)
+		return []*Result{{Path: path, Status: Unreadable, Err: err}}
+	}
+	c.Printf(" done.\n")
+	if len(stories) > 1 && (opts.Slug != "" || opts.Excerpt != "") {
+		color.Yellow("%s has %d stories, so the slug and excerpt given are left out.", path, len(stories))
+	}
+
+	results := []*Result{}
+	for _, story := range stories {
+		story.Links = resolver
+		story.Schedule = opts.Schedule
+		story.Format = opts.Format
+		story.Staff = validator.Staff()
+		story.Kickers = validator.Kickers()
+		if len(stories) == 1 && opts.Slug != "" {
+			story.cacheSet("Slug", opts.Slug)
+		}
+		if len(stories) == 1 && opts.Excerpt != "" {
+			story.cacheSet("Excerpt", opts.Excerpt)
+		}
+		r := &Result{Path: path, Story: story}
+		if story.Name != "" {
+			c.Println(story.Name)
+		}
+		validateStory(validator, r, opts)
+		results = append(results, r)
+	}
+	return results
+}
+
+// validateStory fixes the story in r if asked to, and validates it,
+// leaving it Ready to post if there's nothing blocking.
+func validateStory(validator *validate.Validator, r *Result, opts Options) {
+	story := r.Story
+	if opts.Fix {
+		fixed, changes := validator.Fix(story.ValidationStory())
+		story.ApplyFixes(fixed)
+		PrintChanges(changes)
+	}
+
+	// Errors found here are usually the result of making an improper snippet
+	// in InDesign, and prevent the article from being posted to the website.
+	vs := story.ValidationStory()
+	r.Findings = validator.Validate(vs)
+	PrintFindings(vs, r.Findings)
+	if validate.Blocking(r.Findings) {
+		r.Status = Invalid
+		return
+	}
+	clean, removed := validate.Sanitize(vs)
+	story.ApplyFixes(clean)
+	PrintRemovals(removed)
+	r.Status = Ready
+}
+
+// printSummary prints a table of the stories to upload and what validation
+// found in them.
+func printSummary(results []*Result) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STORY\tKICKER\tHEADLINE\tERRORS\tWARNINGS\tSTATUS")
+	for _, r := range results {
+		kicker, headline := "", ""
+		if r.Story != nil {
+			kicker = seo.Truncate(seo.PlainText(r.Story.Kicker()), 20)
+			headline = seo.Truncate(seo.PlainText(r.Story.Headline()), 40)
+		}
+		counts := map[validate.Severity]int{}
+		for _, f := range r.Findings {
+			counts[f.Severity]++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", r.Label(), kicker, headline,
+			counts[validate.Error], counts[validate.Warning], r.Status)
+	}
+	w.Flush()
+}
+
+// printReport prints what happened to each story.
+func printReport(results []*Result) {
+	groups := []struct {
+		heading  string
+		statuses []Status
+		color    *color.Color
+	}{
+		{"Posted", []Status{Posted}, color.New(color.FgGreen)},
+		{"Updated", []Status{Updated}, color.New(color.FgGreen)},
+		{"Skipped, already posted", []Status{Duplicate}, color.New(color.FgYellow)},
+		{"Failed", []Status{Failed, Invalid, Unreadable}, color.New(color.FgRed)},
+	}
+	for _, g := range groups {
+		lines := []string{}
+		for _, r := range results {
+			for _, status := range g.statuses {
+				if r.Status != status {
+					continue
+				}
+				switch {
+				case r.Link != "":
+					lines = append(lines, fmt.Sprintf("%s: %s", r.Label(), r.Link))
+				case r.Err != nil:
+					lines = append(lines, fmt.Sprintf("%s: %v", r.Label(), r.Err))
+				case r.Status == Invalid:
+					lines = append(lines, fmt.Sprintf("%s: validation errors", r.Label()))
+				default:
+					lines = append(lines, r.Label())
+				}
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		g.color.Printf("%s (%d):\n", g.heading, len(lines))
+		for _, line := range lines {
+			fmt.Printf("\t%s\n", line)
+		}
+	}
+}
+
+// publishAll posts the ready stories in results, concurrency at a time.
+// Stories that were already posted are found first, so there's only one
+// question at a time about what to do with them.
+func publishAll(client *wordpress.Client, results []*Result, opts Options) {
+	for _, r := range results {
+		if r.Status != Ready {
+			continue
+		}
+		post, err := FindPost(client, r.Story.Source, r.Story.Fingerprint())
+		if err != nil {
+			r.Status = Failed
+			r.Err = err
+			continue
+		}
+		if post == nil {
+			continue
+		}
+		update := opts.OnDuplicate == Update ||
+			opts.OnDuplicate != Skip && interactive() && askUpdate(r.Label(), post)
+		if !update {
+			r.Status = Duplicate
+			r.Link = post.Link
+			continue
+		}
+		r.live = post
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	queue := make(chan *Result)
+	wg := sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range queue {
+				link, err := r.Story.publish(client, r.live)
+				if err != nil {
+					r.Status = Failed
+					r.Err = err
+					continue
+				}
+				r.Status = Posted
+				if r.live != nil {
+					r.Status = Updated
+				}
+				r.Link = link
+			}
+		}()
+	}
+	for _, r := range results {
+		if r.Status == Ready {
+			queue <- r
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// publish creates a post for s, or updates live if it isn't nil, and returns
+// its link.
+func (s *Story) publish(client *wordpress.Client, live *WPPostReturned) (string, error) {
+	if live != nil {
+		return SendPost(client, live.ID, UpdatedPost(live, s.CreateWPPost(), ""))
+	}
+	return SendPost(client, 0, s.CreateWPPost())
+}
+
+// SendPost creates post, or updates post id with it if id isn't zero, and
+// returns its link. If creating the post fails in a way that it might have
+// been made anyway, it's looked for by its source and fingerprint before
+// trying again, so it's never made twice.
+func SendPost(client *wordpress.Client, id int, post WPPost) (string, error) {
+	returned := WPPostReturned{}
+	req := wordpress.Request{Method: "POST", Path: "/posts", Body: post}
+	if id != 0 {
+		req.Path = fmt.Sprintf("/posts/%d", id)
+	} else {
+		req.Done = func() (bool, error) {
+			made, err := FindPost(client, post.Meta.SourceID, post.Meta.Fingerprint)
+			if made != nil {
+				returned = *made
+			}
+			return made != nil, err
+		}
+	}
+	if err := client.Do(req, &returned); err != nil {
+		return "", err
+	}
+	return returned.Link, nil
+}
+
+// NewClient returns a client for the configured WordPress site, which says
+// when it's retrying a request.
+func NewClient(cfg *config.Config) (*wordpress.Client, error) {
+	client, err := cfg.WordPress()
+	if err != nil {
+		return nil, err
+	}
+	client.Retrying = func(err error, wait time.Duration) {
+		color.Yellow("%v; trying again in %v", err, wait.Round(100*time.Millisecond))
+	}
+	return client, nil
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
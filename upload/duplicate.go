@@ -0,0 +1,124 @@
+package upload
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/thepoly/uploader/fingerprint"
+	"github.com/thepoly/uploader/wordpress"
+)
+
+// What to do with a story that's already been posted.
+const (
+	// Ask asks what to do, if there's someone to ask; otherwise the story
+	// is skipped.
+	Ask    = "ask"
+	Skip   = "skip"
+	Update = "update"
+)
+
+// Fingerprint identifies the story's content; see package fingerprint.
+func (s *Story) Fingerprint() string {
+	if val, ok := s.cacheGet("Fingerprint"); ok {
+		return val.(string)
+	}
+	res := fingerprint.Of(s.Headline(), s.AuthorName(), s.BodyText())
+	s.cacheSet("Fingerprint", res)
+	return res
+}
+
+// documentIDPattern finds the document ID in XMP metadata, like
+// "xmp.did:0180117407206811822AB5B8E6B69C3A", written either as an element
+// or as an attribute.
+var documentIDPattern = regexp.MustCompile(`xmpMM:DocumentID(?:>|=")\s*([^<"\s]+)`)
+
+// documentID returns the document ID in XMP metadata, or "" if it has none.
+func documentID(xmp string) string {
+	if m := documentIDPattern.FindStringSubmatch(xmp); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// setSource sets the source of s from the ID InDesign gave the document it's
+// from and the story's own ID in that document. Both stay the same as the
+// story is edited and saved, but stories from different documents differ
+// even when their files have the same name, like each week's
+// editorial.idms. Stories from files without a document ID are left without
+// a source, and are only found by their fingerprint.
+func (s *Story) setSource() {
+	s.Source = ""
+	if s.documentID != "" && len(s.IDMLStories) > 0 && s.IDMLStories[0].Self != "" {
+		s.Source = s.documentID + "/" + s.IDMLStories[0].Self
+	}
+}
+
+// FindPost returns the post made from the story with the given source or
+// fingerprint, or nil if there isn't one. The source is checked first, since
+// a corrected story has a new fingerprint; a story's source is its own, so a
+// post with it is the story's even if the words have changed. Posts are
+// found by the meta they store these in, so the site has to allow querying
+// posts by the SourceID and Fingerprint meta keys. Posts the site sends back
+// whose meta doesn't match are ignored.
+func FindPost(client *wordpress.Client, source, fingerprint string) (*WPPostReturned, error) {
+	queries := []struct{ key, value string }{
+		{"SourceID", source},
+		{"Fingerprint", fingerprint},
+	}
+	for _, q := range queries {
+		if q.value == "" {
+			continue
+		}
+		posts := []WPPostReturned{}
+		err := client.Do(wordpress.Request{
+			Method: "GET",
+			Path:   "/posts",
+			Query: url.Values{
+				"status":     {"any"},
+				"context":    {"edit"},
+				"meta_key":   {q.key},
+				"meta_value": {q.value},
+			},
+		}, &posts)
+		if err != nil {
+			return nil, err
+		}
+		// a site that doesn't allow the query ignores it and sends recent
+		// posts, so check each one really matches
+		for i, post := range posts {
+			if q.key == "SourceID" && post.Meta.SourceID == q.value ||
+				q.key == "Fingerprint" && post.Meta.Fingerprint == q.value {
+				return &posts[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// interactive reports whether there's someone at a terminal to ask.
+func interactive() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+var stdin = bufio.NewReader(os.Stdin)
+
+// confirm asks a yes or no question, defaulting to no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	answer, _ := stdin.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// askUpdate asks whether to update the post a story was already posted as.
+func askUpdate(path string, post *WPPostReturned) bool {
+	color.Yellow("%s was already posted as %q: %s", path, post.Title.Raw, post.Link)
+	return confirm("Update that post instead?")
+}
@@ -0,0 +1,148 @@
+package upload
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thepoly/uploader/wordpress"
+)
+
+// site serves posts the way WordPress does when asked for them by meta. A
+// site that doesn't allow querying by meta ignores the query and sends every
+// post. It returns a client for the site and a function to close it.
+func site(t *testing.T, posts []WPPostReturned, allowsMetaQuery bool) (*wordpress.Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key, value := req.URL.Query().Get("meta_key"), req.URL.Query().Get("meta_value")
+		found := []WPPostReturned{}
+		for _, post := range posts {
+			meta := map[string]string{"SourceID": post.Meta.SourceID, "Fingerprint": post.Meta.Fingerprint}
+			if !allowsMetaQuery || meta[key] == value {
+				found = append(found, post)
+			}
+		}
+		json.NewEncoder(w).Encode(found)
+	}))
+	client, err := wordpress.New(ts.URL, "uploader", "secret", wordpress.Config{Timeout: "1s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, ts.Close
+}
+
+// post is a post made from the story with the given source and fingerprint.
+func post(id int, source, fingerprint string) WPPostReturned {
+	p := WPPostReturned{ID: id}
+	p.Meta.SourceID = source
+	p.Meta.Fingerprint = fingerprint
+	return p
+}
+
+func TestFindPost(t *testing.T) {
+	posts := []WPPostReturned{
+		post(1, "xmp.did:A/u1", "aaa"),
+		post(2, "xmp.did:B/u1", "bbb"),
+		post(3, "", "ccc"),
+	}
+	tests := []struct {
+		name                string
+		allowsMetaQuery     bool
+		source, fingerprint string
+		want                int
+	}{
+		{"same source and words", true, "xmp.did:A/u1", "aaa", 1},
+		// a corrected story is still the story, so its post is updated
+		{"same source, words changed", true, "xmp.did:A/u1", "zzz", 1},
+		{"same words from another file", true, "xmp.did:C/u1", "ccc", 3},
+		{"same words without a source", true, "", "bbb", 2},
+		// another document's story with the same ID isn't the story
+		{"same story ID in another document", true, "xmp.did:C/u1", "zzz", 0},
+		{"new story", true, "", "zzz", 0},
+
+		// the site sends every post back; only the ones whose meta really
+		// match count
+		{"query ignored, nothing matches", false, "xmp.did:C/u1", "zzz", 0},
+		{"query ignored, source matches", false, "xmp.did:B/u1", "zzz", 2},
+		{"query ignored, words match", false, "xmp.did:C/u1", "ccc", 3},
+	}
+	for _, test := range tests {
+		client, stop := site(t, posts, test.allowsMetaQuery)
+		found, err := FindPost(client, test.source, test.fingerprint)
+		stop()
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		got := 0
+		if found != nil {
+			got = found.ID
+		}
+		if got != test.want {
+			t.Errorf("%s: found post %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+// snippetFrom is a snippet of the story u1c6 from the document with the
+// given XMP document ID, with the given body text.
+func snippetFrom(documentID, body string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Document Self="d">
+	<MetadataPacketPreference Self="d-MetadataPacketPreference"><Properties><Contents><![CDATA[<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about="" xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/" xmpMM:DocumentID="` + documentID + `"/>
+</rdf:RDF></x:xmpmeta>]]></Contents></Properties></MetadataPacketPreference>
+	<Story Self="u1c6">
+		<ParagraphStyleRange AppliedParagraphStyle="ParagraphStyle/Headline"><CharacterStyleRange><Content>Editorial</Content></CharacterStyleRange></ParagraphStyleRange>
+		<ParagraphStyleRange AppliedParagraphStyle="ParagraphStyle/Body Text"><CharacterStyleRange><Content>` + body + `</Content></CharacterStyleRange></ParagraphStyleRange>
+	</Story>
+</Document>`
+}
+
+func TestSameFileName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "duplicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	read := func(week, documentID, body string) *Story {
+		path := filepath.Join(dir, week, "editorial.idms")
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := ioutil.WriteFile(path, []byte(snippetFrom(documentID, body)), 0644); err != nil {
+			t.Fatal(err)
+		}
+		story, err := OpenStory(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return story
+	}
+	lastWeek := read("2018-03-07", "xmp.did:01801174", "Vote in the Senate election.")
+	thisWeek := read("2018-03-14", "xmp.did:02801174", "Parking is a mess.")
+	corrected := read("2018-03-07", "xmp.did:01801174", "Vote in the Senate elections.")
+	if lastWeek.Source != "xmp.did:01801174/u1c6" || thisWeek.Source != "xmp.did:02801174/u1c6" {
+		t.Fatalf("sources are %q and %q", lastWeek.Source, thisWeek.Source)
+	}
+
+	client, stop := site(t, []WPPostReturned{post(1, lastWeek.Source, lastWeek.Fingerprint())}, true)
+	defer stop()
+	if found, err := FindPost(client, thisWeek.Source, thisWeek.Fingerprint()); err != nil || found != nil {
+		t.Errorf("this week's editorial found %+v, %v; want nothing", found, err)
+	}
+	if found, err := FindPost(client, corrected.Source, corrected.Fingerprint()); err != nil || found == nil || found.ID != 1 {
+		t.Errorf("the corrected editorial found %+v, %v; want post 1", found, err)
+	}
+}
+
+func TestSourceWithoutDocumentID(t *testing.T) {
+	story := NewStoryFromFile(strings.NewReader(snippet("Headline", "Council votes")))
+	story.setSource()
+	if story.Source != "" {
+		t.Errorf("source is %q, want none", story.Source)
+	}
+}
@@ -0,0 +1,260 @@
+package upload
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/thepoly/uploader/styles"
+)
+
+// Extensions are the kinds of file stories are read from: InDesign snippets,
+// InCopy stories and IDML packages.
+var Extensions = []string{".idms", ".icml", ".idml"}
+
+func isStoryFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range Extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Files expands command line arguments into the story files they name. An
+// argument can be a file, a glob like "snippets/*.idms", or a directory,
+// which is searched for story files. Files are returned in order without
+// duplicates.
+func Files(args []string) ([]string, error) {
+	files := []string{}
+	seen := map[string]bool{}
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			files = append(files, path)
+		}
+	}
+	for _, arg := range args {
+		matches := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			var err error
+			if matches, err = filepath.Glob(arg); err != nil {
+				return nil, fmt.Errorf("bad pattern %q: %v", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %q", arg)
+			}
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				return nil, err
+			}
+			if !info.IsDir() {
+				add(match)
+				continue
+			}
+			found := []string{}
+			err = filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && isStoryFile(path) {
+					found = append(found, path)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(found)
+			for _, path := range found {
+				add(path)
+			}
+		}
+	}
+	return files, nil
+}
+
+// OpenStories reads the stories in a snippet or InCopy file, which have one,
+// or in an IDML package, which has one for each story laid out in it.
+func OpenStories(path string) ([]*Story, error) {
+	if strings.ToLower(filepath.Ext(path)) == ".idml" {
+		return openPackage(path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	story := NewStoryFromFile(file)
+	story.setSource()
+	return []*Story{story}, nil
+}
+
+// OpenStory reads the story in a snippet or InCopy file, or in an IDML
+// package with only one story.
+func OpenStory(path string) (*Story, error) {
+	stories, err := OpenStories(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(stories) > 1 {
+		return nil, fmt.Errorf("%s has %d stories; export the one you want as a snippet", path, len(stories))
+	}
+	return stories[0], nil
+}
+
+// Label names a story read from path for people: the path, followed by
+// where the story is in the package if it's from one, like
+// "issue.idml (Stories/Story_u1d8.xml)". s may be nil.
+func Label(path string, s *Story) string {
+	if s == nil || s.Name == "" {
+		return path
+	}
+	return fmt.Sprintf("%s (%s)", path, s.Name)
+}
+
+// packaging is the namespace of the elements that wrap the parts of an IDML
+// package.
+const packaging = "http://ns.adobe.com/AdobeInDesign/idml/1.0/packaging"
+
+// openPackage reads the stories in an IDML package, a zip file in which each
+// of the document's stories is a file under Stories/, the spreads under
+// Spreads/ have the text frames stories are placed in and the photos linked
+// beside them, the styles are in Resources/Styles.xml and the document's
+// metadata is in META-INF/metadata.xml. Each story gets
+// the photos on the spreads it's placed on. Stories with neither a headline
+// nor body text, like folios and page numbers, aren't articles and are left
+// out.
+func openPackage(path string) ([]*Story, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open IDML package %s: %v", path, err)
+	}
+	defer r.Close()
+
+	sheet := styles.New()
+	stories := []*Story{}
+	spreads := []*spread{}
+	id := ""
+	for _, f := range r.File {
+		if !strings.HasSuffix(f.Name, ".xml") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s in %s: %v", f.Name, path, err)
+		}
+		switch {
+		case f.Name == "Resources/Styles.xml":
+			err = readStyles(rc, sheet)
+		case f.Name == "META-INF/metadata.xml":
+			var xmp []byte
+			xmp, err = ioutil.ReadAll(rc)
+			id = documentID(string(xmp))
+		case strings.HasPrefix(f.Name, "Stories/"):
+			story := NewStory()
+			story.Name = f.Name
+			story.Styles = sheet
+			story.parse(rc)
+			stories = append(stories, story)
+		case strings.HasPrefix(f.Name, "Spreads/"):
+			var sp *spread
+			sp, err = readSpread(rc)
+			spreads = append(spreads, sp)
+		}
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s in %s: %v", f.Name, path, err)
+		}
+	}
+
+	articles := []*Story{}
+	for _, story := range stories {
+		if story.Headline() == "" && story.BodyText() == "" {
+			continue
+		}
+		story.documentID = id
+		story.setSource()
+		for _, sp := range spreads {
+			if sp.places(story) {
+				story.IDMLLinks = append(story.IDMLLinks, sp.links...)
+			}
+		}
+		articles = append(articles, story)
+	}
+	if len(articles) == 0 {
+		return nil, fmt.Errorf("no stories with a headline or body text in %s", path)
+	}
+	return articles, nil
+}
+
+// readStyles adds the styles defined in r to sheet.
+func readStyles(r io.Reader, sheet *styles.Sheet) error {
+	decoder := xml.NewDecoder(r)
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if se, ok := t.(xml.StartElement); ok && styles.IsRoot(se.Name.Local) {
+			if err := sheet.Decode(decoder, &se); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// spread is what a spread in an IDML package says about the stories laid
+// out on it: which stories its text frames hold, and the photos on it.
+type spread struct {
+	stories map[string]bool
+	links   []IDMLLink
+}
+
+func readSpread(r io.Reader) (*spread, error) {
+	sp := &spread{stories: map[string]bool{}}
+	decoder := xml.NewDecoder(r)
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			return sp, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		se, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		for _, attr := range se.Attr {
+			switch {
+			case se.Name.Local == "TextFrame" && attr.Name.Local == "ParentStory":
+				sp.stories[attr.Value] = true
+			case se.Name.Local == "Link" && attr.Name.Local == "LinkResourceURI":
+				sp.links = append(sp.links, IDMLLink{ResourceURI: attr.Value})
+			}
+		}
+	}
+}
+
+// places reports whether a text frame on sp holds story.
+func (sp *spread) places(story *Story) bool {
+	for _, s := range story.IDMLStories {
+		if sp.stories[s.Self] {
+			return true
+		}
+	}
+	return false
+}
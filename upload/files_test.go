@@ -0,0 +1,140 @@
+package upload
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// writePackage writes an IDML package with the given files in it.
+func writePackage(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := zip.NewWriter(f)
+	for _, name := range names {
+		fw, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(files[name]))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// packageStory is a file under Stories/ with one story, whose paragraphs
+// are each an applied paragraph style and its text.
+func packageStory(self string, paragraphs ...string) string {
+	s := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<idPkg:Story xmlns:idPkg="` + packaging + `" DOMVersion="13.0"><Story Self="` + self + `">`
+	for i := 0; i+1 < len(paragraphs); i += 2 {
+		s += `<ParagraphStyleRange AppliedParagraphStyle="ParagraphStyle/` + paragraphs[i] + `">` +
+			`<CharacterStyleRange AppliedCharacterStyle="CharacterStyle/$ID/[No character style]">` +
+			`<Content>` + paragraphs[i+1] + `</Content></CharacterStyleRange></ParagraphStyleRange>`
+	}
+	return s + `</Story></idPkg:Story>`
+}
+
+func TestOpenStoriesPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "package")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "issue.idml")
+	writePackage(t, path, map[string]string{
+		"designmap.xml": `<?xml version="1.0"?><Document Self="d"/>`,
+		"META-INF/metadata.xml": `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about="" xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/">
+<xmpMM:InstanceID>xmp.iid:B2</xmpMM:InstanceID>
+<xmpMM:DocumentID>xmp.did:A1</xmpMM:DocumentID>
+</rdf:Description></rdf:RDF></x:xmpmeta>`,
+		"Resources/Styles.xml": `<?xml version="1.0"?>
+<idPkg:Styles xmlns:idPkg="` + packaging + `"><RootParagraphStyleGroup Self="u6">
+	<ParagraphStyle Self="ParagraphStyle/Body Text" Name="Body Text" />
+	<ParagraphStyleGroup Self="u9" Name="News">
+		<ParagraphStyle Self="ParagraphStyle/News%3aNews Body" Name="News:News Body">
+			<Properties><BasedOn type="object">ParagraphStyle/Body Text</BasedOn></Properties>
+		</ParagraphStyle>
+	</ParagraphStyleGroup>
+</RootParagraphStyleGroup></idPkg:Styles>`,
+		"Stories/Story_u10.xml": packageStory("u10",
+			"Headline", "Senate passes budget",
+			"News%3aNews Body", "The Senate met Wednesday."),
+		"Stories/Story_u20.xml": packageStory("u20",
+			"Headline", "Robotics team wins",
+			"Body Text", "The team won Saturday."),
+		// a folio, which isn't an article
+		"Stories/Story_u30.xml": packageStory("u30", "Folio", "March 14, 2018"),
+		"Spreads/Spread_u1.xml": `<?xml version="1.0"?>
+<idPkg:Spread xmlns:idPkg="` + packaging + `"><Spread Self="u1">
+	<TextFrame Self="u11" ParentStory="u10" />
+	<TextFrame Self="u31" ParentStory="u30" />
+	<Rectangle Self="u12"><Image Self="u13"><Link Self="u14" LinkResourceURI="file:Photos/senate.jpg" /></Image></Rectangle>
+</Spread></idPkg:Spread>`,
+		"Spreads/Spread_u2.xml": `<?xml version="1.0"?>
+<idPkg:Spread xmlns:idPkg="` + packaging + `"><Spread Self="u2">
+	<Group Self="u21"><TextFrame Self="u22" ParentStory="u20" /></Group>
+	<Rectangle Self="u23"><Image Self="u24"><Link Self="u25" LinkResourceURI="file:Photos/robots.jpg" /></Image></Rectangle>
+</Spread></idPkg:Spread>`,
+	})
+
+	stories, err := OpenStories(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type read struct {
+		label, headline, body string
+		links                 []IDMLLink
+	}
+	got := []read{}
+	for _, s := range stories {
+		got = append(got, read{Label(path, s), s.Headline(), s.BodyText(), s.IDMLLinks})
+	}
+	want := []read{
+		{path + " (Stories/Story_u10.xml)", "Senate passes budget", "The Senate met Wednesday.",
+			[]IDMLLink{{"file:Photos/senate.jpg"}}},
+		{path + " (Stories/Story_u20.xml)", "Robotics team wins", "The team won Saturday.",
+			[]IDMLLink{{"file:Photos/robots.jpg"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v,\nwant %+v", got, want)
+	}
+	if stories[0].Source != "xmp.did:A1/u10" || stories[1].Source != "xmp.did:A1/u20" {
+		t.Errorf("sources are %q and %q", stories[0].Source, stories[1].Source)
+	}
+
+	if _, err := OpenStory(path); err == nil {
+		t.Error("OpenStory read one story from a package of two")
+	}
+}
+
+func TestPreviewPath(t *testing.T) {
+	tests := []struct {
+		path string
+		s    *Story
+		want string
+	}{
+		{"news/senate.idms", NewStory(), "news/senate.preview.html"},
+		{"issue.idml", &Story{Name: "Stories/Story_u10.xml"}, "issue.Story_u10.preview.html"},
+	}
+	for _, test := range tests {
+		if got := PreviewPath(test.path, test.s); got != test.want {
+			t.Errorf("PreviewPath(%q) = %q, want %q", test.path, got, test.want)
+		}
+	}
+}
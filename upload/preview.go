@@ -0,0 +1,169 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thepoly/uploader/validate"
+)
+
+// Media is a file linked from a story, which goes with its post.
+type Media struct {
+	URI  string `json:"uri"`
+	Size int    `json:"size"`
+	// Error is why the file couldn't be found, if it couldn't.
+	Error string `json:"error,omitempty"`
+}
+
+// Media returns the files linked from s. They're only looked up if s has
+// a link resolver.
+func (s *Story) Media() []Media {
+	media := []Media{}
+	for _, link := range s.IDMLLinks {
+		m := Media{URI: link.ResourceURI}
+		if s.Links != nil {
+			data, err := s.Links.Open(link.ResourceURI)
+			if err != nil {
+				m.Error = err.Error()
+			}
+			m.Size = len(data)
+		}
+		media = append(media, m)
+	}
+	return media
+}
+
+// payload returns the JSON body of the request creating s's post.
+func (s *Story) payload() ([]byte, error) {
+	return json.Marshal(s.CreateWPPost())
+}
+
+// PrintDryRun prints the request that would create s's post, and the media
+// that would go with it, without sending anything.
+func (s *Story) PrintDryRun(apiRoot string) error {
+	body, err := s.payload()
+	if err != nil {
+		return err
+	}
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, body, "", "\t"); err != nil {
+		return err
+	}
+	fmt.Printf("POST %s/wp/v2/posts\n%s\n", apiRoot, indented)
+	media := s.Media()
+	if len(media) == 0 {
+		fmt.Println("Media: none")
+		return nil
+	}
+	fmt.Println("Media:")
+	for _, m := range media {
+		switch {
+		case m.Error != "":
+			fmt.Printf("\t%s (%s)\n", m.URI, m.Error)
+		case s.Links == nil:
+			fmt.Printf("\t%s\n", m.URI)
+		default:
+			fmt.Printf("\t%s (%.2f MB)\n", m.URI, float64(m.Size)/1024/1024)
+		}
+	}
+	return nil
+}
+
+// previewTemplate looks like a post on the site, with everything inline so
+// the file can be opened on its own.
+var previewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} – The Polytechnic (preview)</title>
+<style>
+body { margin: 0; background: #fff; color: #222; font: 18px/1.6 Georgia, "Times New Roman", serif; }
+.masthead { border-bottom: 4px solid #b3121b; padding: 12px 24px; font: bold 28px Georgia, serif; }
+.banner { background: #fff3cd; padding: 6px 24px; font: 14px sans-serif; }
+article { max-width: 720px; margin: 32px auto; padding: 0 24px; }
+.kicker { color: #b3121b; font: bold 14px sans-serif; letter-spacing: .08em; text-transform: uppercase; }
+h1 { font-size: 40px; line-height: 1.15; margin: 8px 0 12px; }
+.excerpt { color: #555; font-size: 20px; margin: 0 0 16px; }
+.byline { font: 14px sans-serif; color: #555; border-top: 1px solid #ddd; padding-top: 8px; }
+.byline strong { color: #222; }
+figure { margin: 24px 0; }
+figure img { width: 100%; }
+figcaption { font: 14px/1.4 sans-serif; color: #555; }
+.credit { display: block; text-align: right; font-size: 12px; text-transform: uppercase; }
+blockquote { border-left: 3px solid #b3121b; margin-left: 0; padding-left: 16px; font-style: italic; }
+</style>
+</head>
+<body>
+<div class="masthead">The Polytechnic</div>
+<div class="banner">Preview of post {{.Slug}}, {{.Schedule}}. Nothing has been posted.</div>
+<article>
+{{with .Kicker}}<div class="kicker">{{.}}</div>{{end}}
+<h1>{{.Title}}</h1>
+{{with .Excerpt}}<p class="excerpt">{{.}}</p>{{end}}
+<div class="byline"><strong>{{.AuthorName}}</strong>{{with .AuthorTitle}}, {{.}}{{end}}</div>
+{{if .Photo}}<figure>
+<img src="{{.Photo}}" alt="">
+<figcaption>{{with .PhotoByline}}<span class="credit">{{.}}</span>{{end}}{{.PhotoCaption}}</figcaption>
+</figure>{{end}}
+{{.Content}}
+</article>
+</body>
+</html>
+`))
+
+// WritePreview writes s as a standalone HTML page that looks like its post
+// will on the site.
+func (s *Story) WritePreview(w io.Writer) error {
+	post := s.CreateWPPost()
+	data := map[string]interface{}{
+		// the post's text has already been sanitized
+		"Title":        template.HTML(post.Title),
+		"Kicker":       template.HTML(post.Meta.Kicker),
+		"Excerpt":      template.HTML(post.Excerpt),
+		"AuthorName":   template.HTML(post.Meta.AuthorName),
+		"AuthorTitle":  template.HTML(post.Meta.AuthorTitle),
+		"Content":      template.HTML(post.Content),
+		"PhotoByline":  s.PhotoByline(),
+		"PhotoCaption": template.HTML(validate.Policy(validate.FieldPhotoCaption).Clean(s.PhotoCaption())),
+		"Schedule":     s.Schedule.String(),
+		"Slug":         post.Slug,
+	}
+	if photo, err := s.Photo(); err == nil && len(photo) > 0 {
+		uri := "data:" + http.DetectContentType(photo) + ";base64," + base64.StdEncoding.EncodeToString(photo)
+		data["Photo"] = template.URL(uri)
+	}
+	return previewTemplate.Execute(w, data)
+}
+
+// PreviewPath is where the preview of story s, read from path, is written:
+// next to it, with a .preview.html extension. Stories from a package are
+// told apart by the name of their file in it, like
+// "issue.Story_u1d8.preview.html".
+func PreviewPath(path string, s *Story) string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	if s != nil && s.Name != "" {
+		name := filepath.Base(s.Name)
+		base += "." + strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return base + ".preview.html"
+}
+
+func writePreviewFile(s *Story, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := s.WritePreview(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,181 @@
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/thepoly/uploader/blocks"
+	"github.com/thepoly/uploader/config"
+	"github.com/thepoly/uploader/validate"
+)
+
+// DefaultCorrectionTemplate is the note added to corrected posts when the
+// config doesn't give one.
+const DefaultCorrectionTemplate = `<p><em>Correction, {{.Date}}: {{.Note}}</em></p>`
+
+// Correction returns the note added to the end of a corrected post, made
+// from tmpl, an HTML template given the note as .Note and today's date as
+// .Date. An empty tmpl uses DefaultCorrectionTemplate.
+func Correction(tmpl, note string, now time.Time) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultCorrectionTemplate
+	}
+	t, err := template.New("correction").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	out := &bytes.Buffer{}
+	err = t.Execute(out, map[string]string{
+		"Note": note,
+		"Date": apDate(now),
+	})
+	return out.String(), err
+}
+
+// apDate formats a date the AP way, like "Sept. 5, 2018".
+func apDate(t time.Time) string {
+	months := map[time.Month]string{
+		time.January: "Jan.", time.February: "Feb.", time.August: "Aug.",
+		time.September: "Sept.", time.October: "Oct.", time.November: "Nov.",
+		time.December: "Dec.",
+	}
+	month, ok := months[t.Month()]
+	if !ok {
+		month = t.Month().String()
+	}
+	return t.Format(month + " 2, 2006")
+}
+
+// PostChanges compares a live post with the one a story would make now,
+// field by field. Only fields that differ are returned.
+func PostChanges(live *WPPostReturned, post WPPost) []validate.Change {
+	fields := []struct {
+		name       string
+		live, next string
+	}{
+		{"title", live.Title.Raw, post.Title},
+		{"kicker", live.Meta.Kicker, post.Meta.Kicker},
+		{"authorName", live.Meta.AuthorName, post.Meta.AuthorName},
+		{"authorTitle", live.Meta.AuthorTitle, post.Meta.AuthorTitle},
+		{"content", live.Content.Raw, post.Content},
+		{"excerpt", live.Excerpt.Raw, post.Excerpt},
+	}
+	changes := []validate.Change{}
+	for _, f := range fields {
+		if f.live == f.next {
+			continue
+		}
+		changes = append(changes, validate.Change{
+			Field:  f.name,
+			Before: f.live,
+			After:  f.next,
+			Diff:   validate.Diff(f.live, f.next),
+		})
+	}
+	return changes
+}
+
+// UpdatedPost returns post changed to update live with. Its status and date
+// are left out so a published post stays published, and its slug so links
+// to it keep working. The live post's corrections are kept at the end,
+// followed by the new correction note if there is one, as blocks if the
+// rest of the post is.
+func UpdatedPost(live *WPPostReturned, post WPPost, correction string) WPPost {
+	post.Status = ""
+	post.DateGMT = ""
+	post.Slug = ""
+	post.Meta.Corrections = live.Meta.Corrections + correction
+	if post.Meta.Corrections != "" {
+		if blocks.IsSerialized(post.Content) {
+			post.Content += "\n\n" + blocks.Serialize(post.Meta.Corrections)
+		} else {
+			post.Content += post.Meta.Corrections
+		}
+	}
+	return post
+}
+
+// UpdateOptions change what UpdatePost does.
+type UpdateOptions struct {
+	// Correction is a note saying what was corrected, added to the end of
+	// the post with the configured template.
+	Correction string
+	// Yes applies the changes without asking.
+	Yes bool
+}
+
+// UpdatePost finds the post made from the story in path, shows how the story
+// has changed since it was posted, and updates the post.
+func UpdatePost(cfg *config.Config, path string, opts UpdateOptions) error {
+	validator, err := validate.New(cfg.Validation)
+	if err != nil {
+		return err
+	}
+	story, err := OpenStory(path)
+	if err != nil {
+		return err
+	}
+	story.Format = cfg.ContentFormat
+	story.Staff = validator.Staff()
+	story.Kickers = validator.Kickers()
+
+	vs := story.ValidationStory()
+	findings := validator.Validate(vs)
+	PrintFindings(vs, findings)
+	if validate.Blocking(findings) {
+		return errors.New("not updating the post with a story that has validation errors")
+	}
+	clean, removed := validate.Sanitize(vs)
+	story.ApplyFixes(clean)
+	PrintRemovals(removed)
+	fmt.Println()
+
+	client, err := NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	live, err := FindPost(client, story.Source, story.Fingerprint())
+	if err != nil {
+		return err
+	}
+	if live == nil {
+		return fmt.Errorf("no post was made from %s; upload it instead", path)
+	}
+	color.Cyan("Post %d: %s", live.ID, live.Link)
+
+	correction := ""
+	if opts.Correction != "" {
+		if correction, err = Correction(cfg.CorrectionTemplate, opts.Correction, time.Now()); err != nil {
+			return fmt.Errorf("bad correction template: %v", err)
+		}
+	}
+	post := UpdatedPost(live, story.CreateWPPost(), correction)
+	changes := PostChanges(live, post)
+	if len(changes) == 0 {
+		color.Green("The post is up to date.")
+		return nil
+	}
+	PrintChanges(changes)
+	fmt.Println()
+
+	if !opts.Yes {
+		if !interactive() {
+			return errors.New("not updating without asking; use --yes")
+		}
+		if !confirm("Apply these changes?") {
+			color.Red("Aborting.")
+			return nil
+		}
+	}
+	link, err := SendPost(client, live.ID, post)
+	if err != nil {
+		return err
+	}
+	color.Green("Updated %s ✓", link)
+	return nil
+}
@@ -1,38 +1,46 @@
 package upload
 
 import (
-	"bytes"
-	"context"
-	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"net/url"
-	"os"
-	"os/user"
-	"path/filepath"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/fatih/color"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
-	"google.golang.org/api/drive/v3"
+
+	"github.com/thepoly/uploader/blocks"
+	"github.com/thepoly/uploader/gdrive"
+	"github.com/thepoly/uploader/kicker"
+	"github.com/thepoly/uploader/links"
+	"github.com/thepoly/uploader/sanitize"
+	"github.com/thepoly/uploader/schedule"
+	"github.com/thepoly/uploader/seo"
+	"github.com/thepoly/uploader/staff"
+	"github.com/thepoly/uploader/styles"
+	"github.com/thepoly/uploader/validate"
 )
 
-const APIRoot = "https://poly.rpi.edu/wp-json"
+// WPRendered is a field of a returned post. Raw is only sent in the edit
+// context.
+type WPRendered struct {
+	Raw      string `json:"raw"`
+	Rendered string `json:"rendered"`
+}
 
 type WPPostReturned struct {
-	Title struct {
-		Rendered string `json:"rendered"`
-	} `json:"title"`
-	Meta struct {
-		AuthorName string
-		Kicker     string
+	ID      int        `json:"id"`
+	Title   WPRendered `json:"title"`
+	Content WPRendered `json:"content"`
+	Excerpt WPRendered `json:"excerpt"`
+	Slug    string     `json:"slug"`
+	Meta    struct {
+		AuthorName  string
+		AuthorTitle string
+		Kicker      string
+		Fingerprint string
+		SourceID    string
+		Corrections string
 	} `json:"meta"`
 	Link string
 }
@@ -41,17 +49,36 @@ type WPPost struct {
 	Title   string     `json:"title"`
 	Content string     `json:"content"`
 	Meta    WPPostMeta `json:"meta"`
-	Status  string     `json:"status"`
-	Date    time.Time  `json:"date"`
+	Status  string     `json:"status,omitempty"`
+	// DateGMT is when the post is published, in UTC, since WordPress reads
+	// dates without an offset in the site's time zone.
+	DateGMT string `json:"date_gmt,omitempty"`
+	// Author is the WordPress user of the first byline.
+	Author     int    `json:"author,omitempty"`
+	Categories []int  `json:"categories,omitempty"`
+	Tags       []int  `json:"tags,omitempty"`
+	Slug       string `json:"slug,omitempty"`
+	Excerpt    string `json:"excerpt,omitempty"`
 }
 
 type WPPostMeta struct {
 	AuthorName  string `json:"AuthorName"`
 	AuthorTitle string `json:"AuthorTitle"`
 	Kicker      string `json:"Kicker"`
+	// AuthorIDs are the WordPress users of every byline, since a post only
+	// has one author.
+	AuthorIDs []int `json:"AuthorIDs,omitempty"`
+	// Fingerprint and SourceID identify the story the post was made from,
+	// to find it again.
+	Fingerprint string `json:"Fingerprint,omitempty"`
+	SourceID    string `json:"SourceID,omitempty"`
+	// Corrections are the correction notes at the end of the post, so
+	// they're kept when it's updated again.
+	Corrections string `json:"Corrections,omitempty"`
 }
 
 type IDMLStory struct {
+	Self                     string                    `xml:",attr"`
 	IDMLParagraphStyleRanges []IDMLParagraphStyleRange `xml:"ParagraphStyleRange"`
 }
 
@@ -61,12 +88,38 @@ type IDMLParagraphStyleRange struct {
 }
 
 type IDMLCharacterStyleRange struct {
-	Content []string
+	Content               []string
+	AppliedCharacterStyle string `xml:",attr"`
+	FontStyle             string `xml:",attr"`
 }
 
 type Story struct {
 	IDMLStories []IDMLStory
 	IDMLLinks   []IDMLLink
+	// Styles are the paragraph and character styles the story's text is
+	// matched by.
+	Styles *styles.Sheet
+	// Links resolves linked photos. Photos aren't loaded if it's nil.
+	Links *links.Resolver
+	// Staff finds the WordPress users of bylines. Posts are left to the
+	// default author if it's nil.
+	Staff *staff.Directory
+	// Kickers gives the WordPress categories and tags for the kicker.
+	// Posts are left uncategorized if it's nil.
+	Kickers *kicker.Taxonomy
+	// Source identifies the story across edits; see setSource. It's empty
+	// for stories in files without a document ID.
+	Source string
+	// Name is where the story is in its IDML package, like
+	// "Stories/Story_u1d8.xml", or "" if it's the only story in its file.
+	Name string
+	// Schedule is when the post goes up. Posts without one are drafts.
+	Schedule schedule.Schedule
+	// Format is the format of the post's content; see package blocks.
+	Format string
+	// documentID is the XMP document ID of the file the story is in, if
+	// it has one.
+	documentID string
 	// cache for caching results of expensive method calls
 	m     sync.Mutex
 	cache map[string]interface{}
@@ -91,17 +144,33 @@ func (s *Story) cacheSet(key string, val interface{}) {
 
 func (s *Story) CreateWPPost() WPPost {
 	wpPost := WPPost{}
-	wpPost.Title = s.Headline()
-	wpPost.Status = "future"
-
-	now := time.Now()
-	date := time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, now.Location())
-	wpPost.Date = date
-
-	wpPost.Meta.AuthorName = s.AuthorName()
-	wpPost.Meta.AuthorTitle = s.AuthorTitle()
-	wpPost.Meta.Kicker = s.Kicker()
-	wpPost.Content = s.BodyText()
+	wpPost.Title = validate.Policy(validate.FieldHeadline).Clean(s.Headline())
+	// stories that haven't been scheduled are left for an editor to publish
+	wpPost.Status = schedule.Draft
+	if s.Schedule.Status != "" {
+		wpPost.Status = s.Schedule.Status
+	}
+	wpPost.DateGMT = s.Schedule.GMT()
+
+	wpPost.Meta.AuthorName = validate.Policy(validate.FieldAuthorName).Clean(s.AuthorName())
+	wpPost.Meta.AuthorTitle = validate.Policy(validate.FieldAuthorTitle).Clean(s.AuthorTitle())
+	wpPost.Meta.Kicker = validate.Policy(validate.FieldKicker).Clean(s.Kicker())
+	wpPost.Content = blocks.Convert(validate.Policy(validate.FieldBodyText).Clean(s.BodyText()), s.Format)
+	wpPost.Meta.Fingerprint = s.Fingerprint()
+	wpPost.Meta.SourceID = s.Source
+	wpPost.Slug = s.Slug()
+	wpPost.Excerpt = validate.Policy(validate.FieldExcerpt).Clean(s.Excerpt())
+	if s.Staff != nil {
+		ids := s.Staff.WPUserIDs(s.AuthorName())
+		if len(ids) > 0 {
+			wpPost.Author = ids[0]
+			wpPost.Meta.AuthorIDs = ids
+		}
+	}
+	if k := s.Kickers.Find(s.Kicker()); k != nil {
+		wpPost.Categories = k.Categories
+		wpPost.Tags = k.Tags
+	}
 	return wpPost
 }
 
@@ -112,7 +181,7 @@ func (s *Story) AuthorName() string {
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Author" {
+			if s.Styles.Is(style, "ParagraphStyle/Author") {
 				res := paragraph.IDMLCharacterStyleRanges[0].Content[0]
 				s.cacheSet("AuthorName", res)
 				return res
@@ -129,24 +198,39 @@ func (s *Story) AuthorTitle() string {
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Author Job" {
-				res := paragraph.IDMLCharacterStyleRanges[0].Content[0]
-				s.cacheSet("AuthorTitle", res)
-				return res
+			if s.Styles.Is(style, "ParagraphStyle/Author Job") {
+				authorTitle := ""
+				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
+					// the author title line is italicized by default, so
+					// text that isn't is what's emphasized
+					emphasized := s.Styles.Emphasized(style, characterRange.AppliedCharacterStyle,
+						characterRange.FontStyle, true)
+					if emphasized {
+						authorTitle += "<i>"
+					}
+					for _, content := range characterRange.Content {
+						authorTitle += content
+					}
+					if emphasized {
+						authorTitle += "</i>"
+					}
+				}
+				s.cacheSet("AuthorTitle", authorTitle)
+				return authorTitle
 			}
 		}
 	}
 	return ""
 }
 
-func (s Story) Kicker() string {
+func (s *Story) Kicker() string {
 	if val, ok := s.cacheGet("Kicker"); ok {
 		return val.(string)
 	}
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Kicker" {
+			if s.Styles.Is(style, "ParagraphStyle/Kicker") {
 				res := paragraph.IDMLCharacterStyleRanges[0].Content[0]
 				s.cacheSet("Kicker", res)
 				return res
@@ -156,45 +240,63 @@ func (s Story) Kicker() string {
 	return ""
 }
 
-func (s Story) BodyText() string {
-	if val, ok := s.cache["BodyText"]; ok {
+// BodyText is the text of every paragraph in a body text style, or one
+// based on it, like a drop cap style for the first paragraph. Italicized
+// text is marked with <em>.
+func (s *Story) BodyText() string {
+	if val, ok := s.cacheGet("BodyText"); ok {
 		return val.(string)
 	}
+	paragraphs := []string{}
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Body Text" {
-				bodyText := ""
-				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
-					for _, content := range characterRange.Content {
-						for _, char := range content {
-							if char == '\t' {
-								bodyText += "\n\n"
-							} else {
-								bodyText += string(char)
-							}
+			if !s.Styles.Is(style, "ParagraphStyle/Body Text") {
+				continue
+			}
+			bodyText := ""
+			for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
+				emphasized := s.Styles.Emphasized(style, characterRange.AppliedCharacterStyle,
+					characterRange.FontStyle, false)
+				if emphasized {
+					bodyText += "<em>"
+				}
+				for _, content := range characterRange.Content {
+					for _, char := range content {
+						if char == '\t' {
+							bodyText += "\n\n"
+						} else {
+							bodyText += string(char)
 						}
 					}
 				}
-				s.cache["BodyText"] = bodyText
-				return bodyText
+				if emphasized {
+					bodyText += "</em>"
+				}
 			}
+			paragraphs = append(paragraphs, bodyText)
 		}
 	}
-	return ""
+	bodyText := strings.Join(paragraphs, "\n\n")
+	s.cacheSet("BodyText", bodyText)
+	return bodyText
 }
 
-func (s Story) Headline() string {
+func (s *Story) Headline() string {
+	if val, ok := s.cacheGet("Headline"); ok {
+		return val.(string)
+	}
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if strings.Contains(style, "Headline") {
+			if s.Styles.Is(style, "ParagraphStyle/Headline") || strings.Contains(style, "Headline") {
 				headline := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
 						headline += content
 					}
 				}
+				s.cacheSet("Headline", headline)
 				return headline
 			}
 		}
@@ -202,17 +304,21 @@ func (s Story) Headline() string {
 	return ""
 }
 
-func (s Story) PhotoByline() string {
+func (s *Story) PhotoByline() string {
+	if val, ok := s.cacheGet("PhotoByline"); ok {
+		return val.(string)
+	}
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Photo Byline" {
+			if s.Styles.Is(style, "ParagraphStyle/Photo Byline") {
 				photoByline := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
 						photoByline += content
 					}
 				}
+				s.cacheSet("PhotoByline", photoByline)
 				return photoByline
 			}
 		}
@@ -220,17 +326,21 @@ func (s Story) PhotoByline() string {
 	return ""
 }
 
-func (s Story) PhotoCaption() string {
+func (s *Story) PhotoCaption() string {
+	if val, ok := s.cacheGet("PhotoCaption"); ok {
+		return val.(string)
+	}
 	for _, story := range s.IDMLStories {
 		for _, paragraph := range story.IDMLParagraphStyleRanges {
 			style := paragraph.AppliedParagraphStyle
-			if style == "ParagraphStyle/Caption" {
+			if s.Styles.Is(style, "ParagraphStyle/Caption") {
 				caption := ""
 				for _, characterRange := range paragraph.IDMLCharacterStyleRanges {
 					for _, content := range characterRange.Content {
 						caption += content
 					}
 				}
+				s.cacheSet("PhotoCaption", caption)
 				return caption
 			}
 		}
@@ -238,321 +348,228 @@ func (s Story) PhotoCaption() string {
 	return ""
 }
 
-// getClient uses a Context and Config to retrieve a Token
-// then generate a Client. It returns the generated Client.
-func getClient(ctx context.Context, config *oauth2.Config) *http.Client {
-	cacheFile, err := tokenCacheFile()
-	if err != nil {
-		log.Fatalf("Unable to get path to cached credential file. %v", err)
-	}
-	tok, err := tokenFromFile(cacheFile)
-	if err != nil {
-		tok = getTokenFromWeb(config)
-		saveToken(cacheFile, tok)
+// Slug is the post's slug, made from the headline unless it's been set.
+func (s *Story) Slug() string {
+	if val, ok := s.cacheGet("Slug"); ok {
+		return val.(string)
 	}
-	return config.Client(ctx, tok)
+	slug := seo.Slug(s.Headline())
+	s.cacheSet("Slug", slug)
+	return slug
 }
 
-// getTokenFromWeb uses Config to request a Token.
-// It returns the retrieved Token.
-func getTokenFromWeb(config *oauth2.Config) *oauth2.Token {
-	authURL := config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
-	fmt.Printf("Go to the following link in your browser then type the "+
-		"authorization code: \n%v\n", authURL)
-
-	var code string
-	if _, err := fmt.Scan(&code); err != nil {
-		log.Fatalf("Unable to read authorization code %v", err)
-	}
-
-	tok, err := config.Exchange(oauth2.NoContext, code)
-	if err != nil {
-		log.Fatalf("Unable to retrieve token from web %v", err)
+// Excerpt is the post's excerpt, made from the body text unless it's been
+// set, since snippets don't have subdecks.
+func (s *Story) Excerpt() string {
+	if val, ok := s.cacheGet("Excerpt"); ok {
+		return val.(string)
 	}
-	return tok
+	excerpt := seo.Excerpt("", s.BodyText())
+	s.cacheSet("Excerpt", excerpt)
+	return excerpt
 }
 
-// tokenCacheFile generates credential file path/filename.
-// It returns the generated credential path/filename.
-func tokenCacheFile() (string, error) {
-	usr, err := user.Current()
-	if err != nil {
-		return "", err
+// Photo returns the first photo linked from the snippet, or nil if the
+// snippet doesn't link any. The result is cached whether or not it's found.
+func (s *Story) Photo() ([]byte, error) {
+	if val, ok := s.cacheGet("Photo"); ok {
+		res := val.(photoResult)
+		return res.data, res.err
 	}
-	tokenCacheDir := filepath.Join(usr.HomeDir, ".credentials")
-	os.MkdirAll(tokenCacheDir, 0700)
-	return filepath.Join(tokenCacheDir,
-		url.QueryEscape("drive-go-quickstart.json")), err
-}
 
-// tokenFromFile retrieves a Token from a given file path.
-// It returns the retrieved Token and any read error encountered.
-func tokenFromFile(file string) (*oauth2.Token, error) {
-	f, err := os.Open(file)
-	if err != nil {
-		return nil, err
+	if len(s.IDMLLinks) == 0 || s.Links == nil {
+		return nil, nil
 	}
-	t := &oauth2.Token{}
-	err = json.NewDecoder(f).Decode(t)
-	defer f.Close()
-	return t, err
-}
+	// this only grabs the first one...
+	uri := s.IDMLLinks[0].ResourceURI
 
-// saveToken uses a file path to create a file and store the
-// token in it.
-func saveToken(file string, token *oauth2.Token) {
-	fmt.Printf("Saving credential file to: %s\n", file)
-	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		log.Fatalf("Unable to cache oauth token: %v", err)
-	}
-	defer f.Close()
-	json.NewEncoder(f).Encode(token)
+	data, err := s.Links.Open(uri)
+	s.cacheSet("Photo", photoResult{data, err})
+	return data, err
 }
 
-func (s Story) Photo() []byte {
-	if val, ok := s.cache["Photo"]; ok {
-		return val.([]byte)
-	}
+type photoResult struct {
+	data []byte
+	err  error
+}
 
-	if len(s.IDMLLinks) == 0 {
-		return []byte("")
+// NewResolver builds a link resolver from link root mappings (see
+// links.ParseMapping) that looks things up on Drive through client.
+func NewResolver(client *gdrive.Client, linkRoots []string) (*links.Resolver, error) {
+	resolver := links.NewResolver()
+	for _, spec := range linkRoots {
+		mapping, err := links.ParseMapping(spec, client)
+		if err != nil {
+			return nil, err
+		}
+		resolver.Add(mapping.Prefix, mapping.Root)
 	}
-	// this only grabs the first one...
-	uri := s.IDMLLinks[0].ResourceURI
-
-	ctx := context.Background()
+	return resolver, nil
+}
 
-	b, err := ioutil.ReadFile("client_secret.json")
+// ValidationStory returns s in the form validation rules check, loading
+// the photo if there is one.
+func (s *Story) ValidationStory() *validate.Story {
+	vs := &validate.Story{
+		Kicker:       s.Kicker(),
+		Headline:     s.Headline(),
+		AuthorName:   s.AuthorName(),
+		AuthorTitle:  s.AuthorTitle(),
+		BodyText:     s.BodyText(),
+		PhotoByline:  s.PhotoByline(),
+		PhotoCaption: s.PhotoCaption(),
+		Photo:        validate.PhotoNone,
+		Slug:         s.Slug(),
+		Excerpt:      s.Excerpt(),
+	}
+	if s.Links == nil {
+		vs.Photo = validate.PhotoUnknown
+		return vs
+	}
+	photo, err := s.Photo()
 	if err != nil {
-		log.Fatalf("Unable to read client secret file: %v", err)
+		vs.Photo = validate.PhotoMissing
+		vs.PhotoError = err.Error()
+	} else if len(photo) > 0 {
+		vs.Photo = validate.PhotoFound
 	}
+	return vs
+}
 
-	// If modifying these scopes, delete your previously saved credentials
-	// at ~/.credentials/drive-go-quickstart.json
-	config, err := google.ConfigFromJSON(b, drive.DriveReadonlyScope)
-	if err != nil {
-		log.Fatalf("Unable to parse client secret file to config: %v", err)
-	}
-	client := getClient(ctx, config)
+// ApplyFixes replaces the text of s with the text of fixed, as returned by
+// validate.Validator.Fix.
+func (s *Story) ApplyFixes(fixed *validate.Story) {
+	s.cacheSet("Kicker", fixed.Kicker)
+	s.cacheSet("Headline", fixed.Headline)
+	s.cacheSet("AuthorName", fixed.AuthorName)
+	s.cacheSet("AuthorTitle", fixed.AuthorTitle)
+	s.cacheSet("BodyText", fixed.BodyText)
+	s.cacheSet("PhotoByline", fixed.PhotoByline)
+	s.cacheSet("PhotoCaption", fixed.PhotoCaption)
+	s.cacheSet("Excerpt", fixed.Excerpt)
+}
 
-	srv, err := drive.New(client)
-	if err != nil {
-		log.Fatalf("Unable to retrieve drive Client %v", err)
+// PrintChanges prints the changes made by fixing a story as colored diffs.
+func PrintChanges(changes []validate.Change) {
+	if len(changes) == 0 {
+		fmt.Println("Nothing to fix.")
+		return
 	}
-	unescaped, err := url.PathUnescape(uri)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	toFind := "/Team Drives/The Polytechnic/"
-	idx := strings.Index(unescaped, toFind)
-	path := unescaped[idx+len(toFind):]
-	parent := "0ACukZyn2MrvEUk9PVA"
-	nextLevel := path[:strings.Index(path, "/")]
-	filename := ""
-
-	done := false
-	for !done {
-		var q string
-		if nextLevel != "" {
-			q = fmt.Sprintf("mimeType = 'application/vnd.google-apps.folder' and '%s' in parents", parent)
-			// log.Println("Looking for", nextLevel)
+	removed := color.New(color.FgRed, color.CrossedOut)
+	added := color.New(color.FgGreen)
+	for _, change := range changes {
+		if len(change.Rules) > 0 {
+			color.Cyan("%s (%s)", change.Field, strings.Join(change.Rules, ", "))
 		} else {
-			idx := strings.Index(path, "/")
-			filename = path[idx+1:]
-			q = fmt.Sprintf("name = '%s' and '%s' in parents", filename, parent)
-			// log.Println("Looking for", filename)
+			color.Cyan(change.Field)
 		}
-
-		r, err := srv.Files.List().PageSize(10).Q(q).
-			Fields("nextPageToken, files(id, name)").
-			SupportsTeamDrives(true).IncludeTeamDriveItems(true).
-			TeamDriveId("0ACukZyn2MrvEUk9PVA").Corpora("teamDrive").Do()
-		if err != nil {
-			log.Fatalf("Unable to retrieve files: %v", err)
-		}
-		if len(r.Files) > 0 {
-			found := false
-			for _, i := range r.Files {
-				// fmt.Printf("%s (%s)\n", i.Name, i.Id)
-				if i.Name == nextLevel {
-					found = true
-					idx := strings.Index(path, "/")
-					path = path[idx+1:]
-					idx = strings.Index(path, "/")
-					if idx == -1 {
-						nextLevel = ""
-						parent = i.Id
-						break
-					}
-					nextLevel = path[:idx]
-					parent = i.Id
-					break
-				} else if i.Name == filename {
-					// Download the file
-					resp, err := srv.Files.Get(i.Id).Download()
-					if err != nil {
-						log.Fatal(err)
-					}
-					data, err := ioutil.ReadAll(resp.Body)
-					if err != nil {
-						log.Fatal(err)
-					}
-					s.cache["Photo"] = data
-					return data
-					// found = true
-					// done = true
-					// break
-				}
+		fmt.Print("\t")
+		for _, e := range change.Diff {
+			switch e.Op {
+			case "-":
+				removed.Print(e.Text)
+			case "+":
+				added.Print(e.Text)
+			default:
+				fmt.Print(e.Text)
 			}
-			if !found {
-				done = true
-			}
-		} else {
-			fmt.Println("No files found.")
 		}
+		fmt.Println()
 	}
-
-	return []byte("")
 }
 
-// Validate checks some things that should be consistent in all articles, e.g.
-// has a headline and author, no double spaces, there's a photo if there's a caption, etc.
-// Errors found here are usually the result of making an improper snippet in InDesign.
-// Any failure here will prevent the article from being posted to the website.
-// We may want to add a command line flag in the future to ignore validation errors.
-func (s Story) Validate() []string {
-	validationErrors := []string{}
-
-	headline := s.Headline()
-	if headline == "" {
-		validationErrors = append(validationErrors, "No headline.")
-	}
-	if strings.Contains(headline, "  ") {
-		validationErrors = append(validationErrors, "Headline contains two consecutive spaces.")
-	}
-
-	authorName := s.AuthorName()
-	if authorName == "" {
-		validationErrors = append(validationErrors, "No author name.")
-	}
-	if strings.Contains(authorName, "  ") {
-		validationErrors = append(validationErrors, "Author name contains two consecutive spaces.")
-	}
-
-	kicker := s.Kicker()
-	if kicker == "" {
-		validationErrors = append(validationErrors, "No kicker.")
-	}
-	if strings.Contains(kicker, "  ") {
-		validationErrors = append(validationErrors, "Kicker contains two consecutive spaces.")
-	}
-
-	authorTitle := s.AuthorTitle()
-	if authorTitle == "" {
-		validationErrors = append(validationErrors, "No author title.")
-	}
-	if strings.Contains(authorTitle, "  ") {
-		validationErrors = append(validationErrors, "Author title contains two consecutive spaces.")
-	}
-
-	bodyText := s.BodyText()
-	if bodyText == "" {
-		validationErrors = append(validationErrors, "No body text.")
-	} else if len(bodyText) < 100 {
-		msg := fmt.Sprintf("Body text extremely short (%d characters).", len(bodyText))
-		validationErrors = append(validationErrors, msg)
+// PrintFindings prints validation findings on vs, colored by severity. Each
+// is followed by the text it's about with carets under the problem.
+func PrintFindings(vs *validate.Story, findings []validate.Finding) {
+	worst, ok := validate.Worst(findings)
+	switch {
+	case !ok:
+		color.Green("✓ Validation succeeded.")
+	case worst >= validate.Error:
+		color.Red("Validation errors.")
+	default:
+		color.Yellow("Validation warnings.")
+	}
+	for _, f := range findings {
+		severityColor(f.Severity).Printf("\t● %s: %s\n", f.Severity, f.Message)
+		if f.End > f.Start {
+			excerpt, carets := f.Excerpt(vs.Field(f.Field), 70)
+			fmt.Printf("\t  %s\n", excerpt)
+			severityColor(f.Severity).Printf("\t  %s\n", carets)
+		}
+		if len(f.Suggestions) > 0 {
+			fmt.Printf("\t  Did you mean: %s?\n", strings.Join(f.Suggestions, ", "))
+		}
 	}
+}
 
-	photo := s.Photo()
-	photoByline := s.PhotoByline()
-	photoCaption := s.PhotoCaption()
-	if photoByline != "" && len(photo) == 0 {
-		validationErrors = append(validationErrors, "Photo byline without photo.")
-	}
-	if photoCaption != "" && len(photo) == 0 {
-		validationErrors = append(validationErrors, "Photo caption without photo.")
+// PrintRemovals prints the HTML removed from each field by validate.Sanitize.
+func PrintRemovals(removed map[string][]sanitize.Removal) {
+	for _, field := range validate.TextFields {
+		if removals, ok := removed[field]; ok {
+			color.Yellow("Removed from %s: %s", field, sanitize.Summary(removals))
+		}
 	}
+}
 
-	if strings.Contains(photoByline, "  ") {
-		validationErrors = append(validationErrors, "Photo byline contains two consecutive spaces.")
-	}
-	if strings.Contains(photoCaption, "  ") {
-		validationErrors = append(validationErrors, "Photo caption contains two consecutive spaces.")
+func severityColor(s validate.Severity) *color.Color {
+	switch s {
+	case validate.Error:
+		return color.New(color.FgRed)
+	case validate.Warning:
+		return color.New(color.FgYellow)
 	}
-
-	return validationErrors
+	return color.New(color.FgCyan)
 }
 
-func (s Story) Print() {
+func (s *Story) Print() {
 	fmt.Printf("%15s\n", "Story")
 	fmt.Printf("-------------------------\n")
 	fmt.Printf("%13s: %s\n", "Kicker", s.Kicker())
 	fmt.Printf("%13s: %s\n", "Headline", s.Headline())
 	fmt.Printf("%13s: %s\n", "Author name", s.AuthorName())
 	fmt.Printf("%13s: %s\n", "Author title", s.AuthorTitle())
-	photo := s.Photo()
-	if len(photo) > 0 {
-		fmt.Printf("%13s: %.2f MB\n", "Photo", float64(len(s.Photo()))/1024/1024)
+	photo, err := s.Photo()
+	if err != nil {
+		fmt.Printf("%13s: %s\n", "Photo", err)
+	} else if len(photo) > 0 {
+		fmt.Printf("%13s: %.2f MB\n", "Photo", float64(len(photo))/1024/1024)
 	} else {
 		fmt.Printf("%13s:\n", "Photo")
 	}
 	fmt.Printf("%13s: %s\n", "Photo byline", s.PhotoByline())
 	fmt.Printf("%13s: %.80s...\n", "Photo caption", s.PhotoCaption())
 	fmt.Printf("%13s: %.80s...\n", "Body text", s.BodyText())
+	fmt.Printf("%13s: %s\n", "Slug", s.Slug())
+	fmt.Printf("%13s: %s\n", "Excerpt", s.Excerpt())
+	fmt.Printf("%13s: %s\n", "Status", s.Schedule)
 }
 
 // func (s *Story) MarshalJSON ([]byte, error) {
 //     storyJSON := bytes.NewBufferString("{")
 // }
 
-func NewStory() Story {
-	return Story{
+func NewStory() *Story {
+	return &Story{
 		IDMLStories: []IDMLStory{},
 		IDMLLinks:   []IDMLLink{},
+		Styles:      styles.New(),
 		cache:       make(map[string]interface{}),
 	}
 }
 
-func NewStoryFromFile(f io.Reader) Story {
+func NewStoryFromFile(f io.Reader) *Story {
 	story := NewStory()
-	decoder := xml.NewDecoder(f)
-	for {
-		t, _ := decoder.Token()
-		if t == nil {
-			break
-		}
-		switch se := t.(type) {
-		case xml.StartElement:
-			switch se.Name.Local {
-			case "Story":
-				idmlStory := IDMLStory{}
-				decoder.DecodeElement(&idmlStory, &se)
-				story.IDMLStories = append(story.IDMLStories, idmlStory)
-			case "Link":
-				idmlLink := IDMLLink{}
-				decoder.DecodeElement(&idmlLink, &se)
-				story.IDMLLinks = append(story.IDMLLinks, idmlLink)
-			}
-		}
-	}
+	story.parse(f)
 	return story
 }
 
-func ParseAndUpload(apiPassword, snippetPath string) {
-	c := color.New(color.FgCyan)
-	c.Printf("Reading \"%s\"...", snippetPath)
-	file, err := os.Open(snippetPath)
-	if err != nil {
-		fmt.Println()
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-
-	story := NewStory()
-	decoder := xml.NewDecoder(file)
+// parse adds the stories, links and styles in the XML read from f to s.
+// Snippets and InCopy files are both read this way: they wrap their Story
+// elements differently, but the stories are the same, and both define their
+// styles inline.
+func (s *Story) parse(f io.Reader) {
+	decoder := xml.NewDecoder(f)
 	for {
 		t, _ := decoder.Token()
 		if t == nil {
@@ -560,115 +577,33 @@ func ParseAndUpload(apiPassword, snippetPath string) {
 		}
 		switch se := t.(type) {
 		case xml.StartElement:
+			// stories in IDML packages are wrapped in an idPkg:Story element,
+			// which is passed over for the Story in it
+			if se.Name.Space == packaging {
+				continue
+			}
 			switch se.Name.Local {
 			case "Story":
 				idmlStory := IDMLStory{}
 				decoder.DecodeElement(&idmlStory, &se)
-				story.IDMLStories = append(story.IDMLStories, idmlStory)
+				s.IDMLStories = append(s.IDMLStories, idmlStory)
 			case "Link":
 				idmlLink := IDMLLink{}
 				decoder.DecodeElement(&idmlLink, &se)
-				story.IDMLLinks = append(story.IDMLLinks, idmlLink)
+				s.IDMLLinks = append(s.IDMLLinks, idmlLink)
+			case "MetadataPacketPreference":
+				metadata := struct {
+					Contents string `xml:"Properties>Contents"`
+				}{}
+				decoder.DecodeElement(&metadata, &se)
+				if id := documentID(metadata.Contents); id != "" {
+					s.documentID = id
+				}
+			default:
+				if styles.IsRoot(se.Name.Local) {
+					s.Styles.Decode(decoder, &se)
+				}
 			}
 		}
 	}
-	c.Printf(" done.\n")
-
-	validationErrors := story.Validate()
-	if len(validationErrors) > 0 {
-		color.Red("Validation errors.")
-	} else {
-		color.Green("✓ Validation succeeded.")
-	}
-	for _, validationError := range validationErrors {
-		color.Yellow("\t● " + validationError)
-	}
-	if len(validationErrors) > 0 {
-		color.Red("Aborting.")
-		return
-	}
-
-	fmt.Println()
-	story.Print()
-	fmt.Println()
-
-	client := http.Client{Timeout: 10 * time.Second}
-	body, err := json.Marshal(story.CreateWPPost())
-	if err != nil {
-		r := color.New(color.FgRed)
-		r.Println(err)
-		log.Println(err)
-		return
-	}
-
-	// check if we already uploaded this
-	req, err := http.NewRequest("GET", APIRoot+"/wp/v2/posts?per_page=30&status=any", nil)
-	req.SetBasicAuth("uploader", apiPassword)
-	if err != nil {
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	if resp.StatusCode == 400 {
-		data, _ := ioutil.ReadAll(resp.Body)
-		color.Red("WordPress communication failed: %s", string(data))
-		return
-	}
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	posts := []WPPostReturned{}
-	err = json.Unmarshal(data, &posts)
-	if err != nil {
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	for _, post := range posts {
-		// See if we have a recent post with the same headline
-		// OR the same kicker and author.
-		if post.Title.Rendered == story.Headline() ||
-			(post.Meta.Kicker == story.Kicker() && post.Meta.AuthorName == story.AuthorName()) {
-			color.Yellow("Similar post already exists: %s", post.Link)
-			color.Red("Aborting.")
-			return
-		}
-	}
-
-	// create post
-	c.Print("Uploading... ")
-	req, err = http.NewRequest("POST", APIRoot+"/wp/v2/posts", bytes.NewBuffer(body))
-	if err != nil {
-		fmt.Println()
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	req.Header["Content-Type"] = []string{"application/json"}
-	req.SetBasicAuth("uploader", apiPassword)
-	resp, err = client.Do(req)
-	if err != nil {
-		fmt.Println()
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	_, err = ioutil.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Println()
-		r := color.New(color.FgRed)
-		r.Println(err)
-		return
-	}
-	g := color.New(color.FgGreen)
-	g.Println(" done. ✓")
 }
@@ -0,0 +1,33 @@
+package upload
+
+import (
+	"strings"
+	"testing"
+)
+
+// snippet is a story with the given paragraphs, each an applied paragraph
+// style and its text.
+func snippet(paragraphs ...string) string {
+	s := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Document><Story Self="u1">`
+	for i := 0; i+1 < len(paragraphs); i += 2 {
+		s += `<ParagraphStyleRange AppliedParagraphStyle="ParagraphStyle/` + paragraphs[i] + `">` +
+			`<CharacterStyleRange AppliedCharacterStyle="CharacterStyle/$ID/[No character style]">` +
+			`<Content>` + paragraphs[i+1] + `</Content></CharacterStyleRange></ParagraphStyleRange>`
+	}
+	return s + `</Story></Document>`
+}
+
+func TestExcerpt(t *testing.T) {
+	story := NewStoryFromFile(strings.NewReader(snippet(
+		"Headline", "Council votes",
+		"Body Text", "The council voted Tuesday.",
+		"Body Text", "It meets again next week.",
+	)))
+	if got, want := story.Excerpt(), "The council voted Tuesday."; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := story.CreateWPPost().Excerpt, "The council voted Tuesday."; got != want {
+		t.Errorf("post excerpt is %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,323 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/thepoly/uploader/typography"
+)
+
+// Typography rules catch mechanical problems, mostly from the web editor,
+// that can always be fixed without a human looking at them.
+func init() {
+	for _, field := range TextFields {
+		label := fieldLabels[field]
+		singleLine := field != FieldBodyText
+
+		Register(&Rule{
+			ID:       ruleID(field, "nbsp"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + " contains non-breaking spaces.",
+			Check:    matching(field, typography.NBSPPattern()),
+			Fix:      typography.NBSP,
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "straight-quotes"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + " contains straight quotes.",
+			Check:    matching(field, typography.StraightQuotePattern()),
+			Fix:      typography.SmartQuotes,
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "hyphen-dash"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + " uses hyphens as a dash.",
+			Check:    matching(field, typography.DashPattern()),
+			Fix:      typography.Dashes,
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "trailing-space"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + " has spaces at the start or end.",
+			Check:    matchingTags(field, typography.TrimSpacePattern()),
+			Fix:      typography.TrimSpace,
+		})
+		if singleLine {
+			Register(&Rule{
+				ID:       ruleID(field, "line-break"),
+				Severity: Error,
+				Field:    field,
+				Message:  label + " contains a line break.",
+				Check:    matchingTags(field, typography.LineBreakPattern()),
+				Fix:      typography.StripLineBreaks,
+			})
+		}
+	}
+}
+
+var fieldLabels = map[string]string{
+	FieldKicker:       "Kicker",
+	FieldHeadline:     "Headline",
+	FieldSubdeck:      "Subdeck",
+	FieldAuthorName:   "Author name",
+	FieldAuthorTitle:  "Author title",
+	FieldBodyText:     "Body text",
+	FieldPhotoByline:  "Photo byline",
+	FieldPhotoCaption: "Photo caption",
+	FieldExcerpt:      "Excerpt",
+}
+
+func matching(field string, pattern *regexp.Regexp) func(s *Story) []Problem {
+	return func(s *Story) []Problem {
+		return Matches(s.Field(field), pattern)
+	}
+}
+
+// matchingTags is like matching, but the pattern may match HTML tags.
+func matchingTags(field string, pattern *regexp.Regexp) func(s *Story) []Problem {
+	return func(s *Story) []Problem {
+		text := s.Field(field)
+		problems := []Problem{}
+		for _, loc := range pattern.FindAllStringIndex(text, -1) {
+			problems = append(problems, Span(text, loc[0], loc[1]))
+		}
+		return problems
+	}
+}
+
+// Change is how fixing a story changed one of its fields.
+type Change struct {
+	Field  string   `json:"field"`
+	Rules  []string `json:"rules"`
+	Before string   `json:"before"`
+	After  string   `json:"after"`
+	Diff   []Edit   `json:"diff"`
+}
+
+// Fix applies the fix of every enabled rule that finds a problem in s,
+// and returns the fixed story along with what changed. s isn't modified.
+func (v *Validator) Fix(s *Story) (*Story, []Change) {
+	fixed := *s
+	section := v.section(s)
+	rules := map[string][]string{}
+
+	// one fix can expose another, e.g. replacing non-breaking spaces can
+	// leave two spaces in a row, so go until nothing changes
+	for pass := 0; pass < 3; pass++ {
+		changed := false
+		for _, rule := range v.rules {
+			if rule.Fix == nil || !v.Enabled(rule, section) || len(rule.Check(&fixed)) == 0 {
+				continue
+			}
+			before := fixed.Field(rule.Field)
+			after := rule.Fix(before)
+			if after == before {
+				continue
+			}
+			fixed.SetField(rule.Field, after)
+			if !contains(rules[rule.Field], rule.ID) {
+				rules[rule.Field] = append(rules[rule.Field], rule.ID)
+			}
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	changes := []Change{}
+	for _, field := range TextFields {
+		before, after := s.Field(field), fixed.Field(field)
+		if before == after {
+			continue
+		}
+		changes = append(changes, Change{
+			Field:  field,
+			Rules:  rules[field],
+			Before: before,
+			After:  after,
+			Diff:   Diff(before, after),
+		})
+	}
+	return &fixed, changes
+}
+
+// Edit is one piece of a diff: text that's the same in both versions ("="),
+// removed ("-") or added ("+").
+type Edit struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// Diff compares a and b word by word. It finds the fewest words to remove
+// and add with Myers' algorithm, in space linear in the length of the text,
+// since bodies run to thousands of words.
+func Diff(a, b string) []Edit {
+	edits := []Edit{}
+	add := func(op string, tokens []string) {
+		for _, text := range tokens {
+			if n := len(edits); n > 0 && edits[n-1].Op == op {
+				edits[n-1].Text += text
+				continue
+			}
+			edits = append(edits, Edit{Op: op, Text: text})
+		}
+	}
+	diff(tokenize(a), tokenize(b), add)
+	return edits
+}
+
+// diff passes the edits that turn x into y to add, in order.
+func diff(x, y []string, add func(op string, tokens []string)) {
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	add("=", x[:prefix])
+	x, y = x[prefix:], y[prefix:]
+	suffix := 0
+	for suffix < len(x) && suffix < len(y) && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+	common := x[len(x)-suffix:]
+	x, y = x[:len(x)-suffix], y[:len(y)-suffix]
+
+	if len(x) == 0 || len(y) == 0 {
+		add("-", x)
+		add("+", y)
+	} else if i, j := middleSnake(x, y); i < 0 {
+		add("-", x)
+		add("+", y)
+	} else {
+		diff(x[:i], y[:j], add)
+		diff(x[i:], y[j:], add)
+	}
+	add("=", common)
+}
+
+// middleSnake finds where the shortest edit script from x to y crosses its
+// middle, by searching forward from the start and backward from the end at
+// once, and returns the point to split x and y at. It returns -1, -1 if x
+// and y have nothing in common.
+func middleSnake(x, y []string) (int, int) {
+	n, m := len(x), len(y)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[offset+k] is how far along x the furthest forward path on
+	// diagonal k has got, and backward the same for paths from the end
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+	delta := n - m
+	// paths meet on a forward step if delta is odd, and a backward one if
+	// it's even
+	odd := delta%2 != 0
+	// how far the diagonals searched have been narrowed at either end, after
+	// paths run off the edge
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			i := offset + k
+			var fx int
+			if k == -d || k != d && forward[i-1] < forward[i+1] {
+				fx = forward[i+1]
+			} else {
+				fx = forward[i-1] + 1
+			}
+			fy := fx - k
+			for fx < n && fy < m && x[fx] == y[fy] {
+				fx++
+				fy++
+			}
+			forward[i] = fx
+			switch {
+			case fx > n:
+				fEnd += 2
+			case fy > m:
+				fStart += 2
+			case odd:
+				if b := offset + delta - k; b >= 0 && b < len(backward) && backward[b] != -1 {
+					if fx >= n-backward[b] {
+						return fx, fy
+					}
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			i := offset + k
+			var bx int
+			if k == -d || k != d && backward[i-1] < backward[i+1] {
+				bx = backward[i+1]
+			} else {
+				bx = backward[i-1] + 1
+			}
+			by := bx - k
+			for bx < n && by < m && x[n-bx-1] == y[m-by-1] {
+				bx++
+				by++
+			}
+			backward[i] = bx
+			switch {
+			case bx > n:
+				bEnd += 2
+			case by > m:
+				bStart += 2
+			case !odd:
+				if f := offset + delta - k; f >= 0 && f < len(forward) && forward[f] != -1 {
+					fx := forward[f]
+					if fx >= n-bx {
+						return fx, offset + fx - f
+					}
+				}
+			}
+		}
+	}
+	return -1, -1
+}
+
+// tokenize splits s into words and the runs of spaces between them, so
+// that the tokens joined together are s again.
+func tokenize(s string) []string {
+	tokens := []string{}
+	start := 0
+	for i, r := range s {
+		if i == start {
+			continue
+		}
+		prevSpace := strings.ContainsRune(" \t\n", rune(s[i-1]))
+		if prevSpace != strings.ContainsRune(" \t\n", r) {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
+
+// FormatDiff renders edits as text, marking removals as [-text-] and
+// additions as {+text+}.
+func FormatDiff(edits []Edit) string {
+	out := ""
+	for _, e := range edits {
+		switch e.Op {
+		case "-":
+			out += "[-" + e.Text + "-]"
+		case "+":
+			out += "{+" + e.Text + "+}"
+		default:
+			out += e.Text
+		}
+	}
+	return out
+}
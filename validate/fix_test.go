@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"", "", ""},
+		{"same words", "same words", "same words"},
+		{"", "new", "{+new+}"},
+		{"old", "", "[-old-]"},
+		{"the cat sat", "the dog sat", "the [-cat-]{+dog+} sat"},
+		{"a b c", "a c", "a [-b -]c"},
+		{"a c", "a b c", "a {+b +}c"},
+		{"one two", "uno dos", "[-one-]{+uno+} [-two-]{+dos+}"},
+		{"It's  here", "It’s here", "[-It's  -]{+It’s +}here"},
+		{"x a b c y", "a b c", "[-x -]a b c[- y-]"},
+	}
+	for _, test := range tests {
+		if got := FormatDiff(Diff(test.a, test.b)); got != test.want {
+			t.Errorf("Diff(%q, %q) = %q, want %q", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+// TestDiffLong checks that the diff of long texts is minimal and gives back
+// both texts.
+func TestDiffLong(t *testing.T) {
+	a := strings.Repeat("the quick brown fox jumps over the lazy dog. ", 500)
+	b := strings.Replace(a, "lazy", "sleepy", 20)
+	b = strings.Replace(b, "quick ", "", 7)
+	edits := Diff(a, b)
+
+	before, after, removed, added := "", "", 0, 0
+	for _, e := range edits {
+		switch e.Op {
+		case "-":
+			before += e.Text
+			removed += len(tokenize(e.Text))
+		case "+":
+			after += e.Text
+			added += len(tokenize(e.Text))
+		default:
+			before += e.Text
+			after += e.Text
+		}
+	}
+	if before != a || after != b {
+		t.Fatal("edits don't give back the original texts")
+	}
+	// each "lazy" is swapped for "sleepy", and each "quick " is two tokens
+	if removed != 20+7*2 || added != 20 {
+		t.Errorf("removed %d and added %d tokens, want %d and %d", removed, added, 34, 20)
+	}
+}
+
+func TestFix(t *testing.T) {
+	v, err := New(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Story{
+		Headline: " \"Council\" votes - again<br>",
+		BodyText: "<p>It's&nbsp;done.</p>",
+	}
+	fixed, changes := v.Fix(s)
+
+	if want := "“Council” votes — again"; fixed.Headline != want {
+		t.Errorf("headline is %q, want %q", fixed.Headline, want)
+	}
+	if want := "<p>It’s done.</p>"; fixed.BodyText != want {
+		t.Errorf("body text is %q, want %q", fixed.BodyText, want)
+	}
+	if s.Headline != " \"Council\" votes - again<br>" {
+		t.Error("Fix changed the original story")
+	}
+
+	fields := []string{}
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	if want := []string{FieldHeadline, FieldBodyText}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("changed fields are %v, want %v", fields, want)
+	}
+	for _, c := range changes {
+		if c.Field == FieldHeadline {
+			for _, rule := range []string{"headline-straight-quotes", "headline-hyphen-dash", "headline-trailing-space"} {
+				if !contains(c.Rules, rule) {
+					t.Errorf("headline change doesn't list %s in %v", rule, c.Rules)
+				}
+			}
+		}
+	}
+}
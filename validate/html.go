@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/thepoly/uploader/sanitize"
+)
+
+// HTML rules report markup a field isn't allowed to have, which is removed
+// when the story is published whether or not it's fixed first.
+func init() {
+	for _, field := range TextFields {
+		field := field
+		policy := Policy(field)
+		Register(&Rule{
+			ID:       ruleID(field, "html"),
+			Severity: Warning,
+			Field:    field,
+			Message:  fieldLabels[field] + " contains HTML that will be removed.",
+			Check: func(s *Story) []Problem {
+				text := s.Field(field)
+				_, removals := policy.Sanitize(text)
+				problems := []Problem{}
+				for _, r := range removals {
+					p := Span(text, r.Start, r.End)
+					p.Message = fmt.Sprintf("%s contains %s, which will be removed.", fieldLabels[field], r.What)
+					problems = append(problems, p)
+				}
+				return problems
+			},
+			Fix: policy.Clean,
+		})
+	}
+}
+
+// Policy returns the HTML allowed in field: body text can have paragraphs,
+// emphasis, links, quotes and lists, and everything else only italics.
+func Policy(field string) *sanitize.Policy {
+	if field == FieldBodyText {
+		return sanitize.Body
+	}
+	return sanitize.Inline
+}
+
+// Sanitize returns s with the HTML its fields aren't allowed removed, and
+// what was removed from each field. s isn't modified.
+func Sanitize(s *Story) (*Story, map[string][]sanitize.Removal) {
+	clean := *s
+	removed := map[string][]sanitize.Removal{}
+	for _, field := range TextFields {
+		text, removals := Policy(field).Sanitize(s.Field(field))
+		clean.SetField(field, text)
+		if len(removals) > 0 {
+			removed[field] = removals
+		}
+	}
+	return &clean, removed
+}
@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/thepoly/uploader/kicker"
+)
+
+// kickerRules check kickers against the configured list. They don't find
+// anything if no kickers are configured.
+func kickerRules(kickers *kicker.Taxonomy) []*Rule {
+	return []*Rule{
+		{
+			ID:       "kicker-unknown",
+			Severity: Warning,
+			Field:    FieldKicker,
+			Message:  "Kicker isn't one of the allowed kickers.",
+			Check: func(s *Story) []Problem {
+				if kickers.Empty() || s.Kicker == "" || kickers.Find(PlainText(s.Kicker)) != nil {
+					return nil
+				}
+				p := Whole(s.Kicker)
+				p.Message = fmt.Sprintf("%q isn't one of the allowed kickers.", PlainText(s.Kicker))
+				p.Suggestions = kickers.Similar(PlainText(s.Kicker), 3)
+				return []Problem{p}
+			},
+		},
+	}
+}
@@ -0,0 +1,133 @@
+package validate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Report is the findings on one story file, for tools like CI that read
+// validation results.
+type Report struct {
+	File     string    `json:"file"`
+	Findings []Finding `json:"findings"`
+}
+
+// WriteJSON writes reports as a JSON list.
+func WriteJSON(w io.Writer, reports []Report) error {
+	b, err := json.MarshalIndent(reports, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes reports as JUnit XML: a test suite for each file, with
+// a failed test case for each warning or error. Info findings aren't
+// failures, and files without any get a single passing case.
+func WriteJUnit(w io.Writer, reports []Report) error {
+	suites := junitSuites{}
+	for _, r := range reports {
+		suite := junitSuite{Name: r.File}
+		for _, f := range r.Findings {
+			if f.Severity < Warning {
+				continue
+			}
+			suite.Cases = append(suite.Cases, junitCase{
+				Name:      f.Rule,
+				ClassName: r.File,
+				Failure: &junitFailure{
+					Message: f.Message,
+					Type:    f.Severity.String(),
+					Text:    fmt.Sprintf("%s: %s (%s, characters %d-%d)", f.Severity, f.Message, f.Field, f.Start, f.End),
+				},
+			})
+			suite.Failures++
+		}
+		if len(suite.Cases) == 0 {
+			suite.Cases = append(suite.Cases, junitCase{Name: "validate", ClassName: r.File})
+		}
+		suite.Tests = len(suite.Cases)
+		suites.Suites = append(suites.Suites, suite)
+	}
+	return writeXML(w, suites)
+}
+
+type checkstyleResult struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// WriteCheckstyle writes reports as Checkstyle XML. Stories don't have
+// lines, so every finding is on line 1, with the column its offset in the
+// field.
+func WriteCheckstyle(w io.Writer, reports []Report) error {
+	result := checkstyleResult{Version: "4.3"}
+	for _, r := range reports {
+		file := checkstyleFile{Name: r.File}
+		for _, f := range r.Findings {
+			file.Errors = append(file.Errors, checkstyleError{
+				Line:     1,
+				Column:   f.Start + 1,
+				Severity: f.Severity.String(),
+				Message:  fmt.Sprintf("%s: %s", f.Field, f.Message),
+				Source:   f.Rule,
+			})
+		}
+		result.Files = append(result.Files, file)
+	}
+	return writeXML(w, result)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
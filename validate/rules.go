@@ -0,0 +1,179 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thepoly/uploader/typography"
+)
+
+// Most of these catch mistakes made building the snippet in InDesign.
+func init() {
+	required := []struct{ field, label string }{
+		{FieldHeadline, "headline"},
+		{FieldAuthorName, "author name"},
+		{FieldKicker, "kicker"},
+		{FieldAuthorTitle, "author title"},
+		{FieldBodyText, "body text"},
+	}
+	for _, r := range required {
+		Register(missing(r.field, "No "+r.label+"."))
+	}
+
+	spaced := []struct{ field, label string }{
+		{FieldHeadline, "Headline"},
+		{FieldSubdeck, "Subdeck"},
+		{FieldAuthorName, "Author name"},
+		{FieldKicker, "Kicker"},
+		{FieldAuthorTitle, "Author title"},
+		{FieldPhotoByline, "Photo byline"},
+		{FieldPhotoCaption, "Photo caption"},
+	}
+	for _, r := range spaced {
+		Register(doubleSpace(r.field, r.label+" contains two consecutive spaces."))
+	}
+
+	Register(&Rule{
+		ID:       "kicker-plural-notebooks",
+		Severity: Error,
+		Field:    FieldKicker,
+		Message:  "Kicker contains plural \"notebooks.\"",
+		Check: func(s *Story) []Problem {
+			if strings.ToUpper(s.Kicker) != "EDITORIAL NOTEBOOKS" {
+				return nil
+			}
+			idx := strings.Index(strings.ToUpper(s.Kicker), "NOTEBOOKS")
+			return []Problem{Span(s.Kicker, idx, idx+len("NOTEBOOKS"))}
+		},
+	})
+
+	Register(&Rule{
+		ID:       "body-text-short",
+		Severity: Warning,
+		Field:    FieldBodyText,
+		Message:  "Body text extremely short.",
+		Check: func(s *Story) []Problem {
+			n := utf8.RuneCountInString(PlainText(s.BodyText))
+			if s.BodyText == "" || n >= 100 {
+				return nil
+			}
+			p := Whole(s.BodyText)
+			p.Message = fmt.Sprintf("Body text extremely short (%d characters).", n)
+			return []Problem{p}
+		},
+	})
+
+	Register(&Rule{
+		ID:       "photo-missing",
+		Severity: Error,
+		Field:    FieldPhoto,
+		Message:  "Linked photo couldn't be loaded.",
+		Check: func(s *Story) []Problem {
+			if s.Photo != PhotoMissing {
+				return nil
+			}
+			return []Problem{{Message: fmt.Sprintf("Unable to load photo: %s.", s.PhotoError)}}
+		},
+	})
+	Register(&Rule{
+		ID:       "photo-byline-without-photo",
+		Severity: Error,
+		Field:    FieldPhotoByline,
+		Message:  "Photo byline without photo.",
+		Check: func(s *Story) []Problem {
+			if s.PhotoByline == "" || (s.Photo != PhotoNone && s.Photo != PhotoMissing) {
+				return nil
+			}
+			return []Problem{Whole(s.PhotoByline)}
+		},
+	})
+	Register(&Rule{
+		ID:       "photo-caption-without-photo",
+		Severity: Error,
+		Field:    FieldPhotoCaption,
+		Message:  "Photo caption without photo.",
+		Check: func(s *Story) []Problem {
+			if s.PhotoCaption == "" || (s.Photo != PhotoNone && s.Photo != PhotoMissing) {
+				return nil
+			}
+			return []Problem{Whole(s.PhotoCaption)}
+		},
+	})
+}
+
+// ruleID turns a field name like "authorTitle" into "author-title".
+func ruleID(field, suffix string) string {
+	id := ""
+	for _, r := range field {
+		if r >= 'A' && r <= 'Z' {
+			id += "-" + string(r-'A'+'a')
+		} else {
+			id += string(r)
+		}
+	}
+	return id + "-" + suffix
+}
+
+func missing(field, message string) *Rule {
+	return &Rule{
+		ID:       ruleID(field, "missing"),
+		Severity: Error,
+		Field:    field,
+		Message:  message,
+		Check: func(s *Story) []Problem {
+			if s.Field(field) != "" {
+				return nil
+			}
+			return []Problem{{}}
+		},
+	}
+}
+
+func doubleSpace(field, message string) *Rule {
+	return &Rule{
+		ID:       ruleID(field, "double-space"),
+		Severity: Error,
+		Field:    field,
+		Message:  message,
+		Check: func(s *Story) []Problem {
+			return Matches(s.Field(field), doubleSpacePattern)
+		},
+		Fix: typography.CollapseSpaces,
+	}
+}
+
+var doubleSpacePattern = regexp.MustCompile(`  +`)
+
+// Span returns a problem covering text[start:end], converting those byte
+// offsets to rune offsets.
+func Span(text string, start, end int) Problem {
+	runeStart := utf8.RuneCountInString(text[:start])
+	return Problem{
+		Start: runeStart,
+		End:   runeStart + utf8.RuneCountInString(text[start:end]),
+	}
+}
+
+// Whole returns a problem covering all of text.
+func Whole(text string) Problem {
+	return Problem{End: utf8.RuneCountInString(text)}
+}
+
+// Matches returns a problem for every match of pattern in text, ignoring
+// anything inside HTML tags.
+func Matches(text string, pattern *regexp.Regexp) []Problem {
+	problems := []Problem{}
+	for _, loc := range typography.OutsideTags(text, pattern) {
+		problems = append(problems, Span(text, loc[0], loc[1]))
+	}
+	return problems
+}
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// PlainText strips HTML tags from s.
+func PlainText(s string) string {
+	return tagPattern.ReplaceAllString(s, "")
+}
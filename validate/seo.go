@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/thepoly/uploader/seo"
+)
+
+// Print headlines are written to fit a column, not search results, so these
+// check what the web version of a story will look like.
+func init() {
+	Register(tooLong(FieldHeadline, seo.TitleLength, "search results"))
+	Register(tooLong(FieldSubdeck, seo.ExcerptLength, "search results when it's the excerpt"))
+	Register(tooLong(FieldExcerpt, seo.ExcerptLength, "search results"))
+	Register(tooLong(FieldSlug, seo.SlugLength, "links"))
+
+	Register(&Rule{
+		ID:       "slug-invalid",
+		Severity: Error,
+		Field:    FieldSlug,
+		Message:  "Slug can only have lowercase letters, numbers and hyphens.",
+		Check: func(s *Story) []Problem {
+			if s.Slug == "" || seo.ValidSlug(s.Slug) {
+				return nil
+			}
+			p := Whole(s.Slug)
+			p.Suggestions = []string{seo.Slug(s.Slug)}
+			return []Problem{p}
+		},
+	})
+	Register(&Rule{
+		ID:       "slug-missing",
+		Severity: Error,
+		Field:    FieldSlug,
+		Message:  "No slug; the headline has no letters or numbers to make one from.",
+		Check: func(s *Story) []Problem {
+			if s.Slug != "" || s.Headline == "" {
+				return nil
+			}
+			return []Problem{{}}
+		},
+	})
+}
+
+func tooLong(field string, limit int, where string) *Rule {
+	label := fieldLabels[field]
+	if field == FieldSlug {
+		label = "Slug"
+	}
+	return &Rule{
+		ID:       ruleID(field, "seo-length"),
+		Severity: Warning,
+		Field:    field,
+		Message:  fmt.Sprintf("%s is too long for %s.", label, where),
+		Check: func(s *Story) []Problem {
+			text := s.Field(field)
+			n := utf8.RuneCountInString(seo.PlainText(text))
+			if n <= limit {
+				return nil
+			}
+			p := Whole(text)
+			p.Message = fmt.Sprintf("%s is %d characters; %s cut it off after %d.", label, n, where, limit)
+			return []Problem{p}
+		},
+	}
+}
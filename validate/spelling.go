@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/thepoly/uploader/spell"
+	"github.com/thepoly/uploader/typography"
+)
+
+// newChecker loads the bundled dictionaries and the one at path, if any.
+func newChecker(path string) (*spell.Dictionary, *spell.Checker, error) {
+	english, err := spell.English()
+	if err != nil {
+		return nil, nil, err
+	}
+	newsroom, err := spell.Newsroom()
+	if err != nil {
+		return nil, nil, err
+	}
+	dictionary := spell.New()
+	if path != "" {
+		if err := dictionary.Open(path); err != nil {
+			return nil, nil, err
+		}
+	}
+	return dictionary, spell.NewChecker(english, newsroom, dictionary), nil
+}
+
+// spellingRules check the fields people write in. Names are left alone,
+// since few of them are in any dictionary.
+func spellingRules(checker *spell.Checker) []*Rule {
+	rules := []*Rule{}
+	for _, field := range []string{FieldHeadline, FieldSubdeck, FieldBodyText, FieldPhotoCaption} {
+		field := field
+		rules = append(rules, &Rule{
+			ID:       ruleID(field, "spelling"),
+			Severity: Info,
+			Field:    field,
+			Message:  fieldLabels[field] + " contains a word that isn't in the dictionary.",
+			Check: func(s *Story) []Problem {
+				return misspellings(checker, s.Field(field))
+			},
+		})
+	}
+	return rules
+}
+
+// a word, possibly with apostrophes or hyphens inside it
+var wordPattern = regexp.MustCompile(`[\pL\d]+(?:['’-][\pL\d]+)*`)
+
+var digitPattern = regexp.MustCompile(`\d`)
+
+func misspellings(checker *spell.Checker, text string) []Problem {
+	problems := []Problem{}
+	for _, loc := range typography.OutsideTags(text, wordPattern) {
+		// entities like &amp;
+		if loc[0] > 0 && text[loc[0]-1] == '&' {
+			continue
+		}
+		// check the parts of hyphenated words separately
+		for _, part := range hyphenated(text, loc) {
+			word := text[part[0]:part[1]]
+			if digitPattern.MatchString(word) || checker.Correct(word) {
+				continue
+			}
+			p := Span(text, part[0], part[1])
+			p.Message = fmt.Sprintf("%q isn't in the dictionary.", word)
+			p.Suggestions = checker.Suggest(word, 5)
+			problems = append(problems, p)
+		}
+	}
+	return problems
+}
+
+func hyphenated(text string, loc []int) [][]int {
+	parts := [][]int{}
+	start := loc[0]
+	for i := loc[0]; i < loc[1]; i++ {
+		if text[i] == '-' {
+			parts = append(parts, []int{start, i})
+			start = i + 1
+		}
+	}
+	return append(parts, []int{start, loc[1]})
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thepoly/uploader/staff"
+)
+
+// staffRules check bylines against the staff directory. They don't find
+// anything if there's no directory.
+func staffRules(directory *staff.Directory) []*Rule {
+	return []*Rule{
+		{
+			ID:       "author-name-unknown",
+			Severity: Warning,
+			Field:    FieldAuthorName,
+			Message:  "Byline isn't in the staff directory.",
+			Check: func(s *Story) []Problem {
+				if directory == nil {
+					return nil
+				}
+				problems := []Problem{}
+				offset := 0
+				for _, name := range staff.Bylines(s.AuthorName) {
+					i := strings.Index(s.AuthorName[offset:], name)
+					if i == -1 {
+						// Bylines gave back the name in another form, so
+						// there's nowhere to point to
+						continue
+					}
+					i += offset
+					offset = i + len(name)
+					if directory.Find(name) != nil {
+						continue
+					}
+					p := Span(s.AuthorName, i, i+len(name))
+					p.Message = fmt.Sprintf("%q isn't in the staff directory.", name)
+					p.Suggestions = directory.Similar(name, 3)
+					problems = append(problems, p)
+				}
+				return problems
+			},
+		},
+		{
+			ID:       "author-title-mismatch",
+			Severity: Warning,
+			Field:    FieldAuthorTitle,
+			Message:  "Author title doesn't match the staff directory.",
+			Check: func(s *Story) []Problem {
+				if directory == nil {
+					return nil
+				}
+				// a shared byline's title covers everyone, so it can't be
+				// checked against any one person
+				bylines := staff.Bylines(s.AuthorName)
+				if len(bylines) != 1 {
+					return nil
+				}
+				m := directory.Find(bylines[0])
+				if m == nil || m.Title == "" || strings.EqualFold(strings.TrimSpace(PlainText(s.AuthorTitle)), m.Title) {
+					return nil
+				}
+				p := Whole(s.AuthorTitle)
+				p.Message = fmt.Sprintf("%s's title is %q in the staff directory.", m.Name, m.Title)
+				p.Suggestions = []string{m.Title}
+				return []Problem{p}
+			},
+		},
+	}
+}
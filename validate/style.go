@@ -0,0 +1,413 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/thepoly/uploader/typography"
+)
+
+// AP style rules are warnings: they're right most of the time, but the copy
+// desk has the final say, so none of them fix anything themselves.
+func init() {
+	for _, field := range []string{FieldHeadline, FieldSubdeck, FieldBodyText, FieldPhotoCaption} {
+		label := fieldLabels[field]
+		// headlines use figures and % signs
+		headline := field == FieldHeadline || field == FieldSubdeck
+
+		if !headline {
+			Register(&Rule{
+				ID:       ruleID(field, "ap-numerals"),
+				Severity: Warning,
+				Field:    field,
+				Message:  label + ": spell out numbers under 10.",
+				Check:    apNumerals(field),
+			})
+			Register(&Rule{
+				ID:       ruleID(field, "ap-percent"),
+				Severity: Warning,
+				Field:    field,
+				Message:  label + ": use \"percent\", not \"%\".",
+				Check:    apPercent(field),
+			})
+		}
+		Register(&Rule{
+			ID:       ruleID(field, "ap-states"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + ": use AP state abbreviations, not postal codes.",
+			Check:    apStates(field),
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "ap-months"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + ": month isn't in AP style.",
+			Check:    apMonths(field),
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "ap-titles"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + ": title before a name isn't in AP style.",
+			Check:    apTitles(field),
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "ap-time"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + ": write times like \"7 p.m.\"",
+			Check:    apTime(field),
+		})
+		Register(&Rule{
+			ID:       ruleID(field, "ap-oxford-comma"),
+			Severity: Warning,
+			Field:    field,
+			Message:  label + ": AP style leaves out the comma before the conjunction in a simple series.",
+			Check:    matchingGroup(field, oxfordCommaPattern, nil),
+		})
+	}
+}
+
+// matchingGroup returns a problem for the first group of every match of
+// pattern in field that keep accepts, or the whole match if the pattern
+// has no groups. Matches inside HTML tags are ignored.
+func matchingGroup(field string, pattern *regexp.Regexp, keep func(text string, m []int) string) func(s *Story) []Problem {
+	return func(s *Story) []Problem {
+		text := s.Field(field)
+		problems := []Problem{}
+		for _, m := range pattern.FindAllStringSubmatchIndex(text, -1) {
+			if insideTag(text, m[0]) {
+				continue
+			}
+			msg := ""
+			if keep != nil {
+				msg = keep(text, m)
+				if msg == skip {
+					continue
+				}
+			}
+			start, end := m[0], m[1]
+			if len(m) > 2 && m[2] >= 0 {
+				start, end = m[2], m[3]
+			}
+			p := Span(text, start, end)
+			p.Message = msg
+			problems = append(problems, p)
+		}
+		return problems
+	}
+}
+
+// skip is what keep functions return to drop a match.
+const skip = "-"
+
+func insideTag(text string, i int) bool {
+	open := strings.LastIndex(text[:i], "<")
+	return open != -1 && !strings.Contains(text[open:i], ">")
+}
+
+// wordBefore returns the word just before text[:i], skipping one space.
+func wordBefore(text string, i int) string {
+	before := strings.TrimSuffix(text[:i], " ")
+	start := strings.LastIndexFunc(before, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '.'
+	})
+	return before[start+1:]
+}
+
+var numeralPattern = regexp.MustCompile(`\b[1-9]\b`)
+
+// words that are followed by figures even under 10
+var numeralWords = map[string]bool{
+	"No.": true, "Room": true, "Route": true, "Chapter": true, "Section": true,
+	"Title": true, "Page": true, "page": true, "Act": true, "Game": true,
+	"Week": true, "Grade": true, "Phase": true, "Vol.": true, "age": true,
+	"ages": true, "Apt.": true, "Level": true, "Division": true,
+}
+
+func apNumerals(field string) func(s *Story) []Problem {
+	return matchingGroup(field, numeralPattern, func(text string, m []int) string {
+		before, after := text[:m[0]], text[m[1]:]
+		// parts of larger figures, scores, fractions, money, times and dates
+		if b, _ := utf8.DecodeLastRuneInString(before); b != utf8.RuneError && strings.ContainsRune("$.,:-/#", b) {
+			return skip
+		}
+		if a, _ := utf8.DecodeRuneInString(after); a != utf8.RuneError && strings.ContainsRune("%:-/", a) {
+			return skip
+		}
+		if len(after) > 1 && strings.ContainsRune(".,", rune(after[0])) && after[1] >= '0' && after[1] <= '9' {
+			return skip
+		}
+		for _, suffix := range []string{" percent", " a.m.", " p.m.", " years old", " year old", " million", " billion"} {
+			if strings.HasPrefix(after, suffix) {
+				return skip
+			}
+		}
+		word := wordBefore(text, m[0])
+		if numeralWords[word] || isMonth(word) {
+			return skip
+		}
+		return fmt.Sprintf("Spell out %q as %q.", text[m[0]:m[1]], numberWords[text[m[0]]-'0'])
+	})
+}
+
+var numberWords = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+var percentPattern = regexp.MustCompile(`\d\s?(%)|\b([Pp]er cent)\b`)
+
+func apPercent(field string) func(s *Story) []Problem {
+	return func(s *Story) []Problem {
+		text := s.Field(field)
+		problems := []Problem{}
+		for _, m := range percentPattern.FindAllStringSubmatchIndex(text, -1) {
+			if insideTag(text, m[0]) {
+				continue
+			}
+			if m[2] >= 0 {
+				problems = append(problems, Span(text, m[2], m[3]))
+				continue
+			}
+			p := Span(text, m[4], m[5])
+			p.Message = "Use \"percent\", not \"per cent\"."
+			problems = append(problems, p)
+		}
+		return problems
+	}
+}
+
+// stateAbbreviations maps postal codes to AP abbreviations. States AP never
+// abbreviates map to their names.
+var stateAbbreviations = map[string]string{
+	"AL": "Ala.", "AK": "Alaska", "AZ": "Ariz.", "AR": "Ark.", "CA": "Calif.",
+	"CO": "Colo.", "CT": "Conn.", "DE": "Del.", "FL": "Fla.", "GA": "Ga.",
+	"HI": "Hawaii", "ID": "Idaho", "IL": "Ill.", "IN": "Ind.", "IA": "Iowa",
+	"KS": "Kan.", "KY": "Ky.", "LA": "La.", "ME": "Maine", "MD": "Md.",
+	"MA": "Mass.", "MI": "Mich.", "MN": "Minn.", "MS": "Miss.", "MO": "Mo.",
+	"MT": "Mont.", "NE": "Neb.", "NV": "Nev.", "NH": "N.H.", "NJ": "N.J.",
+	"NM": "N.M.", "NY": "N.Y.", "NC": "N.C.", "ND": "N.D.", "OH": "Ohio",
+	"OK": "Okla.", "OR": "Ore.", "PA": "Pa.", "RI": "R.I.", "SC": "S.C.",
+	"SD": "S.D.", "TN": "Tenn.", "TX": "Texas", "UT": "Utah", "VT": "Vt.",
+	"VA": "Va.", "WA": "Wash.", "WV": "W.Va.", "WI": "Wis.", "WY": "Wyo.",
+}
+
+// a postal code after a city, as in "Troy, NY"
+var statePattern = regexp.MustCompile(`[A-Z][a-z]+, ([A-Z]{2})\b`)
+
+func apStates(field string) func(s *Story) []Problem {
+	return matchingGroup(field, statePattern, func(text string, m []int) string {
+		code := text[m[2]:m[3]]
+		if stateAbbreviations[code] == "" {
+			return skip
+		}
+		return fmt.Sprintf("Use %q, not %q.", stateAbbreviations[code], code)
+	})
+}
+
+// monthNames maps AP month abbreviations, and the wrong ones people use, to
+// the full names.
+var monthNames = map[string]string{
+	"Jan": "January", "Feb": "February", "Mar": "March", "Apr": "April",
+	"Jun": "June", "Jul": "July", "Aug": "August", "Sep": "September",
+	"Sept": "September", "Oct": "October", "Nov": "November", "Dec": "December",
+}
+
+// apMonthAbbreviations are the months AP abbreviates with a date.
+var apMonthAbbreviations = map[string]string{
+	"January": "Jan.", "February": "Feb.", "August": "Aug.", "September": "Sept.",
+	"October": "Oct.", "November": "Nov.", "December": "Dec.",
+}
+
+var monthPattern = regexp.MustCompile(`(\b(January|February|March|April|May|June|July|August|September|October|November|December|Jan|Feb|Mar|Apr|Jun|Jul|Aug|Sept?|Oct|Nov|Dec)(\.?)( \d{1,2}(st|nd|rd|th)?\b)?)`)
+
+// isMonth reports whether word is the name of a month or an abbreviation of one.
+func isMonth(word string) bool {
+	word = strings.TrimSuffix(word, ".")
+	if _, ok := monthNames[word]; ok {
+		return true
+	}
+	for _, full := range monthNames {
+		if word == full {
+			return true
+		}
+	}
+	// the only month without an abbreviation
+	return word == "May"
+}
+
+func apMonths(field string) func(s *Story) []Problem {
+	return matchingGroup(field, monthPattern, func(text string, m []int) string {
+		month := text[m[4]:m[5]]
+		dotted := m[7] > m[6]
+		dated := m[8] >= 0
+		full, abbreviated := monthNames[month]
+		switch {
+		case dated && m[10] >= 0:
+			return fmt.Sprintf("Write dates without %q.", text[m[10]:m[11]])
+		case abbreviated && !dotted:
+			// "Jan" without a period is probably a name
+			return skip
+		case abbreviated && !dated:
+			return fmt.Sprintf("Spell out %s without a date.", full)
+		case abbreviated && apMonthAbbreviations[full] == "":
+			return fmt.Sprintf("Don't abbreviate %s.", full)
+		case abbreviated && apMonthAbbreviations[full] != month+".":
+			return fmt.Sprintf("Abbreviate %s as %q.", full, apMonthAbbreviations[full])
+		case !abbreviated && dated && apMonthAbbreviations[month] != "":
+			return fmt.Sprintf("Abbreviate %s as %q with a date.", month, apMonthAbbreviations[month])
+		}
+		return skip
+	})
+}
+
+var titlePattern = regexp.MustCompile(`\b(Prof\.|Professor|Governor|Lieutenant Governor|Senator|Representative|Mr\.|Mrs\.|Ms\.|president|provost|dean|chancellor|mayor) [A-Z][a-z]+`)
+
+var titleAbbreviations = map[string]string{
+	"Governor":            "Gov.",
+	"Lieutenant Governor": "Lt. Gov.",
+	"Senator":             "Sen.",
+	"Representative":      "Rep.",
+}
+
+func apTitles(field string) func(s *Story) []Problem {
+	return matchingGroup(field, titlePattern, func(text string, m []int) string {
+		title := text[m[2]:m[3]]
+		switch {
+		case title == "Prof.":
+			return "Don't abbreviate \"professor.\""
+		case title == "Professor":
+			if sentenceStart(text, m[2]) {
+				return skip
+			}
+			return "Lowercase \"professor\" before a name."
+		case titleAbbreviations[title] != "":
+			return fmt.Sprintf("Abbreviate %q as %q before a name.", title, titleAbbreviations[title])
+		case strings.HasPrefix(title, "M"):
+			return fmt.Sprintf("Leave out courtesy titles like %q.", title)
+		case !sentenceStart(text, m[2]):
+			return fmt.Sprintf("Capitalize %q directly before a name.", title)
+		}
+		return skip
+	})
+}
+
+// sentenceStart reports whether text[i:] starts a sentence.
+func sentenceStart(text string, i int) bool {
+	before := strings.TrimRight(PlainText(text[:i]), " “\"‘'")
+	if before == "" {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(before)
+	return strings.ContainsRune(".!?:", r)
+}
+
+var timePattern = regexp.MustCompile(`(\b(\d{1,2})(:\d{2})? ?([AaPp])\.? ?[Mm]\b\.?|\b12 noon\b|\b12 midnight\b)`)
+
+func apTime(field string) func(s *Story) []Problem {
+	return matchingGroup(field, timePattern, func(text string, m []int) string {
+		got := text[m[0]:m[1]]
+		if m[4] < 0 {
+			return fmt.Sprintf("Write %q, not %q.", strings.TrimPrefix(got, "12 "), got)
+		}
+		hour, minutes := text[m[4]:m[5]], ""
+		if m[6] >= 0 && text[m[6]:m[7]] != ":00" {
+			minutes = text[m[6]:m[7]]
+		}
+		want := hour + minutes
+		switch meridiem := strings.ToLower(text[m[8]:m[9]]); {
+		case hour == "12" && minutes == "" && meridiem == "p":
+			want = "noon"
+		case hour == "12" && minutes == "" && meridiem == "a":
+			want = "midnight"
+		default:
+			want += " " + meridiem + ".m."
+		}
+		// "7 p.m" at the end of a sentence shares its period
+		if got == want || got+"." == want && strings.HasPrefix(text[m[1]:], ".") {
+			return skip
+		}
+		return fmt.Sprintf("Write %q, not %q.", want, got)
+	})
+}
+
+// a comma before "and" or "or" ending a series of short items
+var oxfordCommaPattern = regexp.MustCompile(`[^\s,.;:!?<>]+, [^\s,.;:!?<>]+(?: [^\s,.;:!?<>]+){0,2}(,) (?:and|or) `)
+
+// HouseRule is one of the paper's own style rules, loaded from a house style
+// file.
+type HouseRule struct {
+	// ID is added to the field to make the rule ID, e.g. "rpi" becomes
+	// "body-text-house-rpi".
+	ID string `json:"id"`
+	// Pattern is a regular expression matching the problem.
+	Pattern string `json:"pattern"`
+	// Replace, if set, is what to replace matches with. It can refer to
+	// groups in Pattern, like "${1}". The rule can then fix problems itself.
+	Replace string   `json:"replace"`
+	Message string   `json:"message"`
+	Fields  []string `json:"fields"`
+	// Severity defaults to warning.
+	Severity *Severity `json:"severity"`
+}
+
+// house style applies to these fields unless a rule says otherwise
+var houseFields = []string{FieldHeadline, FieldSubdeck, FieldBodyText, FieldPhotoCaption}
+
+// LoadHouseStyle reads a JSON list of house rules from path and turns them
+// into rules.
+func LoadHouseStyle(path string) ([]*Rule, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read house style: %v", err)
+	}
+	var house []HouseRule
+	if err := json.Unmarshal(b, &house); err != nil {
+		return nil, fmt.Errorf("unable to parse house style %s: %v", path, err)
+	}
+
+	rules := []*Rule{}
+	for _, h := range house {
+		if h.ID == "" || h.Pattern == "" {
+			return nil, fmt.Errorf("house style rule %q needs an id and a pattern", h.ID)
+		}
+		pattern, err := regexp.Compile(h.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("house style rule %s: %v", h.ID, err)
+		}
+		severity := Warning
+		if h.Severity != nil {
+			severity = *h.Severity
+		}
+		fields := h.Fields
+		if len(fields) == 0 {
+			fields = houseFields
+		}
+		for _, field := range fields {
+			if _, ok := fieldLabels[field]; !ok {
+				return nil, fmt.Errorf("house style rule %s: unknown field %q", h.ID, field)
+			}
+			rule := &Rule{
+				ID:       ruleID(field, "house-"+h.ID),
+				Severity: severity,
+				Field:    field,
+				Message:  fieldLabels[field] + ": " + h.Message,
+				Check:    matching(field, pattern),
+			}
+			if h.Replace != "" {
+				replace := h.Replace
+				rule.Fix = func(text string) string {
+					return typography.EachText(text, func(prev rune, t string) string {
+						return pattern.ReplaceAllString(t, replace)
+					})
+				}
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
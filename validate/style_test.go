@@ -0,0 +1,172 @@
+package validate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// flagged returns the text each problem covers, followed by its message if
+// it has its own.
+func flagged(text string, problems []Problem) []string {
+	runes := []rune(text)
+	out := []string{}
+	for _, p := range problems {
+		s := string(runes[p.Start:p.End])
+		if p.Message != "" {
+			s += ": " + p.Message
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func TestAPStyle(t *testing.T) {
+	body := func(name string) func(s *Story) []Problem {
+		checks := map[string]func(string) func(s *Story) []Problem{
+			"numerals": apNumerals,
+			"percent":  apPercent,
+			"states":   apStates,
+			"months":   apMonths,
+			"titles":   apTitles,
+			"time":     apTime,
+		}
+		if name == "oxford-comma" {
+			return matchingGroup(FieldBodyText, oxfordCommaPattern, nil)
+		}
+		return checks[name](FieldBodyText)
+	}
+	tests := []struct {
+		rule string
+		text string
+		want []string
+	}{
+		{"numerals", "She has 3 cats.", []string{`3: Spell out "3" as "three".`}},
+		{"numerals", "He scored 12 points.", []string{}},
+		{"numerals", "Room 5, No. 3, age 7, page 2", []string{}},
+		{"numerals", "It costs $5 and 3.5 liters at 7:30 on 3/4.", []string{}},
+		{"numerals", "It grew 5 percent to 4 million.", []string{}},
+		{"numerals", "on Oct. 4", []string{}},
+		{"numerals", `<a href="/2">link</a>`, []string{}},
+
+		{"percent", "up 5%", []string{"%"}},
+		{"percent", "up 5 per cent", []string{`per cent: Use "percent", not "per cent".`}},
+		{"percent", "up 5 percent", []string{}},
+
+		{"states", "Troy, NY", []string{`NY: Use "N.Y.", not "NY".`}},
+		{"states", "Austin, TX", []string{`TX: Use "Texas", not "TX".`}},
+		{"states", "Troy, N.Y.", []string{}},
+		{"states", "Smith, RPI", []string{}},
+
+		{"months", "on January 5", []string{`January 5: Abbreviate January as "Jan." with a date.`}},
+		{"months", "on Jan. 5", []string{}},
+		{"months", "in Jan. next year", []string{`Jan.: Spell out January without a date.`}},
+		{"months", "on Mar. 5", []string{`Mar. 5: Don't abbreviate March.`}},
+		{"months", "on Sep. 5", []string{`Sep. 5: Abbreviate September as "Sept.".`}},
+		{"months", "on March 5th", []string{`March 5th: Write dates without "th".`}},
+		{"months", "in March", []string{}},
+		{"months", "Jan Smith", []string{}},
+
+		{"titles", "said Governor Hochul", []string{`Governor: Abbreviate "Governor" as "Gov." before a name.`}},
+		{"titles", "said Prof. Smith", []string{`Prof.: Don't abbreviate "professor."`}},
+		{"titles", "said Professor Smith", []string{`Professor: Lowercase "professor" before a name.`}},
+		{"titles", "Professor Smith said", []string{}},
+		{"titles", "said Mr. Smith", []string{`Mr.: Leave out courtesy titles like "Mr.".`}},
+		{"titles", "said president Jackson", []string{`president: Capitalize "president" directly before a name.`}},
+		{"titles", "Dean Smith said", []string{}},
+
+		{"time", "at 7 PM", []string{`7 PM: Write "7 p.m.", not "7 PM".`}},
+		{"time", "at 7:00 p.m.", []string{`7:00 p.m.: Write "7 p.m.", not "7:00 p.m.".`}},
+		{"time", "at 12 p.m.", []string{`12 p.m.: Write "noon", not "12 p.m.".`}},
+		{"time", "at 12 noon", []string{`12 noon: Write "noon", not "12 noon".`}},
+		{"time", "at 7:30 a.m.", []string{}},
+		{"time", "It starts at 7 p.m.", []string{}},
+
+		{"oxford-comma", "red, white, and blue", []string{","}},
+		{"oxford-comma", "red, white and blue", []string{}},
+	}
+	for _, test := range tests {
+		got := flagged(test.text, body(test.rule)(&Story{BodyText: test.text}))
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s in %q: got %q, want %q", test.rule, test.text, got, test.want)
+		}
+	}
+}
+
+func TestAPStyleFields(t *testing.T) {
+	v, err := New(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// headlines use figures and % signs, but body text doesn't
+	text := "Tuition up 5% for 3 years"
+	rules := map[string]bool{}
+	for _, f := range v.Validate(&Story{Headline: text, BodyText: text}) {
+		rules[f.Rule] = true
+	}
+	for rule, want := range map[string]bool{
+		"headline-ap-numerals":  false,
+		"headline-ap-percent":   false,
+		"body-text-ap-numerals": true,
+		"body-text-ap-percent":  true,
+	} {
+		if rules[rule] != want {
+			t.Errorf("%s found: %v, want %v", rule, rules[rule], want)
+		}
+	}
+}
+
+func TestLoadHouseStyle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "house")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "house.json")
+	err = ioutil.WriteFile(path, []byte(`[
+		{"id": "email", "pattern": "\\b([Ee])-mail", "replace": "${1}mail", "message": "no hyphen."},
+		{"id": "rpi", "pattern": "Rensselaer Polytechnic Institute", "message": "use RPI.", "fields": ["headline"], "severity": "error"}
+	]`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := LoadHouseStyle(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byID := map[string]*Rule{}
+	for _, rule := range rules {
+		byID[rule.ID] = rule
+	}
+	if len(rules) != len(houseFields)+1 {
+		t.Errorf("got %d rules, want %d", len(rules), len(houseFields)+1)
+	}
+	email := byID["body-text-house-email"]
+	if email == nil || email.Fix == nil || email.Severity != Warning {
+		t.Fatalf("body-text-house-email is %+v", email)
+	}
+	if got := email.Fix("Send an E-mail or e-mail"); got != "Send an Email or email" {
+		t.Errorf("fixed to %q", got)
+	}
+	rpi := byID["headline-house-rpi"]
+	if rpi == nil || rpi.Fix != nil || rpi.Severity != Error {
+		t.Fatalf("headline-house-rpi is %+v", rpi)
+	}
+	if byID["body-text-house-rpi"] != nil {
+		t.Error("rpi applies outside its fields")
+	}
+
+	for _, bad := range []string{
+		`[{"id": "x"}]`,
+		`[{"id": "x", "pattern": "("}]`,
+		`[{"id": "x", "pattern": "a", "fields": ["nope"]}]`,
+	} {
+		ioutil.WriteFile(path, []byte(bad), 0644)
+		if _, err := LoadHouseStyle(path); err == nil {
+			t.Errorf("%s loaded without an error", bad)
+		}
+	}
+}
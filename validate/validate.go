@@ -0,0 +1,498 @@
+// Package validate checks stories against a registry of rules before they're
+// posted. The same rules are used by the server and the command line.
+//
+// Each rule has a severity. Only errors stop a story from being posted;
+// warnings and info are there to catch the copy desk's eye.
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/thepoly/uploader/kicker"
+	"github.com/thepoly/uploader/spell"
+	"github.com/thepoly/uploader/staff"
+)
+
+type Severity int
+
+const (
+	Info Severity = iota
+	Warning
+	Error
+)
+
+var severityNames = []string{"info", "warning", "error"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity parses the name of a severity.
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if n == name {
+			return Severity(i), nil
+		}
+	}
+	return Info, fmt.Errorf("unknown severity %q", name)
+}
+
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *Severity) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	var err error
+	*s, err = ParseSeverity(name)
+	return err
+}
+
+// Fields of a story that rules can apply to.
+const (
+	FieldKicker       = "kicker"
+	FieldHeadline     = "headline"
+	FieldSubdeck      = "subdeck"
+	FieldAuthorName   = "authorName"
+	FieldAuthorTitle  = "authorTitle"
+	FieldBodyText     = "bodyText"
+	FieldPhoto        = "photo"
+	FieldPhotoByline  = "photoByline"
+	FieldPhotoCaption = "photoCaption"
+	FieldSlug         = "slug"
+	FieldExcerpt      = "excerpt"
+)
+
+type PhotoStatus int
+
+const (
+	// PhotoUnknown means nobody looked for the photo, so photo rules are skipped.
+	PhotoUnknown PhotoStatus = iota
+	PhotoNone
+	PhotoFound
+	PhotoMissing
+)
+
+// Story is what rules check. The story and upload packages each fill one in
+// from their own representation of a story.
+type Story struct {
+	Kicker       string
+	Headline     string
+	Subdeck      string
+	AuthorName   string
+	AuthorTitle  string
+	BodyText     string
+	PhotoByline  string
+	PhotoCaption string
+	Photo        PhotoStatus
+	// PhotoError says why the photo is missing.
+	PhotoError string
+	// Slug and Excerpt are what the post will have, whether an editor
+	// wrote them or they were generated.
+	Slug    string
+	Excerpt string
+}
+
+// Field returns the text of one of the Field constants.
+func (s *Story) Field(name string) string {
+	switch name {
+	case FieldKicker:
+		return s.Kicker
+	case FieldHeadline:
+		return s.Headline
+	case FieldSubdeck:
+		return s.Subdeck
+	case FieldAuthorName:
+		return s.AuthorName
+	case FieldAuthorTitle:
+		return s.AuthorTitle
+	case FieldBodyText:
+		return s.BodyText
+	case FieldPhotoByline:
+		return s.PhotoByline
+	case FieldPhotoCaption:
+		return s.PhotoCaption
+	case FieldSlug:
+		return s.Slug
+	case FieldExcerpt:
+		return s.Excerpt
+	}
+	return ""
+}
+
+// SetField sets the text of one of the Field constants.
+func (s *Story) SetField(name, text string) {
+	switch name {
+	case FieldKicker:
+		s.Kicker = text
+	case FieldHeadline:
+		s.Headline = text
+	case FieldSubdeck:
+		s.Subdeck = text
+	case FieldAuthorName:
+		s.AuthorName = text
+	case FieldAuthorTitle:
+		s.AuthorTitle = text
+	case FieldBodyText:
+		s.BodyText = text
+	case FieldPhotoByline:
+		s.PhotoByline = text
+	case FieldPhotoCaption:
+		s.PhotoCaption = text
+	case FieldSlug:
+		s.Slug = text
+	case FieldExcerpt:
+		s.Excerpt = text
+	}
+}
+
+// TextFields are the fields that hold text.
+var TextFields = []string{
+	FieldKicker,
+	FieldHeadline,
+	FieldSubdeck,
+	FieldAuthorName,
+	FieldAuthorTitle,
+	FieldBodyText,
+	FieldPhotoByline,
+	FieldPhotoCaption,
+	FieldExcerpt,
+}
+
+// Section is the section a story belongs to, which decides the rules
+// applied to it. Kickers double as section labels.
+func (s *Story) Section() string {
+	return strings.ToLower(strings.TrimSpace(s.Kicker))
+}
+
+// Rule is one check made on a story.
+type Rule struct {
+	ID       string
+	Severity Severity
+	// Field is the field the rule looks at.
+	Field string
+	// Message describes the problem, for rules that don't say anything more specific.
+	Message string
+	// Check returns each problem it finds in s.
+	Check func(s *Story) []Problem
+	// Fix, if set, returns the text of Field with the problems fixed.
+	Fix func(text string) string
+	// Disabled rules only run in sections that enable them.
+	Disabled bool
+}
+
+// Problem is something wrong found by a rule's Check, in the rule's field.
+type Problem struct {
+	// Message replaces the rule's Message if it isn't empty.
+	Message string
+	// Start and End are the rune offsets of the problem in the field's text.
+	Start, End int
+	// Suggestions are possible replacements for the problem text.
+	Suggestions []string
+}
+
+// Finding is a problem found by a rule.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Field    string   `json:"field"`
+	Message  string   `json:"message"`
+	// Start and End are the rune offsets of the problem in the field's text.
+	// They're both zero when there is no text, e.g. for a missing field.
+	Start int `json:"start"`
+	End   int `json:"end"`
+	// Fixable is set if the rule can fix the problem itself.
+	Fixable     bool     `json:"fixable"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+var registry = map[string]*Rule{}
+
+// Register adds a rule to the registry. It panics if a rule with the same ID
+// is already registered.
+func Register(r *Rule) {
+	if _, ok := registry[r.ID]; ok {
+		panic("validate: rule " + r.ID + " registered twice")
+	}
+	registry[r.ID] = r
+}
+
+// Rules returns every registered rule, sorted by ID.
+func Rules() []*Rule {
+	rules := []*Rule{}
+	for _, r := range registry {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// RuleSettings turn rules on or off.
+type RuleSettings struct {
+	Enable  []string `json:"enable"`
+	Disable []string `json:"disable"`
+}
+
+// Config says which rules apply to which sections. Default applies to every
+// story; Sections then adjusts it for stories with a particular kicker.
+type Config struct {
+	Default  RuleSettings            `json:"default"`
+	Sections map[string]RuleSettings `json:"sections"`
+	// HouseStyle is a JSON file of the paper's own style rules, which are
+	// added to the registered ones. See LoadHouseStyle.
+	HouseStyle string `json:"houseStyle"`
+	// Dictionary is a file of words for the spell checker to accept, on
+	// top of the bundled dictionaries. Words added through the server are
+	// saved there.
+	Dictionary string `json:"dictionary"`
+	// Staff is the staff directory file that bylines are checked against.
+	// See the staff package.
+	Staff string `json:"staff"`
+	// Kickers are the kickers stories may have. Any kicker is allowed if
+	// there are none.
+	Kickers []kicker.Kicker `json:"kickers"`
+}
+
+type Validator struct {
+	config     Config
+	rules      []*Rule
+	dictionary *spell.Dictionary
+	staff      *staff.Directory
+	kickers    *kicker.Taxonomy
+}
+
+func New(c Config) (*Validator, error) {
+	rules := Rules()
+	if c.HouseStyle != "" {
+		house, err := LoadHouseStyle(c.HouseStyle)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, house...)
+	}
+	dictionary, checker, err := newChecker(c.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	rules = append(rules, spellingRules(checker)...)
+	var directory *staff.Directory
+	if c.Staff != "" {
+		if directory, err = staff.Load(c.Staff); err != nil {
+			return nil, err
+		}
+	}
+	rules = append(rules, staffRules(directory)...)
+	kickers, err := kicker.New(c.Kickers)
+	if err != nil {
+		return nil, err
+	}
+	rules = append(rules, kickerRules(kickers)...)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	known := map[string]bool{}
+	for _, r := range rules {
+		if known[r.ID] {
+			return nil, fmt.Errorf("validation rule %q defined twice", r.ID)
+		}
+		known[r.ID] = true
+	}
+
+	check := func(ids []string) error {
+		for _, id := range ids {
+			if !known[id] {
+				return fmt.Errorf("unknown validation rule %q", id)
+			}
+		}
+		return nil
+	}
+	sections := map[string]RuleSettings{}
+	settings := []RuleSettings{c.Default}
+	for name, s := range c.Sections {
+		sections[strings.ToLower(name)] = s
+		settings = append(settings, s)
+	}
+	for _, s := range settings {
+		if err := check(s.Enable); err != nil {
+			return nil, err
+		}
+		if err := check(s.Disable); err != nil {
+			return nil, err
+		}
+	}
+	c.Sections = sections
+	return &Validator{
+		config:     c,
+		rules:      rules,
+		dictionary: dictionary,
+		staff:      directory,
+		kickers:    kickers,
+	}, nil
+}
+
+// Kickers returns the allowed kickers.
+func (v *Validator) Kickers() *kicker.Taxonomy {
+	return v.kickers
+}
+
+// section is the section of s, going by the name of its kicker rather
+// than an alias, so that aliases get the same rules.
+func (v *Validator) section(s *Story) string {
+	if k := v.kickers.Find(s.Kicker); k != nil {
+		return strings.ToLower(k.Name)
+	}
+	return s.Section()
+}
+
+// Staff returns the staff directory, or nil if there isn't one.
+func (v *Validator) Staff() *staff.Directory {
+	return v.staff
+}
+
+// Dictionary returns the dictionary file's words, which editors can add to.
+func (v *Validator) Dictionary() *spell.Dictionary {
+	return v.dictionary
+}
+
+// Enabled reports whether rule applies to stories in section.
+func (v *Validator) Enabled(rule *Rule, section string) bool {
+	enabled := !rule.Disabled
+	apply := func(s RuleSettings) {
+		if contains(s.Enable, rule.ID) {
+			enabled = true
+		}
+		if contains(s.Disable, rule.ID) {
+			enabled = false
+		}
+	}
+	apply(v.config.Default)
+	if s, ok := v.config.Sections[section]; ok {
+		apply(s)
+	}
+	return enabled
+}
+
+// Validate runs every enabled rule on s.
+func (v *Validator) Validate(s *Story) []Finding {
+	findings := []Finding{}
+	section := v.section(s)
+	for _, rule := range v.rules {
+		if !v.Enabled(rule, section) {
+			continue
+		}
+		for _, p := range rule.Check(s) {
+			msg := p.Message
+			if msg == "" {
+				msg = rule.Message
+			}
+			findings = append(findings, Finding{
+				Rule:        rule.ID,
+				Severity:    rule.Severity,
+				Field:       rule.Field,
+				Message:     msg,
+				Start:       p.Start,
+				End:         p.End,
+				Fixable:     rule.Fix != nil,
+				Suggestions: p.Suggestions,
+			})
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+	return findings
+}
+
+// Excerpt returns the part of text around the finding, at most width runes
+// long, and a line of the same length with carets under the problem.
+func (f Finding) Excerpt(text string, width int) (string, string) {
+	runes := []rune(text)
+	start, end := clamp(f.Start, len(runes)), clamp(f.End, len(runes))
+	if end == start {
+		end = start + 1
+	}
+
+	// center the problem in the excerpt
+	from := start - (width-(end-start))/2
+	if from < 0 {
+		from = 0
+	}
+	to := from + width
+	if to > len(runes) {
+		to = len(runes)
+	}
+	if to-from < width {
+		from = to - width
+		if from < 0 {
+			from = 0
+		}
+	}
+
+	// a caret can point just past the end of the text, e.g. at a missing period
+	limit := to
+	if start >= to {
+		limit = start + 1
+	}
+	excerpt := make([]rune, 0, to-from)
+	carets := make([]rune, 0, limit-from)
+	for i := from; i < limit; i++ {
+		if i < to {
+			r := runes[i]
+			// keep the carets lined up
+			if r == '\n' || r == '\t' {
+				r = ' '
+			}
+			excerpt = append(excerpt, r)
+		}
+		if i >= start && i < end {
+			carets = append(carets, '^')
+		} else {
+			carets = append(carets, ' ')
+		}
+	}
+	return string(excerpt), strings.TrimRight(string(carets), " ")
+}
+
+func clamp(i, max int) int {
+	if i < 0 {
+		return 0
+	}
+	if i > max {
+		return max
+	}
+	return i
+}
+
+// Worst returns the highest severity of findings, and false if there are none.
+func Worst(findings []Finding) (Severity, bool) {
+	worst := Info
+	for _, f := range findings {
+		if f.Severity > worst {
+			worst = f.Severity
+		}
+	}
+	return worst, len(findings) > 0
+}
+
+// Blocking reports whether any of findings should stop a story being posted.
+func Blocking(findings []Finding) bool {
+	worst, ok := Worst(findings)
+	return ok && worst >= Error
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
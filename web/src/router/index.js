@@ -2,6 +2,7 @@ import Vue from 'vue'
 import Router from 'vue-router'
 import StoryEditor from '@/components/StoryEditor'
 import StoryList from '@/components/StoryList'
+import Login from '@/components/Login'
 
 Vue.use(Router)
 
@@ -18,6 +19,11 @@ export default new Router({
       name: 'StoryEditor',
       component: StoryEditor,
       props: true
+    },
+    {
+      path: '/login',
+      name: 'Login',
+      component: Login
     }
   ]
 })
@@ -0,0 +1,269 @@
+// Package wordpress makes requests to the WordPress REST API. Requests that
+// fail because the site is busy or unreachable are retried after a jittered
+// backoff, and requests are spaced out so a batch of uploads doesn't
+// overwhelm the site.
+package wordpress
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config says how requests are made.
+type Config struct {
+	// Timeout is how long one attempt at a request may take, like "10s".
+	Timeout string `json:"timeout"`
+	// Retries is how many more times a request is tried after it fails
+	// because the site is busy or unreachable.
+	Retries int `json:"retries"`
+	// RequestsPerSecond is the most requests made each second, or 0 for no
+	// limit.
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+}
+
+// Default is used when the config doesn't say otherwise.
+var Default = Config{
+	Timeout:           "10s",
+	Retries:           4,
+	RequestsPerSecond: 2,
+}
+
+// the first retry waits about firstBackoff, and each one after that twice as
+// long as the one before, up to maxBackoff
+const (
+	firstBackoff = 500 * time.Millisecond
+	maxBackoff   = 30 * time.Second
+)
+
+type Client struct {
+	apiRoot  string
+	username string
+	password string
+	http     *http.Client
+	retries  int
+	interval time.Duration
+
+	// when the next request may be made, and the source of backoff jitter
+	m    sync.Mutex
+	next time.Time
+	rand *rand.Rand
+	// sleep waits between attempts; it's replaced in tests
+	sleep func(time.Duration)
+
+	// Retrying, if set, is called before a request is retried with why it
+	// failed and how long until it's tried again.
+	Retrying func(err error, wait time.Duration)
+}
+
+// New returns a client for the API at apiRoot, which logs in as username
+// with an application password.
+func New(apiRoot, username, password string, c Config) (*Client, error) {
+	timeout, err := time.ParseDuration(c.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("bad WordPress request timeout %q: %v", c.Timeout, err)
+	}
+	if c.Retries < 0 {
+		return nil, fmt.Errorf("bad number of WordPress retries %d", c.Retries)
+	}
+	client := &Client{
+		apiRoot:  apiRoot,
+		username: username,
+		password: password,
+		http:     &http.Client{Timeout: timeout},
+		retries:  c.Retries,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		sleep:    time.Sleep,
+	}
+	if c.RequestsPerSecond > 0 {
+		client.interval = time.Duration(float64(time.Second) / c.RequestsPerSecond)
+	}
+	return client, nil
+}
+
+// Request is a request to the wp/v2 API.
+type Request struct {
+	Method string
+	// Path is relative to wp/v2, like "/posts/12".
+	Path  string
+	Query url.Values
+	// Body is sent as JSON, unless it's nil.
+	Body interface{}
+	// Done, if set, is called before retrying the request after a failure
+	// that might have happened after the site acted on it, like a timeout
+	// or a 502 from a proxy. It reports whether the request took effect
+	// after all, in which case it isn't retried. Requests that create
+	// something use it to look for what they made, so that trying again
+	// can't make two.
+	Done func() (bool, error)
+}
+
+// Error is a response from WordPress saying a request failed.
+type Error struct {
+	StatusCode int
+	Status     string
+	// Code and Message are from the body of the response, if it's a
+	// WordPress error.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("WordPress returned %s: %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("WordPress returned %s", e.Status)
+}
+
+// parseError is a response that succeeded but isn't the JSON expected. It
+// isn't retried, since the site has already acted on the request.
+type parseError struct {
+	err error
+}
+
+func (e *parseError) Error() string {
+	return fmt.Sprintf("unable to parse WordPress response: %v", e.err)
+}
+
+// Temporary reports whether the request might work if it's tried again.
+func (e *Error) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// Do makes a request and decodes the JSON response into out, unless out is
+// nil. Network errors, 429s and 5xx responses are retried, unless the
+// response asks to wait longer than maxBackoff; other responses outside 2xx
+// are returned as an *Error, and responses that can't be parsed aren't
+// retried either. If r.Done reports that the request took effect,
+// Do returns nil and out is left as it was.
+func (c *Client) Do(r Request, out interface{}) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = json.Marshal(r.Body); err != nil {
+			return err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := c.try(r, body, out)
+		if err == nil {
+			return nil
+		}
+		apiErr, isAPIErr := err.(*Error)
+		_, isParseErr := err.(*parseError)
+		if isAPIErr && !apiErr.Temporary() || isParseErr || attempt >= c.retries || retryAfter > maxBackoff {
+			return err
+		}
+		// the site is only certain not to have acted on requests it turned
+		// away for being too busy
+		if r.Done != nil && !(isAPIErr && apiErr.StatusCode == http.StatusTooManyRequests) {
+			done, checkErr := r.Done()
+			if checkErr != nil {
+				return fmt.Errorf("%v, and unable to check whether it worked anyway: %v", err, checkErr)
+			}
+			if done {
+				return nil
+			}
+		}
+		wait := c.backoff(attempt)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		if c.Retrying != nil {
+			c.Retrying(err, wait)
+		}
+		c.sleep(wait)
+	}
+}
+
+// try makes one attempt at a request. If the response says when to try
+// again, that's returned too.
+func (c *Client) try(r Request, body []byte, out interface{}) (time.Duration, error) {
+	u := c.apiRoot + "/wp/v2" + r.Path
+	if len(r.Query) > 0 {
+		u += "?" + r.Query.Encode()
+	}
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(r.Method, u, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.SetBasicAuth(c.username, c.password)
+
+	c.wait()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode, Status: resp.Status}
+		json.Unmarshal(data, apiErr)
+		return retryAfter(resp), apiErr
+	}
+	if out == nil {
+		return 0, nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return 0, &parseError{err}
+	}
+	return 0, nil
+}
+
+// wait blocks until the next request may be made under the rate limit.
+func (c *Client) wait() {
+	if c.interval == 0 {
+		return
+	}
+	c.m.Lock()
+	now := time.Now()
+	if c.next.Before(now) {
+		c.next = now
+	}
+	at := c.next
+	c.next = c.next.Add(c.interval)
+	c.m.Unlock()
+	time.Sleep(at.Sub(now))
+}
+
+// backoff is how long to wait before retry number attempt+1: somewhere
+// between half and all of an exponentially growing delay, so that clients
+// that failed together don't all try again together.
+func (c *Client) backoff(attempt int) time.Duration {
+	d := maxBackoff
+	if attempt < 16 {
+		if exp := firstBackoff << uint(attempt); exp < maxBackoff {
+			d = exp
+		}
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	return d/2 + time.Duration(c.rand.Int63n(int64(d/2)))
+}
+
+// retryAfter is how long resp says to wait before trying again, or 0.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,212 @@
+package wordpress
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// reply is one response from the test server: a status, an optional
+// Retry-After in seconds, and a body.
+type reply struct {
+	status     int
+	retryAfter int
+	body       string
+}
+
+// server answers each request with the next reply, and the last one after
+// they run out. It returns a client that doesn't sleep between attempts, how
+// many requests were made, and a function to close the server.
+func server(t *testing.T, replies ...reply) (*Client, *int, func()) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if user, pass, _ := req.BasicAuth(); user != "editor" || pass != "secret" {
+			t.Errorf("logged in as %q, %q", user, pass)
+		}
+		if !strings.HasPrefix(req.URL.Path, "/wp-json/wp/v2/") {
+			t.Errorf("requested %s", req.URL.Path)
+		}
+		r := replies[len(replies)-1]
+		if requests < len(replies) {
+			r = replies[requests]
+		}
+		requests++
+		if r.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(r.retryAfter))
+		}
+		w.WriteHeader(r.status)
+		fmt.Fprint(w, r.body)
+	}))
+	client, err := New(ts.URL+"/wp-json", "editor", "secret", Config{Timeout: "1s", Retries: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.sleep = func(time.Duration) {}
+	return client, &requests, ts.Close
+}
+
+func TestDo(t *testing.T) {
+	busy := reply{status: 503, body: `{"code":"busy","message":"Try later"}`}
+	ok := reply{status: 200, body: `{"id":7}`}
+	tests := []struct {
+		name         string
+		replies      []reply
+		wantRequests int
+		wantID       int
+		wantStatus   int
+		wantErr      string
+	}{
+		{"ok", []reply{ok}, 1, 7, 0, ""},
+		{"retried", []reply{busy, busy, ok}, 3, 7, 0, ""},
+		{"out of retries", []reply{busy}, 4, 0, 503, "WordPress returned 503 Service Unavailable: Try later"},
+		{"too many requests", []reply{{status: 429, retryAfter: 2}, ok}, 2, 7, 0, ""},
+		{"long Retry-After", []reply{{status: 429, retryAfter: 3600}, ok}, 1, 0, 429, ""},
+		{"not found", []reply{{status: 404, body: `{"code":"rest_post_invalid_id","message":"Invalid post ID."}`}}, 1, 0, 404,
+			"WordPress returned 404 Not Found: Invalid post ID."},
+		{"unauthorized", []reply{{status: 401, body: "no"}, ok}, 1, 0, 401, "WordPress returned 401 Unauthorized"},
+		{"bad JSON", []reply{{status: 200, body: "<html>"}}, 1, 0, 0, "unable to parse WordPress response"},
+	}
+	for _, test := range tests {
+		client, requests, close := server(t, test.replies...)
+		out := struct {
+			ID int `json:"id"`
+		}{}
+		err := client.Do(Request{Method: "GET", Path: "/posts/7"}, &out)
+		close()
+
+		if *requests != test.wantRequests {
+			t.Errorf("%s: made %d requests, want %d", test.name, *requests, test.wantRequests)
+		}
+		if out.ID != test.wantID {
+			t.Errorf("%s: got post %d, want %d", test.name, out.ID, test.wantID)
+		}
+		status := 0
+		if apiErr, ok := err.(*Error); ok {
+			status = apiErr.StatusCode
+		}
+		if status != test.wantStatus {
+			t.Errorf("%s: got error %v, want status %d", test.name, err, test.wantStatus)
+		}
+		if test.wantErr != "" && (err == nil || !strings.HasPrefix(err.Error(), test.wantErr)) {
+			t.Errorf("%s: got error %v, want %q", test.name, err, test.wantErr)
+		}
+		if test.wantErr == "" && test.wantStatus == 0 && err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+	}
+}
+
+func TestDoWaits(t *testing.T) {
+	client, _, close := server(t, reply{status: 500}, reply{status: 429, retryAfter: 20}, reply{status: 502}, reply{status: 201})
+	defer close()
+	waits := []time.Duration{}
+	client.sleep = func(d time.Duration) {
+		waits = append(waits, d)
+	}
+	if err := client.Do(Request{Method: "POST", Path: "/posts", Body: map[string]string{"title": "x"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(waits) != 3 {
+		t.Fatalf("waited %v, want 3 waits", waits)
+	}
+	// backoff doubles from firstBackoff, jittered down by up to half
+	if waits[0] < firstBackoff/2 || waits[0] > firstBackoff {
+		t.Errorf("first wait is %v", waits[0])
+	}
+	if waits[1] != 20*time.Second {
+		t.Errorf("waited %v after Retry-After: 20", waits[1])
+	}
+	if waits[2] < 2*firstBackoff || waits[2] > 4*firstBackoff {
+		t.Errorf("third wait is %v", waits[2])
+	}
+}
+
+func TestDoDone(t *testing.T) {
+	tests := []struct {
+		name         string
+		replies      []reply
+		done         bool
+		doneErr      error
+		wantRequests int
+		wantChecks   int
+		wantErr      bool
+	}{
+		// a 502 might have come after the post was made
+		{"made anyway", []reply{{status: 502}, {status: 201}}, true, nil, 1, 1, false},
+		{"not made", []reply{{status: 502}, {status: 201}}, false, nil, 2, 1, false},
+		{"unable to check", []reply{{status: 502}, {status: 201}}, false, fmt.Errorf("offline"), 1, 1, true},
+		// a 429 means the site didn't act, so there's nothing to look for
+		{"too busy", []reply{{status: 429}, {status: 201}}, true, nil, 2, 0, false},
+		// nor is there after the last attempt
+		{"out of retries", []reply{{status: 502}}, false, nil, 4, 3, true},
+	}
+	for _, test := range tests {
+		client, requests, close := server(t, test.replies...)
+		checks := 0
+		err := client.Do(Request{
+			Method: "POST",
+			Path:   "/posts",
+			Body:   map[string]string{"title": "x"},
+			Done: func() (bool, error) {
+				checks++
+				return test.done, test.doneErr
+			},
+		}, nil)
+		close()
+
+		if *requests != test.wantRequests || checks != test.wantChecks {
+			t.Errorf("%s: made %d requests and %d checks, want %d and %d",
+				test.name, *requests, checks, test.wantRequests, test.wantChecks)
+		}
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+	}
+}
+
+func TestDoBody(t *testing.T) {
+	got := map[string]string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("sent %q", req.Header.Get("Content-Type"))
+		}
+		if req.URL.Query().Get("meta_key") != "SourceID" {
+			t.Errorf("query is %q", req.URL.RawQuery)
+		}
+		json.NewDecoder(req.Body).Decode(&got)
+		w.WriteHeader(201)
+	}))
+	defer ts.Close()
+	client, err := New(ts.URL, "editor", "secret", Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.Do(Request{
+		Method: "POST",
+		Path:   "/posts",
+		Query:  map[string][]string{"meta_key": {"SourceID"}},
+		Body:   map[string]string{"title": "Council votes"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["title"] != "Council votes" {
+		t.Errorf("sent %v", got)
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, c := range []Config{
+		{Timeout: "soon", Retries: 1},
+		{Timeout: "1s", Retries: -1},
+	} {
+		if _, err := New("http://localhost", "u", "p", c); err == nil {
+			t.Errorf("%+v made a client", c)
+		}
+	}
+}